@@ -2,6 +2,7 @@ package platform
 
 import (
 	"context"
+	"time"
 )
 
 var (
@@ -10,6 +11,12 @@ var (
 		Msg:  "unable to create token",
 		Code: EInvalid,
 	}
+
+	// ErrAuthorizationExpired is the error for a token used after its expiration time.
+	ErrAuthorizationExpired = &Error{
+		Msg:  "authorization has expired",
+		Code: EForbidden,
+	}
 )
 
 // Authorization is an authorization. 🎉
@@ -21,18 +28,28 @@ type Authorization struct {
 	OrgID       ID           `json:"orgID"`
 	UserID      ID           `json:"userID"`
 	Permissions []Permission `json:"permissions"`
+
+	// ExpiresAt is the time the token stops being valid. The token does not expire when it is nil.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// LastUsedAt is the last time the token authenticated a request.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
 }
 
-// Allowed returns true if the authorization is active and request permission
+// Allowed returns true if the authorization is active, unexpired and request permission
 // exists in the authorization's list of permissions.
 func (a *Authorization) Allowed(p Permission) bool {
-	if !a.IsActive() {
+	if !a.IsActive() || a.IsExpired(time.Now()) {
 		return false
 	}
 
 	return PermissionAllowed(p, a.Permissions)
 }
 
+// IsExpired returns true if the authorization has an expiration time at or before t.
+func (a *Authorization) IsExpired(t time.Time) bool {
+	return a.ExpiresAt != nil && !a.ExpiresAt.After(t)
+}
+
 // IsActive is a stub for idpe.
 func IsActive(a *Authorization) bool {
 	return a.IsActive()
@@ -62,6 +79,8 @@ const (
 	OpCreateAuthorization      = "CreateAuthorization"
 	OpSetAuthorizationStatus   = "SetAuthorizationStatus"
 	OpDeleteAuthorization      = "DeleteAuthorization"
+	OpRotateAuthorization      = "RotateAuthorization"
+	OpSetAuthorizationLastUsed = "SetAuthorizationLastUsed"
 )
 
 // AuthorizationService represents a service for managing authorization data.
@@ -83,6 +102,13 @@ type AuthorizationService interface {
 	// for setting an authorization to inactive or active.
 	SetAuthorizationStatus(ctx context.Context, id ID, status Status) error
 
+	// RotateAuthorization replaces the token of the authorization with a newly generated one,
+	// keeping its permissions and status. The new token expires at expiresAt, or never if expiresAt is nil.
+	RotateAuthorization(ctx context.Context, id ID, expiresAt *time.Time) (*Authorization, error)
+
+	// SetAuthorizationLastUsed records the last time the authorization's token was used.
+	SetAuthorizationLastUsed(ctx context.Context, id ID, at time.Time) error
+
 	// Removes a authorization by token.
 	DeleteAuthorization(ctx context.Context, id ID) error
 }
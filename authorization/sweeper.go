@@ -0,0 +1,78 @@
+// Package authorization contains helpers for storing and maintaining authorizations.
+package authorization
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/platform"
+	"go.uber.org/zap"
+)
+
+// DefaultSweepInterval is how often a Sweeper looks for expired authorizations by default.
+const DefaultSweepInterval = time.Minute
+
+// Sweeper periodically deactivates authorizations whose tokens have expired.
+//
+// Expired tokens are already refused when authenticating requests;
+// deactivating them makes their state visible to anyone listing authorizations.
+type Sweeper struct {
+	AuthorizationService platform.AuthorizationService
+	Interval             time.Duration
+	Logger               *zap.Logger
+}
+
+// NewSweeper returns a Sweeper that deactivates the expired authorizations of svc every interval.
+func NewSweeper(svc platform.AuthorizationService, interval time.Duration, logger *zap.Logger) *Sweeper {
+	return &Sweeper{
+		AuthorizationService: svc,
+		Interval:             interval,
+		Logger:               logger,
+	}
+}
+
+// Run sweeps expired authorizations every Interval until ctx is done.
+func (s *Sweeper) Run(ctx context.Context) error {
+	t := time.NewTicker(s.Interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			n, err := s.Sweep(ctx)
+			if err != nil {
+				s.Logger.Error("Failed to sweep expired authorizations", zap.Error(err))
+				continue
+			}
+			if n > 0 {
+				s.Logger.Info("Deactivated expired authorizations", zap.Int("count", n))
+			}
+		}
+	}
+}
+
+// Sweep deactivates every active authorization that has expired,
+// and returns the number of authorizations it deactivated.
+func (s *Sweeper) Sweep(ctx context.Context) (int, error) {
+	as, _, err := s.AuthorizationService.FindAuthorizations(ctx, platform.AuthorizationFilter{})
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	var n int
+	for _, a := range as {
+		if !a.IsActive() || !a.IsExpired(now) {
+			continue
+		}
+
+		if err := s.AuthorizationService.SetAuthorizationStatus(ctx, a.ID, platform.Inactive); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, nil
+}
@@ -0,0 +1,98 @@
+package authorization_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorization"
+	"github.com/influxdata/platform/inmem"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSweeper_Sweep(t *testing.T) {
+	ctx := context.Background()
+	svc := inmem.NewService()
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	auths := []*platform.Authorization{
+		{ID: 1, Token: "expired", Status: platform.Active, ExpiresAt: &past},
+		{ID: 2, Token: "unexpired", Status: platform.Active, ExpiresAt: &future},
+		{ID: 3, Token: "forever", Status: platform.Active},
+		{ID: 4, Token: "expired-inactive", Status: platform.Inactive, ExpiresAt: &past},
+	}
+	for _, a := range auths {
+		if err := svc.PutAuthorization(ctx, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := authorization.NewSweeper(svc, time.Minute, zaptest.NewLogger(t))
+	n, err := s.Sweep(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 authorization to be deactivated, got %d", n)
+	}
+
+	exp := map[platform.ID]platform.Status{
+		1: platform.Inactive,
+		2: platform.Active,
+		3: platform.Active,
+		4: platform.Inactive,
+	}
+	for id, status := range exp {
+		a, err := svc.FindAuthorizationByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Status != status {
+			t.Errorf("expected authorization %s to be %s, got %s", id, status, a.Status)
+		}
+	}
+
+	// A second sweep has nothing left to do.
+	if n, err := s.Sweep(ctx); err != nil || n != 0 {
+		t.Fatalf("expected second sweep to deactivate nothing, got %d, %v", n, err)
+	}
+}
+
+func TestSweeper_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc := inmem.NewService()
+
+	past := time.Now().Add(-time.Hour)
+	if err := svc.PutAuthorization(ctx, &platform.Authorization{ID: 1, Token: "expired", Status: platform.Active, ExpiresAt: &past}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := authorization.NewSweeper(svc, 10*time.Millisecond, zaptest.NewLogger(t))
+	done := make(chan error)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		a, err := svc.FindAuthorizationByID(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Status == platform.Inactive {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expired authorization was not deactivated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,60 @@
+package authorization
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// TokenPrefixLength is the number of leading characters of a token that are kept
+// in the clear so that the stored hash of the token can be found again.
+const TokenPrefixLength = 8
+
+const tokenSaltLength = 16
+
+// HashedToken is the form in which an authorization token is stored.
+//
+// Tokens are long random strings, so a salted SHA-256 is enough to make a stolen
+// copy of the store useless, while staying cheap enough to check on every request.
+type HashedToken struct {
+	Prefix string `json:"prefix"`
+	Salt   []byte `json:"salt"`
+	Hash   []byte `json:"hash"`
+}
+
+// HashToken returns the salted hash of token.
+func HashToken(token string) (*HashedToken, error) {
+	salt := make([]byte, tokenSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return &HashedToken{
+		Prefix: TokenPrefix(token),
+		Salt:   salt,
+		Hash:   hashToken(salt, token),
+	}, nil
+}
+
+// Matches reports whether token is the token that was hashed into h.
+func (h *HashedToken) Matches(token string) bool {
+	if h == nil || TokenPrefix(token) != h.Prefix {
+		return false
+	}
+	return subtle.ConstantTimeCompare(h.Hash, hashToken(h.Salt, token)) == 1
+}
+
+// TokenPrefix returns the part of token used to look up its hash.
+func TokenPrefix(token string) string {
+	if len(token) > TokenPrefixLength {
+		return token[:TokenPrefixLength]
+	}
+	return token
+}
+
+func hashToken(salt []byte, token string) []byte {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(token))
+	return h.Sum(nil)
+}
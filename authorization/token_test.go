@@ -0,0 +1,61 @@
+package authorization_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/influxdata/platform/authorization"
+)
+
+func TestHashToken(t *testing.T) {
+	const token = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	h1, err := authorization.HashToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := authorization.HashToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if h1.Prefix != "01234567" {
+		t.Errorf("unexpected prefix %q", h1.Prefix)
+	}
+	if bytes.Contains(h1.Hash, []byte(token)) {
+		t.Error("hash contains the token")
+	}
+	if bytes.Equal(h1.Salt, h2.Salt) || bytes.Equal(h1.Hash, h2.Hash) {
+		t.Error("hashing the same token twice should use different salts")
+	}
+
+	for _, h := range []*authorization.HashedToken{h1, h2} {
+		if !h.Matches(token) {
+			t.Error("hash does not match its token")
+		}
+	}
+
+	for _, other := range []string{"", "01234567", token + "0", "1" + token[1:]} {
+		if h1.Matches(other) {
+			t.Errorf("hash matches %q", other)
+		}
+	}
+}
+
+func TestTokenPrefix(t *testing.T) {
+	tests := []struct {
+		token string
+		want  string
+	}{
+		{token: "", want: ""},
+		{token: "rand1", want: "rand1"},
+		{token: "01234567", want: "01234567"},
+		{token: "0123456789", want: "01234567"},
+	}
+
+	for _, tt := range tests {
+		if got := authorization.TokenPrefix(tt.token); got != tt.want {
+			t.Errorf("TokenPrefix(%q) = %q, want %q", tt.token, got, tt.want)
+		}
+	}
+}
@@ -29,31 +29,54 @@ type Authorizer interface {
 	Kind() string
 }
 
-// PermissionAllowed
+// PermissionAllowed returns true if any of the permissions in ps grants p.
+//
+// A permission in ps grants p when they have the same action and resource,
+// and the permission's organization and ID either match those of p or are unset.
+// An unset organization or ID acts as a wildcard for any organization or any ID,
+// so, for example, a permission to read buckets in an organization grants reading
+// every bucket of that organization. An organization belongs to itself, so p is
+// scoped to the organization it names when it is on an organization without an OrgID.
 func PermissionAllowed(p Permission, ps []Permission) bool {
-	pID := ID(0)
-	if p.ID != nil {
-		pID = *p.ID
-		if !pID.Valid() {
-			return false
-		}
+	if p.ID != nil && !p.ID.Valid() {
+		return false
+	}
+	if p.OrgID != nil && !p.OrgID.Valid() {
+		return false
+	}
+	if p.Resource == OrgsResource && p.OrgID == nil {
+		p.OrgID = p.ID
 	}
 
 	for _, perm := range ps {
-		permID := ID(0)
-		if perm.ID != nil {
-			permID = *perm.ID
-			if !permID.Valid() {
-				return false
-			}
+		if perm.ID != nil && !perm.ID.Valid() {
+			return false
 		}
-		if perm.Action == p.Action && perm.Resource == p.Resource && permID == pID {
-			return true
+		if perm.OrgID != nil && !perm.OrgID.Valid() {
+			return false
+		}
+		if perm.Action != p.Action || perm.Resource != p.Resource {
+			continue
+		}
+		if !wildcardIDMatches(perm.OrgID, p.OrgID) {
+			continue
 		}
+		if !wildcardIDMatches(perm.ID, p.ID) {
+			continue
+		}
+		return true
 	}
 	return false
 }
 
+// wildcardIDMatches returns true if the granted ID is unset, or is set and equal to the requested ID.
+func wildcardIDMatches(granted, requested *ID) bool {
+	if granted == nil {
+		return true
+	}
+	return requested != nil && *granted == *requested
+}
+
 // Action is an enum defining all possible resource operations
 type Action string
 
@@ -62,11 +85,16 @@ const (
 	ReadAction Action = "read" // 1
 	// WriteAction is the action for writing.
 	WriteAction Action = "write" // 2
+	// DeleteAction is the action for deleting.
+	DeleteAction Action = "delete" // 3
+	// ExecuteAction is the action for running; it only applies to tasks.
+	ExecuteAction Action = "execute" // 4
 )
 
 var actions = []Action{
-	ReadAction,  // 1
-	WriteAction, // 2
+	ReadAction,   // 1
+	WriteAction,  // 2
+	DeleteAction, // 3
 }
 
 // Valid checks if the action is a member of the Action enum
@@ -74,6 +102,8 @@ func (a Action) Valid() (err error) {
 	switch a {
 	case ReadAction: // 1
 	case WriteAction: // 2
+	case DeleteAction: // 3
+	case ExecuteAction: // 4
 	default:
 		err = ErrInvalidAction
 	}
@@ -143,15 +173,30 @@ func (r Resource) Valid() (err error) {
 	return err
 }
 
+// resourceActions returns the actions that apply to resources of type r.
+func resourceActions(r Resource) []Action {
+	if r == TasksResource {
+		return append(actions[:len(actions):len(actions)], ExecuteAction)
+	}
+	return actions
+}
+
 // Permission defines an action and a resource.
+// OrgID scopes the permission to the resources of an organization, and ID to a single resource.
+// Leaving either unset grants the action on any organization or any resource respectively.
 type Permission struct {
 	Action   Action   `json:"action"`
 	Resource Resource `json:"resource"`
+	OrgID    *ID      `json:"orgID,omitempty"`
 	ID       *ID      `json:"id,omitempty"`
 }
 
 func (p Permission) String() string {
-	str := fmt.Sprintf("%s:%s", p.Action, p.Resource)
+	str := fmt.Sprintf("%s:", p.Action)
+	if p.OrgID != nil {
+		str += fmt.Sprintf("orgs/%s/", (*p.OrgID).String())
+	}
+	str += string(p.Resource)
 	if p.ID != nil {
 		str += fmt.Sprintf(":%s", (*p.ID).String())
 	}
@@ -177,6 +222,14 @@ func (p *Permission) Valid() error {
 		}
 	}
 
+	if p.Action == ExecuteAction && p.Resource != TasksResource {
+		return &Error{
+			Code: EInvalid,
+			Err:  ErrInvalidAction,
+			Msg:  "execute permission only applies to tasks",
+		}
+	}
+
 	if p.ID != nil && !(*p.ID).Valid() {
 		return &Error{
 			Code: EInvalid,
@@ -185,6 +238,14 @@ func (p *Permission) Valid() error {
 		}
 	}
 
+	if p.OrgID != nil && !(*p.OrgID).Valid() {
+		return &Error{
+			Code: EInvalid,
+			Err:  ErrInvalidID,
+			Msg:  "invalid org id for permission",
+		}
+	}
+
 	return nil
 }
 
@@ -209,11 +270,34 @@ func NewPermissionAtID(id ID, a Action, r Resource) (*Permission, error) {
 	return p, p.Valid()
 }
 
+// NewOrgPermission returns a permission on every resource of type r in the organization.
+func NewOrgPermission(orgID ID, a Action, r Resource) (*Permission, error) {
+	p := &Permission{
+		Action:   a,
+		Resource: r,
+		OrgID:    &orgID,
+	}
+
+	return p, p.Valid()
+}
+
+// NewOrgPermissionAtID returns a permission on the resource with the provided ID in the organization.
+func NewOrgPermissionAtID(orgID, id ID, a Action, r Resource) (*Permission, error) {
+	p := &Permission{
+		Action:   a,
+		Resource: r,
+		OrgID:    &orgID,
+		ID:       &id,
+	}
+
+	return p, p.Valid()
+}
+
 // OperPermissions are the default permissions for those who setup the application.
 func OperPermissions() []Permission {
 	ps := []Permission{}
 	for _, r := range AllResources {
-		for _, a := range actions {
+		for _, a := range resourceActions(r) {
 			ps = append(ps, Permission{Action: a, Resource: r})
 		}
 	}
@@ -225,8 +309,8 @@ func OperPermissions() []Permission {
 func OrgAdminPermissions(orgID ID) []Permission {
 	ps := []Permission{}
 	for _, r := range OrgResources {
-		for _, a := range actions {
-			ps = append(ps, Permission{ID: &orgID, Action: a, Resource: r})
+		for _, a := range resourceActions(r) {
+			ps = append(ps, Permission{OrgID: &orgID, Action: a, Resource: r})
 		}
 	}
 
@@ -237,7 +321,7 @@ func OrgAdminPermissions(orgID ID) []Permission {
 func OrgMemberPermissions(orgID ID) []Permission {
 	ps := []Permission{}
 	for _, r := range OrgResources {
-		ps = append(ps, Permission{ID: &orgID, Action: ReadAction, Resource: r})
+		ps = append(ps, Permission{OrgID: &orgID, Action: ReadAction, Resource: r})
 	}
 
 	return ps
@@ -123,6 +123,166 @@ func TestAuthorizer_PermissionAllowed(t *testing.T) {
 			},
 			allowed: false,
 		},
+		{
+			name: "permission without ID grants any ID",
+			permission: platform.Permission{
+				Action:   platform.WriteAction,
+				Resource: platform.BucketsResource,
+				ID:       IDPtr(1),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.WriteAction,
+					Resource: platform.BucketsResource,
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "permission with ID does not grant all IDs",
+			permission: platform.Permission{
+				Action:   platform.WriteAction,
+				Resource: platform.BucketsResource,
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.WriteAction,
+					Resource: platform.BucketsResource,
+					ID:       IDPtr(1),
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "org permission grants resources of the org",
+			permission: platform.Permission{
+				Action:   platform.ReadAction,
+				Resource: platform.BucketsResource,
+				OrgID:    IDPtr(10),
+				ID:       IDPtr(1),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.ReadAction,
+					Resource: platform.BucketsResource,
+					OrgID:    IDPtr(10),
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "org permission does not grant resources of another org",
+			permission: platform.Permission{
+				Action:   platform.ReadAction,
+				Resource: platform.BucketsResource,
+				OrgID:    IDPtr(11),
+				ID:       IDPtr(1),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.ReadAction,
+					Resource: platform.BucketsResource,
+					OrgID:    IDPtr(10),
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "org permission does not grant resources of unknown org",
+			permission: platform.Permission{
+				Action:   platform.ReadAction,
+				Resource: platform.BucketsResource,
+				ID:       IDPtr(1),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.ReadAction,
+					Resource: platform.BucketsResource,
+					OrgID:    IDPtr(10),
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "permission without org grants resources of any org",
+			permission: platform.Permission{
+				Action:   platform.DeleteAction,
+				Resource: platform.BucketsResource,
+				OrgID:    IDPtr(10),
+				ID:       IDPtr(1),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.DeleteAction,
+					Resource: platform.BucketsResource,
+					ID:       IDPtr(1),
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "write does not grant delete",
+			permission: platform.Permission{
+				Action:   platform.DeleteAction,
+				Resource: platform.BucketsResource,
+				ID:       IDPtr(1),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.WriteAction,
+					Resource: platform.BucketsResource,
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "org-scoped grant on orgs matches the org without an org scope on the check",
+			permission: platform.Permission{
+				Action:   platform.WriteAction,
+				Resource: platform.OrgsResource,
+				ID:       IDPtr(10),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.WriteAction,
+					Resource: platform.OrgsResource,
+					OrgID:    IDPtr(10),
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "org-scoped grant on orgs does not match other orgs",
+			permission: platform.Permission{
+				Action:   platform.WriteAction,
+				Resource: platform.OrgsResource,
+				ID:       IDPtr(11),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.WriteAction,
+					Resource: platform.OrgsResource,
+					OrgID:    IDPtr(10),
+				},
+			},
+			allowed: false,
+		},
+		{
+			name: "bad org id in permissions",
+			permission: platform.Permission{
+				Action:   platform.ReadAction,
+				Resource: platform.BucketsResource,
+				OrgID:    IDPtr(10),
+			},
+			permissions: []platform.Permission{
+				{
+					Action:   platform.ReadAction,
+					Resource: platform.BucketsResource,
+					OrgID:    IDPtr(0),
+				},
+			},
+			allowed: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -139,6 +299,7 @@ func TestPermission_Valid(t *testing.T) {
 	type fields struct {
 		Action   platform.Action
 		Resource platform.Resource
+		OrgID    *platform.ID
 		ID       *platform.ID
 	}
 	tests := []struct {
@@ -146,6 +307,38 @@ func TestPermission_Valid(t *testing.T) {
 		fields  fields
 		wantErr bool
 	}{
+		{
+			name: "valid bucket permission with org ID",
+			fields: fields{
+				Action:   platform.ReadAction,
+				Resource: platform.BucketsResource,
+				OrgID:    validID(),
+			},
+		},
+		{
+			name: "invalid bucket permission with an invalid org ID",
+			fields: fields{
+				Action:   platform.ReadAction,
+				Resource: platform.BucketsResource,
+				OrgID:    func() *platform.ID { id := platform.InvalidID(); return &id }(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid task execute permission",
+			fields: fields{
+				Action:   platform.ExecuteAction,
+				Resource: platform.TasksResource,
+			},
+		},
+		{
+			name: "invalid bucket execute permission",
+			fields: fields{
+				Action:   platform.ExecuteAction,
+				Resource: platform.BucketsResource,
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid bucket permission with ID",
 			fields: fields{
@@ -191,6 +384,7 @@ func TestPermission_Valid(t *testing.T) {
 			p := &platform.Permission{
 				Action:   tt.fields.Action,
 				Resource: tt.fields.Resource,
+				OrgID:    tt.fields.OrgID,
 				ID:       tt.fields.ID,
 			}
 			if err := p.Valid(); (err != nil) != tt.wantErr {
@@ -226,6 +420,8 @@ func TestPermissionAllActions(t *testing.T) {
 	var actions = []platform.Action{
 		platform.ReadAction,
 		platform.WriteAction,
+		platform.DeleteAction,
+		platform.ExecuteAction,
 	}
 
 	for _, a := range actions {
@@ -244,6 +440,7 @@ func TestPermission_String(t *testing.T) {
 	type fields struct {
 		Action   platform.Action
 		Resource platform.Resource
+		OrgID    *platform.ID
 		ID       *platform.ID
 		Name     *string
 	}
@@ -269,12 +466,23 @@ func TestPermission_String(t *testing.T) {
 			},
 			want: `write:buckets:0000000000000064`,
 		},
+		{
+			name: "valid permission with an org id",
+			fields: fields{
+				Action:   platform.DeleteAction,
+				Resource: platform.BucketsResource,
+				OrgID:    IDPtr(10),
+				ID:       validID(),
+			},
+			want: `delete:orgs/000000000000000a/buckets:0000000000000064`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := platform.Permission{
 				Action:   tt.fields.Action,
 				Resource: tt.fields.Resource,
+				OrgID:    tt.fields.OrgID,
 				ID:       tt.fields.ID,
 			}
 			if got := p.String(); got != tt.want {
@@ -0,0 +1,41 @@
+package platform
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// backup service ops
+const (
+	OpBackup  = "Backup"
+	OpRestore = "Restore"
+)
+
+// BackupService represents a service for taking and restoring online backups
+// of all data held by the platform.
+type BackupService interface {
+	// Backup writes an archive holding a consistent snapshot of the data
+	// matched by filter to w.
+	Backup(ctx context.Context, w io.Writer, filter BackupFilter) error
+
+	// Restore replaces the data held by an archive written by Backup with the
+	// contents of the archive.
+	Restore(ctx context.Context, r io.Reader) error
+}
+
+// BackupFilter represents a set of filters that restrict the data written by
+// a backup. The zero value matches all metadata and time series data.
+type BackupFilter struct {
+	// OrganizationID and BucketID limit the backup to the time series data
+	// of a single bucket. Bucket backups do not hold any metadata.
+	OrganizationID *ID
+	BucketID       *ID
+
+	// Since limits a bucket backup to the values written or deleted at or
+	// after it, regardless of their timestamps. It is usually the time the
+	// previous backup was started. Restoring such an incremental backup on
+	// top of an earlier backup of the bucket reproduces the bucket as of the
+	// later backup.
+	Since time.Time
+}
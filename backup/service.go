@@ -0,0 +1,296 @@
+// Package backup combines the platform metadata store and the storage engine
+// into a single archive that can be used to rebuild a node.
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/influxdata/platform"
+	ptar "github.com/influxdata/platform/pkg/tar"
+	"go.uber.org/zap"
+)
+
+const (
+	// KVFileName is the name of the archive entry holding the metadata store.
+	KVFileName = "influxd.bolt"
+
+	// ManifestFileName is the name of the archive entry describing a bucket
+	// backup. Full backups do not have a manifest.
+	ManifestFileName = "manifest.json"
+)
+
+// Manifest describes the contents of a bucket backup.
+type Manifest struct {
+	OrganizationID platform.ID `json:"orgID"`
+	BucketID       platform.ID `json:"bucketID"`
+	Since          time.Time   `json:"since"`
+}
+
+// KVStore is the metadata store of a node.
+type KVStore interface {
+	// Backup writes a consistent copy of the store file to w.
+	Backup(ctx context.Context, w io.Writer) error
+
+	// Verify checks that the store file at path can be restored.
+	Verify(ctx context.Context, path string) error
+
+	// Restore replaces the contents of the store with the store file at path,
+	// migrating it as needed.
+	Restore(ctx context.Context, path string) error
+}
+
+// Invalidator discards state derived from restored data, such as cached
+// query results.
+type Invalidator interface {
+	// Invalidate discards the state derived from the buckets with bucketIDs.
+	Invalidate(bucketIDs ...platform.ID)
+
+	// InvalidateAll discards all derived state.
+	InvalidateAll()
+}
+
+// Engine is the time series storage engine of a node.
+type Engine interface {
+	// Backup writes a consistent snapshot of the engine's files to tw.
+	Backup(ctx context.Context, tw *tar.Writer) error
+
+	// Restore replaces the engine's files with those in dir.
+	Restore(ctx context.Context, dir string) error
+
+	// BackupBucket writes the data of a bucket written or deleted at or
+	// after since to tw.
+	BackupBucket(ctx context.Context, tw *tar.Writer, orgID, bucketID platform.ID, since time.Time) error
+
+	// RestoreBucket applies the data of a bucket backup taken since since
+	// in dir to the bucket.
+	RestoreBucket(ctx context.Context, orgID, bucketID platform.ID, since time.Time, dir string) error
+}
+
+var _ platform.BackupService = (*Service)(nil)
+
+// Service implements platform.BackupService by pairing a KVStore and an
+// Engine into one tar archive.
+type Service struct {
+	Logger *zap.Logger
+
+	KVStore KVStore
+	Engine  Engine
+
+	// Invalidator, if set, is notified of the data replaced by a restore.
+	Invalidator Invalidator
+
+	// Path is the directory archives are extracted into during a restore. It
+	// should be on the same device as the engine's data so that files can be
+	// moved rather than copied. The system temporary directory is used if
+	// Path is empty.
+	Path string
+}
+
+// NewService returns a new instance of Service.
+func NewService(kv KVStore, engine Engine) *Service {
+	return &Service{
+		Logger:  zap.NewNop(),
+		KVStore: kv,
+		Engine:  engine,
+	}
+}
+
+// Backup writes a tar archive of the metadata store and the storage engine to
+// w, or of a single bucket if the filter has a bucket ID.
+func (s *Service) Backup(ctx context.Context, w io.Writer, filter platform.BackupFilter) error {
+	tw := tar.NewWriter(w)
+
+	if filter.BucketID != nil {
+		if err := s.backupBucket(ctx, tw, filter); err != nil {
+			return err
+		}
+		return tw.Close()
+	}
+
+	if err := s.backupKVStore(ctx, tw); err != nil {
+		return &platform.Error{
+			Op:  platform.OpBackup,
+			Msg: "unable to back up metadata",
+			Err: err,
+		}
+	}
+
+	if err := s.Engine.Backup(ctx, tw); err != nil {
+		return &platform.Error{
+			Op:  platform.OpBackup,
+			Msg: "unable to back up storage engine",
+			Err: err,
+		}
+	}
+
+	return tw.Close()
+}
+
+// backupBucket writes a manifest followed by the bucket's data to tw.
+func (s *Service) backupBucket(ctx context.Context, tw *tar.Writer, filter platform.BackupFilter) error {
+	if filter.OrganizationID == nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpBackup,
+			Msg:  "organization id is required to back up a bucket",
+		}
+	}
+
+	m := Manifest{
+		OrganizationID: *filter.OrganizationID,
+		BucketID:       *filter.BucketID,
+		Since:          filter.Since,
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     ManifestFileName,
+		Mode:     0666,
+		Size:     int64(len(b)),
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	} else if _, err := tw.Write(b); err != nil {
+		return err
+	}
+
+	if err := s.Engine.BackupBucket(ctx, tw, m.OrganizationID, m.BucketID, m.Since); err != nil {
+		return &platform.Error{
+			Op:  platform.OpBackup,
+			Msg: "unable to back up bucket",
+			Err: err,
+		}
+	}
+	return nil
+}
+
+// backupKVStore writes a copy of the metadata store to tw. The copy is spooled
+// to a temporary file first as the size of an entry must be known before it
+// is written.
+func (s *Service) backupKVStore(ctx context.Context, tw *tar.Writer) error {
+	f, err := ioutil.TempFile("", "influxd-backup")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := s.KVStore.Backup(ctx, f); err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return ptar.StreamFile(fi, KVFileName, f.Name(), tw)
+}
+
+// Restore extracts the archive read from r and replaces the contents of the
+// metadata store and storage engine with it. Bucket backups only replace the
+// data of their bucket.
+//
+// The archive is fully extracted and validated before any existing data is
+// replaced. The storage engine is restored before the metadata store, which
+// is swapped in last within a single transaction, so that a failed restore
+// never leaves metadata referring to data that was not restored.
+func (s *Service) Restore(ctx context.Context, r io.Reader) error {
+	if s.Path != "" {
+		if err := os.MkdirAll(s.Path, 0777); err != nil {
+			return err
+		}
+	}
+
+	dir, err := ioutil.TempDir(s.Path, "restore")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	// The engine takes ownership of the files extracted into dataDir, so the
+	// metadata store is extracted next to it.
+	dataDir := filepath.Join(dir, "data")
+	if err := ptar.Restore(r, dataDir); err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpRestore,
+			Msg:  "unable to read backup archive",
+			Err:  err,
+		}
+	}
+
+	if m, err := readManifest(dataDir); err != nil {
+		return err
+	} else if m != nil {
+		s.Logger.Info("Restoring bucket",
+			zap.Stringer("org_id", m.OrganizationID),
+			zap.Stringer("bucket_id", m.BucketID),
+			zap.Time("since", m.Since))
+		err := s.Engine.RestoreBucket(ctx, m.OrganizationID, m.BucketID, m.Since, dataDir)
+		if s.Invalidator != nil {
+			s.Invalidator.Invalidate(m.BucketID)
+		}
+		return err
+	}
+
+	kvPath := filepath.Join(dir, KVFileName)
+	if err := os.Rename(filepath.Join(dataDir, KVFileName), kvPath); os.IsNotExist(err) {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpRestore,
+			Msg:  "backup archive does not contain metadata",
+		}
+	} else if err != nil {
+		return err
+	}
+
+	if err := s.KVStore.Verify(ctx, kvPath); err != nil {
+		return err
+	}
+
+	s.Logger.Info("Restoring storage engine")
+	err = s.Engine.Restore(ctx, dataDir)
+	if s.Invalidator != nil {
+		defer s.Invalidator.InvalidateAll()
+	}
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("Restoring metadata")
+	return s.KVStore.Restore(ctx, kvPath)
+}
+
+// readManifest returns the manifest of the archive extracted into dir, or nil
+// if the archive is a full backup.
+func readManifest(dir string) (*Manifest, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, ManifestFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpRestore,
+			Msg:  "unable to read backup manifest",
+			Err:  err,
+		}
+	}
+	return &m, nil
+}
@@ -1,20 +1,44 @@
 package bolt
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"reflect"
+	"time"
 
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorization"
+	"go.uber.org/zap"
 )
 
 var (
 	authorizationBucket = []byte("authorizationsv1")
-	authorizationIndex  = []byte("authorizationindexv1")
+	authorizationIndex  = []byte("authorizationindexv2")
+
+	// plaintextAuthorizationIndex indexed authorizations by their token,
+	// before tokens were stored hashed.
+	plaintextAuthorizationIndex = []byte("authorizationindexv1")
+
+	// authorizationActionsBucket marks that the permissions of authorizations
+	// have been migrated to the delete and execute actions.
+	authorizationActionsBucket = []byte("authorizationactionsv1")
 )
 
 var _ platform.AuthorizationService = (*Client)(nil)
 
+// authorizationRecord is the stored form of an authorization.
+// The token itself is never stored, only a salted hash of it.
+type authorizationRecord struct {
+	*platform.Authorization
+
+	// Token shadows the token of the embedded authorization so that it is not encoded.
+	// It is only set when decoding authorizations written before tokens were hashed.
+	Token     string                     `json:"token,omitempty"`
+	TokenHash *authorization.HashedToken `json:"tokenHash,omitempty"`
+}
+
 func (c *Client) initializeAuthorizations(ctx context.Context, tx *bolt.Tx) error {
 	if _, err := tx.CreateBucketIfNotExists([]byte(authorizationBucket)); err != nil {
 		return err
@@ -22,9 +46,166 @@ func (c *Client) initializeAuthorizations(ctx context.Context, tx *bolt.Tx) erro
 	if _, err := tx.CreateBucketIfNotExists([]byte(authorizationIndex)); err != nil {
 		return err
 	}
+	if err := c.migrateAuthorizationTokens(ctx, tx); err != nil {
+		return err
+	}
+	if err := c.migrateOrgPermissions(ctx, tx); err != nil {
+		return err
+	}
+	return c.migrateAuthorizationActions(ctx, tx)
+}
+
+// migrateAuthorizationTokens replaces the plaintext tokens of authorizations
+// written by earlier versions with hashes, and drops the plaintext token index.
+func (c *Client) migrateAuthorizationTokens(ctx context.Context, tx *bolt.Tx) error {
+	if tx.Bucket(plaintextAuthorizationIndex) == nil {
+		return nil
+	}
+
+	var as []*platform.Authorization
+	cur := tx.Bucket(authorizationBucket).Cursor()
+	for k, v := cur.First(); k != nil; k, v = cur.Next() {
+		r, err := decodeAuthorizationRecord(v)
+		if err != nil {
+			return err
+		}
+		if r.Token == "" {
+			continue
+		}
+		r.Authorization.Token = r.Token
+		as = append(as, r.Authorization)
+	}
+
+	for _, a := range as {
+		if pe := c.putAuthorization(ctx, tx, a); pe != nil {
+			return pe
+		}
+	}
+
+	if err := tx.DeleteBucket(plaintextAuthorizationIndex); err != nil {
+		return err
+	}
+
+	c.Logger.Info("Migrated authorization tokens to hashed storage", zap.Int("count", len(as)))
 	return nil
 }
 
+// migrateOrgPermissions rewrites the organization-wide permissions of
+// authorizations written by earlier versions, which held the organization's ID
+// as the permission's ID, to be scoped with the permission's OrgID instead.
+func (c *Client) migrateOrgPermissions(ctx context.Context, tx *bolt.Tx) error {
+	var as []*platform.Authorization
+	cur := tx.Bucket(authorizationBucket).Cursor()
+	for k, v := cur.First(); k != nil; k, v = cur.Next() {
+		r, err := decodeAuthorizationRecord(v)
+		if err != nil {
+			return err
+		}
+
+		migrated := false
+		for i, p := range r.Permissions {
+			if p.OrgID != nil || p.ID == nil || p.Resource == platform.OrgsResource || !isOrgResource(p.Resource) {
+				continue
+			}
+			encodedID, err := p.ID.Encode()
+			if err != nil {
+				continue
+			}
+			if tx.Bucket(organizationBucket).Get(encodedID) == nil {
+				// The permission is on a single resource.
+				continue
+			}
+			r.Permissions[i].OrgID, r.Permissions[i].ID = p.ID, nil
+			migrated = true
+		}
+		if migrated {
+			as = append(as, r.Authorization)
+		}
+	}
+
+	for _, a := range as {
+		if pe := c.putAuthorization(ctx, tx, a); pe != nil {
+			return pe
+		}
+	}
+
+	if len(as) > 0 {
+		c.Logger.Info("Migrated organization permissions of authorizations", zap.Int("count", len(as)))
+	}
+	return nil
+}
+
+// migrateAuthorizationActions grants the delete action alongside every write
+// permission of authorizations written before the delete and execute actions
+// existed, when writing a resource allowed deleting it, and the execute action
+// alongside every write permission on tasks, which allowed running them.
+func (c *Client) migrateAuthorizationActions(ctx context.Context, tx *bolt.Tx) error {
+	if tx.Bucket(authorizationActionsBucket) != nil {
+		return nil
+	}
+
+	var as []*platform.Authorization
+	cur := tx.Bucket(authorizationBucket).Cursor()
+	for k, v := cur.First(); k != nil; k, v = cur.Next() {
+		r, err := decodeAuthorizationRecord(v)
+		if err != nil {
+			return err
+		}
+
+		ps := r.Permissions
+		for _, p := range r.Permissions {
+			if p.Action != platform.WriteAction {
+				continue
+			}
+			p.Action = platform.DeleteAction
+			ps = appendPermission(ps, p)
+			if p.Resource == platform.TasksResource {
+				p.Action = platform.ExecuteAction
+				ps = appendPermission(ps, p)
+			}
+		}
+		if len(ps) > len(r.Permissions) {
+			r.Permissions = ps
+			as = append(as, r.Authorization)
+		}
+	}
+
+	for _, a := range as {
+		if pe := c.putAuthorization(ctx, tx, a); pe != nil {
+			return pe
+		}
+	}
+
+	if _, err := tx.CreateBucket(authorizationActionsBucket); err != nil {
+		return err
+	}
+
+	if len(as) > 0 {
+		c.Logger.Info("Migrated authorizations to the delete and execute actions", zap.Int("count", len(as)))
+	}
+	return nil
+}
+
+// appendPermission appends p to ps unless ps already holds it.
+func appendPermission(ps []platform.Permission, p platform.Permission) []platform.Permission {
+	for _, o := range ps {
+		if reflect.DeepEqual(o, p) {
+			return ps
+		}
+	}
+	return append(ps, p)
+}
+
+// isOrgResource returns true if resources of type r belong to an organization.
+func isOrgResource(r platform.Resource) bool {
+	for _, or := range platform.OrgResources {
+		if r == or {
+			return true
+		}
+	}
+	return false
+}
+
 // FindAuthorizationByID retrieves a authorization by id.
 func (c *Client) FindAuthorizationByID(ctx context.Context, id platform.ID) (*platform.Authorization, error) {
 	var a *platform.Authorization
@@ -43,6 +224,14 @@ func (c *Client) FindAuthorizationByID(ctx context.Context, id platform.ID) (*pl
 }
 
 func (c *Client) findAuthorizationByID(ctx context.Context, tx *bolt.Tx, id platform.ID) (*platform.Authorization, *platform.Error) {
+	r, pe := c.findAuthorizationRecord(ctx, tx, id)
+	if pe != nil {
+		return nil, pe
+	}
+	return r.Authorization, nil
+}
+
+func (c *Client) findAuthorizationRecord(ctx context.Context, tx *bolt.Tx, id platform.ID) (*authorizationRecord, *platform.Error) {
 	encodedID, err := id.Encode()
 	if err != nil {
 		return nil, &platform.Error{
@@ -51,7 +240,6 @@ func (c *Client) findAuthorizationByID(ctx context.Context, tx *bolt.Tx, id plat
 		}
 	}
 
-	var a platform.Authorization
 	v := tx.Bucket(authorizationBucket).Get(encodedID)
 
 	if len(v) == 0 {
@@ -61,17 +249,19 @@ func (c *Client) findAuthorizationByID(ctx context.Context, tx *bolt.Tx, id plat
 		}
 	}
 
-	if err := decodeAuthorization(v, &a); err != nil {
+	r, err := decodeAuthorizationRecord(v)
+	if err != nil {
 		return nil, &platform.Error{
 			Code: platform.EInvalid,
 			Err:  err,
 		}
 	}
 
-	return &a, nil
+	return r, nil
 }
 
 // FindAuthorizationByToken returns a authorization by token for a particular authorization.
+// Only the hash of the token is stored, so the token of the returned authorization is n.
 func (c *Client) FindAuthorizationByToken(ctx context.Context, n string) (*platform.Authorization, error) {
 	var a *platform.Authorization
 	var err error
@@ -89,21 +279,36 @@ func (c *Client) FindAuthorizationByToken(ctx context.Context, n string) (*platf
 }
 
 func (c *Client) findAuthorizationByToken(ctx context.Context, tx *bolt.Tx, n string) (*platform.Authorization, *platform.Error) {
-	a := tx.Bucket(authorizationIndex).Get(authorizationIndexKey(n))
-	if a == nil {
-		return nil, &platform.Error{
-			Code: platform.ENotFound,
-			Msg:  "authorization not found",
+	// Several tokens may share a prefix; the hash tells which one, if any, is n.
+	prefix := []byte(authorization.TokenPrefix(n))
+	cur := tx.Bucket(authorizationIndex).Cursor()
+	for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+		if len(k) != len(prefix)+platform.IDLength {
+			continue
+		}
+
+		var id platform.ID
+		if err := id.Decode(v); err != nil {
+			return nil, &platform.Error{
+				Code: platform.EInvalid,
+				Err:  err,
+			}
 		}
-	}
-	var id platform.ID
-	if err := id.Decode(a); err != nil {
-		return nil, &platform.Error{
-			Code: platform.EInvalid,
-			Err:  err,
+
+		r, pe := c.findAuthorizationRecord(ctx, tx, id)
+		if pe != nil {
+			return nil, pe
+		}
+		if r.TokenHash.Matches(n) {
+			r.Authorization.Token = n
+			return r.Authorization, nil
 		}
 	}
-	return c.findAuthorizationByID(ctx, tx, id)
+
+	return nil, &platform.Error{
+		Code: platform.ENotFound,
+		Msg:  "authorization not found",
+	}
 }
 
 func filterAuthorizationsFn(filter platform.AuthorizationFilter) func(a *platform.Authorization) bool {
@@ -113,12 +318,6 @@ func filterAuthorizationsFn(filter platform.AuthorizationFilter) func(a *platfor
 		}
 	}
 
-	if filter.Token != nil {
-		return func(a *platform.Authorization) bool {
-			return a.Token == *filter.Token
-		}
-	}
-
 	if filter.UserID != nil {
 		return func(a *platform.Authorization) bool {
 			return a.UserID == *filter.UserID
@@ -215,10 +414,6 @@ func (c *Client) CreateAuthorization(ctx context.Context, a *platform.Authorizat
 			return platform.ErrUnableToCreateToken
 		}
 
-		if unique := c.uniqueAuthorizationToken(ctx, tx, a); !unique {
-			return platform.ErrUnableToCreateToken
-		}
-
 		token, err := c.TokenGenerator.Token()
 		if err != nil {
 			return &platform.Error{
@@ -226,7 +421,11 @@ func (c *Client) CreateAuthorization(ctx context.Context, a *platform.Authorizat
 				Op:  op,
 			}
 		}
+		if unique := c.uniqueAuthorizationToken(ctx, tx, token); !unique {
+			return platform.ErrUnableToCreateToken
+		}
 		a.Token = token
+		a.LastUsedAt = nil
 
 		a.ID = c.IDGenerator.ID()
 
@@ -240,6 +439,7 @@ func (c *Client) CreateAuthorization(ctx context.Context, a *platform.Authorizat
 }
 
 // PutAuthorization will put a authorization without setting an ID.
+// If a has no token, the token of the stored authorization is kept.
 func (c *Client) PutAuthorization(ctx context.Context, a *platform.Authorization) (err error) {
 	return c.db.Update(func(tx *bolt.Tx) error {
 		pe := c.putAuthorization(ctx, tx, a)
@@ -250,11 +450,11 @@ func (c *Client) PutAuthorization(ctx context.Context, a *platform.Authorization
 	})
 }
 
-func encodeAuthorization(a *platform.Authorization) ([]byte, error) {
-	switch a.Status {
+func encodeAuthorization(r *authorizationRecord) ([]byte, error) {
+	switch r.Status {
 	case platform.Active, platform.Inactive:
 	case "":
-		a.Status = platform.Active
+		r.Status = platform.Active
 	default:
 		return nil, &platform.Error{
 			Code: platform.EInvalid,
@@ -262,30 +462,53 @@ func encodeAuthorization(a *platform.Authorization) ([]byte, error) {
 		}
 	}
 
-	return json.Marshal(a)
+	return json.Marshal(r)
 }
 
 func (c *Client) putAuthorization(ctx context.Context, tx *bolt.Tx, a *platform.Authorization) *platform.Error {
-	v, err := encodeAuthorization(a)
+	encodedID, err := a.ID.Encode()
 	if err != nil {
 		return &platform.Error{
-			Code: platform.EInvalid,
+			Code: platform.ENotFound,
 			Err:  err,
 		}
 	}
 
-	encodedID, err := a.ID.Encode()
+	r := &authorizationRecord{Authorization: a}
+	if prev, pe := c.findAuthorizationRecord(ctx, tx, a.ID); pe == nil && prev.TokenHash != nil {
+		r.TokenHash = prev.TokenHash
+		if a.Token != "" {
+			if err := tx.Bucket(authorizationIndex).Delete(authorizationIndexKey(prev.TokenHash, encodedID)); err != nil {
+				return &platform.Error{
+					Err: err,
+				}
+			}
+		}
+	}
+
+	if a.Token != "" {
+		if r.TokenHash, err = authorization.HashToken(a.Token); err != nil {
+			return &platform.Error{
+				Code: platform.EInternal,
+				Err:  err,
+			}
+		}
+	}
+
+	v, err := encodeAuthorization(r)
 	if err != nil {
 		return &platform.Error{
-			Code: platform.ENotFound,
+			Code: platform.EInvalid,
 			Err:  err,
 		}
 	}
 
-	if err := tx.Bucket(authorizationIndex).Put(authorizationIndexKey(a.Token), encodedID); err != nil {
-		return &platform.Error{
-			Code: platform.EInternal,
-			Err:  err,
+	if r.TokenHash != nil {
+		if err := tx.Bucket(authorizationIndex).Put(authorizationIndexKey(r.TokenHash, encodedID), encodedID); err != nil {
+			return &platform.Error{
+				Code: platform.EInternal,
+				Err:  err,
+			}
 		}
 	}
 
@@ -298,30 +521,33 @@ func (c *Client) putAuthorization(ctx context.Context, tx *bolt.Tx, a *platform.
 	return nil
 }
 
-func authorizationIndexKey(n string) []byte {
-	return []byte(n)
+// authorizationIndexKey is the prefix of the token followed by the encoded id of the authorization.
+func authorizationIndexKey(h *authorization.HashedToken, encodedID []byte) []byte {
+	k := make([]byte, 0, len(h.Prefix)+len(encodedID))
+	k = append(k, h.Prefix...)
+	return append(k, encodedID...)
 }
 
-func decodeAuthorization(b []byte, a *platform.Authorization) error {
-	if err := json.Unmarshal(b, a); err != nil {
-		return err
+func decodeAuthorizationRecord(b []byte) (*authorizationRecord, error) {
+	r := &authorizationRecord{Authorization: &platform.Authorization{}}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
 	}
-	if a.Status == "" {
-		a.Status = platform.Active
+	if r.Status == "" {
+		r.Status = platform.Active
 	}
-	return nil
+	return r, nil
 }
 
 // forEachAuthorization will iterate through all authorizations while fn returns true.
 func (c *Client) forEachAuthorization(ctx context.Context, tx *bolt.Tx, fn func(*platform.Authorization) bool) error {
 	cur := tx.Bucket(authorizationBucket).Cursor()
 	for k, v := cur.First(); k != nil; k, v = cur.Next() {
-		a := &platform.Authorization{}
-
-		if err := decodeAuthorization(v, a); err != nil {
+		r, err := decodeAuthorizationRecord(v)
+		if err != nil {
 			return err
 		}
-		if !fn(a) {
+		if !fn(r.Authorization) {
 			break
 		}
 	}
@@ -329,9 +555,9 @@ func (c *Client) forEachAuthorization(ctx context.Context, tx *bolt.Tx, fn func(
 	return nil
 }
 
-func (c *Client) uniqueAuthorizationToken(ctx context.Context, tx *bolt.Tx, a *platform.Authorization) bool {
-	v := tx.Bucket(authorizationIndex).Get(authorizationIndexKey(a.Token))
-	return len(v) == 0
+func (c *Client) uniqueAuthorizationToken(ctx context.Context, tx *bolt.Tx, token string) bool {
+	_, pe := c.findAuthorizationByToken(ctx, tx, token)
+	return pe != nil && pe.Code == platform.ENotFound
 }
 
 // DeleteAuthorization deletes a authorization and prunes it from the index.
@@ -348,21 +574,23 @@ func (c *Client) DeleteAuthorization(ctx context.Context, id platform.ID) error
 }
 
 func (c *Client) deleteAuthorization(ctx context.Context, tx *bolt.Tx, id platform.ID) *platform.Error {
-	a, pe := c.findAuthorizationByID(ctx, tx, id)
+	r, pe := c.findAuthorizationRecord(ctx, tx, id)
 	if pe != nil {
 		return pe
 	}
-	if err := tx.Bucket(authorizationIndex).Delete(authorizationIndexKey(a.Token)); err != nil {
-		return &platform.Error{
-			Err: err,
-		}
-	}
 	encodedID, err := id.Encode()
 	if err != nil {
 		return &platform.Error{
 			Err: err,
 		}
 	}
+	if r.TokenHash != nil {
+		if err := tx.Bucket(authorizationIndex).Delete(authorizationIndexKey(r.TokenHash, encodedID)); err != nil {
+			return &platform.Error{
+				Err: err,
+			}
+		}
+	}
 
 	if err := tx.Bucket(authorizationBucket).Delete(encodedID); err != nil {
 		return &platform.Error{
@@ -393,24 +621,71 @@ func (c *Client) updateAuthorization(ctx context.Context, tx *bolt.Tx, id platfo
 	}
 
 	a.Status = status
-	b, err := encodeAuthorization(a)
-	if err != nil {
-		return &platform.Error{
-			Err: err,
+	return c.putAuthorization(ctx, tx, a)
+}
+
+// RotateAuthorization replaces the token of the authorization with a newly generated one.
+func (c *Client) RotateAuthorization(ctx context.Context, id platform.ID, expiresAt *time.Time) (*platform.Authorization, error) {
+	var a *platform.Authorization
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		var pe *platform.Error
+		a, pe = c.rotateAuthorization(ctx, tx, id, expiresAt)
+		if pe != nil {
+			pe.Op = getOp(platform.OpRotateAuthorization)
+			return pe
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	encodedID, err := id.Encode()
+	return a, nil
+}
+
+func (c *Client) rotateAuthorization(ctx context.Context, tx *bolt.Tx, id platform.ID, expiresAt *time.Time) (*platform.Authorization, *platform.Error) {
+	a, pe := c.findAuthorizationByID(ctx, tx, id)
+	if pe != nil {
+		return nil, pe
+	}
+
+	token, err := c.TokenGenerator.Token()
 	if err != nil {
-		return &platform.Error{
+		return nil, &platform.Error{
 			Err: err,
 		}
 	}
-
-	if err = tx.Bucket(authorizationBucket).Put(encodedID, b); err != nil {
-		return &platform.Error{
-			Err: err,
+	if unique := c.uniqueAuthorizationToken(ctx, tx, token); !unique {
+		return nil, &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "generated token is not unique",
 		}
 	}
-	return nil
+
+	a.Token = token
+	a.ExpiresAt = expiresAt
+	a.LastUsedAt = nil
+	if pe := c.putAuthorization(ctx, tx, a); pe != nil {
+		return nil, pe
+	}
+
+	return a, nil
+}
+
+// SetAuthorizationLastUsed records the last time the authorization's token was used.
+func (c *Client) SetAuthorizationLastUsed(ctx context.Context, id platform.ID, at time.Time) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		a, pe := c.findAuthorizationByID(ctx, tx, id)
+		if pe != nil {
+			pe.Op = getOp(platform.OpSetAuthorizationLastUsed)
+			return pe
+		}
+
+		a.LastUsedAt = &at
+		if pe := c.putAuthorization(ctx, tx, a); pe != nil {
+			pe.Op = getOp(platform.OpSetAuthorizationLastUsed)
+			return pe
+		}
+		return nil
+	})
 }
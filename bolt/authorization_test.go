@@ -1,9 +1,13 @@
 package bolt_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"reflect"
 	"testing"
 
+	bbolt "github.com/coreos/bbolt"
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/bolt"
 	platformtesting "github.com/influxdata/platform/testing"
@@ -61,3 +65,297 @@ func initAuthorizationService(f platformtesting.AuthorizationFields, t *testing.
 func TestAuthorizationService(t *testing.T) {
 	platformtesting.AuthorizationService(initAuthorizationService, t)
 }
+
+func TestAuthorizationService_TokenNotStored(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	a := &platform.Authorization{
+		ID:     platformtesting.MustIDBase16("020f755c3c082000"),
+		UserID: platformtesting.MustIDBase16("020f755c3c082001"),
+		OrgID:  platformtesting.MustIDBase16("020f755c3c082002"),
+		Token:  "supersecrettoken",
+	}
+	if err := c.PutAuthorization(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.DB().View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			return b.ForEach(func(k, v []byte) error {
+				if bytes.Contains(k, []byte(a.Token)) || bytes.Contains(v, []byte(a.Token)) {
+					t.Errorf("token stored in the clear in bucket %s", name)
+				}
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := c.FindAuthorizationByToken(ctx, a.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.ID != a.ID || found.Token != a.Token {
+		t.Errorf("found authorization %v with token %q, want %v with token %q", found.ID, found.Token, a.ID, a.Token)
+	}
+
+	if _, err := c.FindAuthorizationByToken(ctx, "supersecret"); platform.ErrorCode(err) != platform.ENotFound {
+		t.Errorf("expected a token sharing the prefix not to be found, got %v", err)
+	}
+}
+
+func TestAuthorizationService_MigratePlaintextTokens(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	a := &platform.Authorization{
+		ID:     platformtesting.MustIDBase16("020f755c3c082000"),
+		UserID: platformtesting.MustIDBase16("020f755c3c082001"),
+		OrgID:  platformtesting.MustIDBase16("020f755c3c082002"),
+		Token:  "supersecrettoken",
+		Status: platform.Active,
+	}
+
+	// Write the authorization the way earlier versions did.
+	err = c.DB().Update(func(tx *bbolt.Tx) error {
+		encodedID, err := a.ID.Encode()
+		if err != nil {
+			return err
+		}
+		v, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket([]byte("authorizationsv1")).Put(encodedID, v); err != nil {
+			return err
+		}
+		idx, err := tx.CreateBucketIfNotExists([]byte("authorizationindexv1"))
+		if err != nil {
+			return err
+		}
+		return idx.Put([]byte(a.Token), encodedID)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := bolt.NewClient()
+	mc.Path = c.Path
+	if err := mc.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer mc.Close()
+
+	found, err := mc.FindAuthorizationByToken(ctx, a.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.ID != a.ID || found.UserID != a.UserID || found.OrgID != a.OrgID {
+		t.Errorf("migrated authorization is %+v, want %+v", found, a)
+	}
+
+	err = mc.DB().View(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte("authorizationindexv1")) != nil {
+			t.Error("plaintext token index was not removed")
+		}
+		encodedID, err := a.ID.Encode()
+		if err != nil {
+			return err
+		}
+		if v := tx.Bucket([]byte("authorizationsv1")).Get(encodedID); bytes.Contains(v, []byte(a.Token)) {
+			t.Error("token stored in the clear after migration")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuthorizationService_MigrateActions(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	orgID := platformtesting.MustIDBase16("020f755c3c082002")
+	bucketID := platformtesting.MustIDBase16("020f755c3c082003")
+
+	// The operator token created by onboarding, before the delete and execute actions existed.
+	var perms []platform.Permission
+	for _, r := range platform.AllResources {
+		perms = append(perms,
+			platform.Permission{Action: platform.ReadAction, Resource: r},
+			platform.Permission{Action: platform.WriteAction, Resource: r})
+	}
+	perms = append(perms,
+		platform.Permission{Action: platform.WriteAction, Resource: platform.BucketsResource, ID: &bucketID},
+		platform.Permission{Action: platform.ReadAction, Resource: platform.BucketsResource, ID: &bucketID},
+		platform.Permission{Action: platform.ReadAction, Resource: platform.TasksResource, OrgID: &orgID})
+
+	a := &platform.Authorization{
+		ID:          platformtesting.MustIDBase16("020f755c3c082000"),
+		UserID:      platformtesting.MustIDBase16("020f755c3c082001"),
+		OrgID:       orgID,
+		Status:      platform.Active,
+		Permissions: perms,
+	}
+
+	// Write the authorization the way earlier versions did, before the actions were migrated.
+	err = c.DB().Update(func(tx *bbolt.Tx) error {
+		encodedID, err := a.ID.Encode()
+		if err != nil {
+			return err
+		}
+		v, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket([]byte("authorizationsv1")).Put(encodedID, v); err != nil {
+			return err
+		}
+		return tx.DeleteBucket([]byte("authorizationactionsv1"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := bolt.NewClient()
+	mc.Path = c.Path
+	if err := mc.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer mc.Close()
+
+	found, err := mc.FindAuthorizationByID(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every operator permission, including those needed to restore backups, delete points and run tasks, must be allowed.
+	for _, p := range platform.OperPermissions() {
+		if !found.Allowed(p) {
+			t.Errorf("migrated authorization does not allow %v", p)
+		}
+	}
+
+	// Read permissions must not gain any action.
+	for _, p := range found.Permissions {
+		if p.OrgID != nil && p.Action != platform.ReadAction {
+			t.Errorf("migrated authorization was granted %v", p)
+		}
+	}
+	if got, exp := len(found.Permissions), len(perms)+len(platform.AllResources)+2; got != exp {
+		t.Errorf("migrated authorization has %d permissions, want %d", got, exp)
+	}
+
+	// The migration must only run once, so that write permissions created later do not gain delete.
+	if err := mc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := mc.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if again, err := mc.FindAuthorizationByID(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(again.Permissions, found.Permissions) {
+		t.Errorf("permissions changed by reopening: %v, want %v", again.Permissions, found.Permissions)
+	}
+}
+
+func TestAuthorizationService_MigrateOrgPermissions(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	org := &platform.Organization{Name: "o1"}
+	if err := c.CreateOrganization(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+
+	bucketID := platformtesting.MustIDBase16("020f755c3c082003")
+	a := &platform.Authorization{
+		ID:     platformtesting.MustIDBase16("020f755c3c082000"),
+		UserID: platformtesting.MustIDBase16("020f755c3c082001"),
+		OrgID:  org.ID,
+		Status: platform.Active,
+		Permissions: []platform.Permission{
+			{Action: platform.ReadAction, Resource: platform.BucketsResource, ID: &org.ID},
+			{Action: platform.WriteAction, Resource: platform.BucketsResource, ID: &bucketID},
+			{Action: platform.ReadAction, Resource: platform.OrgsResource, ID: &org.ID},
+		},
+	}
+
+	// Write the authorization the way earlier versions did, with the organization's ID as the ID of org-wide permissions.
+	err = c.DB().Update(func(tx *bbolt.Tx) error {
+		encodedID, err := a.ID.Encode()
+		if err != nil {
+			return err
+		}
+		v, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket([]byte("authorizationsv1")).Put(encodedID, v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := bolt.NewClient()
+	mc.Path = c.Path
+	if err := mc.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer mc.Close()
+
+	found, err := mc.FindAuthorizationByID(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []platform.Permission{
+		{Action: platform.ReadAction, Resource: platform.BucketsResource, OrgID: &org.ID},
+		{Action: platform.WriteAction, Resource: platform.BucketsResource, ID: &bucketID},
+		{Action: platform.ReadAction, Resource: platform.OrgsResource, ID: &org.ID},
+	}
+	if !reflect.DeepEqual(found.Permissions, exp) {
+		t.Errorf("migrated permissions are %v, want %v", found.Permissions, exp)
+	}
+
+	p, err := platform.NewOrgPermissionAtID(org.ID, bucketID, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found.Allowed(*p) {
+		t.Errorf("migrated authorization does not allow %v", p)
+	}
+}
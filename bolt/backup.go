@@ -0,0 +1,123 @@
+package bolt
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+// Backup writes a consistent copy of the bolt database to w.
+func (c *Client) Backup(ctx context.Context, w io.Writer) error {
+	return c.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// Verify checks that the file at path is a consistent bolt database that
+// can be restored.
+func (c *Client) Verify(ctx context.Context, path string) error {
+	src, err := openBackup(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return src.View(func(tx *bolt.Tx) error {
+		// The check must run to completion before the transaction closes.
+		var first error
+		for err := range tx.Check() {
+			if first == nil {
+				first = err
+			}
+		}
+		if first != nil {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Op:   getOp("Verify"),
+				Msg:  "bolt database from backup is corrupt",
+				Err:  first,
+			}
+		}
+		return nil
+	})
+}
+
+// Restore replaces the contents of the bolt database with the contents of the
+// database file at path. The replacement happens within a single transaction,
+// so readers observe either the old or the restored data. The restored data
+// is migrated as it would be when opening the database, so that backups taken
+// by earlier versions are usable immediately.
+func (c *Client) Restore(ctx context.Context, path string) error {
+	src, err := openBackup(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return src.View(func(stx *bolt.Tx) error {
+		return c.db.Update(func(tx *bolt.Tx) error {
+			var names [][]byte
+			if err := tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+				names = append(names, name)
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			for _, name := range names {
+				if err := tx.DeleteBucket(name); err != nil {
+					return err
+				}
+			}
+
+			if err := stx.ForEach(func(name []byte, sb *bolt.Bucket) error {
+				b, err := tx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, sb)
+			}); err != nil {
+				return err
+			}
+
+			return c.initializeTx(ctx, tx)
+		})
+	})
+}
+
+// openBackup opens the bolt database file at path read-only.
+func openBackup(path string) (*bolt.DB, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   getOp("Restore"),
+			Msg:  "unable to open bolt database from backup",
+			Err:  err,
+		}
+	}
+	return db, nil
+}
+
+// copyBucket recursively copies all keys and nested buckets of src into dst.
+func copyBucket(dst, src *bolt.Bucket) error {
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(k, v)
+		}
+
+		b, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+		return copyBucket(b, src.Bucket(k))
+	})
+}
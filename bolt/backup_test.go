@@ -0,0 +1,147 @@
+package bolt_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	bbolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func TestClient_BackupRestore(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	before := &platform.Organization{Name: "before"}
+	if err := c.CreateOrganization(ctx, before); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := ioutil.TempFile("", "influxdata-platform-bolt-backup-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := c.Backup(ctx, f); err != nil {
+		t.Fatalf("unable to back up database: %v", err)
+	} else if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	after := &platform.Organization{Name: "after"}
+	if err := c.CreateOrganization(ctx, after); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Restore(ctx, f.Name()); err != nil {
+		t.Fatalf("unable to restore database: %v", err)
+	}
+
+	if _, err := c.FindOrganizationByID(ctx, before.ID); err != nil {
+		t.Fatalf("expected organization created before backup to exist: %v", err)
+	}
+
+	if _, err := c.FindOrganizationByID(ctx, after.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("expected organization created after backup to be removed, got %v", err)
+	}
+
+	// The restored database must still be writable, including the indexes.
+	if err := c.CreateOrganization(ctx, &platform.Organization{Name: "after"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Restore_InvalidFile(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	f, err := ioutil.TempFile("", "influxdata-platform-bolt-backup-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("not a bolt database")
+	f.Close()
+
+	if err := c.Verify(context.Background(), f.Name()); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("expected invalid error from verify, got %v", err)
+	}
+	if err := c.Restore(context.Background(), f.Name()); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("expected invalid error, got %v", err)
+	}
+}
+
+func TestClient_Restore_MigratesPlaintextTokens(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	src, srcCloseFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer srcCloseFn()
+
+	ctx := context.Background()
+	a := &platform.Authorization{
+		ID:     platformtesting.MustIDBase16("020f755c3c082000"),
+		UserID: platformtesting.MustIDBase16("020f755c3c082001"),
+		OrgID:  platformtesting.MustIDBase16("020f755c3c082002"),
+		Token:  "supersecrettoken",
+		Status: platform.Active,
+	}
+
+	// Write the authorization the way versions before token hashing did.
+	err = src.DB().Update(func(tx *bbolt.Tx) error {
+		encodedID, err := a.ID.Encode()
+		if err != nil {
+			return err
+		}
+		v, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket([]byte("authorizationsv1")).Put(encodedID, v); err != nil {
+			return err
+		}
+		idx, err := tx.CreateBucketIfNotExists([]byte("authorizationindexv1"))
+		if err != nil {
+			return err
+		}
+		return idx.Put([]byte(a.Token), encodedID)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Restore(ctx, src.Path); err != nil {
+		t.Fatalf("unable to restore database: %v", err)
+	}
+
+	// The restored token must be usable without reopening the database.
+	found, err := c.FindAuthorizationByToken(ctx, a.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.ID != a.ID {
+		t.Errorf("found authorization %v, want %v", found.ID, a.ID)
+	}
+}
@@ -87,89 +87,106 @@ func (c *Client) Open(ctx context.Context) error {
 
 // initialize creates Buckets that are missing
 func (c *Client) initialize(ctx context.Context) error {
-	if err := c.db.Update(func(tx *bolt.Tx) error {
-		// Always create Buckets bucket.
-		if err := c.initializeBuckets(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Organizations bucket.
-		if err := c.initializeOrganizations(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Dashboards bucket.
-		if err := c.initializeDashboards(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create User bucket.
-		if err := c.initializeUsers(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Authorization bucket.
-		if err := c.initializeAuthorizations(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Onboarding bucket.
-		if err := c.initializeOnboarding(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Telegraf Config bucket.
-		if err := c.initializeTelegraf(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Source bucket.
-		if err := c.initializeSources(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Views bucket.
-		if err := c.initializeViews(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Macros bucket.
-		if err := c.initializeMacros(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Scraper bucket.
-		if err := c.initializeScraperTargets(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create UserResourceMapping bucket.
-		if err := c.initializeUserResourceMappings(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create labels bucket.
-		if err := c.initializeLabels(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create Session bucket.
-		if err := c.initializeSessions(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create KeyValueLog bucket.
-		if err := c.initializeKeyValueLog(ctx, tx); err != nil {
-			return err
-		}
-
-		// Always create SecretService bucket.
-		if err := c.initializeSecretService(ctx, tx); err != nil {
-			return err
-		}
-
-		return nil
-	}); err != nil {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return c.initializeTx(ctx, tx)
+	})
+}
+
+// initializeTx creates the Buckets that are missing within tx and migrates
+// the data written by earlier versions.
+func (c *Client) initializeTx(ctx context.Context, tx *bolt.Tx) error {
+	// Always create Buckets bucket.
+	if err := c.initializeBuckets(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Organizations bucket.
+	if err := c.initializeOrganizations(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Dashboards bucket.
+	if err := c.initializeDashboards(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create User bucket.
+	if err := c.initializeUsers(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Authorization bucket.
+	if err := c.initializeAuthorizations(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Onboarding bucket.
+	if err := c.initializeOnboarding(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Telegraf Config bucket.
+	if err := c.initializeTelegraf(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Source bucket.
+	if err := c.initializeSources(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Views bucket.
+	if err := c.initializeViews(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Macros bucket.
+	if err := c.initializeMacros(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Scraper bucket.
+	if err := c.initializeScraperTargets(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create ScraperTargetStatus bucket.
+	if err := c.initializeScraperTargetStatus(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create UserResourceMapping bucket.
+	if err := c.initializeUserResourceMappings(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create labels bucket.
+	if err := c.initializeLabels(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create Session bucket.
+	if err := c.initializeSessions(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create KeyValueLog bucket.
+	if err := c.initializeKeyValueLog(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create SecretService bucket.
+	if err := c.initializeSecretService(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create OrganizationSettings bucket.
+	if err := c.initializeOrganizationSettings(ctx, tx); err != nil {
+		return err
+	}
+
+	// Always create DBRPMappings bucket.
+	if err := c.initializeDBRPMappings(ctx, tx); err != nil {
 		return err
 	}
 
@@ -0,0 +1,165 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DBRPMappingService = (*Client)(nil)
+
+var (
+	dbrpMappingBucket = []byte("dbrpmappingsv1")
+
+	errDBRPMappingNotFound = fmt.Errorf("dbrp mapping not found")
+)
+
+func (c *Client) initializeDBRPMappings(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists([]byte(dbrpMappingBucket)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func dbrpMappingKey(cluster, db, rp string) []byte {
+	return []byte(path.Join(cluster, db, rp))
+}
+
+// FindBy returns a single dbrp mapping by cluster, db and rp.
+func (c *Client) FindBy(ctx context.Context, cluster, db, rp string) (*platform.DBRPMapping, error) {
+	var m *platform.DBRPMapping
+	err := c.db.View(func(tx *bolt.Tx) error {
+		mapping, err := c.findDBRPMapping(ctx, tx, cluster, db, rp)
+		if err != nil {
+			return err
+		}
+		m = mapping
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (c *Client) findDBRPMapping(ctx context.Context, tx *bolt.Tx, cluster, db, rp string) (*platform.DBRPMapping, error) {
+	v := tx.Bucket(dbrpMappingBucket).Get(dbrpMappingKey(cluster, db, rp))
+	if v == nil {
+		return nil, errDBRPMappingNotFound
+	}
+
+	var m platform.DBRPMapping
+	if err := json.Unmarshal(v, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Find returns the first dbrp mapping that matches filter.
+func (c *Client) Find(ctx context.Context, filter platform.DBRPMappingFilter) (*platform.DBRPMapping, error) {
+	if filter.Cluster == nil && filter.Database == nil && filter.RetentionPolicy == nil {
+		return nil, fmt.Errorf("no filter parameters provided")
+	}
+
+	// filter by dbrp mapping key
+	if filter.Cluster != nil && filter.Database != nil && filter.RetentionPolicy != nil {
+		return c.FindBy(ctx, *filter.Cluster, *filter.Database, *filter.RetentionPolicy)
+	}
+
+	ms, n, err := c.FindMany(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 1 {
+		return nil, errDBRPMappingNotFound
+	}
+
+	return ms[0], nil
+}
+
+// FindMany returns a list of dbrp mappings that match filter and the total count of matching dbrp mappings.
+func (c *Client) FindMany(ctx context.Context, filter platform.DBRPMappingFilter, opt ...platform.FindOptions) ([]*platform.DBRPMapping, int, error) {
+	// filter by dbrp mapping key
+	if filter.Cluster != nil && filter.Database != nil && filter.RetentionPolicy != nil {
+		m, err := c.FindBy(ctx, *filter.Cluster, *filter.Database, *filter.RetentionPolicy)
+		if err != nil {
+			return nil, 0, err
+		}
+		return []*platform.DBRPMapping{m}, 1, nil
+	}
+
+	ms := []*platform.DBRPMapping{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return c.forEachDBRPMapping(ctx, tx, func(m *platform.DBRPMapping) bool {
+			if (filter.Cluster == nil || *filter.Cluster == m.Cluster) &&
+				(filter.Database == nil || *filter.Database == m.Database) &&
+				(filter.RetentionPolicy == nil || *filter.RetentionPolicy == m.RetentionPolicy) &&
+				(filter.Default == nil || *filter.Default == m.Default) {
+				ms = append(ms, m)
+			}
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ms, len(ms), nil
+}
+
+func (c *Client) forEachDBRPMapping(ctx context.Context, tx *bolt.Tx, fn func(*platform.DBRPMapping) bool) error {
+	cur := tx.Bucket(dbrpMappingBucket).Cursor()
+	for k, v := cur.First(); k != nil; k, v = cur.Next() {
+		m := &platform.DBRPMapping{}
+		if err := json.Unmarshal(v, m); err != nil {
+			return err
+		}
+		if !fn(m) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Create creates a new dbrp mapping.
+// Creating a mapping that is identical to an existing one is not an error.
+func (c *Client) Create(ctx context.Context, m *platform.DBRPMapping) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		existing, err := c.findDBRPMapping(ctx, tx, m.Cluster, m.Database, m.RetentionPolicy)
+		if err != nil && err != errDBRPMappingNotFound {
+			return err
+		}
+
+		if existing != nil && !existing.Equal(m) {
+			return errors.New("dbrp mapping already exists")
+		}
+
+		v, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(dbrpMappingBucket).Put(dbrpMappingKey(m.Cluster, m.Database, m.RetentionPolicy), v)
+	})
+}
+
+// Delete removes a dbrp mapping.
+func (c *Client) Delete(ctx context.Context, cluster, db, rp string) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(dbrpMappingBucket).Delete(dbrpMappingKey(cluster, db, rp))
+	})
+}
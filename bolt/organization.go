@@ -385,6 +385,9 @@ func (c *Client) DeleteOrganization(ctx context.Context, id platform.ID) error {
 		if pe := c.deleteOrganizationsBuckets(ctx, tx, id); pe != nil {
 			return pe
 		}
+		if pe := c.deleteOrganizationSettings(ctx, tx, id); pe != nil {
+			return pe
+		}
 		if pe := c.deleteOrganization(ctx, tx, id); pe != nil {
 			return pe
 		}
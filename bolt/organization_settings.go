@@ -0,0 +1,129 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+var (
+	organizationSettingsBucket = []byte("organizationsettingsv1")
+)
+
+var _ platform.OrganizationSettingsService = (*Client)(nil)
+
+func (c *Client) initializeOrganizationSettings(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists([]byte(organizationSettingsBucket)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindOrganizationSettings retrieves the settings of the organization with orgID.
+func (c *Client) FindOrganizationSettings(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error) {
+	var s *platform.OrganizationSettings
+	err := c.db.View(func(tx *bolt.Tx) error {
+		settings, pe := c.findOrganizationSettings(ctx, tx, orgID)
+		if pe != nil {
+			return &platform.Error{
+				Op:  getOp(platform.OpFindOrganizationSettings),
+				Err: pe,
+			}
+		}
+		s = settings
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (c *Client) findOrganizationSettings(ctx context.Context, tx *bolt.Tx, orgID platform.ID) (*platform.OrganizationSettings, *platform.Error) {
+	encodedID, err := orgID.Encode()
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+
+	s := &platform.OrganizationSettings{OrganizationID: orgID}
+	v := tx.Bucket(organizationSettingsBucket).Get(encodedID)
+	if len(v) == 0 {
+		return s, nil
+	}
+
+	if err := json.Unmarshal(v, s); err != nil {
+		return nil, &platform.Error{
+			Err: err,
+		}
+	}
+	return s, nil
+}
+
+// PutOrganizationSettings replaces the settings of an organization.
+func (c *Client) PutOrganizationSettings(ctx context.Context, s *platform.OrganizationSettings) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		if pe := c.putOrganizationSettings(ctx, tx, s); pe != nil {
+			return &platform.Error{
+				Op:  getOp(platform.OpPutOrganizationSettings),
+				Err: pe,
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *Client) putOrganizationSettings(ctx context.Context, tx *bolt.Tx, s *platform.OrganizationSettings) *platform.Error {
+	if err := s.QueryQuota.Valid(); err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	if _, pe := c.findOrganizationByID(ctx, tx, s.OrganizationID); pe != nil {
+		return pe
+	}
+
+	encodedID, err := s.OrganizationID.Encode()
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+
+	v, err := json.Marshal(s)
+	if err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	if err := tx.Bucket(organizationSettingsBucket).Put(encodedID, v); err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	return nil
+}
+
+func (c *Client) deleteOrganizationSettings(ctx context.Context, tx *bolt.Tx, orgID platform.ID) *platform.Error {
+	encodedID, err := orgID.Encode()
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+	if err := tx.Bucket(organizationSettingsBucket).Delete(encodedID); err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func initOrganizationSettingsService(f platformtesting.OrganizationSettingsFields, t *testing.T) (platform.OrganizationSettingsService, string, func()) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	ctx := context.TODO()
+	for _, o := range f.Organizations {
+		if err := c.PutOrganization(ctx, o); err != nil {
+			t.Fatalf("failed to populate organizations")
+		}
+	}
+	for _, s := range f.Settings {
+		if err := c.PutOrganizationSettings(ctx, s); err != nil {
+			t.Fatalf("failed to populate organization settings")
+		}
+	}
+	return c, bolt.OpPrefix, func() {
+		defer closeFn()
+		for _, o := range f.Organizations {
+			if err := c.DeleteOrganization(ctx, o.ID); err != nil {
+				t.Logf("failed to remove organizations: %v", err)
+			}
+		}
+	}
+}
+
+func TestOrganizationSettingsService(t *testing.T) {
+	platformtesting.OrganizationSettingsService(initOrganizationSettingsService, t)
+}
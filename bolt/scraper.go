@@ -73,7 +73,13 @@ func (c *Client) RemoveTarget(ctx context.Context, id platform.ID) error {
 				Err:  err,
 			}
 		}
-		return tx.Bucket(scraperBucket).Delete(encID)
+		if err := tx.Bucket(scraperBucket).Delete(encID); err != nil {
+			return err
+		}
+		if pe := c.deleteTargetStatus(ctx, tx, id); pe != nil {
+			return pe
+		}
+		return nil
 	})
 	if err != nil {
 		return &platform.Error{
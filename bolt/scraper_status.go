@@ -0,0 +1,128 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+var (
+	scraperStatusBucket = []byte("scraperstatusv1")
+)
+
+var _ platform.ScraperTargetStatusService = (*Client)(nil)
+
+func (c *Client) initializeScraperTargetStatus(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists([]byte(scraperStatusBucket)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindTargetStatus retrieves the status of the last scrape of the target with id.
+func (c *Client) FindTargetStatus(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+	var s *platform.ScraperTargetStatus
+	err := c.db.View(func(tx *bolt.Tx) error {
+		status, pe := c.findTargetStatus(ctx, tx, id)
+		if pe != nil {
+			return &platform.Error{
+				Op:  getOp(platform.OpFindTargetStatus),
+				Err: pe,
+			}
+		}
+		s = status
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (c *Client) findTargetStatus(ctx context.Context, tx *bolt.Tx, id platform.ID) (*platform.ScraperTargetStatus, *platform.Error) {
+	encodedID, err := id.Encode()
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+
+	v := tx.Bucket(scraperStatusBucket).Get(encodedID)
+	if len(v) == 0 {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "scraper target status is not found",
+		}
+	}
+
+	s := &platform.ScraperTargetStatus{}
+	if err := json.Unmarshal(v, s); err != nil {
+		return nil, &platform.Error{
+			Err: err,
+		}
+	}
+	return s, nil
+}
+
+// PutTargetStatus replaces the status of a scraper target.
+func (c *Client) PutTargetStatus(ctx context.Context, s *platform.ScraperTargetStatus) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		if pe := c.putTargetStatus(ctx, tx, s); pe != nil {
+			return &platform.Error{
+				Op:  getOp(platform.OpPutTargetStatus),
+				Err: pe,
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *Client) putTargetStatus(ctx context.Context, tx *bolt.Tx, s *platform.ScraperTargetStatus) *platform.Error {
+	// The status of a target removed during its scrape is dropped.
+	if _, pe := c.findTargetByID(ctx, tx, s.TargetID); pe != nil {
+		return pe
+	}
+
+	encodedID, err := s.TargetID.Encode()
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+
+	v, err := json.Marshal(s)
+	if err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	if err := tx.Bucket(scraperStatusBucket).Put(encodedID, v); err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	return nil
+}
+
+func (c *Client) deleteTargetStatus(ctx context.Context, tx *bolt.Tx, id platform.ID) *platform.Error {
+	encodedID, err := id.Encode()
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+	if err := tx.Bucket(scraperStatusBucket).Delete(encodedID); err != nil {
+		return &platform.Error{
+			Err: err,
+		}
+	}
+	return nil
+}
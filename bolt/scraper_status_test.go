@@ -0,0 +1,40 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func initScraperTargetStatusService(f platformtesting.TargetStatusFields, t *testing.T) (platform.ScraperTargetStatusService, string, func()) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	ctx := context.Background()
+	for _, target := range f.Targets {
+		if err := c.PutTarget(ctx, target); err != nil {
+			t.Fatalf("failed to populate scraper targets")
+		}
+	}
+	for _, s := range f.Statuses {
+		if err := c.PutTargetStatus(ctx, s); err != nil {
+			t.Fatalf("failed to populate scraper target statuses")
+		}
+	}
+	return c, bolt.OpPrefix, func() {
+		defer closeFn()
+		for _, target := range f.Targets {
+			if err := c.RemoveTarget(ctx, target.ID); err != nil {
+				t.Logf("failed to remove targets: %v", err)
+			}
+		}
+	}
+}
+
+func TestScraperTargetStatusService(t *testing.T) {
+	platformtesting.ScraperTargetStatusService(initScraperTargetStatusService, t)
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/bolt"
@@ -21,15 +23,26 @@ var authorizationCmd = &cobra.Command{
 	},
 }
 
+// tokenShownOnce is printed after a new token, as only the hash of a token is
+// stored and it cannot be retrieved again.
+const tokenShownOnce = "The token is shown only once; store it securely, as it cannot be retrieved again."
+
 // AuthorizationCreateFlags are command line args used when creating a authorization
 type AuthorizationCreateFlags struct {
-	user string
+	user      string
+	orgID     string
+	expiresAt string
 
 	createUserPermission bool
 	deleteUserPermission bool
 
-	readBucketPermissions  []string
-	writeBucketPermissions []string
+	readBucketPermissions   []string
+	writeBucketPermissions  []string
+	deleteBucketPermissions []string
+
+	readBucketsPermission  bool
+	writeBucketsPermission bool
+	executeTasksPermission bool
 }
 
 var authorizationCreateFlags AuthorizationCreateFlags
@@ -49,6 +62,14 @@ func init() {
 
 	authorizationCreateCmd.Flags().StringArrayVarP(&authorizationCreateFlags.readBucketPermissions, "read-bucket", "", []string{}, "bucket id")
 	authorizationCreateCmd.Flags().StringArrayVarP(&authorizationCreateFlags.writeBucketPermissions, "write-bucket", "", []string{}, "bucket id")
+	authorizationCreateCmd.Flags().StringArrayVarP(&authorizationCreateFlags.deleteBucketPermissions, "delete-bucket", "", []string{}, "bucket id")
+
+	authorizationCreateCmd.Flags().StringVarP(&authorizationCreateFlags.orgID, "org-id", "o", "", "limits the permissions on all buckets and tasks to the organization with this id")
+	authorizationCreateCmd.Flags().BoolVarP(&authorizationCreateFlags.readBucketsPermission, "read-buckets", "", false, "grants the permission to read all buckets")
+	authorizationCreateCmd.Flags().BoolVarP(&authorizationCreateFlags.writeBucketsPermission, "write-buckets", "", false, "grants the permission to write all buckets")
+	authorizationCreateCmd.Flags().BoolVarP(&authorizationCreateFlags.executeTasksPermission, "execute-tasks", "", false, "grants the permission to run all tasks")
+
+	authorizationCreateCmd.Flags().StringVarP(&authorizationCreateFlags.expiresAt, "expires-at", "", "", "RFC3339 time after which the token is rejected")
 
 	authorizationCmd.AddCommand(authorizationCreateCmd)
 }
@@ -98,8 +119,58 @@ func authorizationCreateF(cmd *cobra.Command, args []string) error {
 		permissions = append(permissions, *p)
 	}
 
+	for _, p := range authorizationCreateFlags.deleteBucketPermissions {
+		var id platform.ID
+		if err := id.DecodeFromString(p); err != nil {
+			return err
+		}
+
+		p, err := platform.NewPermissionAtID(id, platform.DeleteAction, platform.BucketsResource)
+		if err != nil {
+			return err
+		}
+		permissions = append(permissions, *p)
+	}
+
+	var orgID *platform.ID
+	if authorizationCreateFlags.orgID != "" {
+		orgID = new(platform.ID)
+		if err := orgID.DecodeFromString(authorizationCreateFlags.orgID); err != nil {
+			return err
+		}
+	}
+
+	for _, w := range []struct {
+		granted  bool
+		action   platform.Action
+		resource platform.Resource
+	}{
+		{authorizationCreateFlags.readBucketsPermission, platform.ReadAction, platform.BucketsResource},
+		{authorizationCreateFlags.writeBucketsPermission, platform.WriteAction, platform.BucketsResource},
+		{authorizationCreateFlags.executeTasksPermission, platform.ExecuteAction, platform.TasksResource},
+	} {
+		if !w.granted {
+			continue
+		}
+
+		p := &platform.Permission{Action: w.action, Resource: w.resource, OrgID: orgID}
+		if err := p.Valid(); err != nil {
+			return err
+		}
+		permissions = append(permissions, *p)
+	}
+
+	expiresAt, err := parseExpiresAt(authorizationCreateFlags.expiresAt)
+	if err != nil {
+		return err
+	}
+
 	authorization := &platform.Authorization{
 		Permissions: permissions,
+		ExpiresAt:   expiresAt,
+	}
+	if orgID != nil {
+		authorization.OrgID = *orgID
 	}
 
 	s, err := newAuthorizationService(flags)
@@ -135,6 +206,7 @@ func authorizationCreateF(cmd *cobra.Command, args []string) error {
 	})
 
 	w.Flush()
+	fmt.Fprintln(os.Stderr, tokenShownOnce)
 
 	return nil
 }
@@ -215,7 +287,6 @@ func authorizationFindF(cmd *cobra.Command, args []string) error {
 	w := internal.NewTabWriter(os.Stdout)
 	w.WriteHeaders(
 		"ID",
-		"Token",
 		"Status",
 		"User",
 		"UserID",
@@ -230,7 +301,6 @@ func authorizationFindF(cmd *cobra.Command, args []string) error {
 
 		w.Write(map[string]interface{}{
 			"ID":          a.ID,
-			"Token":       a.Token,
 			"Status":      a.Status,
 			"UserID":      a.UserID.String(),
 			"Permissions": permissions,
@@ -286,7 +356,6 @@ func authorizationDeleteF(cmd *cobra.Command, args []string) error {
 	w := internal.NewTabWriter(os.Stdout)
 	w.WriteHeaders(
 		"ID",
-		"Token",
 		"User",
 		"UserID",
 		"Permissions",
@@ -300,7 +369,6 @@ func authorizationDeleteF(cmd *cobra.Command, args []string) error {
 
 	w.Write(map[string]interface{}{
 		"ID":          a.ID.String(),
-		"Token":       a.Token,
 		"UserID":      a.UserID.String(),
 		"Permissions": ps,
 		"Deleted":     true,
@@ -355,7 +423,6 @@ func authorizationActiveF(cmd *cobra.Command, args []string) error {
 	w := internal.NewTabWriter(os.Stdout)
 	w.WriteHeaders(
 		"ID",
-		"Token",
 		"Status",
 		"User",
 		"UserID",
@@ -369,7 +436,6 @@ func authorizationActiveF(cmd *cobra.Command, args []string) error {
 
 	w.Write(map[string]interface{}{
 		"ID":          a.ID.String(),
-		"Token":       a.Token,
 		"Status":      a.Status,
 		"UserID":      a.UserID.String(),
 		"Permissions": ps,
@@ -424,7 +490,6 @@ func authorizationInactiveF(cmd *cobra.Command, args []string) error {
 	w := internal.NewTabWriter(os.Stdout)
 	w.WriteHeaders(
 		"ID",
-		"Token",
 		"Status",
 		"User",
 		"UserID",
@@ -436,15 +501,104 @@ func authorizationInactiveF(cmd *cobra.Command, args []string) error {
 		ps = append(ps, p.String())
 	}
 
+	w.Write(map[string]interface{}{
+		"ID":          a.ID.String(),
+		"Status":      a.Status,
+		"UserID":      a.UserID.String(),
+		"Permissions": ps,
+	})
+
+	w.Flush()
+
+	return nil
+}
+
+// AuthorizationRotateFlags are command line args used when rotating the token of an authorization
+type AuthorizationRotateFlags struct {
+	id        string
+	expiresAt string
+}
+
+var authorizationRotateFlags AuthorizationRotateFlags
+
+func init() {
+	authorizationRotateCmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Replace the token of an authorization",
+		RunE:  authorizationRotateF,
+	}
+
+	authorizationRotateCmd.Flags().StringVarP(&authorizationRotateFlags.id, "id", "i", "", "authorization id (required)")
+	authorizationRotateCmd.MarkFlagRequired("id")
+	authorizationRotateCmd.Flags().StringVarP(&authorizationRotateFlags.expiresAt, "expires-at", "", "", "RFC3339 time after which the new token is rejected")
+
+	authorizationCmd.AddCommand(authorizationRotateCmd)
+}
+
+func authorizationRotateF(cmd *cobra.Command, args []string) error {
+	s, err := newAuthorizationService(flags)
+	if err != nil {
+		return err
+	}
+
+	var id platform.ID
+	if err := id.DecodeFromString(authorizationRotateFlags.id); err != nil {
+		return err
+	}
+
+	expiresAt, err := parseExpiresAt(authorizationRotateFlags.expiresAt)
+	if err != nil {
+		return err
+	}
+
+	a, err := s.RotateAuthorization(context.Background(), id, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	w := internal.NewTabWriter(os.Stdout)
+	w.WriteHeaders(
+		"ID",
+		"Token",
+		"Status",
+		"ExpiresAt",
+		"UserID",
+		"Permissions",
+	)
+
+	ps := []string{}
+	for _, p := range a.Permissions {
+		ps = append(ps, p.String())
+	}
+
+	expires := ""
+	if a.ExpiresAt != nil {
+		expires = a.ExpiresAt.Format(time.RFC3339)
+	}
+
 	w.Write(map[string]interface{}{
 		"ID":          a.ID.String(),
 		"Token":       a.Token,
 		"Status":      a.Status,
+		"ExpiresAt":   expires,
 		"UserID":      a.UserID.String(),
 		"Permissions": ps,
 	})
 
 	w.Flush()
+	fmt.Fprintln(os.Stderr, tokenShownOnce)
 
 	return nil
 }
+
+func parseExpiresAt(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/http"
+	"github.com/influxdata/platform/kit/signals"
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Backup the data in influxdb",
+	Long: `Write an archive of all metadata and time series data held by influxdb
+		to a file, or to stdout when the path is "-".
+
+		When a bucket is given only the time series data of that bucket is
+		written, and --since limits it to the data written or deleted at or
+		after the given time, usually the start of the previous backup.
+		Restoring such an incremental backup on top of an earlier backup
+		reproduces the bucket as of the incremental backup.`,
+	RunE: backupF,
+}
+
+var backupFlags struct {
+	Path     string
+	OrgID    string
+	Org      string
+	BucketID string
+	Bucket   string
+	Since    string
+}
+
+func init() {
+	backupCmd.Flags().StringVarP(&backupFlags.Path, "path", "p", "", "path of the archive that will be written")
+	backupCmd.MarkFlagRequired("path")
+	backupCmd.Flags().StringVar(&backupFlags.OrgID, "org-id", "", "id of the organization that owns the bucket")
+	backupCmd.Flags().StringVarP(&backupFlags.Org, "org", "o", "", "name of the organization that owns the bucket")
+	backupCmd.Flags().StringVar(&backupFlags.BucketID, "bucket-id", "", "id of the bucket to back up")
+	backupCmd.Flags().StringVarP(&backupFlags.Bucket, "bucket", "b", "", "name of the bucket to back up")
+	backupCmd.Flags().StringVar(&backupFlags.Since, "since", "", "only back up bucket data written or deleted at or after this RFC3339 time")
+}
+
+func newBackupService(f Flags) *http.BackupService {
+	return &http.BackupService{
+		Addr:  f.host,
+		Token: f.token,
+	}
+}
+
+func backupF(cmd *cobra.Command, args []string) error {
+	ctx := signals.WithStandardSignals(context.Background())
+
+	filter, err := backupFilter(ctx)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if backupFlags.Path != "-" {
+		f, err := os.Create(backupFlags.Path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := newBackupService(flags).Backup(ctx, w, filter); err != nil {
+		if backupFlags.Path != "-" {
+			os.Remove(backupFlags.Path)
+		}
+		return err
+	}
+
+	if backupFlags.Path != "-" {
+		fmt.Printf("Backup written to %s\n", backupFlags.Path)
+	}
+	return nil
+}
+
+// backupFilter returns the filter described by the backup flags, looking up
+// the bucket if one was given.
+func backupFilter(ctx context.Context) (platform.BackupFilter, error) {
+	var filter platform.BackupFilter
+
+	if backupFlags.Org != "" && backupFlags.OrgID != "" {
+		return filter, fmt.Errorf("please specify one of org or org-id")
+	}
+
+	if backupFlags.Bucket != "" && backupFlags.BucketID != "" {
+		return filter, fmt.Errorf("please specify one of bucket or bucket-id")
+	}
+
+	if backupFlags.Since != "" {
+		t, err := time.Parse(time.RFC3339Nano, backupFlags.Since)
+		if err != nil {
+			return filter, fmt.Errorf("invalid since time: %v", err)
+		}
+		filter.Since = t
+	}
+
+	if backupFlags.Bucket == "" && backupFlags.BucketID == "" {
+		if !filter.Since.IsZero() {
+			return filter, fmt.Errorf("please specify a bucket to back up since a time")
+		}
+		return filter, nil
+	}
+
+	var err error
+	bucketFilter := platform.BucketFilter{}
+	if backupFlags.BucketID != "" {
+		if bucketFilter.ID, err = platform.IDFromString(backupFlags.BucketID); err != nil {
+			return filter, err
+		}
+	}
+	if backupFlags.Bucket != "" {
+		bucketFilter.Name = &backupFlags.Bucket
+	}
+	if backupFlags.OrgID != "" {
+		if bucketFilter.OrganizationID, err = platform.IDFromString(backupFlags.OrgID); err != nil {
+			return filter, err
+		}
+	}
+	if backupFlags.Org != "" {
+		bucketFilter.Organization = &backupFlags.Org
+	}
+
+	bs := &http.BucketService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	buckets, n, err := bs.FindBuckets(ctx, bucketFilter)
+	if err != nil {
+		return filter, err
+	} else if n == 0 {
+		return filter, fmt.Errorf("bucket does not exist")
+	}
+
+	filter.BucketID = &buckets[0].ID
+	filter.OrganizationID = &buckets[0].OrganizationID
+	return filter, nil
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the data in influxdb from a backup",
+	Long: `Replace all metadata and time series data held by influxdb with the
+		contents of an archive written by the backup command. Archives of a
+		single bucket only replace that bucket's data.`,
+	RunE: restoreF,
+}
+
+var restoreFlags struct {
+	Path string
+}
+
+func init() {
+	restoreCmd.Flags().StringVarP(&restoreFlags.Path, "path", "p", "", "path of the archive to restore, or - for stdin")
+	restoreCmd.MarkFlagRequired("path")
+}
+
+func restoreF(cmd *cobra.Command, args []string) error {
+	ctx := signals.WithStandardSignals(context.Background())
+
+	var r io.Reader = os.Stdin
+	if restoreFlags.Path != "-" {
+		f, err := os.Open(restoreFlags.Path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if err := newBackupService(flags).Restore(ctx, r); err != nil {
+		return err
+	}
+
+	fmt.Println("Restore complete")
+	return nil
+}
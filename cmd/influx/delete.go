@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/http"
+	"github.com/influxdata/platform/kit/signals"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete points from influxdb",
+	Long: `Delete the points of a bucket with a timestamp between start and stop.
+		A predicate of tag comparisons, such as 'host="a" AND region=~/^eu/',
+		limits the delete to the matching series.`,
+	Args: cobra.NoArgs,
+	RunE: deleteF,
+}
+
+var deleteFlags struct {
+	OrgID     string
+	Org       string
+	BucketID  string
+	Bucket    string
+	Start     string
+	Stop      string
+	Predicate string
+}
+
+func init() {
+	deleteCmd.Flags().StringVar(&deleteFlags.OrgID, "org-id", "", "id of the organization that owns the bucket")
+	deleteCmd.Flags().StringVarP(&deleteFlags.Org, "org", "o", "", "name of the organization that owns the bucket")
+	deleteCmd.Flags().StringVar(&deleteFlags.BucketID, "bucket-id", "", "id of the bucket to delete from")
+	deleteCmd.Flags().StringVarP(&deleteFlags.Bucket, "bucket", "b", "", "name of the bucket to delete from")
+	deleteCmd.Flags().StringVar(&deleteFlags.Start, "start", "", "the RFC3339 time to start deleting from (required)")
+	deleteCmd.MarkFlagRequired("start")
+	deleteCmd.Flags().StringVar(&deleteFlags.Stop, "stop", "", "the RFC3339 time to stop deleting at (required)")
+	deleteCmd.MarkFlagRequired("stop")
+	deleteCmd.Flags().StringVarP(&deleteFlags.Predicate, "predicate", "p", "", "delete only the series matching this predicate")
+}
+
+func deleteF(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if deleteFlags.Org != "" && deleteFlags.OrgID != "" {
+		cmd.Usage()
+		return fmt.Errorf("please specify one of org or org-id")
+	}
+
+	if deleteFlags.Bucket != "" && deleteFlags.BucketID != "" {
+		cmd.Usage()
+		return fmt.Errorf("please specify one of bucket or bucket-id")
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, deleteFlags.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start: %v", err)
+	}
+	stop, err := time.Parse(time.RFC3339Nano, deleteFlags.Stop)
+	if err != nil {
+		return fmt.Errorf("invalid stop: %v", err)
+	}
+
+	bs := &http.BucketService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	filter := platform.BucketFilter{}
+	if deleteFlags.BucketID != "" {
+		filter.ID, err = platform.IDFromString(deleteFlags.BucketID)
+		if err != nil {
+			return err
+		}
+	}
+	if deleteFlags.Bucket != "" {
+		filter.Name = &deleteFlags.Bucket
+	}
+
+	if deleteFlags.OrgID != "" {
+		filter.OrganizationID, err = platform.IDFromString(deleteFlags.OrgID)
+		if err != nil {
+			return err
+		}
+	}
+	if deleteFlags.Org != "" {
+		filter.Organization = &deleteFlags.Org
+	}
+
+	buckets, n, err := bs.FindBuckets(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("bucket does not exist")
+	}
+
+	s := &http.DeleteService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	ctx = signals.WithStandardSignals(ctx)
+	return s.DeleteBucketRangePredicate(ctx, buckets[0].OrganizationID, buckets[0].ID, start, stop, deleteFlags.Predicate)
+}
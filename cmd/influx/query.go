@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/influxdata/flux/repl"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/cmd/influx/internal"
+	"github.com/influxdata/platform/http"
+	"github.com/influxdata/platform/query"
 	_ "github.com/influxdata/platform/query/builtin"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -25,12 +30,12 @@ var queryFlags struct {
 }
 
 func init() {
-	queryCmd.PersistentFlags().StringVar(&queryFlags.OrgID, "org-id", "", "Organization ID")
+	queryCmd.Flags().StringVar(&queryFlags.OrgID, "org-id", "", "Organization ID")
 	viper.BindEnv("ORG_ID")
 	if h := viper.GetString("ORG_ID"); h != "" {
 		queryFlags.OrgID = h
 	}
-	queryCmd.MarkPersistentFlagRequired("org-id")
+	queryCmd.MarkFlagRequired("org-id")
 }
 
 func fluxQueryF(cmd *cobra.Command, args []string) {
@@ -63,3 +68,117 @@ func fluxQueryF(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 }
+
+// QueryPSFlags define the ps Command
+type QueryPSFlags struct {
+	orgID string
+}
+
+var queryPSFlags QueryPSFlags
+
+func init() {
+	queryPSCmd := &cobra.Command{
+		Use:   "ps",
+		Short: "List running queries",
+		Args:  cobra.NoArgs,
+		Run:   queryPSF,
+	}
+
+	queryPSCmd.Flags().StringVarP(&queryPSFlags.orgID, "org-id", "", "", "only list the queries of this organization")
+
+	queryCmd.AddCommand(queryPSCmd)
+}
+
+func queryPSF(cmd *cobra.Command, args []string) {
+	s := &http.ActiveQueryService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	filter := query.ActiveQueryFilter{}
+	if queryPSFlags.orgID != "" {
+		id, err := platform.IDFromString(queryPSFlags.orgID)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		filter.OrganizationID = id
+	}
+
+	qs, err := s.FindActiveQueries(context.Background(), filter)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	w := internal.NewTabWriter(os.Stdout)
+	w.WriteHeaders(
+		"ID",
+		"Organization",
+		"User",
+		"Compiler",
+		"State",
+		"Duration",
+		"MaxAllocated",
+		"Query",
+	)
+	now := time.Now()
+	for _, q := range qs {
+		user := ""
+		if q.UserID.Valid() {
+			user = q.UserID.String()
+		}
+		w.Write(map[string]interface{}{
+			"ID":           q.ID.String(),
+			"Organization": q.OrganizationID.String(),
+			"User":         user,
+			"Compiler":     q.CompilerType,
+			"State":        q.State,
+			"Duration":     now.Sub(q.StartTime).Round(time.Millisecond),
+			"MaxAllocated": q.MaxAllocated,
+			"Query":        q.Query,
+		})
+	}
+	w.Flush()
+}
+
+// QueryKillFlags define the kill Command
+type QueryKillFlags struct {
+	id string
+}
+
+var queryKillFlags QueryKillFlags
+
+func init() {
+	queryKillCmd := &cobra.Command{
+		Use:   "kill",
+		Short: "Cancel a running query",
+		Args:  cobra.NoArgs,
+		Run:   queryKillF,
+	}
+
+	queryKillCmd.Flags().StringVarP(&queryKillFlags.id, "id", "i", "", "query id (required)")
+	queryKillCmd.MarkFlagRequired("id")
+
+	queryCmd.AddCommand(queryKillCmd)
+}
+
+func queryKillF(cmd *cobra.Command, args []string) {
+	s := &http.ActiveQueryService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	var id platform.ID
+	if err := id.DecodeFromString(queryKillFlags.id); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := s.CancelQuery(context.Background(), id); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Query %s canceled\n", id)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/influxdata/flux/repl"
 	"github.com/influxdata/platform"
@@ -474,11 +475,15 @@ func taskLogFindF(cmd *cobra.Command, args []string) {
 
 	w := internal.NewTabWriter(os.Stdout)
 	w.WriteHeaders(
-		"Log",
+		"RunID",
+		"Time",
+		"Message",
 	)
 	for _, log := range logs {
 		w.Write(map[string]interface{}{
-			"Log": *log,
+			"RunID":   log.RunID,
+			"Time":    log.Time,
+			"Message": log.Message,
 		})
 	}
 	w.Flush()
@@ -632,3 +637,59 @@ func runRetryF(cmd *cobra.Command, args []string) {
 
 	fmt.Printf("Retry for task %s's run %s queued as run %s.\n", taskID, runID, newRun.ID)
 }
+
+type TaskBackfillFlags struct {
+	id          string
+	start, stop string
+}
+
+var taskBackfillFlags TaskBackfillFlags
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "backfill runs for a time range",
+		Run:   taskBackfillF,
+	}
+
+	cmd.Flags().StringVarP(&taskBackfillFlags.id, "id", "i", "", "task id (required)")
+	cmd.Flags().StringVarP(&taskBackfillFlags.start, "start", "", "", "the start time of the range to backfill in RFC3339 format (required)")
+	cmd.Flags().StringVarP(&taskBackfillFlags.stop, "stop", "", "", "the stop time of the range to backfill in RFC3339 format (required)")
+	cmd.MarkFlagRequired("id")
+	cmd.MarkFlagRequired("start")
+	cmd.MarkFlagRequired("stop")
+
+	taskCmd.AddCommand(cmd)
+}
+
+func taskBackfillF(cmd *cobra.Command, args []string) {
+	s := &http.TaskService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	var id platform.ID
+	if err := id.DecodeFromString(taskBackfillFlags.id); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	start, err := time.Parse(time.RFC3339, taskBackfillFlags.start)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	stop, err := time.Parse(time.RFC3339, taskBackfillFlags.stop)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	ctx := context.TODO()
+	if err := s.BackfillRuns(ctx, id, start.Unix(), stop.Unix()); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Backfill for task %s from %s to %s queued.\n", id, start.Format(time.RFC3339), stop.Format(time.RFC3339))
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/influxdata/flux/control"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorization"
+	"github.com/influxdata/platform/backup"
 	"github.com/influxdata/platform/bolt"
 	"github.com/influxdata/platform/chronograf/server"
 	protofs "github.com/influxdata/platform/fs"
@@ -26,6 +28,7 @@ import (
 	influxlogger "github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/nats"
 	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/cache"
 	pcontrol "github.com/influxdata/platform/query/control"
 	"github.com/influxdata/platform/snowflake"
 	"github.com/influxdata/platform/source"
@@ -62,6 +65,9 @@ type Launcher struct {
 
 	secretStore string
 
+	queryCacheTTL      time.Duration
+	queryCacheMaxBytes int
+
 	boltClient *bolt.Client
 	engine     *storage.Engine
 
@@ -76,6 +82,10 @@ type Launcher struct {
 
 	logger *zap.Logger
 
+	// StorageOptions are applied to the storage engine when it is created.
+	// They should only be set for testing purposes.
+	StorageOptions []storage.Option
+
 	Stdin  io.Reader
 	Stdout io.Writer
 	Stderr io.Writer
@@ -105,6 +115,12 @@ func (m *Launcher) Engine() *storage.Engine {
 	return m.engine
 }
 
+// DBRPMappingService returns the service of the dbrp mappings for InfluxQL queries.
+// It should only be called for end-to-end testing purposes.
+func (m *Launcher) DBRPMappingService() platform.DBRPMappingService {
+	return m.boltClient
+}
+
 // Shutdown shuts down the HTTP server and waits for all services to clean up.
 func (m *Launcher) Shutdown(ctx context.Context) {
 	m.httpServer.Shutdown(ctx)
@@ -197,6 +213,18 @@ func (m *Launcher) Run(ctx context.Context, args ...string) error {
 				Default: filepath.Join(dir, "protos"),
 				Desc:    "path to protos on the filesystem",
 			},
+			{
+				DestP:   &m.queryCacheTTL,
+				Flag:    "query-cache-ttl",
+				Default: time.Duration(0),
+				Desc:    "how long query results are cached; results are not cached when zero",
+			},
+			{
+				DestP:   &m.queryCacheMaxBytes,
+				Flag:    "query-cache-max-bytes",
+				Default: 64 * 1024 * 1024,
+				Desc:    "size of the query results cached at once",
+			},
 		},
 	}
 
@@ -259,11 +287,13 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		orgLogSvc        platform.OrganizationOperationLogService = m.boltClient
 		onboardingSvc    platform.OnboardingService               = m.boltClient
 		scraperTargetSvc platform.ScraperTargetStoreService       = m.boltClient
+		scraperStatusSvc platform.ScraperTargetStatusService      = m.boltClient
 		telegrafSvc      platform.TelegrafConfigStore             = m.boltClient
 		userResourceSvc  platform.UserResourceMappingService      = m.boltClient
 		labelSvc         platform.LabelService                    = m.boltClient
 		secretSvc        platform.SecretService                   = m.boltClient
 		lookupSvc        platform.LookupService                   = m.boltClient
+		dbrpMappingSvc   platform.DBRPMappingService              = m.boltClient
 	)
 
 	switch m.secretStore {
@@ -296,9 +326,20 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		return err
 	}
 
+	var queryCache *cache.ProxyQueryService
+	if m.queryCacheTTL > 0 {
+		queryCache = cache.NewProxyQueryService(bucketSvc, m.queryCacheTTL, int64(m.queryCacheMaxBytes))
+		reg.MustRegister(queryCache.PrometheusCollectors()...)
+	}
+
 	var pointsWriter storage.PointsWriter
 	{
-		m.engine = storage.NewEngine(m.enginePath, storage.NewConfig(), storage.WithRetentionEnforcer(bucketSvc))
+		opts := append(append([]storage.Option{}, m.StorageOptions...), storage.WithRetentionEnforcer(bucketSvc))
+		if queryCache != nil {
+			// Retention enforcement invalidates the cached results of the buckets it deletes from.
+			opts = append(opts, storage.WithBucketInvalidator(queryCache))
+		}
+		m.engine = storage.NewEngine(m.enginePath, storage.NewConfig(), opts...)
 		m.engine.WithLogger(m.logger)
 
 		if err := m.engine.Open(); err != nil {
@@ -309,6 +350,10 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		reg.MustRegister(m.engine.PrometheusCollectors()...)
 
 		pointsWriter = m.engine
+		if queryCache != nil {
+			// Writes invalidate the cached results of the buckets they write to.
+			pointsWriter = queryCache.PointsWriter(pointsWriter)
+		}
 
 		const (
 			concurrencyQuota = 10
@@ -323,17 +368,29 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		}
 
 		if err := readservice.AddControllerConfigDependencies(
-			&cc, m.engine, bucketSvc, orgSvc,
+			&cc, m.engine, pointsWriter, bucketSvc, orgSvc,
 		); err != nil {
 			m.logger.Error("Failed to configure query controller dependencies", zap.Error(err))
 			return err
 		}
 
 		m.queryController = pcontrol.New(cc)
+		m.queryController.OrganizationSettingsService = m.boltClient
 		reg.MustRegister(m.queryController.PrometheusCollectors()...)
 	}
 
+	backupSvc := backup.NewService(m.boltClient, m.engine)
+	backupSvc.Logger = m.logger.With(zap.String("service", "backup"))
+	backupSvc.Path = m.enginePath
+	if queryCache != nil {
+		backupSvc.Invalidator = queryCache
+	}
+
 	var storageQueryService query.ProxyQueryService = readservice.NewProxyQueryService(m.queryController)
+	if queryCache != nil {
+		queryCache.ProxyQueryService = storageQueryService
+		storageQueryService = queryCache
+	}
 	var taskSvc platform.TaskService
 	{
 		boltStore, err := taskbolt.New(m.boltClient.DB(), "tasks")
@@ -375,7 +432,7 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		return err
 	}
 
-	scraperScheduler, err := gather.NewScheduler(10, m.logger, scraperTargetSvc, publisher, subscriber, 0, 0)
+	scraperScheduler, err := gather.NewScheduler(10, m.logger, scraperTargetSvc, scraperStatusSvc, publisher, subscriber, 0, 0)
 	if err != nil {
 		m.logger.Error("failed to create scraper subscriber", zap.Error(err))
 		return err
@@ -391,19 +448,42 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		logger.Info("Stopping")
 	}(m.logger)
 
+	authSweeper := authorization.NewSweeper(authSvc, authorization.DefaultSweepInterval, m.logger.With(zap.String("service", "authorization-sweeper")))
+	m.wg.Add(1)
+	go func(logger *zap.Logger) {
+		defer m.wg.Done()
+		if err := authSweeper.Run(ctx); err != nil {
+			logger.Error("failed authorization sweeper", zap.Error(err))
+		}
+		logger.Info("Stopping")
+	}(authSweeper.Logger)
+
 	m.httpServer = &nethttp.Server{
 		Addr: m.httpBindAddress,
 	}
 
+	var pointsDeleter storage.PointsDeleter = m.engine
+	if queryCache != nil {
+		pointsDeleter = queryCache.PointsDeleter(pointsDeleter)
+	}
+
+	// Wrap the BucketService in a storage backed one that will ensure deleted buckets are removed from the storage engine.
+	var storageBucketSvc platform.BucketService = storage.NewBucketService(bucketSvc, m.engine)
+	if queryCache != nil {
+		// Updating or deleting a bucket invalidates the cached results reading it.
+		storageBucketSvc = queryCache.BucketService(storageBucketSvc)
+	}
+
 	handlerConfig := &http.APIBackend{
 		DeveloperMode:        m.developerMode,
 		Logger:               m.logger,
 		NewBucketService:     source.NewBucketService,
 		NewQueryService:      source.NewQueryService,
 		PointsWriter:         pointsWriter,
+		PointsDeleter:        pointsDeleter,
 		AuthorizationService: authSvc,
-		// Wrap the BucketService in a storage backed one that will ensure deleted buckets are removed from the storage engine.
-		BucketService:                   storage.NewBucketService(bucketSvc, m.engine),
+		// The BucketService removes the data of deleted buckets from the storage engine.
+		BucketService:                   storageBucketSvc,
 		SessionService:                  sessionSvc,
 		UserService:                     userSvc,
 		OrganizationService:             orgSvc,
@@ -419,13 +499,18 @@ func (m *Launcher) run(ctx context.Context) (err error) {
 		BasicAuthService:                basicAuthSvc,
 		OnboardingService:               onboardingSvc,
 		ProxyQueryService:               storageQueryService,
+		ActiveQueryService:              m.queryController,
 		TaskService:                     taskSvc,
 		TelegrafService:                 telegrafSvc,
 		ScraperTargetStoreService:       scraperTargetSvc,
+		ScraperTargetStatusService:      scraperStatusSvc,
 		ChronografService:               chronografSvc,
 		SecretService:                   secretSvc,
+		OrganizationSettingsService:     m.boltClient,
 		LookupService:                   lookupSvc,
 		ProtoService:                    protoSvc,
+		BackupService:                   backupSvc,
+		DBRPMappingService:              dbrpMappingSvc,
 	}
 
 	// HTTP server
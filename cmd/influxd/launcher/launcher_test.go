@@ -7,16 +7,23 @@ import (
 	"io"
 	"io/ioutil"
 	nethttp "net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/influxdata/platform/cmd/influxd/launcher"
 
+	"github.com/golang/snappy"
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/http"
+	"github.com/influxdata/platform/prometheus/remote"
+	_ "github.com/influxdata/platform/query/builtin"
+	"github.com/influxdata/platform/storage"
 )
 
 // Default context.
@@ -88,6 +95,266 @@ func TestLauncher_WriteAndQuery(t *testing.T) {
 	}
 }
 
+func TestLauncher_InfluxQLQuery(t *testing.T) {
+	l := RunLauncherOrFail(t, ctx)
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	l.WritePointsOrFail(t, `m,k=v f=100i 946684800000000000`)
+	if err := l.Launcher.DBRPMappingService().Create(ctx, &platform.DBRPMapping{
+		Cluster:         http.DefaultInfluxQLCluster,
+		Database:        "db0",
+		RetentionPolicy: "autogen",
+		Default:         true,
+		OrganizationID:  l.Org.ID,
+		BucketID:        l.Bucket.ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Query the server as an InfluxDB 1.X client, with the token as the password.
+	body := l.InfluxQLQueryOrFail(t, "db0", "SELECT f FROM m WHERE time >= '2000-01-01T00:00:00Z' AND time < '2000-01-02T00:00:00Z'")
+	exp := `{"results":[{"statement_id":0,"series":[{"name":"m","columns":["time","f"],"values":[["2000-01-01T00:00:00Z",100]]}]}]}` + "\n"
+	if diff := cmp.Diff(exp, body); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestLauncher_InfluxQLShow(t *testing.T) {
+	l := RunLauncherOrFail(t, ctx)
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	l.WritePointsOrFail(t, `cpu,host=server01,region=uswest usage=1.5,n=1i 0
+cpu,host=server01,region=uswest usage=2.5,n=2i 1000000000
+cpu,host=server02,region=useast usage=3.5,n=3i 0
+disk,host=server01,path=/ free=100,ok=true 0
+mem,host=server02 used="high" 0`)
+	if err := l.Launcher.DBRPMappingService().Create(ctx, &platform.DBRPMapping{
+		Cluster:         http.DefaultInfluxQLCluster,
+		Database:        "db0",
+		RetentionPolicy: "autogen",
+		Default:         true,
+		OrganizationID:  l.Org.ID,
+		BucketID:        l.Bucket.ID,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// SHOW MEASUREMENTS and SHOW TAG KEYS are read from the storage index.
+	tests := []struct {
+		query string
+		exp   string
+	}{
+		{
+			query: `SHOW MEASUREMENTS`,
+			exp:   `{"results":[{"statement_id":0,"series":[{"name":"measurements","columns":["name"],"values":[["cpu"],["disk"],["mem"]]}]}]}`,
+		},
+		{
+			query: `SHOW MEASUREMENTS WITH MEASUREMENT =~ /^(cpu|mem)$/`,
+			exp:   `{"results":[{"statement_id":0,"series":[{"name":"measurements","columns":["name"],"values":[["cpu"],["mem"]]}]}]}`,
+		},
+		{
+			query: `SHOW MEASUREMENTS WHERE host = 'server02' LIMIT 1`,
+			exp:   `{"results":[{"statement_id":0,"series":[{"name":"measurements","columns":["name"],"values":[["cpu"]]}]}]}`,
+		},
+		{
+			query: `SHOW MEASUREMENTS WHERE region = 'uswest' OR path = '/'`,
+			exp:   `{"results":[{"statement_id":0,"series":[{"name":"measurements","columns":["name"],"values":[["cpu"],["disk"]]}]}]}`,
+		},
+		{
+			query: `SHOW TAG KEYS`,
+			exp:   `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["tagKey"],"values":[["host"],["region"]]},{"name":"disk","columns":["tagKey"],"values":[["host"],["path"]]},{"name":"mem","columns":["tagKey"],"values":[["host"]]}]}]}`,
+		},
+		{
+			query: `SHOW TAG KEYS FROM cpu, mem WHERE host = 'server02'`,
+			exp:   `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["tagKey"],"values":[["host"],["region"]]},{"name":"mem","columns":["tagKey"],"values":[["host"]]}]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			body := l.InfluxQLQueryOrFail(t, "db0", tt.query)
+			if diff := cmp.Diff(tt.exp+"\n", body); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestLauncher_InfluxQLQueryType(t *testing.T) {
+	l := RunLauncherOrFail(t, ctx)
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	l.WritePointsOrFail(t, `m,k=v f=100i 946684800000000000`)
+
+	// Query the bucket with influxql through the flux query API.
+	qs := "SELECT f FROM m WHERE time >= '2000-01-01T00:00:00Z' AND time < '2000-01-02T00:00:00Z'"
+	exp := `,result,table,_start,_stop,_measurement,_time,f` + "\r\n" +
+		`,result,table,2000-01-01T00:00:00Z,2000-01-01T23:59:59.999999999Z,m,2000-01-01T00:00:00Z,100` + "\r\n\r\n"
+
+	var buf bytes.Buffer
+	req := (http.QueryRequest{Type: "influxql", Query: qs, Bucket: "BUCKET", Org: l.Org}).WithDefaults()
+	if preq, err := req.ProxyRequest(); err != nil {
+		t.Fatal(err)
+	} else if _, err := l.FluxService().Query(ctx, &buf, preq); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(exp, buf.String()); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestLauncher_PromQLQueryType(t *testing.T) {
+	l := RunLauncherOrFail(t, ctx)
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	// Write a counter and a histogram the way the scrapers store them.
+	t0, t1 := time.Now().Add(-2*time.Minute).UnixNano(), time.Now().Add(-time.Minute).UnixNano()
+	l.WritePointsOrFail(t, fmt.Sprintf(`http_requests_total,code=200 counter=10 %d
+http_requests_total,code=200 counter=70 %d
+http_requests_total,code=500 counter=4 %d
+http_requests_total,code=500 counter=1 %d
+request_duration_seconds,code=200 0.1=1,0.5=3,+Inf=4,count=4,sum=1.2 %d
+request_duration_seconds,code=200 0.1=2,0.5=6,+Inf=8,count=8,sum=2.4 %d`, t0, t1, t0, t1, t0, t1))
+
+	for _, tt := range []struct {
+		query string
+		exp   string
+	}{
+		{
+			// The counter of code 500 was reset, so its increase is its last value.
+			query: `sum by (code) (rate(http_requests_total[5m]))`,
+			exp: `,result,table,code,_value` + "\r\n" +
+				`,result,table,200,0.2` + "\r\n" +
+				`,,,500,0.0033333333333333335` + "\r\n\r\n",
+		},
+		{
+			query: `histogram_quantile(0.5, sum by (le) (rate(request_duration_seconds_bucket[5m])))`,
+			exp: `,result,table,_value` + "\r\n" +
+				`,result,table,0.30000000000000004` + "\r\n\r\n",
+		},
+		{
+			query: `request_duration_seconds_sum / on (code) request_duration_seconds_count`,
+			exp: `,result,table,_value,code` + "\r\n" +
+				`,result,table,0.3,200` + "\r\n\r\n",
+		},
+	} {
+		var buf bytes.Buffer
+		req := (http.QueryRequest{Type: "promql", Query: tt.query, Bucket: "BUCKET", Org: l.Org}).WithDefaults()
+		if preq, err := req.ProxyRequest(); err != nil {
+			t.Fatal(err)
+		} else if _, err := l.FluxService().Query(ctx, &buf, preq); err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if diff := cmp.Diff(tt.exp, buf.String()); diff != "" {
+			t.Errorf("%s: %s", tt.query, diff)
+		}
+	}
+}
+
+func TestLauncher_PrometheusRemoteWriteAndRead(t *testing.T) {
+	l := RunLauncherOrFail(t, ctx)
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	now := time.Now().Add(-time.Minute).UnixNano() / int64(time.Millisecond)
+	series := []remote.TimeSeries{
+		{
+			Labels: []remote.Label{
+				{Name: "__name__", Value: "http_requests_total"},
+				{Name: "code", Value: "200"},
+			},
+			Samples: []remote.Sample{{Value: 10, Timestamp: now - 1000}, {Value: 12, Timestamp: now}},
+		},
+		{
+			Labels: []remote.Label{
+				{Name: "__name__", Value: "http_requests_total"},
+				{Name: "code", Value: "500"},
+			},
+			Samples: []remote.Sample{{Value: 1, Timestamp: now - 1000}, {Value: 2, Timestamp: now}},
+		},
+	}
+	wreq, err := (&remote.WriteRequest{Timeseries: series}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := nethttp.DefaultClient.Do(l.MustNewHTTPRequest("POST", fmt.Sprintf("/api/v2/prom/write?org=%s&bucket=%s", l.Org.ID, l.Bucket.ID), string(snappy.Encode(nil, wreq))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != nethttp.StatusNoContent {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	rreq, err := (&remote.ReadRequest{
+		Queries: []*remote.Query{
+			{
+				StartTimestampMs: now - 60000,
+				EndTimestampMs:   now,
+				Matchers: []*remote.LabelMatcher{
+					{Type: remote.LabelMatcher_EQ, Name: "__name__", Value: "http_requests_total"},
+					{Type: remote.LabelMatcher_RE, Name: "code", Value: "2.."},
+				},
+			},
+			{
+				StartTimestampMs: now - 60000,
+				EndTimestampMs:   now,
+				Matchers: []*remote.LabelMatcher{
+					{Type: remote.LabelMatcher_EQ, Name: "__name__", Value: "up"},
+				},
+			},
+		},
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = nethttp.DefaultClient.Do(l.MustNewHTTPRequest("POST", fmt.Sprintf("/api/v2/prom/read?org=%s&bucket=%s", l.Org.Name, l.Bucket.Name), string(snappy.Encode(nil, rreq))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != nethttp.StatusOK {
+		t.Fatalf("unexpected status code: %d, body: %s", resp.StatusCode, body)
+	}
+	data, err := snappy.Decode(nil, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got remote.ReadResponse
+	if err := got.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := remote.ReadResponse{
+		Results: []*remote.QueryResult{
+			{Timeseries: []*remote.TimeSeries{&series[0]}},
+			{},
+		},
+	}
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// The samples can be queried with PromQL.
+	var buf bytes.Buffer
+	req := (http.QueryRequest{Type: "promql", Query: `sum(increase(http_requests_total[5m]))`, Bucket: "BUCKET", Org: l.Org}).WithDefaults()
+	if preq, err := req.ProxyRequest(); err != nil {
+		t.Fatal(err)
+	} else if _, err := l.FluxService().Query(ctx, &buf, preq); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(",result,table,_value\r\n,result,table,3\r\n\r\n", buf.String()); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
 func TestLauncher_BucketDelete(t *testing.T) {
 	l := RunLauncherOrFail(t, ctx)
 	l.SetupOrFail(t)
@@ -156,6 +423,117 @@ func TestLauncher_BucketDelete(t *testing.T) {
 	}
 }
 
+func TestLauncher_BackupRestore(t *testing.T) {
+	l := RunLauncherOrFail(t, ctx)
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	l.WritePointsOrFail(t, `m,k=v f=100i 946684800000000000`)
+
+	var archive bytes.Buffer
+	svc := &http.BackupService{Addr: l.URL(), Token: l.Auth.Token}
+	if err := svc.Backup(ctx, &archive, platform.BackupFilter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Data and metadata created after the backup must not survive the restore.
+	l.WritePointsOrFail(t, `m,k=v2 f=200i 946684800000000000`)
+	bs := &http.BucketService{Addr: l.URL(), Token: l.Auth.Token}
+	b := &platform.Bucket{Name: "AFTER", OrganizationID: l.Org.ID}
+	if err := bs.CreateBucket(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.Restore(ctx, &archive); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bs.FindBucketByID(ctx, b.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("expected bucket created after backup to be removed, got %v", err)
+	}
+
+	qs := `from(bucket:"BUCKET") |> range(start:2000-01-01T00:00:00Z,stop:2000-01-02T00:00:00Z)`
+	exp := `,result,table,_start,_stop,_time,_value,_field,_measurement,k` + "\r\n" +
+		`,result,table,2000-01-01T00:00:00Z,2000-01-02T00:00:00Z,2000-01-01T00:00:00Z,100,f,m,v` + "\r\n\r\n"
+
+	var buf bytes.Buffer
+	req := (http.QueryRequest{Query: qs, Org: l.Org}).WithDefaults()
+	if preq, err := req.ProxyRequest(); err != nil {
+		t.Fatal(err)
+	} else if _, err := l.FluxService().Query(ctx, &buf, preq); err != nil {
+		t.Fatal(err)
+	} else if diff := cmp.Diff(buf.String(), exp); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestLauncher_BackupRestoreBucket(t *testing.T) {
+	var now int64
+	l := NewLauncher()
+	l.StorageOptions = []storage.Option{storage.WithNow(func() time.Time {
+		return time.Unix(0, atomic.LoadInt64(&now))
+	})}
+	if err := l.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	l.SetupOrFail(t)
+	defer l.ShutdownOrFail(t, ctx)
+
+	l.WritePointsOrFail(t, `m,k=v f=100i 946684800000000000`)
+	l.WritePointsOrFail(t, `m,k=v f=150i 946728000000000000`)
+
+	var full bytes.Buffer
+	svc := &http.BackupService{Addr: l.URL(), Token: l.Auth.Token}
+	if err := svc.Backup(ctx, &full, platform.BackupFilter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The incremental backup holds the data written and deleted since the
+	// full backup.
+	since := time.Unix(0, atomic.AddInt64(&now, int64(time.Minute)))
+	l.WritePointsOrFail(t, `m,k=v f=200i 946771200000000000`)
+
+	ds := &http.DeleteService{Addr: l.URL(), Token: l.Auth.Token}
+	at := time.Unix(0, 946728000000000000)
+	if err := ds.DeleteBucketRangePredicate(ctx, l.Org.ID, l.Bucket.ID, at, at, `k="v"`); err != nil {
+		t.Fatal(err)
+	}
+
+	var incr bytes.Buffer
+	filter := platform.BackupFilter{
+		OrganizationID: &l.Org.ID,
+		BucketID:       &l.Bucket.ID,
+		Since:          since,
+	}
+	if err := svc.Backup(ctx, &incr, filter); err != nil {
+		t.Fatal(err)
+	}
+
+	// Data written after the incremental backup must not survive the restores.
+	l.WritePointsOrFail(t, `m,k=v f=300i 946857600000000000`)
+
+	if err := svc.Restore(ctx, &full); err != nil {
+		t.Fatal(err)
+	} else if err := svc.Restore(ctx, &incr); err != nil {
+		t.Fatal(err)
+	}
+
+	qs := `from(bucket:"BUCKET") |> range(start:2000-01-01T00:00:00Z,stop:2000-01-04T00:00:00Z)`
+	exp := `,result,table,_start,_stop,_time,_value,_field,_measurement,k` + "\r\n" +
+		`,result,table,2000-01-01T00:00:00Z,2000-01-04T00:00:00Z,2000-01-01T00:00:00Z,100,f,m,v` + "\r\n" +
+		`,result,table,2000-01-01T00:00:00Z,2000-01-04T00:00:00Z,2000-01-02T00:00:00Z,200,f,m,v` + "\r\n\r\n"
+
+	var buf bytes.Buffer
+	req := (http.QueryRequest{Query: qs, Org: l.Org}).WithDefaults()
+	if preq, err := req.ProxyRequest(); err != nil {
+		t.Fatal(err)
+	} else if _, err := l.FluxService().Query(ctx, &buf, preq); err != nil {
+		t.Fatal(err)
+	} else if diff := cmp.Diff(buf.String(), exp); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
 // Launcher is a test wrapper for launcher.Launcher.
 type Launcher struct {
 	*launcher.Launcher
@@ -263,3 +641,56 @@ func (l *Launcher) MustNewHTTPRequest(method, rawurl, body string) *nethttp.Requ
 	req.Header.Set("Authorization", "Token "+l.Auth.Token)
 	return req
 }
+
+// InfluxQLQueryOrFail queries the database db as an InfluxDB 1.X client, with the
+// token created by SetupOrFail as the password, and returns the body of the response.
+// Fail on error.
+func (l *Launcher) InfluxQLQueryOrFail(tb testing.TB, db, qs string) string {
+	tb.Helper()
+	q := url.Values{}
+	q.Set("db", db)
+	q.Set("q", qs)
+	q.Set("u", "USER")
+	q.Set("p", l.Auth.Token)
+	resp, err := nethttp.Get(l.URL() + "/query?" + q.Encode())
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		tb.Fatal(err)
+	}
+
+	if resp.StatusCode != nethttp.StatusOK {
+		tb.Fatalf("unexpected status code: %d, body: %s, headers: %v", resp.StatusCode, body, resp.Header)
+	}
+	return string(body)
+}
+
+// WritePointsOrFail attempts a write to the organization and bucket created by
+// SetupOrFail. Fail on error.
+func (l *Launcher) WritePointsOrFail(tb testing.TB, s string) {
+	tb.Helper()
+	resp, err := nethttp.DefaultClient.Do(l.MustNewHTTPRequest("POST", fmt.Sprintf("/api/v2/write?org=%s&bucket=%s", l.Org.ID, l.Bucket.ID), s))
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		tb.Fatal(err)
+	}
+
+	if resp.StatusCode != nethttp.StatusNoContent {
+		tb.Fatalf("unexpected status code: %d, body: %s, headers: %v", resp.StatusCode, body, resp.Header)
+	}
+}
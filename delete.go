@@ -0,0 +1,15 @@
+package platform
+
+import (
+	"context"
+	"time"
+)
+
+// DeleteService represents a service for deleting points from buckets.
+type DeleteService interface {
+	// DeleteBucketRangePredicate removes the points of a bucket with a
+	// timestamp within [start, stop] that belong to the series matched by
+	// predicate, such as `host="a" AND region="eu"`. An empty predicate
+	// matches all series of the bucket.
+	DeleteBucketRangePredicate(ctx context.Context, orgID, bucketID ID, start, stop time.Time, predicate string) error
+}
@@ -18,6 +18,7 @@ const (
 	EUnavailable      = "unavailable"
 	EForbidden        = "forbidden"
 	EMethodNotAllowed = "method not allowed"
+	ETooManyRequests  = "too many requests" // a quota was exceeded
 )
 
 // Error is the error struct of platform.
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/nats"
@@ -13,12 +14,14 @@ import (
 // handler implents nats Handler interface.
 type handler struct {
 	Scraper   Scraper
+	Status    platform.ScraperTargetStatusService
 	Publisher nats.Publisher
 	Logger    *zap.Logger
 }
 
 // Process consumes scraper target from scraper target queue,
-// call the scraper to gather, and publish to metrics queue.
+// call the scraper to gather within the timeout of the target, record the status of the scrape,
+// and publish to metrics queue.
 func (h *handler) Process(s nats.Subscription, m nats.Message) {
 	defer m.Ack()
 
@@ -29,7 +32,16 @@ func (h *handler) Process(s nats.Subscription, m nats.Message) {
 		return
 	}
 
-	ms, err := h.Scraper.Gather(context.TODO(), *req)
+	ctx := context.Background()
+	if req.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	ms, err := h.Scraper.Gather(ctx, *req)
+	h.recordStatus(req.ID, start, ms, err)
 	if err != nil {
 		h.Logger.Error("unable to gather", zap.Error(err))
 		return
@@ -46,5 +58,23 @@ func (h *handler) Process(s nats.Subscription, m nats.Message) {
 		h.Logger.Error("unable to publish scraper metrics", zap.Error(err))
 		return
 	}
+}
 
+// recordStatus stores the outcome of the scrape of a target started at start.
+func (h *handler) recordStatus(id platform.ID, start time.Time, ms []Metrics, err error) {
+	status := &platform.ScraperTargetStatus{
+		TargetID:   id,
+		LastScrape: start,
+		Duration:   time.Since(start),
+	}
+	for _, m := range ms {
+		status.Samples += len(m.Fields)
+	}
+	if err != nil {
+		status.LastError = err.Error()
+	}
+
+	if err := h.Status.PutTargetStatus(context.TODO(), status); err != nil {
+		h.Logger.Error("unable to record scrape status", zap.Error(err))
+	}
 }
@@ -21,12 +21,20 @@ type prometheusScraper struct{}
 
 // Gather parse metrics from a scraper target url.
 func (p *prometheusScraper) Gather(ctx context.Context, target platform.ScraperTarget) (ms []Metrics, err error) {
-	resp, err := http.Get(target.URL)
+	req, err := http.NewRequest("GET", target.URL, nil)
+	if err != nil {
+		return ms, err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return ms, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return ms, fmt.Errorf("server returned HTTP status %s", resp.Status)
+	}
+
 	return p.parse(resp.Body, resp.Header)
 }
 
@@ -21,9 +21,9 @@ const (
 // Scheduler is struct to run scrape jobs.
 type Scheduler struct {
 	Targets platform.ScraperTargetStoreService
-	// Interval is between each metrics gathering event.
+	// Interval is between each metrics gathering event of the targets without their own interval.
 	Interval time.Duration
-	// Timeout is the maxisium time duration allowed by each TCP request
+	// Timeout is the maxisium time duration allowed by each scrape of the targets without their own timeout.
 	Timeout time.Duration
 
 	// Publisher will send the gather requests and gathered metrics to the queue.
@@ -32,6 +32,9 @@ type Scheduler struct {
 	Logger *zap.Logger
 
 	gather chan struct{}
+
+	// requested is when the scrape of each target was last requested.
+	requested map[platform.ID]time.Time
 }
 
 // NewScheduler creates a new Scheduler and subscriptions for scraper jobs.
@@ -39,6 +42,7 @@ func NewScheduler(
 	numScrapers int,
 	l *zap.Logger,
 	targets platform.ScraperTargetStoreService,
+	status platform.ScraperTargetStatusService,
 	p nats.Publisher,
 	s nats.Subscriber,
 	interval time.Duration,
@@ -57,11 +61,13 @@ func NewScheduler(
 		Publisher: p,
 		Logger:    l,
 		gather:    make(chan struct{}, 100),
+		requested: make(map[platform.ID]time.Time),
 	}
 
 	for i := 0; i < numScrapers; i++ {
 		err := s.Subscribe(promTargetSubject, "", &handler{
 			Scraper:   new(prometheusScraper),
+			Status:    status,
 			Publisher: p,
 			Logger:    l,
 		})
@@ -73,15 +79,25 @@ func NewScheduler(
 	return scheduler, nil
 }
 
+// checkInterval is the time between the checks for targets due to be scraped,
+// and so the shortest interval at which a target is scraped.
+const checkInterval = time.Second
+
 // Run will retrieve scraper targets from the target storage,
 // and publish them to nats job queue for gather.
 func (s *Scheduler) Run(ctx context.Context) error {
+	tick := checkInterval
+	if s.Interval < tick {
+		tick = s.Interval
+	}
 	go func(s *Scheduler, ctx context.Context) {
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case <-time.After(s.Interval): // TODO: change to ticker because of garbage collection
+			case <-ticker.C:
 				s.gather <- struct{}{}
 			}
 		}
@@ -95,20 +111,44 @@ func (s *Scheduler) run(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-s.gather:
-			ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-			defer cancel()
-			targets, err := s.Targets.ListTargets(ctx)
-			if err != nil {
-				s.Logger.Error("cannot list targets", zap.Error(err))
-				continue
-			}
-			for _, target := range targets {
-				if err := requestScrape(target, s.Publisher); err != nil {
-					s.Logger.Error("json encoding error", zap.Error(err))
-				}
-			}
+			s.requestDueScrapes(ctx, time.Now())
+		}
+	}
+}
+
+// requestDueScrapes requests the scrapes of the targets whose interval has elapsed
+// since their scrape was last requested.
+func (s *Scheduler) requestDueScrapes(ctx context.Context, now time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	targets, err := s.Targets.ListTargets(ctx)
+	if err != nil {
+		s.Logger.Error("cannot list targets", zap.Error(err))
+		return
+	}
+
+	// Only the targets still listed are kept, so removed targets are forgotten.
+	requested := make(map[platform.ID]time.Time, len(targets))
+	for _, target := range targets {
+		interval := target.Interval
+		if interval <= 0 {
+			interval = s.Interval
+		}
+		if last, ok := s.requested[target.ID]; ok && now.Sub(last) < interval {
+			requested[target.ID] = last
+			continue
+		}
+
+		if target.Timeout <= 0 {
+			target.Timeout = s.Timeout
+		}
+		if err := requestScrape(target, s.Publisher); err != nil {
+			s.Logger.Error("cannot request scrape", zap.Stringer("target", target.ID), zap.Error(err))
+			continue
 		}
+		requested[target.ID] = now
 	}
+	s.requested = requested
 }
 
 func requestScrape(t platform.ScraperTarget, publisher nats.Publisher) error {
@@ -2,6 +2,8 @@ package gather
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http/httptest"
 	"os"
 	"testing"
@@ -12,6 +14,7 @@ import (
 	influxlogger "github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/mock"
 	platformtesting "github.com/influxdata/platform/testing"
+	"go.uber.org/zap"
 )
 
 func TestScheduler(t *testing.T) {
@@ -46,7 +49,7 @@ func TestScheduler(t *testing.T) {
 	})
 
 	scheduler, err := NewScheduler(10, logger,
-		storage, publisher, subscriber, time.Millisecond, time.Microsecond)
+		storage, storage, publisher, subscriber, time.Millisecond, time.Second)
 
 	go func() {
 		err = scheduler.run(ctx)
@@ -87,9 +90,96 @@ func TestScheduler(t *testing.T) {
 			t.Fatalf("scraper parse metrics want %v, got %v", want, v)
 		}
 	}
+
+	status, err := storage.FindTargetStatus(ctx, storage.Targets[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.LastScrape.IsZero() || status.Samples != 1 || status.LastError != "" {
+		t.Fatalf("unexpected scrape status %+v", status)
+	}
 	ts.Close()
 }
 
+// mockPublisher records the targets of the scrape requests.
+type mockPublisher struct {
+	targets []platform.ScraperTarget
+}
+
+func (p *mockPublisher) Publish(subject string, r io.Reader) error {
+	var target platform.ScraperTarget
+	if err := json.NewDecoder(r).Decode(&target); err != nil {
+		return err
+	}
+	p.targets = append(p.targets, target)
+	return nil
+}
+
+func TestScheduler_TargetInterval(t *testing.T) {
+	storage := &mockStorage{
+		Targets: []platform.ScraperTarget{
+			{
+				ID:   platformtesting.MustIDBase16("3a0d0a6365646120"),
+				Type: platform.PrometheusScraperType,
+			},
+			{
+				ID:       platformtesting.MustIDBase16("3a0d0a6365646121"),
+				Type:     platform.PrometheusScraperType,
+				Interval: 10 * time.Second,
+				Timeout:  5 * time.Second,
+			},
+		},
+	}
+	publisher := &mockPublisher{}
+	scheduler := &Scheduler{
+		Targets:   storage,
+		Interval:  time.Minute,
+		Timeout:   30 * time.Second,
+		Publisher: publisher,
+		Logger:    zap.NewNop(),
+		requested: make(map[platform.ID]time.Time),
+	}
+
+	now := time.Now()
+	for _, tt := range []struct {
+		elapsed time.Duration
+		want    []platform.ScraperTarget
+	}{
+		{
+			elapsed: 0,
+			want: []platform.ScraperTarget{
+				withTimeout(storage.Targets[0], 30*time.Second),
+				storage.Targets[1],
+			},
+		},
+		{
+			elapsed: 5 * time.Second,
+		},
+		{
+			elapsed: 10 * time.Second,
+			want:    []platform.ScraperTarget{storage.Targets[1]},
+		},
+		{
+			elapsed: time.Minute,
+			want: []platform.ScraperTarget{
+				withTimeout(storage.Targets[0], 30*time.Second),
+				storage.Targets[1],
+			},
+		},
+	} {
+		publisher.targets = nil
+		scheduler.requestDueScrapes(context.Background(), now.Add(tt.elapsed))
+		if diff := cmp.Diff(tt.want, publisher.targets); diff != "" {
+			t.Errorf("unexpected scrape requests after %s: %s", tt.elapsed, diff)
+		}
+	}
+}
+
+func withTimeout(target platform.ScraperTarget, timeout time.Duration) platform.ScraperTarget {
+	target.Timeout = timeout
+	return target
+}
+
 const sampleRespSmall = `
 # HELP go_goroutines Number of goroutines that currently exist.
 # TYPE go_goroutines gauge
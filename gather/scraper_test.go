@@ -182,13 +182,14 @@ go_memstats_frees_total 1.8944339e+07
 go_memstats_gc_cpu_fraction 1.972734963012756e-05
 `
 
-// mockStorage implement storage interface
-// and platform.ScraperTargetStoreService interface.
+// mockStorage implement storage interface,
+// platform.ScraperTargetStoreService and platform.ScraperTargetStatusService interfaces.
 type mockStorage struct {
 	sync.RWMutex
 	TotalGatherJobs chan struct{}
 	Metrics         map[int64]Metrics
 	Targets         []platform.ScraperTarget
+	Statuses        map[platform.ID]platform.ScraperTargetStatus
 }
 
 func (s *mockStorage) Record(ms []Metrics) error {
@@ -267,6 +268,31 @@ func (s *mockStorage) UpdateTarget(ctx context.Context, update *platform.Scraper
 	return update, err
 }
 
+func (s *mockStorage) FindTargetStatus(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	status, ok := s.Statuses[id]
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "scraper target status is not found",
+		}
+	}
+	return &status, nil
+}
+
+func (s *mockStorage) PutTargetStatus(ctx context.Context, status *platform.ScraperTargetStatus) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.Statuses == nil {
+		s.Statuses = make(map[platform.ID]platform.ScraperTargetStatus)
+	}
+	s.Statuses[status.TargetID] = *status
+	return nil
+}
+
 type mockHTTPHandler struct {
 	unauthorized bool
 	noContent    bool
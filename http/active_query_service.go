@@ -0,0 +1,283 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	kerrors "github.com/influxdata/platform/kit/errors"
+	"github.com/influxdata/platform/query"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+const (
+	activeQueriesPath = "/api/v2/queries"
+)
+
+// ActiveQueryHandler lists and cancels the queries being executed.
+//
+// Queries read the buckets of their organization, so listing them requires
+// read permission on the buckets of the organization and canceling them write permission.
+// Users may always cancel their own queries.
+type ActiveQueryHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	ActiveQueryService query.ActiveQueryService
+}
+
+// NewActiveQueryHandler returns a new instance of ActiveQueryHandler.
+func NewActiveQueryHandler() *ActiveQueryHandler {
+	h := &ActiveQueryHandler{
+		Router: NewRouter(),
+		Logger: zap.NewNop(),
+	}
+
+	h.HandlerFunc("GET", activeQueriesPath, h.handleGetActiveQueries)
+	h.HandlerFunc("GET", activeQueriesPath+"/:id", h.handleGetActiveQuery)
+	h.HandlerFunc("DELETE", activeQueriesPath+"/:id", h.handleDeleteActiveQuery)
+	return h
+}
+
+type activeQueryLinks struct {
+	Self string `json:"self"`
+}
+
+type activeQueryResponse struct {
+	*query.ActiveQuery
+	Links activeQueryLinks `json:"links"`
+}
+
+func newActiveQueryResponse(q *query.ActiveQuery) activeQueryResponse {
+	return activeQueryResponse{
+		ActiveQuery: q,
+		Links: activeQueryLinks{
+			Self: fmt.Sprintf("%s/%s", activeQueriesPath, q.ID),
+		},
+	}
+}
+
+type activeQueriesResponse struct {
+	Queries []activeQueryResponse `json:"queries"`
+	Links   activeQueryLinks      `json:"links"`
+}
+
+func newActiveQueriesResponse(qs []*query.ActiveQuery) activeQueriesResponse {
+	res := activeQueriesResponse{
+		Queries: make([]activeQueryResponse, 0, len(qs)),
+		Links: activeQueryLinks{
+			Self: activeQueriesPath,
+		},
+	}
+	for _, q := range qs {
+		res.Queries = append(res.Queries, newActiveQueryResponse(q))
+	}
+	return res
+}
+
+// handleGetActiveQueries is the HTTP handler for the GET /api/v2/queries route.
+func (h *ActiveQueryHandler) handleGetActiveQueries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	var filter query.ActiveQueryFilter
+	if id := r.URL.Query().Get("orgID"); id != "" {
+		if filter.OrganizationID, err = platform.IDFromString(id); err != nil {
+			EncodeError(ctx, &platform.Error{
+				Code: platform.EInvalid,
+				Op:   "http/handleGetActiveQueries",
+				Msg:  "invalid orgID",
+				Err:  err,
+			}, w)
+			return
+		}
+	}
+
+	qs, err := h.ActiveQueryService.FindActiveQueries(ctx, filter)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	allowed := make([]*query.ActiveQuery, 0, len(qs))
+	for _, q := range qs {
+		if activeQueryAllowed(a, q, platform.ReadAction) {
+			allowed = append(allowed, q)
+		}
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newActiveQueriesResponse(allowed)); err != nil {
+		logEncodingError(h.Logger, r, err)
+		return
+	}
+}
+
+// handleGetActiveQuery is the HTTP handler for the GET /api/v2/queries/:id route.
+func (h *ActiveQueryHandler) handleGetActiveQuery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := h.findAllowedActiveQuery(ctx, platform.ReadAction)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newActiveQueryResponse(q)); err != nil {
+		logEncodingError(h.Logger, r, err)
+		return
+	}
+}
+
+// handleDeleteActiveQuery is the HTTP handler for the DELETE /api/v2/queries/:id route.
+func (h *ActiveQueryHandler) handleDeleteActiveQuery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := h.findAllowedActiveQuery(ctx, platform.WriteAction)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	h.Logger.Info("Canceling query",
+		zap.Stringer("query_id", q.ID),
+		zap.Stringer("org_id", q.OrganizationID))
+
+	if err := h.ActiveQueryService.CancelQuery(ctx, q.ID); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findAllowedActiveQuery returns the query identified by the request,
+// if the authorizer of the request is allowed to perform action on it.
+func (h *ActiveQueryHandler) findAllowedActiveQuery(ctx context.Context, action platform.Action) (*query.ActiveQuery, error) {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := httprouter.ParamsFromContext(ctx)
+	id, err := platform.IDFromString(params.ByName("id"))
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/findAllowedActiveQuery",
+			Msg:  "invalid query id",
+			Err:  err,
+		}
+	}
+
+	q, err := h.ActiveQueryService.FindActiveQueryByID(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !activeQueryAllowed(a, q, action) {
+		return nil, kerrors.Forbiddenf("insufficient permissions for query")
+	}
+	return q, nil
+}
+
+// activeQueryAllowed reports whether a may perform action on q.
+func activeQueryAllowed(a platform.Authorizer, q *query.ActiveQuery, action platform.Action) bool {
+	if action == platform.WriteAction && q.UserID.Valid() && a.GetUserID() == q.UserID {
+		return true
+	}
+	p, err := platform.NewOrgPermission(q.OrganizationID, action, platform.BucketsResource)
+	if err != nil {
+		return false
+	}
+	return a.Allowed(*p)
+}
+
+// ActiveQueryService lists and cancels the queries being executed over HTTP.
+type ActiveQueryService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ query.ActiveQueryService = (*ActiveQueryService)(nil)
+
+// FindActiveQueries returns the queries being executed that match filter.
+func (s *ActiveQueryService) FindActiveQueries(ctx context.Context, filter query.ActiveQueryFilter) ([]*query.ActiveQuery, error) {
+	u, err := newURL(s.Addr, activeQueriesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.OrganizationID != nil {
+		params := u.Query()
+		params.Set("orgID", filter.OrganizationID.String())
+		u.RawQuery = params.Encode()
+	}
+
+	var res struct {
+		Queries []*query.ActiveQuery `json:"queries"`
+	}
+	if err := s.do(ctx, "GET", u.String(), &res); err != nil {
+		return nil, err
+	}
+	return res.Queries, nil
+}
+
+// FindActiveQueryByID returns the query being executed with id.
+func (s *ActiveQueryService) FindActiveQueryByID(ctx context.Context, id platform.ID) (*query.ActiveQuery, error) {
+	u, err := newURL(s.Addr, path.Join(activeQueriesPath, id.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	var q query.ActiveQuery
+	if err := s.do(ctx, "GET", u.String(), &q); err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// CancelQuery stops the execution of the query with id.
+func (s *ActiveQueryService) CancelQuery(ctx context.Context, id platform.ID) error {
+	u, err := newURL(s.Addr, path.Join(activeQueriesPath, id.String()))
+	if err != nil {
+		return err
+	}
+	return s.do(ctx, "DELETE", u.String(), nil)
+}
+
+// do sends a request without a body and decodes the response into v, unless v is nil.
+func (s *ActiveQueryService) do(ctx context.Context, method, url string, v interface{}) error {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+	SetToken(s.Token, req)
+	req = req.WithContext(ctx)
+
+	hc := newClient(req.URL.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
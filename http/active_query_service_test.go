@@ -0,0 +1,194 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/query"
+)
+
+type fakeActiveQueryService struct {
+	queries  []*query.ActiveQuery
+	canceled []platform.ID
+}
+
+func (s *fakeActiveQueryService) FindActiveQueries(ctx context.Context, filter query.ActiveQueryFilter) ([]*query.ActiveQuery, error) {
+	var qs []*query.ActiveQuery
+	for _, q := range s.queries {
+		if filter.OrganizationID != nil && *filter.OrganizationID != q.OrganizationID {
+			continue
+		}
+		qs = append(qs, q)
+	}
+	return qs, nil
+}
+
+func (s *fakeActiveQueryService) FindActiveQueryByID(ctx context.Context, id platform.ID) (*query.ActiveQuery, error) {
+	for _, q := range s.queries {
+		if q.ID == id {
+			return q, nil
+		}
+	}
+	return nil, &platform.Error{Code: platform.ENotFound, Msg: "query not found"}
+}
+
+func (s *fakeActiveQueryService) CancelQuery(ctx context.Context, id platform.ID) error {
+	s.canceled = append(s.canceled, id)
+	return nil
+}
+
+func newFakeActiveQueryService() *fakeActiveQueryService {
+	start := time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)
+	return &fakeActiveQueryService{
+		queries: []*query.ActiveQuery{
+			{ID: 1, OrganizationID: 10, UserID: 100, CompilerType: "flux", Query: `from(bucket:"a")`, StartTime: start, State: "executing"},
+			{ID: 2, OrganizationID: 20, UserID: 200, CompilerType: "flux", Query: `from(bucket:"b")`, StartTime: start, State: "queueing"},
+		},
+	}
+}
+
+func TestActiveQueryHandler(t *testing.T) {
+	read10, err := platform.NewOrgPermission(10, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write10, err := platform.NewOrgPermission(10, platform.WriteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		userID      platform.ID
+		permissions []platform.Permission
+		status      int
+		canceled    []platform.ID
+	}{
+		{
+			name:        "get query of the org",
+			method:      "GET",
+			path:        "/api/v2/queries/0000000000000001",
+			permissions: []platform.Permission{*read10},
+			status:      http.StatusOK,
+		},
+		{
+			name:        "get query of another org",
+			method:      "GET",
+			path:        "/api/v2/queries/0000000000000002",
+			permissions: []platform.Permission{*read10},
+			status:      http.StatusForbidden,
+		},
+		{
+			name:        "get unknown query",
+			method:      "GET",
+			path:        "/api/v2/queries/0000000000000003",
+			permissions: []platform.Permission{*read10},
+			status:      http.StatusNotFound,
+		},
+		{
+			name:        "cancel with write permission",
+			method:      "DELETE",
+			path:        "/api/v2/queries/0000000000000001",
+			permissions: []platform.Permission{*write10},
+			status:      http.StatusNoContent,
+			canceled:    []platform.ID{1},
+		},
+		{
+			name:        "cancel with read permission",
+			method:      "DELETE",
+			path:        "/api/v2/queries/0000000000000001",
+			permissions: []platform.Permission{*read10},
+			status:      http.StatusForbidden,
+		},
+		{
+			name:     "cancel own query",
+			method:   "DELETE",
+			path:     "/api/v2/queries/0000000000000002",
+			userID:   200,
+			status:   http.StatusNoContent,
+			canceled: []platform.ID{2},
+		},
+		{
+			name:   "cancel query of another user",
+			method: "DELETE",
+			path:   "/api/v2/queries/0000000000000002",
+			userID: 100,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newFakeActiveQueryService()
+			h := NewActiveQueryHandler()
+			h.ActiveQueryService = svc
+
+			r := httptest.NewRequest(tt.method, "http://any.url"+tt.path, nil)
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				UserID:      tt.userID,
+				Permissions: tt.permissions,
+			}))
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if got, exp := w.Code, tt.status; got != exp {
+				t.Fatalf("got status %d, exp %d: %s", got, exp, w.Body.String())
+			}
+			if got, exp := len(svc.canceled), len(tt.canceled); got != exp {
+				t.Fatalf("got %d cancels, exp %d", got, exp)
+			}
+			for i := range tt.canceled {
+				if got, exp := svc.canceled[i], tt.canceled[i]; got != exp {
+					t.Fatalf("got canceled query %s, exp %s", got, exp)
+				}
+			}
+		})
+	}
+}
+
+func TestActiveQueryService_FindActiveQueries(t *testing.T) {
+	read10, err := platform.NewOrgPermission(10, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewActiveQueryHandler()
+	h.ActiveQueryService = newFakeActiveQueryService()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+			Status:      platform.Active,
+			Permissions: []platform.Permission{*read10},
+		})))
+	}))
+	defer server.Close()
+
+	s := &ActiveQueryService{Addr: server.URL}
+	qs, err := s.FindActiveQueries(context.Background(), query.ActiveQueryFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qs) != 1 {
+		t.Fatalf("got %d queries, exp 1", len(qs))
+	}
+	if got, exp := *qs[0], *newFakeActiveQueryService().queries[0]; got != exp {
+		t.Fatalf("got query %+v, exp %+v", got, exp)
+	}
+
+	orgID := platform.ID(20)
+	qs, err = s.FindActiveQueries(context.Background(), query.ActiveQueryFilter{OrganizationID: &orgID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qs) != 0 {
+		t.Fatalf("got %d queries of an org without permission, exp 0", len(qs))
+	}
+}
@@ -13,11 +13,14 @@ import (
 
 // APIHandler is a collection of all the service handlers.
 type APIHandler struct {
+	BackupHandler        *BackupHandler
 	BucketHandler        *BucketHandler
 	UserHandler          *UserHandler
 	OrgHandler           *OrgHandler
 	AuthorizationHandler *AuthorizationHandler
 	DashboardHandler     *DashboardHandler
+	DBRPMappingHandler   *DBRPMappingHandler
+	DeleteHandler        *DeleteHandler
 	AssetHandler         *AssetHandler
 	ChronografHandler    *ChronografHandler
 	SourceHandler        *SourceHandler
@@ -25,7 +28,11 @@ type APIHandler struct {
 	TaskHandler          *TaskHandler
 	TelegrafHandler      *TelegrafHandler
 	QueryHandler         *FluxHandler
+	InfluxQLHandler      *InfluxQLHandler
+	ActiveQueryHandler   *ActiveQueryHandler
 	ProtoHandler         *ProtoHandler
+	PrometheusHandler    *PrometheusHandler
+	ScraperHandler       *ScraperHandler
 	WriteHandler         *WriteHandler
 	SetupHandler         *SetupHandler
 	SessionHandler       *SessionHandler
@@ -41,6 +48,7 @@ type APIBackend struct {
 	NewQueryService  func(*platform.Source) (query.ProxyQueryService, error)
 
 	PointsWriter                    storage.PointsWriter
+	PointsDeleter                   storage.PointsDeleter
 	AuthorizationService            platform.AuthorizationService
 	BucketService                   platform.BucketService
 	SessionService                  platform.SessionService
@@ -58,13 +66,18 @@ type APIBackend struct {
 	BasicAuthService                platform.BasicAuthService
 	OnboardingService               platform.OnboardingService
 	ProxyQueryService               query.ProxyQueryService
+	ActiveQueryService              query.ActiveQueryService
 	TaskService                     platform.TaskService
 	TelegrafService                 platform.TelegrafConfigStore
 	ScraperTargetStoreService       platform.ScraperTargetStoreService
+	ScraperTargetStatusService      platform.ScraperTargetStatusService
 	SecretService                   platform.SecretService
+	OrganizationSettingsService     platform.OrganizationSettingsService
 	LookupService                   platform.LookupService
 	ChronografService               *server.Service
 	ProtoService                    platform.ProtoService
+	BackupService                   platform.BackupService
+	DBRPMappingService              platform.DBRPMappingService
 }
 
 // NewAPIHandler constructs all api handlers beneath it and returns an APIHandler
@@ -82,6 +95,7 @@ func NewAPIHandler(b *APIBackend) *APIHandler {
 	h.OrgHandler.BucketService = b.BucketService
 	h.OrgHandler.OrganizationOperationLogService = b.OrganizationOperationLogService
 	h.OrgHandler.SecretService = b.SecretService
+	h.OrgHandler.OrganizationSettingsService = b.OrganizationSettingsService
 
 	h.UserHandler = NewUserHandler()
 	h.UserHandler.UserService = b.UserService
@@ -122,18 +136,57 @@ func NewAPIHandler(b *APIBackend) *APIHandler {
 		b.UserService,
 	)
 
+	h.ScraperHandler = NewScraperHandler()
+	h.ScraperHandler.ScraperStorageService = b.ScraperTargetStoreService
+	h.ScraperHandler.ScraperTargetStatusService = b.ScraperTargetStatusService
+	h.ScraperHandler.OrganizationService = b.OrganizationService
+	h.ScraperHandler.BucketService = b.BucketService
+	h.ScraperHandler.Logger = b.Logger.With(zap.String("handler", "scraper"))
+
 	h.WriteHandler = NewWriteHandler(b.PointsWriter)
 	h.WriteHandler.OrganizationService = b.OrganizationService
 	h.WriteHandler.BucketService = b.BucketService
 	h.WriteHandler.Logger = b.Logger.With(zap.String("handler", "write"))
 
+	h.DeleteHandler = NewDeleteHandler(b.PointsDeleter)
+	h.DeleteHandler.OrganizationService = b.OrganizationService
+	h.DeleteHandler.BucketService = b.BucketService
+	h.DeleteHandler.Logger = b.Logger.With(zap.String("handler", "delete"))
+
 	h.QueryHandler = NewFluxHandler()
 	h.QueryHandler.OrganizationService = b.OrganizationService
+	h.QueryHandler.DBRPMappingService = b.DBRPMappingService
 	h.QueryHandler.Logger = b.Logger.With(zap.String("handler", "query"))
 	h.QueryHandler.ProxyQueryService = b.ProxyQueryService
 
+	h.InfluxQLHandler = NewInfluxQLHandler()
+	h.InfluxQLHandler.DBRPMappingService = b.DBRPMappingService
+	h.InfluxQLHandler.Logger = b.Logger.With(zap.String("handler", "influxql"))
+	h.InfluxQLHandler.ProxyQueryService = b.ProxyQueryService
+
+	h.DBRPMappingHandler = NewDBRPMappingHandler()
+	h.DBRPMappingHandler.DBRPMappingService = b.DBRPMappingService
+	h.DBRPMappingHandler.BucketService = b.BucketService
+	h.DBRPMappingHandler.Logger = b.Logger.With(zap.String("handler", "dbrps"))
+
+	h.PrometheusHandler = NewPrometheusHandler()
+	h.PrometheusHandler.OrganizationService = b.OrganizationService
+	h.PrometheusHandler.BucketService = b.BucketService
+	h.PrometheusHandler.PointsWriter = b.PointsWriter
+	h.PrometheusHandler.ProxyQueryService = b.ProxyQueryService
+	h.PrometheusHandler.Logger = b.Logger.With(zap.String("handler", "prometheus"))
+
+	h.ActiveQueryHandler = NewActiveQueryHandler()
+	h.ActiveQueryHandler.ActiveQueryService = b.ActiveQueryService
+	h.ActiveQueryHandler.Logger = b.Logger.With(zap.String("handler", "queries"))
+
 	h.ProtoHandler = NewProtoHandler(NewProtoBackend(b))
 
+	h.BackupHandler = NewBackupHandler()
+	h.BackupHandler.BackupService = b.BackupService
+	h.BackupHandler.BucketService = b.BucketService
+	h.BackupHandler.Logger = b.Logger.With(zap.String("handler", "backup"))
+
 	h.ChronografHandler = NewChronografHandler(b.ChronografService)
 
 	return h
@@ -143,15 +196,23 @@ var apiLinks = map[string]interface{}{
 	// when adding new links, please take care to keep this list alphabetical
 	// as this makes it easier to verify values against the swagger document.
 	"authorizations": "/api/v2/authorizations",
+	"backup":         "/api/v2/backup",
 	"buckets":        "/api/v2/buckets",
 	"dashboards":     "/api/v2/dashboards",
+	"dbrps":          "/api/v2/dbrps",
+	"delete":         "/api/v2/delete",
 	"external": map[string]string{
 		"statusFeed": "https://www.influxdata.com/feed/json",
 	},
 	"macros": "/api/v2/macros",
 	"me":     "/api/v2/me",
 	"orgs":   "/api/v2/orgs",
-	"protos": "/api/v2/protos",
+	"prom": map[string]string{
+		"read":  "/api/v2/prom/read",
+		"write": "/api/v2/prom/write",
+	},
+	"protos":  "/api/v2/protos",
+	"queries": "/api/v2/queries",
 	"query": map[string]string{
 		"self":        "/api/v2/query",
 		"ast":         "/api/v2/query/ast",
@@ -159,10 +220,12 @@ var apiLinks = map[string]interface{}{
 		"spec":        "/api/v2/query/spec",
 		"suggestions": "/api/v2/query/suggestions",
 	},
-	"setup":   "/api/v2/setup",
-	"signin":  "/api/v2/signin",
-	"signout": "/api/v2/signout",
-	"sources": "/api/v2/sources",
+	"restore":  "/api/v2/restore",
+	"scrapers": "/api/v2/scrapers",
+	"setup":    "/api/v2/setup",
+	"signin":   "/api/v2/signin",
+	"signout":  "/api/v2/signout",
+	"sources":  "/api/v2/sources",
 	"system": map[string]string{
 		"metrics": "/metrics",
 		"debug":   "/debug/pprof",
@@ -210,11 +273,36 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v2/prom/") {
+		h.PrometheusHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v2/delete") {
+		h.DeleteHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v2/queries") {
+		h.ActiveQueryHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/query") {
 		h.QueryHandler.ServeHTTP(w, r)
 		return
 	}
 
+	if r.URL.Path == influxqlPath {
+		h.InfluxQLHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, dbrpsPath) {
+		h.DBRPMappingHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/buckets") {
 		h.BucketHandler.ServeHTTP(w, r)
 		return
@@ -260,11 +348,21 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v2/scrapers") {
+		h.ScraperHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/macros") {
 		h.MacroHandler.ServeHTTP(w, r)
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v2/backup") || strings.HasPrefix(r.URL.Path, "/api/v2/restore") {
+		h.BackupHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/protos") {
 		h.ProtoHandler.ServeHTTP(w, r)
 		return
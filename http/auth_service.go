@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"path"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -40,12 +42,13 @@ func NewAuthorizationHandler(userService platform.UserService) *AuthorizationHan
 	h.HandlerFunc("GET", "/api/v2/authorizations/:id", h.handleGetAuthorization)
 	h.HandlerFunc("PATCH", "/api/v2/authorizations/:id", h.handleSetAuthorizationStatus)
 	h.HandlerFunc("DELETE", "/api/v2/authorizations/:id", h.handleDeleteAuthorization)
+	h.HandlerFunc("POST", "/api/v2/authorizations/:id/rotate", h.handleRotateAuthorization)
 	return h
 }
 
 type authResponse struct {
 	ID          platform.ID          `json:"id"`
-	Token       string               `json:"token"`
+	Token       string               `json:"token,omitempty"`
 	Status      platform.Status      `json:"status"`
 	Description string               `json:"description"`
 	OrgID       platform.ID          `json:"orgID"`
@@ -53,6 +56,8 @@ type authResponse struct {
 	UserID      platform.ID          `json:"userID"`
 	User        string               `json:"user"`
 	Permissions []permissionResponse `json:"permissions"`
+	ExpiresAt   *time.Time           `json:"expiresAt,omitempty"`
+	LastUsedAt  *time.Time           `json:"lastUsedAt,omitempty"`
 	Links       map[string]string    `json:"links"`
 }
 
@@ -67,9 +72,12 @@ func newAuthResponse(a *platform.Authorization, org *platform.Organization, user
 		User:        user.Name,
 		Org:         org.Name,
 		Permissions: ps,
+		ExpiresAt:   a.ExpiresAt,
+		LastUsedAt:  a.LastUsedAt,
 		Links: map[string]string{
-			"self": fmt.Sprintf("/api/v2/authorizations/%s", a.ID),
-			"user": fmt.Sprintf("/api/v2/users/%s", a.UserID),
+			"self":   fmt.Sprintf("/api/v2/authorizations/%s", a.ID),
+			"user":   fmt.Sprintf("/api/v2/users/%s", a.UserID),
+			"rotate": fmt.Sprintf("/api/v2/authorizations/%s/rotate", a.ID),
 		},
 	}
 	return res
@@ -83,6 +91,8 @@ func (a *authResponse) toPlatform() *platform.Authorization {
 		Description: a.Description,
 		OrgID:       a.OrgID,
 		UserID:      a.UserID,
+		ExpiresAt:   a.ExpiresAt,
+		LastUsedAt:  a.LastUsedAt,
 	}
 	for _, p := range a.Permissions {
 		res.Permissions = append(res.Permissions, p.Permission)
@@ -174,6 +184,7 @@ type postAuthorizationRequest struct {
 	OrgID       platform.ID           `json:"orgID"`
 	Description string                `json:"description"`
 	Permissions []platform.Permission `json:"permissions"`
+	ExpiresAt   *time.Time            `json:"expiresAt,omitempty"`
 }
 
 func (p *postAuthorizationRequest) toPlatform(userID platform.ID) *platform.Authorization {
@@ -183,6 +194,7 @@ func (p *postAuthorizationRequest) toPlatform(userID platform.ID) *platform.Auth
 		Description: p.Description,
 		Permissions: p.Permissions,
 		UserID:      userID,
+		ExpiresAt:   p.ExpiresAt,
 	}
 }
 
@@ -192,6 +204,7 @@ func newPostAuthorizationRequest(a *platform.Authorization) (*postAuthorizationR
 		Description: a.Description,
 		Permissions: a.Permissions,
 		Status:      a.Status,
+		ExpiresAt:   a.ExpiresAt,
 	}
 
 	res.SetDefaults()
@@ -238,6 +251,17 @@ func (p *postAuthorizationRequest) Validate() error {
 		return err
 	}
 
+	return validateExpiresAt(p.ExpiresAt)
+}
+
+// validateExpiresAt ensures that a token expiration time, if any, is in the future.
+func validateExpiresAt(expiresAt *time.Time) error {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "expiresAt must be in the future",
+		}
+	}
 	return nil
 }
 
@@ -453,6 +477,117 @@ func (h *AuthorizationHandler) handleSetAuthorizationStatus(w http.ResponseWrite
 	}
 }
 
+// handleRotateAuthorization is the HTTP handler for the POST /api/v2/authorizations/:id/rotate route
+// that replaces the authorization's token with a new one.
+func (h *AuthorizationHandler) handleRotateAuthorization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeRotateAuthorizationRequest(ctx, r)
+	if err != nil {
+		h.Logger.Info("failed to decode request", zap.String("handler", "rotateAuthorization"), zap.Error(err))
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.authorizeRotate(ctx, req.ID); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	a, err := h.AuthorizationService.RotateAuthorization(ctx, req.ID, req.ExpiresAt)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	o, err := h.OrganizationService.FindOrganizationByID(ctx, a.OrgID)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	u, err := h.UserService.FindUserByID(ctx, a.UserID)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	ps, err := newPermissionsResponse(ctx, a.Permissions, h.LookupService)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newAuthResponse(a, o, u, ps)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+// authorizeRotate ensures the authorizer in ctx may rotate the authorization
+// with id: it must own the authorization, or be allowed to write authorizations
+// in the authorization's organization.
+func (h *AuthorizationHandler) authorizeRotate(ctx context.Context, id platform.ID) error {
+	a, err := platcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	target, err := h.AuthorizationService.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if target.UserID.Valid() && a.GetUserID() == target.UserID {
+		return nil
+	}
+
+	p, err := platform.NewOrgPermissionAtID(target.OrgID, target.ID, platform.WriteAction, platform.AuthorizationsResource)
+	if err != nil {
+		return err
+	}
+
+	if !a.Allowed(*p) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  "insufficient permissions to rotate authorization",
+		}
+	}
+	return nil
+}
+
+type rotateAuthorizationRequest struct {
+	ID        platform.ID `json:"-"`
+	ExpiresAt *time.Time  `json:"expiresAt,omitempty"`
+}
+
+func decodeRotateAuthorizationRequest(ctx context.Context, r *http.Request) (*rotateAuthorizationRequest, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	id := params.ByName("id")
+	if id == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "url missing id",
+		}
+	}
+
+	req := &rotateAuthorizationRequest{}
+	if err := req.ID.DecodeFromString(id); err != nil {
+		return nil, err
+	}
+
+	// The body is optional; without one the new token does not expire.
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil && err != io.EOF {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "invalid json structure",
+			Err:  err,
+		}
+	}
+
+	return req, validateExpiresAt(req.ExpiresAt)
+}
+
 type updateAuthorizationRequest struct {
 	ID     platform.ID
 	Status platform.Status
@@ -727,6 +862,54 @@ func (s *AuthorizationService) SetAuthorizationStatus(ctx context.Context, id pl
 	return nil
 }
 
+// RotateAuthorization replaces the token of an authorization with a new one that expires at expiresAt.
+func (s *AuthorizationService) RotateAuthorization(ctx context.Context, id platform.ID, expiresAt *time.Time) (*platform.Authorization, error) {
+	u, err := newURL(s.Addr, path.Join(authorizationIDPath(id), "rotate"))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(rotateAuthorizationRequest{
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var a authResponse
+	if err := json.NewDecoder(resp.Body).Decode(&a); err != nil {
+		return nil, err
+	}
+
+	return a.toPlatform(), nil
+}
+
+// SetAuthorizationLastUsed is not supported by the HTTP authorization service;
+// the server records the last use of a token itself.
+func (s *AuthorizationService) SetAuthorizationLastUsed(ctx context.Context, id platform.ID, at time.Time) error {
+	return errors.New("not supported in HTTP authorization service")
+}
+
 // DeleteAuthorization removes a authorization by id.
 func (s *AuthorizationService) DeleteAuthorization(ctx context.Context, id platform.ID) error {
 	u, err := newURL(s.Addr, authorizationIDPath(id))
@@ -8,7 +8,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	pcontext "github.com/influxdata/platform/context"
 	"github.com/influxdata/platform/inmem"
@@ -97,7 +100,8 @@ func TestService_handleGetAuthorizations(t *testing.T) {
     {
       "links": {
         "user": "/api/v2/users/2070616e656d2076",
-        "self": "/api/v2/authorizations/0d0a657820696e74"
+        "self": "/api/v2/authorizations/0d0a657820696e74",
+        "rotate": "/api/v2/authorizations/0d0a657820696e74/rotate"
       },
       "id": "0d0a657820696e74",
 	  "userID": "2070616e656d2076",
@@ -112,7 +116,8 @@ func TestService_handleGetAuthorizations(t *testing.T) {
     {
       "links": {
         "user": "/api/v2/users/6c7574652c206f6e",
-        "self": "/api/v2/authorizations/6669646573207375"
+        "self": "/api/v2/authorizations/6669646573207375",
+        "rotate": "/api/v2/authorizations/6669646573207375/rotate"
       },
       "id": "6669646573207375",
       "userID": "6c7574652c206f6e",
@@ -273,7 +278,8 @@ func TestService_handleGetAuthorization(t *testing.T) {
 {
   "links": {
     "user": "/api/v2/users/020f755c3c082000",
-    "self": "/api/v2/authorizations/020f755c3c082000"
+    "self": "/api/v2/authorizations/020f755c3c082000",
+    "rotate": "/api/v2/authorizations/020f755c3c082000/rotate"
   },
   "id": "020f755c3c082000",
   "user": "u1",
@@ -354,6 +360,185 @@ func TestService_handleGetAuthorization(t *testing.T) {
 	}
 }
 
+func TestService_handleRotateAuthorization(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ownerID := platformtesting.MustIDBase16("020f755c3c082000")
+	orgID := platformtesting.MustIDBase16("020f755c3c083000")
+	owner := &platform.Authorization{UserID: ownerID, Status: platform.Active}
+
+	type args struct {
+		id         string
+		body       string
+		authorizer platform.Authorizer
+	}
+	type wants struct {
+		statusCode int
+		expiresAt  *time.Time
+		body       string
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		wants wants
+	}{
+		{
+			name: "rotate with expiration",
+			args: args{
+				id:         "020f755c3c082000",
+				body:       `{"expiresAt":"2030-01-01T00:00:00Z"}`,
+				authorizer: owner,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				expiresAt:  &expiresAt,
+				body: `
+{
+  "links": {
+    "user": "/api/v2/users/020f755c3c082000",
+    "self": "/api/v2/authorizations/020f755c3c082000",
+    "rotate": "/api/v2/authorizations/020f755c3c082000/rotate"
+  },
+  "id": "020f755c3c082000",
+  "user": "u1",
+  "userID": "020f755c3c082000",
+  "org": "o1",
+  "orgID": "020f755c3c083000",
+  "token": "rotated",
+  "status": "active",
+  "description": "",
+  "permissions": [],
+  "expiresAt": "2030-01-01T00:00:00Z"
+}
+`,
+			},
+		},
+		{
+			name: "rotate without body",
+			args: args{
+				id:         "020f755c3c082000",
+				authorizer: owner,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+		},
+		{
+			name: "rotate by org authorization writer",
+			args: args{
+				id: "020f755c3c082000",
+				authorizer: &platform.Authorization{
+					UserID: platformtesting.MustIDBase16("020f755c3c082001"),
+					Status: platform.Active,
+					Permissions: []platform.Permission{
+						{Action: platform.WriteAction, Resource: platform.AuthorizationsResource, OrgID: &orgID},
+					},
+				},
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+			},
+		},
+		{
+			name: "rotate by foreign token",
+			args: args{
+				id: "020f755c3c082000",
+				authorizer: &platform.Authorization{
+					UserID: platformtesting.MustIDBase16("020f755c3c082001"),
+					Status: platform.Active,
+					Permissions: []platform.Permission{
+						{Action: platform.ReadAction, Resource: platform.AuthorizationsResource, OrgID: &orgID},
+					},
+				},
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+				body:       `{"code":"forbidden","message":"insufficient permissions to rotate authorization"}`,
+			},
+		},
+		{
+			name: "rotate with expiration in the past",
+			args: args{
+				id:         "020f755c3c082000",
+				body:       `{"expiresAt":"2000-01-01T00:00:00Z"}`,
+				authorizer: owner,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+				body:       `{"code":"invalid","message":"expiresAt must be in the future"}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotExpiresAt *time.Time
+			svc := mock.NewAuthorizationService()
+			svc.FindAuthorizationByIDFn = func(ctx context.Context, id platform.ID) (*platform.Authorization, error) {
+				return &platform.Authorization{ID: id, UserID: ownerID, OrgID: orgID, Status: platform.Active}, nil
+			}
+			svc.RotateAuthorizationFn = func(ctx context.Context, id platform.ID, expiresAt *time.Time) (*platform.Authorization, error) {
+				gotExpiresAt = expiresAt
+				return &platform.Authorization{
+					ID:          id,
+					UserID:      ownerID,
+					OrgID:       orgID,
+					Token:       "rotated",
+					Status:      platform.Active,
+					Permissions: []platform.Permission{},
+					ExpiresAt:   expiresAt,
+				}, nil
+			}
+
+			h := NewAuthorizationHandler(mock.NewUserService())
+			h.AuthorizationService = svc
+			h.UserService = &mock.UserService{
+				FindUserByIDFn: func(ctx context.Context, id platform.ID) (*platform.User, error) {
+					return &platform.User{ID: id, Name: "u1"}, nil
+				},
+			}
+			h.OrganizationService = &mock.OrganizationService{
+				FindOrganizationByIDF: func(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+					return &platform.Organization{ID: id, Name: "o1"}, nil
+				},
+			}
+			h.LookupService = &mock.LookupService{}
+
+			r := httptest.NewRequest("POST", "http://any.url", strings.NewReader(tt.args.body))
+			r = r.WithContext(context.WithValue(
+				pcontext.SetAuthorizer(context.Background(), tt.args.authorizer),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{
+						Key:   "id",
+						Value: tt.args.id,
+					},
+				}))
+
+			w := httptest.NewRecorder()
+
+			h.handleRotateAuthorization(w, r)
+
+			res := w.Result()
+			body, _ := ioutil.ReadAll(res.Body)
+
+			if res.StatusCode != tt.wants.statusCode {
+				t.Logf("headers: %v body: %s", res.Header, body)
+				t.Errorf("%q. handleRotateAuthorization() = %v, want %v", tt.name, res.StatusCode, tt.wants.statusCode)
+			}
+			if tt.wants.statusCode == http.StatusOK && !reflect.DeepEqual(gotExpiresAt, tt.wants.expiresAt) {
+				t.Errorf("%q. handleRotateAuthorization() expiresAt = %v, want %v", tt.name, gotExpiresAt, tt.wants.expiresAt)
+			}
+			if tt.wants.body != "" {
+				if eq, diff, _ := jsonEqual(string(body), tt.wants.body); !eq {
+					t.Errorf("%q. handleRotateAuthorization() = -got/+want %s**", tt.name, diff)
+				}
+			}
+		})
+	}
+}
+
 func TestService_handlePostAuthorization(t *testing.T) {
 	type fields struct {
 		AuthorizationService platform.AuthorizationService
@@ -437,7 +622,8 @@ func TestService_handlePostAuthorization(t *testing.T) {
 {
   "links": {
     "user": "/api/v2/users/aaaaaaaaaaaaaaaa",
-    "self": "/api/v2/authorizations/020f755c3c082000"
+    "self": "/api/v2/authorizations/020f755c3c082000",
+    "rotate": "/api/v2/authorizations/020f755c3c082000/rotate"
   },
   "id": "020f755c3c082000",
   "user": "u1",
@@ -647,8 +833,13 @@ func initAuthorizationService(f platformtesting.AuthorizationFields, t *testing.
 	authZ.UserService = svc
 	authZ.OrganizationService = svc
 
+	// The middleware records when the token was last used; keep that out of
+	// the fixtures so the authorizations compare equal.
+	authNSvc := mock.NewAuthorizationService()
+	authNSvc.FindAuthorizationByTokenFn = svc.FindAuthorizationByToken
+
 	authN := NewAuthenticationHandler()
-	authN.AuthorizationService = svc
+	authN.AuthorizationService = authNSvc
 	authN.Handler = authZ
 
 	server := httptest.NewServer(authN)
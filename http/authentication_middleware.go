@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/influxdata/platform"
 	platcontext "github.com/influxdata/platform/context"
@@ -21,6 +22,7 @@ type AuthenticationHandler struct {
 	// This is only really used for it's lookup method the specific http
 	// hanlder used to register routes does not matter.
 	noAuthRouter *httprouter.Router
+	v1AuthRouter *httprouter.Router
 
 	Handler http.Handler
 }
@@ -31,6 +33,7 @@ func NewAuthenticationHandler() *AuthenticationHandler {
 		Logger:       zap.NewNop(),
 		Handler:      http.DefaultServeMux,
 		noAuthRouter: httprouter.New(),
+		v1AuthRouter: httprouter.New(),
 	}
 }
 
@@ -40,11 +43,22 @@ func (h *AuthenticationHandler) RegisterNoAuthRoute(method, path string) {
 	h.noAuthRouter.HandlerFunc(method, path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 }
 
+// RegisterV1AuthRoute accepts the credentials of InfluxDB 1.X clients for routes,
+// as well as tokens and sessions. See GetV1Token.
+func (h *AuthenticationHandler) RegisterV1AuthRoute(method, path string) {
+	// the handler specified here does not matter.
+	h.v1AuthRouter.HandlerFunc(method, path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+}
+
 const (
 	tokenAuthScheme   = "token"
 	sessionAuthScheme = "session"
 )
 
+// lastUsedResolution is how often the last use of a token is recorded,
+// so that a token authenticating many requests does not cause a write on each of them.
+const lastUsedResolution = time.Minute
+
 // ProbeAuthScheme probes the http request for the requests for token or cookie session.
 func ProbeAuthScheme(r *http.Request) (string, error) {
 	_, tokenErr := GetToken(r)
@@ -69,6 +83,18 @@ func (h *AuthenticationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	}
 
 	ctx := r.Context()
+	if handler, _, _ := h.v1AuthRouter.Lookup(r.Method, r.URL.Path); handler != nil {
+		if t, err := GetV1Token(r); err == nil {
+			ctx, err = h.authorizeToken(ctx, t)
+			if err != nil {
+				ForbiddenError(ctx, fmt.Errorf("unauthorized"), w)
+				return
+			}
+			h.Handler.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+	}
+
 	scheme, err := ProbeAuthScheme(r)
 	if err != nil {
 		ForbiddenError(ctx, err, w)
@@ -102,12 +128,29 @@ func (h *AuthenticationHandler) extractAuthorization(ctx context.Context, r *htt
 	if err != nil {
 		return ctx, err
 	}
+	return h.authorizeToken(ctx, t)
+}
 
+// authorizeToken places the authorization of the token on the context.
+func (h *AuthenticationHandler) authorizeToken(ctx context.Context, t string) (context.Context, error) {
 	a, err := h.AuthorizationService.FindAuthorizationByToken(ctx, t)
 	if err != nil {
 		return ctx, err
 	}
 
+	now := time.Now()
+	if a.IsExpired(now) {
+		return ctx, platform.ErrAuthorizationExpired
+	}
+
+	if a.LastUsedAt == nil || now.Sub(*a.LastUsedAt) >= lastUsedResolution {
+		if err := h.AuthorizationService.SetAuthorizationLastUsed(ctx, a.ID, now); err != nil {
+			h.Logger.Info("Failed to record last use of authorization", zap.Stringer("authorization_id", a.ID), zap.Error(err))
+		} else {
+			a.LastUsedAt = &now
+		}
+	}
+
 	return platcontext.SetAuthorizer(ctx, a), nil
 }
 
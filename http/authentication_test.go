@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/influxdata/platform"
 	platformhttp "github.com/influxdata/platform/http"
@@ -72,6 +73,30 @@ func TestAuthenticationHandler(t *testing.T) {
 					FindAuthorizationByTokenFn: func(ctx context.Context, token string) (*platform.Authorization, error) {
 						return &platform.Authorization{}, nil
 					},
+					SetAuthorizationLastUsedFn: func(context.Context, platform.ID, time.Time) error {
+						return nil
+					},
+				},
+				SessionService: mock.NewSessionService(),
+			},
+			args: args{
+				token: "abc123",
+			},
+			wants: wants{
+				code: http.StatusOK,
+			},
+		},
+		{
+			name: "token not yet expired",
+			fields: fields{
+				AuthorizationService: &mock.AuthorizationService{
+					FindAuthorizationByTokenFn: func(ctx context.Context, token string) (*platform.Authorization, error) {
+						expiresAt := time.Now().Add(time.Hour)
+						return &platform.Authorization{ExpiresAt: &expiresAt}, nil
+					},
+					SetAuthorizationLastUsedFn: func(context.Context, platform.ID, time.Time) error {
+						return nil
+					},
 				},
 				SessionService: mock.NewSessionService(),
 			},
@@ -82,6 +107,24 @@ func TestAuthenticationHandler(t *testing.T) {
 				code: http.StatusOK,
 			},
 		},
+		{
+			name: "token expired",
+			fields: fields{
+				AuthorizationService: &mock.AuthorizationService{
+					FindAuthorizationByTokenFn: func(ctx context.Context, token string) (*platform.Authorization, error) {
+						expiresAt := time.Now().Add(-time.Hour)
+						return &platform.Authorization{ExpiresAt: &expiresAt}, nil
+					},
+				},
+				SessionService: mock.NewSessionService(),
+			},
+			args: args{
+				token: "abc123",
+			},
+			wants: wants{
+				code: http.StatusForbidden,
+			},
+		},
 		{
 			name: "token does not exist",
 			fields: fields{
@@ -143,6 +186,68 @@ func TestAuthenticationHandler(t *testing.T) {
 	}
 }
 
+func TestAuthenticationHandler_LastUsed(t *testing.T) {
+	recent := time.Now().Add(-time.Second)
+	stale := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		lastUsedAt *time.Time
+		recorded   bool
+	}{
+		{
+			name:     "never used",
+			recorded: true,
+		},
+		{
+			name:       "used a while ago",
+			lastUsedAt: &stale,
+			recorded:   true,
+		},
+		{
+			name:       "used recently",
+			lastUsedAt: &recent,
+			recorded:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorded bool
+			svc := mock.NewAuthorizationService()
+			svc.FindAuthorizationByTokenFn = func(ctx context.Context, token string) (*platform.Authorization, error) {
+				return &platform.Authorization{ID: platform.ID(1), LastUsedAt: tt.lastUsedAt}, nil
+			}
+			svc.SetAuthorizationLastUsedFn = func(ctx context.Context, id platform.ID, at time.Time) error {
+				if id != platform.ID(1) {
+					t.Errorf("expected last use of authorization 1 to be recorded, got %s", id)
+				}
+				recorded = true
+				return nil
+			}
+
+			h := platformhttp.NewAuthenticationHandler()
+			h.AuthorizationService = svc
+			h.SessionService = mock.NewSessionService()
+			h.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url", nil)
+			platformhttp.SetToken("abc123", r)
+			h.ServeHTTP(w, r)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status code to be %d got %d", http.StatusOK, w.Code)
+			}
+			if recorded != tt.recorded {
+				t.Errorf("expected last use recorded to be %v, got %v", tt.recorded, recorded)
+			}
+		})
+	}
+}
+
 func TestProbeAuthScheme(t *testing.T) {
 	type args struct {
 		token   string
@@ -291,3 +396,73 @@ func TestAuthenticationHandler_NoAuthRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthenticationHandler_V1AuthRoutes(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		basic bool
+		token string
+		code  int
+	}{
+		{
+			name:  "password parameter",
+			path:  "/query?u=me&p=abc123",
+			token: "abc123",
+			code:  http.StatusOK,
+		},
+		{
+			name:  "basic authentication",
+			path:  "/query",
+			basic: true,
+			token: "abc123",
+			code:  http.StatusOK,
+		},
+		{
+			name:  "unknown token",
+			path:  "/query?u=me&p=wrong",
+			token: "abc123",
+			code:  http.StatusForbidden,
+		},
+		{
+			name:  "password parameter on another route",
+			path:  "/api/v2/write?u=me&p=abc123",
+			token: "abc123",
+			code:  http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mock.NewAuthorizationService()
+			svc.FindAuthorizationByTokenFn = func(ctx context.Context, token string) (*platform.Authorization, error) {
+				if token != tt.token {
+					return nil, fmt.Errorf("authorization not found")
+				}
+				return &platform.Authorization{}, nil
+			}
+			svc.SetAuthorizationLastUsedFn = func(context.Context, platform.ID, time.Time) error {
+				return nil
+			}
+
+			h := platformhttp.NewAuthenticationHandler()
+			h.AuthorizationService = svc
+			h.SessionService = mock.NewSessionService()
+			h.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			h.RegisterV1AuthRoute("GET", "/query")
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.path, nil)
+			if tt.basic {
+				r.SetBasicAuth("me", tt.token)
+			}
+			h.ServeHTTP(w, r)
+
+			if got, want := w.Code, tt.code; got != want {
+				t.Errorf("expected status code to be %d got %d", want, got)
+			}
+		})
+	}
+}
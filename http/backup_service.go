@@ -0,0 +1,298 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// BackupHandler represents an HTTP API handler for taking and restoring
+// backups of the platform.
+type BackupHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	BackupService platform.BackupService
+	BucketService platform.BucketService
+}
+
+const (
+	backupPath  = "/api/v2/backup"
+	restorePath = "/api/v2/restore"
+)
+
+// NewBackupHandler returns a new instance of BackupHandler.
+func NewBackupHandler() *BackupHandler {
+	h := &BackupHandler{
+		Router: NewRouter(),
+		Logger: zap.NewNop(),
+	}
+
+	h.HandlerFunc("GET", backupPath, h.handleGetBackup)
+	h.HandlerFunc("POST", restorePath, h.handlePostRestore)
+	return h
+}
+
+// handleGetBackup is the HTTP handler for the GET /api/v2/backup route.
+func (h *BackupHandler) handleGetBackup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeGetBackupRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if req.filter.BucketID != nil {
+		err = h.authorizeBucketBackup(ctx, *req.filter.BucketID)
+	} else {
+		err = authorizeBackup(ctx, platform.ReadAction)
+	}
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-tar")
+	bw := &backupResponseWriter{ResponseWriter: w}
+	if err := h.BackupService.Backup(ctx, bw, req.filter); err != nil {
+		h.Logger.Info("Failed to write backup", zap.Error(err))
+		if !bw.written {
+			EncodeError(ctx, err, w)
+		}
+		return
+	}
+}
+
+type getBackupRequest struct {
+	filter platform.BackupFilter
+}
+
+func decodeGetBackupRequest(ctx context.Context, r *http.Request) (*getBackupRequest, error) {
+	qp := r.URL.Query()
+	req := &getBackupRequest{}
+
+	if orgID := qp.Get("orgID"); orgID != "" {
+		id, err := platform.IDFromString(orgID)
+		if err != nil {
+			return nil, err
+		}
+		req.filter.OrganizationID = id
+	}
+
+	if bucketID := qp.Get("bucketID"); bucketID != "" {
+		id, err := platform.IDFromString(bucketID)
+		if err != nil {
+			return nil, err
+		}
+		req.filter.BucketID = id
+	}
+
+	if since := qp.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339Nano, since)
+		if err != nil {
+			return nil, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "since must be an RFC3339 timestamp",
+				Err:  err,
+			}
+		}
+		req.filter.Since = t
+	}
+
+	if req.filter.BucketID == nil && (req.filter.OrganizationID != nil || !req.filter.Since.IsZero()) {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "bucketID is required for bucket backups",
+		}
+	} else if req.filter.BucketID != nil && req.filter.OrganizationID == nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "orgID is required for bucket backups",
+		}
+	}
+
+	return req, nil
+}
+
+// handlePostRestore is the HTTP handler for the POST /api/v2/restore route.
+func (h *BackupHandler) handlePostRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+
+	if err := authorizeRestore(ctx); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.BackupService.Restore(ctx, r.Body); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorizeBackup ensures the authorizer in ctx may perform action on every
+// bucket, as backups hold the data of all organizations.
+func authorizeBackup(ctx context.Context, action platform.Action) error {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	p, err := platform.NewPermission(action, platform.BucketsResource)
+	if err != nil {
+		return err
+	}
+
+	if !a.Allowed(*p) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  "insufficient permissions for backup and restore",
+		}
+	}
+	return nil
+}
+
+// authorizeRestore ensures the authorizer in ctx has every operator permission,
+// as a restore replaces all data and metadata, including users and
+// authorizations.
+func authorizeRestore(ctx context.Context) error {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range platform.OperPermissions() {
+		if !a.Allowed(p) {
+			return &platform.Error{
+				Code: platform.EForbidden,
+				Msg:  "restore requires an operator authorization",
+			}
+		}
+	}
+	return nil
+}
+
+// authorizeBucketBackup ensures the authorizer in ctx may read the bucket.
+func (h *BackupHandler) authorizeBucketBackup(ctx context.Context, bucketID platform.ID) error {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	b, err := h.BucketService.FindBucketByID(ctx, bucketID)
+	if err != nil {
+		return err
+	}
+
+	p, err := platform.NewOrgPermissionAtID(b.OrganizationID, b.ID, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		return err
+	}
+
+	if !a.Allowed(*p) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  "insufficient permissions for bucket backup",
+		}
+	}
+	return nil
+}
+
+// backupResponseWriter records whether any part of the backup has been
+// written, after which errors can no longer be reported to the client.
+type backupResponseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *backupResponseWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(p)
+}
+
+// BackupService connects to Influx via HTTP to take and restore backups.
+type BackupService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.BackupService = (*BackupService)(nil)
+
+// Backup writes a backup archive of the data of the remote platform matched
+// by filter to w.
+func (s *BackupService) Backup(ctx context.Context, w io.Writer, filter platform.BackupFilter) error {
+	u, err := newURL(s.Addr, backupPath)
+	if err != nil {
+		return err
+	}
+
+	query := u.Query()
+	if filter.OrganizationID != nil {
+		query.Add("orgID", filter.OrganizationID.String())
+	}
+	if filter.BucketID != nil {
+		query.Add("bucketID", filter.BucketID.String())
+	}
+	if !filter.Since.IsZero() {
+		query.Add("since", filter.Since.Format(time.RFC3339Nano))
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	SetToken(s.Token, req)
+	req = req.WithContext(ctx)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// Restore replaces the data of the remote platform held by the backup archive
+// read from r with the contents of the archive.
+func (s *BackupService) Restore(ctx context.Context, r io.Reader) error {
+	u, err := newURL(s.Addr, restorePath)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-tar")
+	SetToken(s.Token, req)
+	req = req.WithContext(ctx)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return CheckError(resp, true)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+)
+
+type fakeBackupService struct {
+	restores int
+}
+
+func (s *fakeBackupService) Backup(ctx context.Context, w io.Writer, filter platform.BackupFilter) error {
+	return nil
+}
+
+func (s *fakeBackupService) Restore(ctx context.Context, r io.Reader) error {
+	s.restores++
+	return nil
+}
+
+func TestBackupHandler_handlePostRestore(t *testing.T) {
+	writeBuckets, err := platform.NewPermission(platform.WriteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		permissions []platform.Permission
+		status      int
+		restores    int
+	}{
+		{
+			name:        "operator",
+			permissions: platform.OperPermissions(),
+			status:      http.StatusNoContent,
+			restores:    1,
+		},
+		{
+			name:        "write on every bucket",
+			permissions: []platform.Permission{*writeBuckets},
+			status:      http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeBackupService{}
+			h := NewBackupHandler()
+			h.BackupService = svc
+
+			r := httptest.NewRequest("POST", "http://any.url"+restorePath, strings.NewReader(""))
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				Permissions: tt.permissions,
+			}))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if got := w.Result().StatusCode; got != tt.status {
+				t.Errorf("got status %d, want %d", got, tt.status)
+			}
+			if svc.restores != tt.restores {
+				t.Errorf("got %d restores, want %d", svc.restores, tt.restores)
+			}
+		})
+	}
+}
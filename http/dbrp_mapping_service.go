@@ -0,0 +1,285 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+const (
+	dbrpsPath = "/api/v2/dbrps"
+)
+
+// DBRPMappingHandler is the handler for the dbrp mappings, which map the databases and
+// retention policies of InfluxQL queries to buckets.
+type DBRPMappingHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	DBRPMappingService platform.DBRPMappingService
+	BucketService      platform.BucketService
+}
+
+// NewDBRPMappingHandler returns a new handler for the dbrp mappings.
+func NewDBRPMappingHandler() *DBRPMappingHandler {
+	h := &DBRPMappingHandler{
+		Router: NewRouter(),
+		Logger: zap.NewNop(),
+	}
+
+	h.HandlerFunc("GET", dbrpsPath, h.handleGetDBRPMappings)
+	h.HandlerFunc("POST", dbrpsPath, h.handlePostDBRPMapping)
+	h.HandlerFunc("DELETE", dbrpsPath, h.handleDeleteDBRPMapping)
+	return h
+}
+
+type dbrpMappingsLinks struct {
+	Self string `json:"self"`
+}
+
+type getDBRPMappingsResponse struct {
+	DBRPs []*platform.DBRPMapping `json:"dbrps"`
+	Links dbrpMappingsLinks       `json:"links"`
+}
+
+type dbrpMappingResponse struct {
+	DBRP *platform.DBRPMapping `json:"dbrp"`
+}
+
+// handleGetDBRPMappings is the HTTP handler for the GET /api/v2/dbrps route.
+// It lists the mappings to the buckets that the authorizer may read.
+func (h *DBRPMappingHandler) handleGetDBRPMappings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	req, err := decodeGetDBRPMappingsRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	// The retention policy is matched here, since the services look up a single
+	// mapping by its cluster, database and retention policy, and fail if it does not exist.
+	ms, _, err := h.DBRPMappingService.FindMany(ctx, platform.DBRPMappingFilter{
+		Cluster:  req.filter.Cluster,
+		Database: req.filter.Database,
+		Default:  req.filter.Default,
+	})
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	resp := getDBRPMappingsResponse{
+		DBRPs: make([]*platform.DBRPMapping, 0, len(ms)),
+		Links: dbrpMappingsLinks{Self: dbrpsPath},
+	}
+	for _, m := range ms {
+		if req.filter.RetentionPolicy != nil && *req.filter.RetentionPolicy != m.RetentionPolicy {
+			continue
+		}
+		if req.orgID != nil && *req.orgID != m.OrganizationID {
+			continue
+		}
+		if !dbrpMappingAllowed(a, m, platform.ReadAction) {
+			continue
+		}
+		resp.DBRPs = append(resp.DBRPs, m)
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, resp); err != nil {
+		logEncodingError(h.Logger, r, err)
+		return
+	}
+}
+
+type getDBRPMappingsRequest struct {
+	filter platform.DBRPMappingFilter
+	orgID  *platform.ID
+}
+
+func decodeGetDBRPMappingsRequest(ctx context.Context, r *http.Request) (*getDBRPMappingsRequest, error) {
+	qp := r.URL.Query()
+	req := &getDBRPMappingsRequest{}
+
+	if cluster := qp.Get("cluster"); cluster != "" {
+		req.filter.Cluster = &cluster
+	}
+	if db := qp.Get("db"); db != "" {
+		req.filter.Database = &db
+	}
+	if rp := qp.Get("rp"); rp != "" {
+		req.filter.RetentionPolicy = &rp
+	}
+	switch def := qp.Get("default"); def {
+	case "":
+	case "true", "false":
+		isDefault := def == "true"
+		req.filter.Default = &isDefault
+	default:
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("invalid default %q; it must be true or false", def),
+		}
+	}
+	if orgID := qp.Get("orgID"); orgID != "" {
+		id, err := platform.IDFromString(orgID)
+		if err != nil {
+			return nil, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "invalid orgID",
+				Err:  err,
+			}
+		}
+		req.orgID = id
+	}
+	return req, nil
+}
+
+// handlePostDBRPMapping is the HTTP handler for the POST /api/v2/dbrps route.
+// The authorizer must be allowed to write to the bucket of the mapping.
+func (h *DBRPMappingHandler) handlePostDBRPMapping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	m, err := decodePostDBRPMappingRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.authorizeBucket(ctx, m); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	// The services do not tell an existing mapping from other errors.
+	if existing, err := h.DBRPMappingService.FindBy(ctx, m.Cluster, m.Database, m.RetentionPolicy); err == nil && !existing.Equal(m) {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EConflict,
+			Msg:  fmt.Sprintf("a different dbrp mapping exists for database %s and retention policy %s", m.Database, m.RetentionPolicy),
+		}, w)
+		return
+	}
+
+	if err := h.DBRPMappingService.Create(ctx, m); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusCreated, dbrpMappingResponse{DBRP: m}); err != nil {
+		logEncodingError(h.Logger, r, err)
+		return
+	}
+}
+
+// decodePostDBRPMappingRequest decodes a mapping, in the cluster of the InfluxQL handler by default.
+func decodePostDBRPMappingRequest(ctx context.Context, r *http.Request) (*platform.DBRPMapping, error) {
+	m := &platform.DBRPMapping{}
+	if err := json.NewDecoder(r.Body).Decode(m); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "invalid dbrp mapping",
+			Err:  err,
+		}
+	}
+	if m.Cluster == "" {
+		m.Cluster = DefaultInfluxQLCluster
+	}
+	if err := m.Validate(); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+	return m, nil
+}
+
+// handleDeleteDBRPMapping is the HTTP handler for the DELETE /api/v2/dbrps route.
+// The authorizer must be allowed to write to the bucket of the mapping.
+func (h *DBRPMappingHandler) handleDeleteDBRPMapping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	qp := r.URL.Query()
+	cluster, db, rp := qp.Get("cluster"), qp.Get("db"), qp.Get("rp")
+	if cluster == "" {
+		cluster = DefaultInfluxQLCluster
+	}
+	if db == "" || rp == "" {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "db and rp are required",
+		}, w)
+		return
+	}
+
+	m, err := h.DBRPMappingService.FindBy(ctx, cluster, db, rp)
+	if err != nil {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  fmt.Sprintf("dbrp mapping not found for database %s and retention policy %s", db, rp),
+			Err:  err,
+		}, w)
+		return
+	}
+
+	if err := h.authorizeBucket(ctx, m); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.DBRPMappingService.Delete(ctx, m.Cluster, m.Database, m.RetentionPolicy); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorizeBucket ensures the bucket of m belongs to the organization of m,
+// and that the authorizer in ctx may write to it.
+func (h *DBRPMappingHandler) authorizeBucket(ctx context.Context, m *platform.DBRPMapping) error {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	b, err := h.BucketService.FindBucketByID(ctx, m.BucketID)
+	if err != nil {
+		return err
+	}
+	if b.OrganizationID != m.OrganizationID {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("bucket %s does not belong to organization %s", m.BucketID, m.OrganizationID),
+		}
+	}
+
+	if !dbrpMappingAllowed(a, m, platform.WriteAction) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  "insufficient permissions for the bucket of the dbrp mapping",
+		}
+	}
+	return nil
+}
+
+// dbrpMappingAllowed reports whether a may perform action on the bucket of m.
+func dbrpMappingAllowed(a platform.Authorizer, m *platform.DBRPMapping, action platform.Action) bool {
+	p, err := platform.NewOrgPermissionAtID(m.OrganizationID, m.BucketID, action, platform.BucketsResource)
+	if err != nil {
+		return false
+	}
+	return a.Allowed(*p)
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/inmem"
+)
+
+func TestDBRPMappingHandler(t *testing.T) {
+	ctx := context.Background()
+	svc := inmem.NewService()
+	org := &platform.Organization{Name: "org"}
+	if err := svc.CreateOrganization(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+	orgID := org.ID
+	bucket := &platform.Bucket{OrganizationID: orgID, Name: "telegraf"}
+	if err := svc.CreateBucket(ctx, bucket); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewDBRPMappingHandler()
+	h.DBRPMappingService = svc
+	h.BucketService = svc
+
+	writeBucket, err := platform.NewOrgPermissionAtID(orgID, bucket.ID, platform.WriteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	readBucket, err := platform.NewOrgPermissionAtID(orgID, bucket.ID, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serve := func(method, url, body string, ps ...platform.Permission) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(method, "http://any.url"+url, strings.NewReader(body))
+		r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+			Status:      platform.Active,
+			OrgID:       orgID,
+			Permissions: ps,
+		}))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	list := func(ps ...platform.Permission) []*platform.DBRPMapping {
+		t.Helper()
+		w := serve("GET", "/api/v2/dbrps?db=db0&rp=autogen", "", ps...)
+		if w.Code != http.StatusOK {
+			t.Fatalf("got status %d listing mappings: %s", w.Code, w.Body.String())
+		}
+		var resp getDBRPMappingsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.DBRPs
+	}
+
+	mapping := `{"database":"db0","retention_policy":"autogen","default":true,"organization_id":"` + orgID.String() + `","bucket_id":"` + bucket.ID.String() + `"}`
+	if w := serve("POST", "/api/v2/dbrps", mapping, *readBucket); w.Code != http.StatusForbidden {
+		t.Fatalf("got status %d creating a mapping without write permission, want %d", w.Code, http.StatusForbidden)
+	}
+	otherOrg := `{"database":"db0","retention_policy":"autogen","organization_id":"` + (orgID + 1).String() + `","bucket_id":"` + bucket.ID.String() + `"}`
+	if w := serve("POST", "/api/v2/dbrps", otherOrg, *writeBucket); w.Code != http.StatusBadRequest {
+		t.Fatalf("got status %d creating a mapping to the bucket of another organization, want %d", w.Code, http.StatusBadRequest)
+	}
+	if w := serve("POST", "/api/v2/dbrps", mapping, *writeBucket); w.Code != http.StatusCreated {
+		t.Fatalf("got status %d creating a mapping: %s", w.Code, w.Body.String())
+	}
+
+	ms := list(*readBucket)
+	if len(ms) != 1 {
+		t.Fatalf("got %d mappings, want 1", len(ms))
+	}
+	if m := ms[0]; m.Cluster != DefaultInfluxQLCluster || m.BucketID != bucket.ID || !m.Default {
+		t.Errorf("unexpected mapping %+v", m)
+	}
+	if ms := list(); len(ms) != 0 {
+		t.Errorf("got %d mappings without read permission, want 0", len(ms))
+	}
+
+	if w := serve("DELETE", "/api/v2/dbrps?db=db0&rp=autogen", "", *readBucket); w.Code != http.StatusForbidden {
+		t.Fatalf("got status %d deleting a mapping without write permission, want %d", w.Code, http.StatusForbidden)
+	}
+	if w := serve("DELETE", "/api/v2/dbrps?db=db0&rp=autogen", "", *writeBucket); w.Code != http.StatusNoContent {
+		t.Fatalf("got status %d deleting a mapping: %s", w.Code, w.Body.String())
+	}
+	if ms := list(*readBucket); len(ms) != 0 {
+		t.Errorf("got %d mappings after deleting it, want 0", len(ms))
+	}
+	if w := serve("DELETE", "/api/v2/dbrps?db=db0&rp=autogen", "", *writeBucket); w.Code != http.StatusNotFound {
+		t.Errorf("got status %d deleting a missing mapping, want %d", w.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,259 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/kit/errors"
+	"github.com/influxdata/platform/storage"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// DeleteHandler receives requests to delete points from a bucket.
+type DeleteHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	BucketService       platform.BucketService
+	OrganizationService platform.OrganizationService
+
+	PointsDeleter storage.PointsDeleter
+}
+
+const (
+	deletePath = "/api/v2/delete"
+)
+
+// NewDeleteHandler creates a new handler at /api/v2/delete to delete points.
+func NewDeleteHandler(deleter storage.PointsDeleter) *DeleteHandler {
+	h := &DeleteHandler{
+		Router:        NewRouter(),
+		Logger:        zap.NewNop(),
+		PointsDeleter: deleter,
+	}
+
+	h.HandlerFunc("POST", deletePath, h.handleDelete)
+	return h
+}
+
+func (h *DeleteHandler) handleDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	req, err := decodeDeleteRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	org, err := h.findOrganization(ctx, req.Org)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	bucket, err := h.findBucket(ctx, org.ID, req.Bucket)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	p, err := platform.NewOrgPermissionAtID(bucket.OrganizationID, bucket.ID, platform.DeleteAction, platform.BucketsResource)
+	if err != nil {
+		EncodeError(ctx, fmt.Errorf("could not create permission for bucket: %v", err), w)
+		return
+	}
+
+	if !a.Allowed(*p) {
+		EncodeError(ctx, errors.Forbiddenf("insufficient permissions for delete"), w)
+		return
+	}
+
+	pred, err := storage.ParseDeletePredicate(req.Predicate)
+	if err != nil {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/handleDelete",
+			Msg:  "invalid predicate",
+			Err:  err,
+		}, w)
+		return
+	}
+
+	h.Logger.Info("Deleting points",
+		zap.Stringer("org_id", org.ID),
+		zap.Stringer("bucket_id", bucket.ID),
+		zap.Time("start", req.Start),
+		zap.Time("stop", req.Stop),
+		zap.String("predicate", req.Predicate))
+
+	if err := h.PointsDeleter.DeleteBucketRangePredicate(org.ID, bucket.ID, req.Start.UnixNano(), req.Stop.UnixNano(), pred); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findOrganization returns the organization with the ID or name org.
+func (h *DeleteHandler) findOrganization(ctx context.Context, org string) (*platform.Organization, error) {
+	if id, err := platform.IDFromString(org); err == nil {
+		o, err := h.OrganizationService.FindOrganizationByID(ctx, *id)
+		if err == nil {
+			return o, nil
+		} else if platform.ErrorCode(err) != platform.ENotFound {
+			return nil, err
+		}
+	}
+
+	o, err := h.OrganizationService.FindOrganization(ctx, platform.OrganizationFilter{Name: &org})
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   "http/handleDelete",
+			Msg:  fmt.Sprintf("organization %q not found", org),
+			Err:  err,
+		}
+	}
+	return o, nil
+}
+
+// findBucket returns the bucket of the organization with the ID or name bucket.
+func (h *DeleteHandler) findBucket(ctx context.Context, orgID platform.ID, bucket string) (*platform.Bucket, error) {
+	if id, err := platform.IDFromString(bucket); err == nil {
+		b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+			OrganizationID: &orgID,
+			ID:             id,
+		})
+		if err == nil {
+			return b, nil
+		} else if platform.ErrorCode(err) != platform.ENotFound {
+			return nil, err
+		}
+	}
+
+	b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+		OrganizationID: &orgID,
+		Name:           &bucket,
+	})
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   "http/handleDelete",
+			Msg:  fmt.Sprintf("bucket %q not found", bucket),
+			Err:  err,
+		}
+	}
+	return b, nil
+}
+
+type deleteRequest struct {
+	Org       string    `json:"-"`
+	Bucket    string    `json:"-"`
+	Start     time.Time `json:"start"`
+	Stop      time.Time `json:"stop"`
+	Predicate string    `json:"predicate"`
+}
+
+func decodeDeleteRequest(ctx context.Context, r *http.Request) (*deleteRequest, error) {
+	req := &deleteRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodeDeleteRequest",
+			Msg:  "invalid request body",
+			Err:  err,
+		}
+	}
+
+	qp := r.URL.Query()
+	req.Org = qp.Get("org")
+	req.Bucket = qp.Get("bucket")
+
+	if req.Org == "" || req.Bucket == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodeDeleteRequest",
+			Msg:  "org and bucket are required",
+		}
+	}
+
+	if req.Start.IsZero() || req.Stop.IsZero() {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodeDeleteRequest",
+			Msg:  "start and stop are required",
+		}
+	} else if req.Stop.Before(req.Start) {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodeDeleteRequest",
+			Msg:  "stop must not be before start",
+		}
+	}
+
+	return req, nil
+}
+
+// DeleteService deletes points from buckets over HTTP.
+type DeleteService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.DeleteService = (*DeleteService)(nil)
+
+// DeleteBucketRangePredicate removes the points of a bucket with a timestamp
+// within [start, stop] that belong to the series matched by predicate.
+func (s *DeleteService) DeleteBucketRangePredicate(ctx context.Context, orgID, bucketID platform.ID, start, stop time.Time, predicate string) error {
+	u, err := newURL(s.Addr, deletePath)
+	if err != nil {
+		return err
+	}
+
+	query := u.Query()
+	query.Set("org", orgID.String())
+	query.Set("bucket", bucketID.String())
+	u.RawQuery = query.Encode()
+
+	b, err := json.Marshal(deleteRequest{
+		Start:     start,
+		Stop:      stop,
+		Predicate: predicate,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+	req = req.WithContext(ctx)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return CheckError(resp, true)
+}
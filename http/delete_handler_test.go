@@ -0,0 +1,197 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/inmem"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type deleteCall struct {
+	orgID, bucketID platform.ID
+	min, max        int64
+	pred            string
+}
+
+type fakePointsDeleter struct {
+	calls []deleteCall
+}
+
+func (d *fakePointsDeleter) DeleteBucketRangePredicate(orgID, bucketID platform.ID, min, max int64, pred influxql.Expr) error {
+	c := deleteCall{orgID: orgID, bucketID: bucketID, min: min, max: max}
+	if pred != nil {
+		c.pred = pred.String()
+	}
+	d.calls = append(d.calls, c)
+	return nil
+}
+
+func TestDeleteHandler_handleDelete(t *testing.T) {
+	ctx := context.Background()
+	svc := inmem.NewService()
+
+	org := &platform.Organization{Name: "org"}
+	if err := svc.CreateOrganization(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+	bucket := &platform.Bucket{Name: "bucket", OrganizationID: org.ID}
+	if err := svc.CreateBucket(ctx, bucket); err != nil {
+		t.Fatal(err)
+	}
+
+	del, err := platform.NewPermissionAtID(bucket.ID, platform.DeleteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orgDel, err := platform.NewOrgPermission(org.ID, platform.DeleteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherOrgDel, err := platform.NewOrgPermission(org.ID+1, platform.DeleteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write, err := platform.NewPermissionAtID(bucket.ID, platform.WriteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := platform.NewPermissionAtID(bucket.ID, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	stop := time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		query       string
+		body        string
+		permissions []platform.Permission
+		status      int
+		calls       []deleteCall
+	}{
+		{
+			name:        "delete with predicate by name",
+			query:       "?org=org&bucket=bucket",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z","predicate":"host=\"a\" AND region=\"eu\""}`,
+			permissions: []platform.Permission{*del},
+			status:      http.StatusNoContent,
+			calls: []deleteCall{{
+				orgID:    org.ID,
+				bucketID: bucket.ID,
+				min:      start.UnixNano(),
+				max:      stop.UnixNano(),
+				pred:     `host = 'a' AND region = 'eu'`,
+			}},
+		},
+		{
+			name:        "delete without predicate by id",
+			query:       "?org=" + org.ID.String() + "&bucket=" + bucket.ID.String(),
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z"}`,
+			permissions: []platform.Permission{*del},
+			status:      http.StatusNoContent,
+			calls: []deleteCall{{
+				orgID:    org.ID,
+				bucketID: bucket.ID,
+				min:      start.UnixNano(),
+				max:      stop.UnixNano(),
+			}},
+		},
+		{
+			name:        "delete permission on every bucket of the org",
+			query:       "?org=org&bucket=bucket",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z"}`,
+			permissions: []platform.Permission{*orgDel},
+			status:      http.StatusNoContent,
+			calls: []deleteCall{{
+				orgID:    org.ID,
+				bucketID: bucket.ID,
+				min:      start.UnixNano(),
+				max:      stop.UnixNano(),
+			}},
+		},
+		{
+			name:        "delete permission in another org is insufficient",
+			query:       "?org=org&bucket=bucket",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z"}`,
+			permissions: []platform.Permission{*otherOrgDel},
+			status:      http.StatusForbidden,
+		},
+		{
+			name:        "read and write permissions are insufficient",
+			query:       "?org=org&bucket=bucket",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z"}`,
+			permissions: []platform.Permission{*read, *write},
+			status:      http.StatusForbidden,
+		},
+		{
+			name:        "invalid predicate",
+			query:       "?org=org&bucket=bucket",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z","predicate":"value > 1"}`,
+			permissions: []platform.Permission{*del},
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "missing stop",
+			query:       "?org=org&bucket=bucket",
+			body:        `{"start":"2018-01-01T00:00:00Z"}`,
+			permissions: []platform.Permission{*del},
+			status:      http.StatusBadRequest,
+		},
+		{
+			name:        "unknown bucket",
+			query:       "?org=org&bucket=missing",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z"}`,
+			permissions: []platform.Permission{*del},
+			status:      http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleter := &fakePointsDeleter{}
+			core, logs := observer.New(zap.InfoLevel)
+			h := NewDeleteHandler(deleter)
+			h.Logger = zap.New(core)
+			h.OrganizationService = svc
+			h.BucketService = svc
+
+			r := httptest.NewRequest("POST", "http://any.url/api/v2/delete"+tt.query, strings.NewReader(tt.body))
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				Permissions: tt.permissions,
+			}))
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if got, exp := w.Code, tt.status; got != exp {
+				t.Fatalf("got status %d, exp %d: %s", got, exp, w.Body.String())
+			}
+
+			if got, exp := len(deleter.calls), len(tt.calls); got != exp {
+				t.Fatalf("got %d deletes, exp %d", got, exp)
+			}
+			for i := range tt.calls {
+				if got, exp := deleter.calls[i], tt.calls[i]; got != exp {
+					t.Fatalf("got delete %+v, exp %+v", got, exp)
+				}
+			}
+
+			// Only valid deletes are logged.
+			if got, exp := logs.FilterMessage("Deleting points").Len(), len(tt.calls); got != exp {
+				t.Fatalf("got %d logged deletes, exp %d", got, exp)
+			}
+		})
+	}
+}
@@ -195,4 +195,5 @@ var statusCodePlatformError = map[string]int{
 	platform.EUnavailable:      http.StatusServiceUnavailable,
 	platform.EForbidden:        http.StatusForbidden,
 	platform.EMethodNotAllowed: http.StatusMethodNotAllowed,
+	platform.ETooManyRequests:  http.StatusTooManyRequests,
 }
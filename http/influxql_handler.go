@@ -0,0 +1,255 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/influxql"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+const (
+	influxqlPath = "/query"
+
+	// DefaultInfluxQLCluster is the cluster of the dbrp mappings of the InfluxQL handler by default.
+	DefaultInfluxQLCluster = "default"
+
+	// DefaultChunkSize is the number of rows in each chunk of a chunked query
+	// that does not set chunk_size, as in InfluxDB 1.X.
+	DefaultChunkSize = 10000
+)
+
+// InfluxQLHandler serves InfluxQL queries at /query, compatible with the InfluxDB 1.X HTTP API.
+// The databases and retention policies of the queries are resolved to buckets with the dbrp mappings of the cluster.
+type InfluxQLHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	// Cluster is the cluster of the dbrp mappings for the queries.
+	Cluster string
+
+	DBRPMappingService platform.DBRPMappingService
+	ProxyQueryService  query.ProxyQueryService
+}
+
+// NewInfluxQLHandler returns a new handler at /query for InfluxQL queries.
+func NewInfluxQLHandler() *InfluxQLHandler {
+	h := &InfluxQLHandler{
+		Router:  NewRouter(),
+		Logger:  zap.NewNop(),
+		Cluster: DefaultInfluxQLCluster,
+	}
+
+	h.HandlerFunc("GET", influxqlPath, h.handleQuery)
+	h.HandlerFunc("POST", influxqlPath, h.handleQuery)
+	return h
+}
+
+func (h *InfluxQLHandler) handleQuery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		h.encodeError(w, err)
+		return
+	}
+	auth, ok := a.(*platform.Authorization)
+	if !ok {
+		h.encodeError(w, platform.ErrAuthorizerNotSupported)
+		return
+	}
+
+	req, err := decodeInfluxQLQueryRequest(ctx, r)
+	if err != nil {
+		h.encodeError(w, err)
+		return
+	}
+
+	orgID, err := h.organizationID(ctx, req, auth)
+	if err != nil {
+		h.encodeError(w, err)
+		return
+	}
+
+	compiler := influxql.NewCompiler(h.DBRPMappingService)
+	compiler.Cluster = h.Cluster
+	compiler.DB = req.DB
+	compiler.RP = req.RP
+	compiler.Query = req.Query
+	pr := &query.ProxyRequest{
+		Request: query.Request{
+			Authorization:  auth,
+			OrganizationID: orgID,
+			Compiler:       compiler,
+		},
+		Dialect: req.Dialect,
+	}
+
+	req.Dialect.SetHeaders(w)
+	n, err := h.ProxyQueryService.Query(ctx, w, pr)
+	if err != nil {
+		if n == 0 {
+			// Only record the error headers IFF nothing has been written to w.
+			h.encodeError(w, err)
+			return
+		}
+		h.Logger.Info("Error writing response to client",
+			zap.String("handler", "influxql"),
+			zap.Error(err),
+		)
+	}
+}
+
+// organizationID returns the organization to run the query in. It is the organization of the
+// dbrp mapping of the database, which must be the organization of the authorization; without
+// a database, it is the organization of the authorization.
+func (h *InfluxQLHandler) organizationID(ctx context.Context, req *influxqlQueryRequest, auth *platform.Authorization) (platform.ID, error) {
+	if req.DB == "" {
+		return auth.OrgID, nil
+	}
+
+	filter := platform.DBRPMappingFilter{
+		Cluster:  &h.Cluster,
+		Database: &req.DB,
+	}
+	if req.RP != "" {
+		filter.RetentionPolicy = &req.RP
+	} else {
+		defaultRP := true
+		filter.Default = &defaultRP
+	}
+	m, err := h.DBRPMappingService.Find(ctx, filter)
+	if err != nil {
+		return 0, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  fmt.Sprintf("database not found: %s", req.DB),
+			Err:  err,
+		}
+	}
+	if m.OrganizationID != auth.OrgID {
+		return 0, &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  fmt.Sprintf("not authorized to query database %s", req.DB),
+		}
+	}
+	return m.OrganizationID, nil
+}
+
+type influxqlQueryRequest struct {
+	Query   string
+	DB      string
+	RP      string
+	Dialect *influxql.Dialect
+}
+
+// decodeInfluxQLQueryRequest decodes the parameters of an InfluxDB 1.X query request,
+// from the URL or the form of a POST request.
+func decodeInfluxQLQueryRequest(ctx context.Context, r *http.Request) (*influxqlQueryRequest, error) {
+	req := &influxqlQueryRequest{
+		Query: r.FormValue("q"),
+		DB:    r.FormValue("db"),
+		RP:    r.FormValue("rp"),
+	}
+	if req.Query == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  `missing required parameter "q"`,
+		}
+	}
+
+	d, err := decodeInfluxQLDialect(r)
+	if err != nil {
+		return nil, err
+	}
+	req.Dialect = d
+	return req, nil
+}
+
+// decodeInfluxQLDialect decodes the format of the response from the Accept header
+// and the epoch, pretty, chunked and chunk_size parameters.
+func decodeInfluxQLDialect(r *http.Request) (*influxql.Dialect, error) {
+	d := &influxql.Dialect{}
+	switch accept(r) {
+	case "application/csv", "text/csv":
+		d.Encoding = influxql.CSV
+		// The times in CSV are nanoseconds unless another epoch is requested.
+		d.TimeFormat = influxql.Nanosecond
+	default:
+		if r.FormValue("pretty") == "true" {
+			d.Encoding = influxql.JSONPretty
+		}
+	}
+
+	switch epoch := r.FormValue("epoch"); epoch {
+	case "":
+	case "h":
+		d.TimeFormat = influxql.Hour
+	case "m":
+		d.TimeFormat = influxql.Minute
+	case "s":
+		d.TimeFormat = influxql.Second
+	case "ms":
+		d.TimeFormat = influxql.Millisecond
+	case "u", "µ":
+		d.TimeFormat = influxql.Microsecond
+	case "ns", "n":
+		d.TimeFormat = influxql.Nanosecond
+	default:
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("invalid epoch %q; valid epochs are h, m, s, ms, u and ns", epoch),
+		}
+	}
+
+	if r.FormValue("chunked") == "true" {
+		d.ChunkSize = DefaultChunkSize
+		if s := r.FormValue("chunk_size"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				return nil, &platform.Error{
+					Code: platform.EInvalid,
+					Msg:  fmt.Sprintf("invalid chunk_size %q; it must be a positive integer", s),
+				}
+			}
+			d.ChunkSize = n
+		}
+	}
+	return d, nil
+}
+
+// accept returns the first media type of the Accept header.
+func accept(r *http.Request) string {
+	for _, s := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mt, _, err := mime.ParseMediaType(s); err == nil {
+			return mt
+		}
+	}
+	return ""
+}
+
+// encodeError writes err in the format of InfluxDB 1.X.
+// The status code of a platform error follows its code; other errors are bad requests,
+// since they are mostly errors in the query.
+func (h *InfluxQLHandler) encodeError(w http.ResponseWriter, err error) {
+	code, msg := http.StatusBadRequest, err.Error()
+	if pe, ok := err.(*platform.Error); ok {
+		if c, ok := statusCodePlatformError[platform.ErrorCode(pe)]; ok {
+			code = c
+		}
+		msg = platform.ErrorMessage(pe)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	b, _ := json.Marshal(influxql.Response{Err: msg})
+	_, _ = w.Write(b)
+}
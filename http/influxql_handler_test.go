@@ -0,0 +1,210 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/inmem"
+	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/influxql"
+)
+
+func TestInfluxQLHandler_handleQuery(t *testing.T) {
+	ctx := context.Background()
+	svc := inmem.NewService()
+	orgID := platform.ID(1)
+	if err := svc.Create(ctx, &platform.DBRPMapping{
+		Cluster:         DefaultInfluxQLCluster,
+		Database:        "db0",
+		RetentionPolicy: "autogen",
+		Default:         true,
+		OrganizationID:  orgID,
+		BucketID:        platform.ID(2),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Create(ctx, &platform.DBRPMapping{
+		Cluster:         DefaultInfluxQLCluster,
+		Database:        "other",
+		RetentionPolicy: "autogen",
+		Default:         true,
+		OrganizationID:  orgID + 1,
+		BucketID:        platform.ID(3),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		accept   string
+		status   int
+		body     string
+		compiler *influxql.Compiler
+		dialect  *influxql.Dialect
+	}{
+		{
+			name:   "query with the default retention policy",
+			method: "GET",
+			url:    "/query?db=db0&q=SELECT+*+FROM+cpu",
+			status: http.StatusOK,
+			compiler: &influxql.Compiler{
+				Cluster: DefaultInfluxQLCluster,
+				DB:      "db0",
+				Query:   "SELECT * FROM cpu",
+			},
+			dialect: &influxql.Dialect{},
+		},
+		{
+			name:   "chunked query with an epoch",
+			method: "POST",
+			url:    "/query?db=db0&rp=autogen&q=SELECT+*+FROM+cpu&epoch=ms&chunked=true&chunk_size=100",
+			status: http.StatusOK,
+			compiler: &influxql.Compiler{
+				Cluster: DefaultInfluxQLCluster,
+				DB:      "db0",
+				RP:      "autogen",
+				Query:   "SELECT * FROM cpu",
+			},
+			dialect: &influxql.Dialect{
+				TimeFormat: influxql.Millisecond,
+				ChunkSize:  100,
+			},
+		},
+		{
+			name:   "query without a database in csv",
+			method: "GET",
+			url:    "/query?q=SELECT+*+FROM+db0..cpu",
+			accept: "application/csv",
+			status: http.StatusOK,
+			compiler: &influxql.Compiler{
+				Cluster: DefaultInfluxQLCluster,
+				Query:   "SELECT * FROM db0..cpu",
+			},
+			dialect: &influxql.Dialect{
+				Encoding:   influxql.CSV,
+				TimeFormat: influxql.Nanosecond,
+			},
+		},
+		{
+			name:   "missing query",
+			method: "GET",
+			url:    "/query?db=db0",
+			status: http.StatusBadRequest,
+			body:   `{"error":"missing required parameter \"q\""}`,
+		},
+		{
+			name:   "unknown database",
+			method: "GET",
+			url:    "/query?db=missing&q=SELECT+*+FROM+cpu",
+			status: http.StatusNotFound,
+			body:   `{"error":"database not found: missing"}`,
+		},
+		{
+			name:   "database of another organization",
+			method: "GET",
+			url:    "/query?db=other&q=SELECT+*+FROM+cpu",
+			status: http.StatusForbidden,
+			body:   `{"error":"not authorized to query database other"}`,
+		},
+		{
+			name:   "invalid epoch",
+			method: "GET",
+			url:    "/query?db=db0&q=SELECT+*+FROM+cpu&epoch=d",
+			status: http.StatusBadRequest,
+			body:   `{"error":"invalid epoch \"d\"; valid epochs are h, m, s, ms, u and ns"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *query.ProxyRequest
+			qs := mock.NewProxyQueryService()
+			qs.QueryFn = func(ctx context.Context, w io.Writer, req *query.ProxyRequest) (int64, error) {
+				got = req
+				return 0, nil
+			}
+
+			h := NewInfluxQLHandler()
+			h.DBRPMappingService = svc
+			h.ProxyQueryService = qs
+
+			r := httptest.NewRequest(tt.method, "http://any.url"+tt.url, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status: platform.Active,
+				OrgID:  orgID,
+			}))
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if got, exp := w.Code, tt.status; got != exp {
+				t.Fatalf("got status %d, exp %d: %s", got, exp, w.Body.String())
+			}
+			if tt.body != "" {
+				if got, exp := w.Body.String(), tt.body; got != exp {
+					t.Fatalf("unexpected body -want/+got:\n%s", cmp.Diff(exp, got))
+				}
+			}
+			if tt.compiler == nil {
+				if got != nil {
+					t.Fatal("unexpected query")
+				}
+				return
+			}
+
+			if got.Request.OrganizationID != orgID {
+				t.Errorf("got organization %s, exp %s", got.Request.OrganizationID, orgID)
+			}
+			opt := cmpopts.IgnoreUnexported(influxql.Compiler{})
+			if compiler := got.Request.Compiler.(*influxql.Compiler); !cmp.Equal(tt.compiler, compiler, opt) {
+				t.Errorf("unexpected compiler -want/+got:\n%s", cmp.Diff(tt.compiler, compiler, opt))
+			}
+			if !cmp.Equal(tt.dialect, got.Dialect) {
+				t.Errorf("unexpected dialect -want/+got:\n%s", cmp.Diff(tt.dialect, got.Dialect))
+			}
+		})
+	}
+}
+
+func TestInfluxQLHandler_handleQuery_Flush(t *testing.T) {
+	qs := mock.NewProxyQueryService()
+	qs.QueryFn = func(ctx context.Context, w io.Writer, req *query.ProxyRequest) (int64, error) {
+		f, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("response writer cannot be flushed")
+		}
+		n, err := io.WriteString(w, "chunk\n")
+		f.Flush()
+		return int64(n), err
+	}
+
+	h := NewInfluxQLHandler()
+	h.ProxyQueryService = qs
+
+	r := httptest.NewRequest("GET", "http://any.url/query?q=SHOW+DATABASES&chunked=true", nil)
+	r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+		Status: platform.Active,
+		OrgID:  platform.ID(1),
+	}))
+
+	// The responses of the platform handler are recorded for their status code.
+	w := httptest.NewRecorder()
+	h.ServeHTTP(newStatusResponseWriter(w), r)
+
+	if !w.Flushed {
+		t.Error("chunked response was not flushed")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
 	kerrors "github.com/influxdata/platform/kit/errors"
 	"github.com/julienschmidt/httprouter"
 	"go.uber.org/zap"
@@ -26,6 +27,7 @@ type OrgHandler struct {
 	BucketService                   platform.BucketService
 	UserResourceMappingService      platform.UserResourceMappingService
 	SecretService                   platform.SecretService
+	OrganizationSettingsService     platform.OrganizationSettingsService
 	LabelService                    platform.LabelService
 	UserService                     platform.UserService
 }
@@ -43,6 +45,7 @@ const (
 	organizationsIDSecretsDeletePath = "/api/v2/orgs/:id/secrets/delete"
 	organizationsIDLabelsPath        = "/api/v2/orgs/:id/labels"
 	organizationsIDLabelsNamePath    = "/api/v2/orgs/:id/labels/:name"
+	organizationsIDSettingsPath      = "/api/v2/orgs/:id/settings"
 )
 
 // NewOrgHandler returns a new instance of OrgHandler.
@@ -76,6 +79,9 @@ func NewOrgHandler(mappingService platform.UserResourceMappingService,
 	// TODO(desa): need a way to specify which secrets to delete. this should work for now
 	h.HandlerFunc("POST", organizationsIDSecretsDeletePath, h.handleDeleteSecrets)
 
+	h.HandlerFunc("GET", organizationsIDSettingsPath, h.handleGetOrgSettings)
+	h.HandlerFunc("PUT", organizationsIDSettingsPath, h.handlePutOrgSettings)
+
 	h.HandlerFunc("GET", organizationsIDLabelsPath, newGetLabelsHandler(h.LabelService))
 	h.HandlerFunc("POST", organizationsIDLabelsPath, newPostLabelHandler(h.LabelService))
 	h.HandlerFunc("DELETE", organizationsIDLabelsNamePath, newDeleteLabelHandler(h.LabelService))
@@ -122,6 +128,7 @@ func newOrgResponse(o *platform.Organization) *orgResponse {
 			"log":        fmt.Sprintf("/api/v2/orgs/%s/log", o.ID),
 			"members":    fmt.Sprintf("/api/v2/orgs/%s/members", o.ID),
 			"secrets":    fmt.Sprintf("/api/v2/orgs/%s/secrets", o.ID),
+			"settings":   fmt.Sprintf("/api/v2/orgs/%s/settings", o.ID),
 			"labels":     fmt.Sprintf("/api/v2/orgs/%s/labels", o.ID),
 			"buckets":    fmt.Sprintf("/api/v2/buckets?org=%s", o.Name),
 			"tasks":      fmt.Sprintf("/api/v2/tasks?org=%s", o.Name),
@@ -495,6 +502,151 @@ func decodeDeleteSecretsRequest(ctx context.Context, r *http.Request) (*deleteSe
 	return req, nil
 }
 
+type orgSettingsResponse struct {
+	Links map[string]string `json:"links"`
+	platform.OrganizationSettings
+}
+
+func newOrgSettingsResponse(s *platform.OrganizationSettings) *orgSettingsResponse {
+	return &orgSettingsResponse{
+		Links: map[string]string{
+			"self": fmt.Sprintf("/api/v2/orgs/%s/settings", s.OrganizationID),
+			"org":  fmt.Sprintf("/api/v2/orgs/%s", s.OrganizationID),
+		},
+		OrganizationSettings: *s,
+	}
+}
+
+// handleGetOrgSettings is the HTTP handler for the GET /api/v2/orgs/:id/settings route.
+func (h *OrgHandler) handleGetOrgSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeGetOrgSettingsRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := authorizeOrgSettings(ctx, req.orgID, platform.ReadAction); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	s, err := h.OrganizationSettingsService.FindOrganizationSettings(ctx, req.orgID)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newOrgSettingsResponse(s)); err != nil {
+		logEncodingError(h.Logger, r, err)
+		return
+	}
+}
+
+type getOrgSettingsRequest struct {
+	orgID platform.ID
+}
+
+func decodeGetOrgSettingsRequest(ctx context.Context, r *http.Request) (*getOrgSettingsRequest, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	id := params.ByName("id")
+	if id == "" {
+		return nil, kerrors.InvalidDataf("url missing id")
+	}
+
+	var i platform.ID
+	if err := i.DecodeFromString(id); err != nil {
+		return nil, err
+	}
+
+	return &getOrgSettingsRequest{orgID: i}, nil
+}
+
+// handlePutOrgSettings is the HTTP handler for the PUT /api/v2/orgs/:id/settings route.
+func (h *OrgHandler) handlePutOrgSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodePutOrgSettingsRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := authorizeOrgSettings(ctx, req.settings.OrganizationID, platform.WriteAction); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.OrganizationSettingsService.PutOrganizationSettings(ctx, req.settings); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newOrgSettingsResponse(req.settings)); err != nil {
+		logEncodingError(h.Logger, r, err)
+		return
+	}
+}
+
+// authorizeOrgSettings ensures the authorizer in ctx may perform action on the settings of the organization with orgID.
+// Reading them requires reading the organization. The settings hold the quotas of the organization, which must not
+// be raised by its owners, so writing them requires writing every organization, as only operators may.
+func authorizeOrgSettings(ctx context.Context, orgID platform.ID, action platform.Action) error {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	var p *platform.Permission
+	if action == platform.ReadAction {
+		p, err = platform.NewPermissionAtID(orgID, action, platform.OrgsResource)
+	} else {
+		p, err = platform.NewPermission(action, platform.OrgsResource)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !a.Allowed(*p) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Op:   "http/authorizeOrgSettings",
+			Msg:  "insufficient permissions for organization settings",
+		}
+	}
+	return nil
+}
+
+type putOrgSettingsRequest struct {
+	settings *platform.OrganizationSettings
+}
+
+func decodePutOrgSettingsRequest(ctx context.Context, r *http.Request) (*putOrgSettingsRequest, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	id := params.ByName("id")
+	if id == "" {
+		return nil, kerrors.InvalidDataf("url missing id")
+	}
+
+	var i platform.ID
+	if err := i.DecodeFromString(id); err != nil {
+		return nil, err
+	}
+
+	s := &platform.OrganizationSettings{}
+	if err := json.NewDecoder(r.Body).Decode(s); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodePutOrgSettingsRequest",
+			Err:  err,
+		}
+	}
+	s.OrganizationID = i
+
+	return &putOrgSettingsRequest{settings: s}, nil
+}
+
 const (
 	organizationPath = "/api/v2/orgs"
 )
@@ -508,6 +660,8 @@ type OrganizationService struct {
 	OpPrefix string
 }
 
+var _ platform.OrganizationSettingsService = (*OrganizationService)(nil)
+
 // FindOrganizationByID gets a single organization with a given id using HTTP.
 func (s *OrganizationService) FindOrganizationByID(ctx context.Context, id platform.ID) (*platform.Organization, error) {
 	filter := platform.OrganizationFilter{ID: &id}
@@ -690,6 +844,70 @@ func (s *OrganizationService) DeleteOrganization(ctx context.Context, id platfor
 	return CheckErrorStatus(http.StatusNoContent, resp, true)
 }
 
+// FindOrganizationSettings returns the settings of the organization with orgID over HTTP.
+func (s *OrganizationService) FindOrganizationSettings(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error) {
+	u, err := newURL(s.Addr, organizationSettingsPath(orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var settings platform.OrganizationSettings
+	if err := json.NewDecoder(resp.Body).Decode(&settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// PutOrganizationSettings replaces the settings of an organization over HTTP.
+func (s *OrganizationService) PutOrganizationSettings(ctx context.Context, settings *platform.OrganizationSettings) error {
+	u, err := newURL(s.Addr, organizationSettingsPath(settings.OrganizationID))
+	if err != nil {
+		return err
+	}
+
+	octets, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", u.String(), bytes.NewReader(octets))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return CheckError(resp, true)
+}
+
+func organizationSettingsPath(id platform.ID) string {
+	return path.Join(organizationPath, id.String(), "settings")
+}
+
 func organizationIDPath(id platform.ID) string {
 	return path.Join(organizationPath, id.String())
 }
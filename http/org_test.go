@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
 	"github.com/influxdata/platform/inmem"
 	"github.com/influxdata/platform/mock"
 	platformtesting "github.com/influxdata/platform/testing"
@@ -301,3 +302,220 @@ func TestSecretService_handleDeleteSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestOrgHandler_handleOrgSettings(t *testing.T) {
+	type args struct {
+		method string
+		orgID  platform.ID
+		body   string
+	}
+	type wants struct {
+		statusCode int
+		body       string
+	}
+
+	orgPermission := func(orgID platform.ID, action platform.Action) platform.Permission {
+		p, err := platform.NewPermissionAtID(orgID, action, platform.OrgsResource)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *p
+	}
+	// Only operators may write the settings of an organization.
+	operWrite := platform.Permission{Action: platform.WriteAction, Resource: platform.OrgsResource}
+	forbidden := &mock.OrganizationSettingsService{
+		FindOrganizationSettingsFn: func(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error) {
+			return nil, fmt.Errorf("unexpected read of settings")
+		},
+		PutOrganizationSettingsFn: func(ctx context.Context, s *platform.OrganizationSettings) error {
+			return fmt.Errorf("unexpected write of settings")
+		},
+	}
+
+	tests := []struct {
+		name        string
+		service     *mock.OrganizationSettingsService
+		permissions []platform.Permission
+		args        args
+		wants       wants
+	}{
+		{
+			name: "get settings",
+			service: &mock.OrganizationSettingsService{
+				FindOrganizationSettingsFn: func(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error) {
+					return &platform.OrganizationSettings{
+						OrganizationID: orgID,
+						QueryQuota: platform.QueryQuota{
+							MaxConcurrentQueries: 2,
+							MaxQueuedQueries:     4,
+						},
+					}, nil
+				},
+			},
+			permissions: []platform.Permission{orgPermission(1, platform.ReadAction)},
+			args: args{
+				method: "GET",
+				orgID:  1,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				body: `
+{
+  "links": {
+    "org": "/api/v2/orgs/0000000000000001",
+    "self": "/api/v2/orgs/0000000000000001/settings"
+  },
+  "orgID": "0000000000000001",
+  "queryQuota": {
+    "maxConcurrentQueries": 2,
+    "maxQueuedQueries": 4,
+    "maxMemoryBytes": 0,
+    "maxQueryDuration": 0
+  }
+}
+`,
+			},
+		},
+		{
+			name: "put settings",
+			service: &mock.OrganizationSettingsService{
+				PutOrganizationSettingsFn: func(ctx context.Context, s *platform.OrganizationSettings) error {
+					if s.OrganizationID != 1 {
+						return fmt.Errorf("unexpected organization %s", s.OrganizationID)
+					}
+					return nil
+				},
+			},
+			permissions: []platform.Permission{operWrite},
+			args: args{
+				method: "PUT",
+				orgID:  1,
+				body:   `{"orgID": "0000000000000002", "queryQuota": {"maxMemoryBytes": 1024, "maxQueryDuration": 60000000000}}`,
+			},
+			wants: wants{
+				statusCode: http.StatusOK,
+				body: `
+{
+  "links": {
+    "org": "/api/v2/orgs/0000000000000001",
+    "self": "/api/v2/orgs/0000000000000001/settings"
+  },
+  "orgID": "0000000000000001",
+  "queryQuota": {
+    "maxConcurrentQueries": 0,
+    "maxQueuedQueries": 0,
+    "maxMemoryBytes": 1024,
+    "maxQueryDuration": 60000000000
+  }
+}
+`,
+			},
+		},
+		{
+			name: "put invalid settings",
+			service: &mock.OrganizationSettingsService{
+				PutOrganizationSettingsFn: func(ctx context.Context, s *platform.OrganizationSettings) error {
+					return s.QueryQuota.Valid()
+				},
+			},
+			permissions: []platform.Permission{operWrite},
+			args: args{
+				method: "PUT",
+				orgID:  1,
+				body:   `{"queryQuota": {"maxConcurrentQueries": -1}}`,
+			},
+			wants: wants{
+				statusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:    "get settings without permission",
+			service: forbidden,
+			args: args{
+				method: "GET",
+				orgID:  1,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+		},
+		{
+			name:        "get settings of another organization",
+			service:     forbidden,
+			permissions: []platform.Permission{orgPermission(2, platform.ReadAction)},
+			args: args{
+				method: "GET",
+				orgID:  1,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+		},
+		{
+			name:        "put settings with read permission",
+			service:     forbidden,
+			permissions: []platform.Permission{orgPermission(1, platform.ReadAction)},
+			args: args{
+				method: "PUT",
+				orgID:  1,
+				body:   `{"queryQuota": {"maxMemoryBytes": 1024}}`,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+		},
+		{
+			name:        "put settings as the owner of the organization",
+			service:     forbidden,
+			permissions: append(platform.OrgAdminPermissions(1), orgPermission(1, platform.WriteAction)),
+			args: args{
+				method: "PUT",
+				orgID:  1,
+				body:   `{"queryQuota": {"maxConcurrentQueries": 100}}`,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+		},
+		{
+			name:        "put settings of another organization",
+			service:     forbidden,
+			permissions: []platform.Permission{orgPermission(2, platform.WriteAction)},
+			args: args{
+				method: "PUT",
+				orgID:  1,
+				body:   `{"orgID": "0000000000000002", "queryQuota": {"maxMemoryBytes": 1024}}`,
+			},
+			wants: wants{
+				statusCode: http.StatusForbidden,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewOrgHandler(mock.NewUserResourceMappingService(), mock.NewLabelService(), mock.NewUserService())
+			h.OrganizationSettingsService = tt.service
+
+			u := fmt.Sprintf("http://any.url/api/v2/orgs/%s/settings", tt.args.orgID)
+			r := httptest.NewRequest(tt.args.method, u, bytes.NewBufferString(tt.args.body))
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				Permissions: tt.permissions,
+			}))
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			res := w.Result()
+			body, _ := ioutil.ReadAll(res.Body)
+
+			if res.StatusCode != tt.wants.statusCode {
+				t.Errorf("handleOrgSettings() = %v, want %v: %s", res.StatusCode, tt.wants.statusCode, body)
+			}
+			if eq, diff, _ := jsonEqual(string(body), tt.wants.body); tt.wants.body != "" && !eq {
+				t.Errorf("handleOrgSettings() = ***%s***", diff)
+			}
+		})
+	}
+}
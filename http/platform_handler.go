@@ -34,6 +34,10 @@ func NewPlatformHandler(b *APIBackend) *PlatformHandler {
 	h.RegisterNoAuthRoute("POST", "/api/v2/setup")
 	h.RegisterNoAuthRoute("GET", "/api/v2/setup")
 
+	// InfluxDB 1.X clients pass their credentials as a username and password.
+	h.RegisterV1AuthRoute("GET", influxqlPath)
+	h.RegisterV1AuthRoute("POST", influxqlPath)
+
 	assetHandler := NewAssetHandler()
 	assetHandler.DeveloperMode = b.DeveloperMode
 
@@ -52,7 +56,8 @@ func (h *PlatformHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Serve the chronograf assets for any basepath that does not start with addressable parts
 	// of the platform API.
-	if !strings.HasPrefix(r.URL.Path, "/v1") &&
+	if r.URL.Path != influxqlPath &&
+		!strings.HasPrefix(r.URL.Path, "/v1") &&
 		!strings.HasPrefix(r.URL.Path, "/api/v2") &&
 		!strings.HasPrefix(r.URL.Path, "/chronograf/") {
 		h.AssetHandler.ServeHTTP(w, r)
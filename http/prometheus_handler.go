@@ -0,0 +1,283 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/snappy"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/kit/errors"
+	"github.com/influxdata/platform/prometheus/remote"
+	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// PrometheusHandler serves the remote write and remote read protocols of Prometheus,
+// so that Prometheus servers can store their samples in a bucket and read them back.
+type PrometheusHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	BucketService       platform.BucketService
+	OrganizationService platform.OrganizationService
+
+	PointsWriter      storage.PointsWriter
+	ProxyQueryService query.ProxyQueryService
+}
+
+const (
+	prometheusWritePath = "/api/v2/prom/write"
+	prometheusReadPath  = "/api/v2/prom/read"
+)
+
+// NewPrometheusHandler returns a new handler at /api/v2/prom/write and /api/v2/prom/read
+// for the remote write and read requests of Prometheus.
+func NewPrometheusHandler() *PrometheusHandler {
+	h := &PrometheusHandler{
+		Router: NewRouter(),
+		Logger: zap.NewNop(),
+	}
+
+	h.HandlerFunc("POST", prometheusWritePath, h.handleWrite)
+	h.HandlerFunc("POST", prometheusReadPath, h.handleRead)
+	return h
+}
+
+func (h *PrometheusHandler) handleWrite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	bucket, err := h.authorizedBucket(ctx, r, a, platform.WriteAction)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	req := &remote.WriteRequest{}
+	if err := decodeSnappyProto(r, req); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	points, err := remote.PointsFromWriteRequest(req)
+	if err != nil {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/handlePrometheusWrite",
+			Msg:  "invalid write request",
+			Err:  err,
+		}, w)
+		return
+	}
+
+	exploded, err := tsdb.ExplodePoints(bucket.OrganizationID, bucket.ID, points)
+	if err != nil {
+		h.Logger.Info("Error exploding points", zap.Error(err))
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.PointsWriter.WritePoints(exploded); err != nil {
+		EncodeError(ctx, errors.BadRequestError(err.Error()), w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *PrometheusHandler) handleRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+	auth, ok := a.(*platform.Authorization)
+	if !ok {
+		EncodeError(ctx, platform.ErrAuthorizerNotSupported, w)
+		return
+	}
+
+	bucket, err := h.authorizedBucket(ctx, r, a, platform.ReadAction)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	req := &remote.ReadRequest{}
+	if err := decodeSnappyProto(r, req); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	spec, err := remote.QuerySpec(bucket.ID, req)
+	if err != nil {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/handlePrometheusRead",
+			Msg:  "invalid read request",
+			Err:  err,
+		}, w)
+		return
+	}
+
+	d := &remote.Dialect{Queries: len(req.Queries)}
+	pr := &query.ProxyRequest{
+		Request: query.Request{
+			Authorization:  auth,
+			OrganizationID: bucket.OrganizationID,
+			Compiler:       lang.SpecCompiler{Spec: spec},
+		},
+		Dialect: d,
+	}
+
+	// The response is a single compressed message, so it is built before any of it is written.
+	var buf bytes.Buffer
+	if _, err := h.ProxyQueryService.Query(ctx, &buf, pr); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	d.SetHeaders(w)
+	if _, err := buf.WriteTo(w); err != nil {
+		h.Logger.Info("Error writing response to client",
+			zap.String("handler", "prometheus"),
+			zap.Error(err),
+		)
+	}
+}
+
+// authorizedBucket returns the bucket of the org and bucket parameters of the request,
+// on which the authorizer must be allowed the action.
+func (h *PrometheusHandler) authorizedBucket(ctx context.Context, r *http.Request, a platform.Authorizer, action platform.Action) (*platform.Bucket, error) {
+	qp := r.URL.Query()
+	orgParam, bucketParam := qp.Get("org"), qp.Get("bucket")
+	if orgParam == "" || bucketParam == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodePrometheusRequest",
+			Msg:  "org and bucket are required",
+		}
+	}
+
+	org, err := h.findOrganization(ctx, orgParam)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := h.findBucket(ctx, org.ID, bucketParam)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := platform.NewOrgPermissionAtID(bucket.OrganizationID, bucket.ID, action, platform.BucketsResource)
+	if err != nil {
+		return nil, fmt.Errorf("could not create permission for bucket: %v", err)
+	}
+
+	if !a.Allowed(*p) {
+		return nil, errors.Forbiddenf("insufficient permissions for %s", action)
+	}
+	return bucket, nil
+}
+
+// findOrganization returns the organization with the ID or name org.
+func (h *PrometheusHandler) findOrganization(ctx context.Context, org string) (*platform.Organization, error) {
+	if id, err := platform.IDFromString(org); err == nil {
+		o, err := h.OrganizationService.FindOrganizationByID(ctx, *id)
+		if err == nil {
+			return o, nil
+		} else if platform.ErrorCode(err) != platform.ENotFound {
+			return nil, err
+		}
+	}
+
+	o, err := h.OrganizationService.FindOrganization(ctx, platform.OrganizationFilter{Name: &org})
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   "http/findOrganization",
+			Msg:  fmt.Sprintf("organization %q not found", org),
+			Err:  err,
+		}
+	}
+	return o, nil
+}
+
+// findBucket returns the bucket of the organization with the ID or name bucket.
+func (h *PrometheusHandler) findBucket(ctx context.Context, orgID platform.ID, bucket string) (*platform.Bucket, error) {
+	if id, err := platform.IDFromString(bucket); err == nil {
+		b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+			OrganizationID: &orgID,
+			ID:             id,
+		})
+		if err == nil {
+			return b, nil
+		} else if platform.ErrorCode(err) != platform.ENotFound {
+			return nil, err
+		}
+	}
+
+	b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+		OrganizationID: &orgID,
+		Name:           &bucket,
+	})
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   "http/findBucket",
+			Msg:  fmt.Sprintf("bucket %q not found", bucket),
+			Err:  err,
+		}
+	}
+	return b, nil
+}
+
+type snappyProtoMessage interface {
+	Unmarshal([]byte) error
+}
+
+// decodeSnappyProto decodes the snappy compressed protobuf message of the body of the request into m.
+func decodeSnappyProto(r *http.Request, m snappyProtoMessage) error {
+	compressed, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodeSnappyProto",
+			Msg:  "body is not snappy compressed",
+			Err:  err,
+		}
+	}
+
+	if err := m.Unmarshal(data); err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Op:   "http/decodeSnappyProto",
+			Msg:  "invalid protobuf message",
+			Err:  err,
+		}
+	}
+	return nil
+}
@@ -21,9 +21,13 @@ import (
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/kit/errors"
 	"github.com/influxdata/platform/query"
+	parrow "github.com/influxdata/platform/query/arrow"
+	pinfluxql "github.com/influxdata/platform/query/influxql"
+	pjson "github.com/influxdata/platform/query/json"
+	"github.com/influxdata/platform/query/promql"
 )
 
-// QueryRequest is a flux query request.
+// QueryRequest is a query request in flux, influxql or promql.
 type QueryRequest struct {
 	Spec    *flux.Spec   `json:"spec,omitempty"`
 	AST     *ast.Package `json:"ast,omitempty"`
@@ -31,11 +35,24 @@ type QueryRequest struct {
 	Type    string       `json:"type"`
 	Dialect QueryDialect `json:"dialect"`
 
+	// Cluster, DB and RP are the dbrp mapping of the measurements of influxql queries
+	// that do not specify a database.
+	Cluster string `json:"cluster,omitempty"`
+	DB      string `json:"db,omitempty"`
+	RP      string `json:"rp,omitempty"`
+	// Bucket is the name of the bucket of influxql queries without a database and of promql queries.
+	Bucket string `json:"bucket,omitempty"`
+
 	Org *platform.Organization `json:"-"`
+
+	dbrpMappingSvc platform.DBRPMappingService
 }
 
 // QueryDialect is the formatting options for the query response.
 type QueryDialect struct {
+	// Type is the format of the response: csv, json or arrow.
+	// The other options only apply to csv, which is the default.
+	Type           string   `json:"type,omitempty"`
 	Header         *bool    `json:"header"`
 	Delimiter      string   `json:"delimiter"`
 	CommentPrefix  string   `json:"commentPrefix"`
@@ -48,6 +65,9 @@ func (r QueryRequest) WithDefaults() QueryRequest {
 	if r.Type == "" {
 		r.Type = "flux"
 	}
+	if r.Type == "influxql" && r.Cluster == "" {
+		r.Cluster = DefaultInfluxQLCluster
+	}
 	if r.Dialect.Delimiter == "" {
 		r.Dialect.Delimiter = ","
 	}
@@ -67,10 +87,22 @@ func (r QueryRequest) Validate() error {
 		return errors.New(`request body requires either query, spec, or AST`)
 	}
 
-	if r.Type != "flux" {
+	switch r.Type {
+	case "flux":
+	case "influxql", "promql":
+		if r.Query == "" {
+			return fmt.Errorf(`%s queries require the query field`, r.Type)
+		}
+	default:
 		return fmt.Errorf(`unknown query type: %s`, r.Type)
 	}
 
+	switch r.Dialect.Type {
+	case "", csv.DialectType, pjson.DialectType, parrow.DialectType:
+	default:
+		return fmt.Errorf(`unknown dialect type: %s`, r.Dialect.Type)
+	}
+
 	if len(r.Dialect.CommentPrefix) > 1 {
 		return fmt.Errorf("invalid dialect comment prefix: must be length 0 or 1")
 	}
@@ -121,6 +153,8 @@ func (r QueryRequest) Analyze() (*QueryAnalysis, error) {
 		return r.analyzeFluxQuery()
 	case "influxql":
 		return r.analyzeInfluxQLQuery()
+	case "promql":
+		return r.analyzePromQLQuery()
 	}
 
 	return nil, fmt.Errorf("unknown query request type %s", r.Type)
@@ -203,6 +237,47 @@ func columnFromCharacter(q string, char int) int {
 
 var influxqlParseErrorRE = regexp.MustCompile(`^(.+) at line (\d+), char (\d+)$`)
 
+func (r QueryRequest) analyzePromQLQuery() (*QueryAnalysis, error) {
+	a := &QueryAnalysis{}
+	_, err := promql.ParsePromQL(r.Query)
+	if err == nil {
+		a.Errors = []queryParseError{}
+		return a, nil
+	}
+
+	// The parser reports each error on its own line.
+	ms := promqlParseErrorRE.FindAllStringSubmatch(err.Error(), -1)
+	if len(ms) == 0 {
+		return nil, fmt.Errorf("promql query error is not formatted as expected: %v", err)
+	}
+	a.Errors = make([]queryParseError, 0, len(ms))
+	for _, m := range ms {
+		line, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse line number from error mesage: %s -> %v", m[1], err)
+		}
+		col, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse column number from error mesage: %s -> %v", m[2], err)
+		}
+		char, err := strconv.Atoi(m[3])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse character number from error mesage: %s -> %v", m[3], err)
+		}
+
+		a.Errors = append(a.Errors, queryParseError{
+			Line:      line,
+			Column:    col,
+			Character: char,
+			Message:   m[4],
+		})
+	}
+
+	return a, nil
+}
+
+var promqlParseErrorRE = regexp.MustCompile(`(?m)^(\d+):(\d+) \((\d+)\): (.+)$`)
+
 func nowFunc(now time.Time) values.Function {
 	timeVal := values.NewTime(values.ConvertTime(now))
 	ftype := semantic.NewFunctionType(semantic.FunctionSignature{
@@ -240,11 +315,25 @@ func (r QueryRequest) proxyRequest(now func() time.Time) (*query.ProxyRequest, e
 	}
 	// Query is preferred over spec
 	var compiler flux.Compiler
-	if r.Query != "" {
+	switch {
+	case r.Type == "influxql":
+		c := pinfluxql.NewCompiler(r.dbrpMappingSvc)
+		c.Cluster = r.Cluster
+		c.DB = r.DB
+		c.RP = r.RP
+		c.Bucket = r.Bucket
+		c.Query = r.Query
+		compiler = c
+	case r.Type == "promql":
+		compiler = &promql.Compiler{
+			Bucket: r.Bucket,
+			Query:  r.Query,
+		}
+	case r.Query != "":
 		compiler = lang.FluxCompiler{
 			Query: r.Query,
 		}
-	} else if r.AST != nil {
+	case r.AST != nil:
 		var err error
 		r.Spec, err = toSpec(r.AST, now)
 		if err != nil {
@@ -253,33 +342,43 @@ func (r QueryRequest) proxyRequest(now func() time.Time) (*query.ProxyRequest, e
 		compiler = lang.SpecCompiler{
 			Spec: r.Spec,
 		}
-	} else if r.Spec != nil {
+	case r.Spec != nil:
 		compiler = lang.SpecCompiler{
 			Spec: r.Spec,
 		}
 	}
 
-	delimiter, _ := utf8.DecodeRuneInString(r.Dialect.Delimiter)
+	var dialect flux.Dialect
+	switch r.Dialect.Type {
+	case pjson.DialectType:
+		dialect = &pjson.Dialect{}
+	case parrow.DialectType:
+		dialect = &parrow.Dialect{}
+	default:
+		delimiter, _ := utf8.DecodeRuneInString(r.Dialect.Delimiter)
 
-	noHeader := false
-	if r.Dialect.Header != nil {
-		noHeader = !*r.Dialect.Header
-	}
+		noHeader := false
+		if r.Dialect.Header != nil {
+			noHeader = !*r.Dialect.Header
+		}
 
-	// TODO(nathanielc): Use commentPrefix and dateTimeFormat
-	// once they are supported.
-	return &query.ProxyRequest{
-		Request: query.Request{
-			OrganizationID: r.Org.ID,
-			Compiler:       compiler,
-		},
-		Dialect: &csv.Dialect{
+		// TODO(nathanielc): Use commentPrefix and dateTimeFormat
+		// once they are supported.
+		dialect = &csv.Dialect{
 			ResultEncoderConfig: csv.ResultEncoderConfig{
 				NoHeader:    noHeader,
 				Delimiter:   delimiter,
 				Annotations: r.Dialect.Annotations,
 			},
+		}
+	}
+
+	return &query.ProxyRequest{
+		Request: query.Request{
+			OrganizationID: r.Org.ID,
+			Compiler:       compiler,
 		},
+		Dialect: dialect,
 	}, nil
 }
 
@@ -294,6 +393,17 @@ func QueryRequestFromProxyRequest(req *query.ProxyRequest) (*QueryRequest, error
 	case lang.SpecCompiler:
 		qr.Type = "flux"
 		qr.Spec = c.Spec
+	case *pinfluxql.Compiler:
+		qr.Type = "influxql"
+		qr.Query = c.Query
+		qr.Cluster = c.Cluster
+		qr.DB = c.DB
+		qr.RP = c.RP
+		qr.Bucket = c.Bucket
+	case *promql.Compiler:
+		qr.Type = "promql"
+		qr.Query = c.Query
+		qr.Bucket = c.Bucket
 	default:
 		return nil, fmt.Errorf("unsupported compiler %T", c)
 	}
@@ -305,6 +415,10 @@ func QueryRequestFromProxyRequest(req *query.ProxyRequest) (*QueryRequest, error
 		qr.Dialect.CommentPrefix = "#"
 		qr.Dialect.DateTimeFormat = "RFC3339"
 		qr.Dialect.Annotations = d.ResultEncoderConfig.Annotations
+	case *pjson.Dialect:
+		qr.Dialect.Type = pjson.DialectType
+	case *parrow.Dialect:
+		qr.Dialect.Type = parrow.DialectType
 	default:
 		return nil, fmt.Errorf("unsupported dialect %T", d)
 	}
@@ -312,7 +426,7 @@ func QueryRequestFromProxyRequest(req *query.ProxyRequest) (*QueryRequest, error
 	return qr, nil
 }
 
-func decodeQueryRequest(ctx context.Context, r *http.Request, svc platform.OrganizationService) (*QueryRequest, error) {
+func decodeQueryRequest(ctx context.Context, r *http.Request, svc platform.OrganizationService, dbrpMappingSvc platform.DBRPMappingService) (*QueryRequest, error) {
 	var req QueryRequest
 	// TODO(desa): I'm not sure I like this kind of conditional logic, but it feels better than
 	// introducing another method that does this exact thing.
@@ -328,18 +442,49 @@ func decodeQueryRequest(ctx context.Context, r *http.Request, svc platform.Organ
 		}
 	}
 
+	// The dialect of the request takes precedence over the Accept header.
+	if req.Dialect.Type == "" {
+		req.Dialect.Type = acceptDialectType(accept(r))
+	}
+
 	req = req.WithDefaults()
 	err := req.Validate()
 	if err != nil {
 		return nil, err
 	}
 
+	req.dbrpMappingSvc = dbrpMappingSvc
 	req.Org, err = queryOrganization(ctx, r, svc)
 	return &req, err
 }
 
-func decodeProxyQueryRequest(ctx context.Context, r *http.Request, auth platform.Authorizer, svc platform.OrganizationService) (*query.ProxyRequest, error) {
-	req, err := decodeQueryRequest(ctx, r, svc)
+// acceptDialectType returns the dialect type of the media type of an Accept header,
+// or an empty string for the default dialect.
+func acceptDialectType(mediaType string) string {
+	switch mediaType {
+	case "application/json":
+		return pjson.DialectType
+	case parrow.ContentType, "application/vnd.apache.arrow.stream":
+		return parrow.DialectType
+	default:
+		return ""
+	}
+}
+
+// dialectMediaType returns the media type of the responses of a dialect type.
+func dialectMediaType(typ string) string {
+	switch typ {
+	case pjson.DialectType:
+		return "application/json"
+	case parrow.DialectType:
+		return parrow.ContentType
+	default:
+		return "text/csv"
+	}
+}
+
+func decodeProxyQueryRequest(ctx context.Context, r *http.Request, auth platform.Authorizer, svc platform.OrganizationService, dbrpMappingSvc platform.DBRPMappingService) (*query.ProxyRequest, error) {
+	req, err := decodeQueryRequest(ctx, r, svc, dbrpMappingSvc)
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,8 @@ import (
 	pcontext "github.com/influxdata/platform/context"
 	"github.com/influxdata/platform/kit/errors"
 	"github.com/influxdata/platform/query"
+	parrow "github.com/influxdata/platform/query/arrow"
+	pjson "github.com/influxdata/platform/query/json"
 	"github.com/julienschmidt/httprouter"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -36,6 +38,7 @@ type FluxHandler struct {
 
 	Now                 func() time.Time
 	OrganizationService platform.OrganizationService
+	DBRPMappingService  platform.DBRPMappingService
 	ProxyQueryService   query.ProxyQueryService
 }
 
@@ -66,7 +69,7 @@ func (h *FluxHandler) handleQuery(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req, err := decodeProxyQueryRequest(ctx, r, a, h.OrganizationService)
+	req, err := decodeProxyQueryRequest(ctx, r, a, h.OrganizationService, h.DBRPMappingService)
 	if err != nil && err != platform.ErrAuthorizerNotSupported {
 		EncodeError(ctx, err, w)
 		return
@@ -289,7 +292,7 @@ func (s *FluxService) Query(ctx context.Context, w io.Writer, r *query.ProxyRequ
 	SetToken(s.Token, hreq)
 
 	hreq.Header.Set("Content-Type", "application/json")
-	hreq.Header.Set("Accept", "text/csv")
+	hreq.Header.Set("Accept", dialectMediaType(qreq.Dialect.Type))
 	hreq = hreq.WithContext(ctx)
 
 	hc := newClient(u.Scheme, s.InsecureSkipVerify)
@@ -315,6 +318,9 @@ type FluxQueryService struct {
 	Addr               string
 	Token              string
 	InsecureSkipVerify bool
+	// Dialect is the dialect type of the responses: csv, json or arrow.
+	// Defaults to csv.
+	Dialect string
 }
 
 // Query runs a flux query against a influx server and decodes the result
@@ -327,9 +333,27 @@ func (s *FluxQueryService) Query(ctx context.Context, r *query.Request) (flux.Re
 	params.Set(OrgID, r.OrganizationID.String())
 	u.RawQuery = params.Encode()
 
+	var (
+		dialect flux.Dialect
+		decoder flux.MultiResultDecoder
+	)
+	switch s.Dialect {
+	case "", csv.DialectType:
+		dialect = csv.DefaultDialect()
+		decoder = csv.NewMultiResultDecoder(csv.ResultDecoderConfig{})
+	case pjson.DialectType:
+		dialect = &pjson.Dialect{}
+		decoder = pjson.NewMultiResultDecoder()
+	case parrow.DialectType:
+		dialect = &parrow.Dialect{}
+		decoder = parrow.NewMultiResultDecoder()
+	default:
+		return nil, fmt.Errorf("unknown dialect type: %s", s.Dialect)
+	}
+
 	preq := &query.ProxyRequest{
 		Request: *r,
-		Dialect: csv.DefaultDialect(),
+		Dialect: dialect,
 	}
 	qreq, err := QueryRequestFromProxyRequest(preq)
 	if err != nil {
@@ -348,7 +372,7 @@ func (s *FluxQueryService) Query(ctx context.Context, r *query.Request) (flux.Re
 	SetToken(s.Token, hreq)
 
 	hreq.Header.Set("Content-Type", "application/json")
-	hreq.Header.Set("Accept", "text/csv")
+	hreq.Header.Set("Accept", dialectMediaType(qreq.Dialect.Type))
 	hreq = hreq.WithContext(ctx)
 
 	hc := newClient(u.Scheme, s.InsecureSkipVerify)
@@ -361,6 +385,5 @@ func (s *FluxQueryService) Query(ctx context.Context, r *query.Request) (flux.Re
 		return nil, err
 	}
 
-	decoder := csv.NewMultiResultDecoder(csv.ResultDecoderConfig{})
 	return decoder.Decode(resp.Body)
 }
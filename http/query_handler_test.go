@@ -96,6 +96,7 @@ func TestFluxQueryService_Query(t *testing.T) {
 		token   string
 		ctx     context.Context
 		r       *query.Request
+		dialect string
 		csv     string
 		status  int
 		want    string
@@ -133,20 +134,40 @@ func TestFluxQueryService_Query(t *testing.T) {
 `,
 			want: toCRLF(`,,,2018-08-29T13:08:47Z,10.2,10,yay,true,cpu-total,a,cpui
 
+`),
+		},
+		{
+			name:  "returns json",
+			token: "mytoken",
+			ctx:   context.Background(),
+			r: &query.Request{
+				OrganizationID: orgID,
+				Compiler: lang.FluxCompiler{
+					Query: "from()",
+				},
+			},
+			dialect: "json",
+			status:  http.StatusOK,
+			csv: `{"results":[{"name":"","tables":[{"columns":[{"label":"_time","type":"time","group":false},{"label":"usage_user","type":"float","group":false},{"label":"cpu","type":"string","group":true}],` +
+				`"group_key":{"cpu":"cpu-total"},"records":[{"_time":"2018-08-29T13:08:47Z","usage_user":10.2,"cpu":"cpu-total"}]}]}]}`,
+			want: toCRLF(`,,,2018-08-29T13:08:47Z,10.2,cpu-total
+
 `),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var orgIDStr string
+			var orgIDStr, accept string
 			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				orgIDStr = r.URL.Query().Get(OrgID)
+				accept = r.Header.Get("Accept")
 				w.WriteHeader(tt.status)
 				fmt.Fprintln(w, tt.csv)
 			}))
 			s := &FluxQueryService{
-				Addr:  ts.URL,
-				Token: tt.token,
+				Addr:    ts.URL,
+				Token:   tt.token,
+				Dialect: tt.dialect,
 			}
 			res, err := s.Query(tt.ctx, tt.r)
 			if (err != nil) != tt.wantErr {
@@ -181,6 +202,9 @@ func TestFluxQueryService_Query(t *testing.T) {
 			if got, want := orgIDStr, tt.r.OrganizationID.String(); got != want {
 				t.Errorf("FluxQueryService.Query() encoded orgID = %s, want %s", got, want)
 			}
+			if got, want := accept, dialectMediaType(tt.dialect); got != want {
+				t.Errorf("FluxQueryService.Query() accept = %s, want %s", got, want)
+			}
 
 			got := b.String()
 			if !reflect.DeepEqual(got, tt.want) {
@@ -18,7 +18,11 @@ import (
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/mock"
 	"github.com/influxdata/platform/query"
+	parrow "github.com/influxdata/platform/query/arrow"
 	_ "github.com/influxdata/platform/query/builtin"
+	pinfluxql "github.com/influxdata/platform/query/influxql"
+	pjson "github.com/influxdata/platform/query/json"
+	"github.com/influxdata/platform/query/promql"
 )
 
 func TestQueryRequest_WithDefaults(t *testing.T) {
@@ -161,6 +165,65 @@ func TestQueryRequest_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "influxql requires query",
+			fields: fields{
+				Spec: &flux.Spec{},
+				Type: "influxql",
+				Dialect: QueryDialect{
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid influxql query",
+			fields: fields{
+				Query: "SELECT * FROM cpu",
+				Type:  "influxql",
+				Dialect: QueryDialect{
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+			},
+		},
+		{
+			name: "valid promql query",
+			fields: fields{
+				Query: "node_cpu",
+				Type:  "promql",
+				Dialect: QueryDialect{
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+			},
+		},
+		{
+			name: "valid json dialect",
+			fields: fields{
+				Query: "from()",
+				Type:  "flux",
+				Dialect: QueryDialect{
+					Type:           "json",
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+			},
+		},
+		{
+			name: "unknown dialect type",
+			fields: fields{
+				Query: "from()",
+				Type:  "flux",
+				Dialect: QueryDialect{
+					Type:           "xml",
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,6 +300,8 @@ func TestQueryRequest_proxyRequest(t *testing.T) {
 		Query   string
 		Type    string
 		Dialect QueryDialect
+		DB      string
+		Bucket  string
 		org     *platform.Organization
 	}
 	tests := []struct {
@@ -335,6 +400,102 @@ func TestQueryRequest_proxyRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid influxql query",
+			fields: fields{
+				Query: "SELECT * FROM cpu",
+				Type:  "influxql",
+				Dialect: QueryDialect{
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+				DB:  "telegraf",
+				org: &platform.Organization{},
+			},
+			want: &query.ProxyRequest{
+				Request: query.Request{
+					Compiler: &pinfluxql.Compiler{
+						DB:    "telegraf",
+						Query: "SELECT * FROM cpu",
+					},
+				},
+				Dialect: &csv.Dialect{
+					ResultEncoderConfig: csv.ResultEncoderConfig{
+						NoHeader:  false,
+						Delimiter: ',',
+					},
+				},
+			},
+		},
+		{
+			name: "valid promql query",
+			fields: fields{
+				Query: "node_cpu",
+				Type:  "promql",
+				Dialect: QueryDialect{
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+				Bucket: "prom",
+				org:    &platform.Organization{},
+			},
+			want: &query.ProxyRequest{
+				Request: query.Request{
+					Compiler: &promql.Compiler{
+						Bucket: "prom",
+						Query:  "node_cpu",
+					},
+				},
+				Dialect: &csv.Dialect{
+					ResultEncoderConfig: csv.ResultEncoderConfig{
+						NoHeader:  false,
+						Delimiter: ',',
+					},
+				},
+			},
+		},
+		{
+			name: "json dialect",
+			fields: fields{
+				Query: "howdy",
+				Type:  "flux",
+				Dialect: QueryDialect{
+					Type:           "json",
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+				org: &platform.Organization{},
+			},
+			want: &query.ProxyRequest{
+				Request: query.Request{
+					Compiler: lang.FluxCompiler{
+						Query: "howdy",
+					},
+				},
+				Dialect: &pjson.Dialect{},
+			},
+		},
+		{
+			name: "arrow dialect",
+			fields: fields{
+				Query: "howdy",
+				Type:  "flux",
+				Dialect: QueryDialect{
+					Type:           "arrow",
+					Delimiter:      ",",
+					DateTimeFormat: "RFC3339",
+				},
+				org: &platform.Organization{},
+			},
+			want: &query.ProxyRequest{
+				Request: query.Request{
+					Compiler: lang.FluxCompiler{
+						Query: "howdy",
+					},
+				},
+				Dialect: &parrow.Dialect{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -344,6 +505,8 @@ func TestQueryRequest_proxyRequest(t *testing.T) {
 				Query:   tt.fields.Query,
 				Type:    tt.fields.Type,
 				Dialect: tt.fields.Dialect,
+				DB:      tt.fields.DB,
+				Bucket:  tt.fields.Bucket,
 				Org:     tt.fields.org,
 			}
 			got, err := r.proxyRequest(tt.now)
@@ -412,7 +575,7 @@ func Test_decodeQueryRequest(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := decodeQueryRequest(tt.args.ctx, tt.args.r, tt.args.svc)
+			got, err := decodeQueryRequest(tt.args.ctx, tt.args.r, tt.args.svc, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("decodeQueryRequest() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -491,6 +654,58 @@ func Test_decodeProxyQueryRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "json dialect from accept header",
+			args: args{
+				r: func() *http.Request {
+					r := httptest.NewRequest("POST", "/", bytes.NewBufferString(`{"query": "from()"}`))
+					r.Header.Set("Accept", "application/json")
+					return r
+				}(),
+				svc: &mock.OrganizationService{
+					FindOrganizationF: func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+						return &platform.Organization{
+							ID: func() platform.ID { s, _ := platform.IDFromString("deadbeefdeadbeef"); return *s }(),
+						}, nil
+					},
+				},
+			},
+			want: &query.ProxyRequest{
+				Request: query.Request{
+					OrganizationID: func() platform.ID { s, _ := platform.IDFromString("deadbeefdeadbeef"); return *s }(),
+					Compiler: lang.FluxCompiler{
+						Query: "from()",
+					},
+				},
+				Dialect: &pjson.Dialect{},
+			},
+		},
+		{
+			name: "dialect type takes precedence over accept header",
+			args: args{
+				r: func() *http.Request {
+					r := httptest.NewRequest("POST", "/", bytes.NewBufferString(`{"query": "from()", "dialect": {"type": "arrow"}}`))
+					r.Header.Set("Accept", "application/json")
+					return r
+				}(),
+				svc: &mock.OrganizationService{
+					FindOrganizationF: func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+						return &platform.Organization{
+							ID: func() platform.ID { s, _ := platform.IDFromString("deadbeefdeadbeef"); return *s }(),
+						}, nil
+					},
+				},
+			},
+			want: &query.ProxyRequest{
+				Request: query.Request{
+					OrganizationID: func() platform.ID { s, _ := platform.IDFromString("deadbeefdeadbeef"); return *s }(),
+					Compiler: lang.FluxCompiler{
+						Query: "from()",
+					},
+				},
+				Dialect: &parrow.Dialect{},
+			},
+		},
 	}
 	var cmpOptions = cmp.Options{
 		cmpopts.IgnoreUnexported(query.ProxyRequest{}),
@@ -499,7 +714,7 @@ func Test_decodeProxyQueryRequest(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := decodeProxyQueryRequest(tt.args.ctx, tt.args.r, tt.args.auth, tt.args.svc)
+			got, err := decodeProxyQueryRequest(tt.args.ctx, tt.args.r, tt.args.auth, tt.args.svc, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("decodeProxyQueryRequest() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -510,3 +725,65 @@ func Test_decodeProxyQueryRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryRequest_Analyze(t *testing.T) {
+	tests := []struct {
+		name string
+		req  QueryRequest
+		want *QueryAnalysis
+	}{
+		{
+			name: "valid influxql query",
+			req: QueryRequest{
+				Type:  "influxql",
+				Query: "SELECT * FROM cpu",
+			},
+			want: &QueryAnalysis{Errors: []queryParseError{}},
+		},
+		{
+			name: "invalid influxql query",
+			req: QueryRequest{
+				Type:  "influxql",
+				Query: "SELECT * FORM cpu",
+			},
+			want: &QueryAnalysis{Errors: []queryParseError{{
+				Line:      1,
+				Column:    10,
+				Character: 10,
+				Message:   "found FORM, expected FROM",
+			}}},
+		},
+		{
+			name: "valid promql query",
+			req: QueryRequest{
+				Type:  "promql",
+				Query: `node_cpu{mode="idle"}`,
+			},
+			want: &QueryAnalysis{Errors: []queryParseError{}},
+		},
+		{
+			name: "invalid promql query",
+			req: QueryRequest{
+				Type:  "promql",
+				Query: "node_cpu{",
+			},
+			want: &QueryAnalysis{Errors: []queryParseError{{
+				Line:      1,
+				Column:    10,
+				Character: 9,
+				Message:   "no match found, expected: [\\pL_]",
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.Analyze()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("QueryRequest.Analyze() -want/+got:\n%s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
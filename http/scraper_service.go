@@ -4,24 +4,30 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"path"
 
 	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
 	kerrors "github.com/influxdata/platform/kit/errors"
 	"github.com/julienschmidt/httprouter"
 	"go.uber.org/zap"
 )
 
 // ScraperHandler represents an HTTP API handler for scraper targets.
+// Access to a target is governed by the permissions on the bucket it writes to.
 type ScraperHandler struct {
 	*httprouter.Router
-	Logger                *zap.Logger
-	ScraperStorageService platform.ScraperTargetStoreService
+	Logger                     *zap.Logger
+	ScraperStorageService      platform.ScraperTargetStoreService
+	ScraperTargetStatusService platform.ScraperTargetStatusService
+	OrganizationService        platform.OrganizationService
+	BucketService              platform.BucketService
 }
 
 const (
-	targetPath = "/api/v2/scrapertargets"
+	targetPath = "/api/v2/scrapers"
 )
 
 // NewScraperHandler returns a new instance of ScraperHandler.
@@ -38,7 +44,7 @@ func NewScraperHandler() *ScraperHandler {
 	return h
 }
 
-// handlePostScraperTarget is HTTP handler for the POST /api/v2/scrapertargets route.
+// handlePostScraperTarget is HTTP handler for the POST /api/v2/scrapers route.
 func (h *ScraperHandler) handlePostScraperTarget(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -48,6 +54,11 @@ func (h *ScraperHandler) handlePostScraperTarget(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if err := h.authorizeNewTarget(ctx, req); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
 	if err := h.ScraperStorageService.AddTarget(ctx, req); err != nil {
 		EncodeError(ctx, err, w)
 		return
@@ -58,7 +69,7 @@ func (h *ScraperHandler) handlePostScraperTarget(w http.ResponseWriter, r *http.
 	}
 }
 
-// handleDeleteScraperTarget is the HTTP handler for the DELETE /api/v2/scrapertargets/:id route.
+// handleDeleteScraperTarget is the HTTP handler for the DELETE /api/v2/scrapers/:id route.
 func (h *ScraperHandler) handleDeleteScraperTarget(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -68,6 +79,17 @@ func (h *ScraperHandler) handleDeleteScraperTarget(w http.ResponseWriter, r *htt
 		return
 	}
 
+	target, err := h.ScraperStorageService.GetTargetByID(ctx, *id)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.authorizeTarget(ctx, target, platform.WriteAction); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
 	if err := h.ScraperStorageService.RemoveTarget(ctx, *id); err != nil {
 		EncodeError(ctx, err, w)
 		return
@@ -76,7 +98,7 @@ func (h *ScraperHandler) handleDeleteScraperTarget(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// handlePatchScraperTarget is the HTTP handler for the PATCH /api/v2/scrapertargets/:id route.
+// handlePatchScraperTarget is the HTTP handler for the PATCH /api/v2/scrapers/:id route.
 func (h *ScraperHandler) handlePatchScraperTarget(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -86,6 +108,21 @@ func (h *ScraperHandler) handlePatchScraperTarget(w http.ResponseWriter, r *http
 		return
 	}
 
+	// The update replaces the target, which may move it to another bucket.
+	existing, err := h.ScraperStorageService.GetTargetByID(ctx, update.ID)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+	if err := h.authorizeTarget(ctx, existing, platform.WriteAction); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+	if err := h.authorizeNewTarget(ctx, update); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
 	target, err := h.ScraperStorageService.UpdateTarget(ctx, update)
 	if err != nil {
 		EncodeError(ctx, err, w)
@@ -98,6 +135,8 @@ func (h *ScraperHandler) handlePatchScraperTarget(w http.ResponseWriter, r *http
 	}
 }
 
+// handleGetScraperTarget is the HTTP handler for the GET /api/v2/scrapers/:id route.
+// The target includes the status of its last scrape, once it has been scraped.
 func (h *ScraperHandler) handleGetScraperTarget(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -112,13 +151,27 @@ func (h *ScraperHandler) handleGetScraperTarget(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if err := encodeResponse(ctx, w, http.StatusOK, newTargetResponse(*target)); err != nil {
+	if err := h.authorizeTarget(ctx, target, platform.ReadAction); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	status, err := h.ScraperTargetStatusService.FindTargetStatus(ctx, *id)
+	if err != nil && platform.ErrorCode(err) != platform.ENotFound {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	res := newTargetResponse(*target)
+	res.Status = status
+	if err := encodeResponse(ctx, w, http.StatusOK, res); err != nil {
 		logEncodingError(h.Logger, r, err)
 		return
 	}
 }
 
-// handleGetScraperTargets is the HTTP handler for the GET /api/v2/scrapertargets route.
+// handleGetScraperTargets is the HTTP handler for the GET /api/v2/scrapers route.
+// It lists the targets that write to the buckets the authorizer may read.
 func (h *ScraperHandler) handleGetScraperTargets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -128,7 +181,19 @@ func (h *ScraperHandler) handleGetScraperTargets(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if err := encodeResponse(ctx, w, http.StatusOK, newListTargetsResponse(targets)); err != nil {
+	allowed := make([]platform.ScraperTarget, 0, len(targets))
+	for i := range targets {
+		if err := h.authorizeTarget(ctx, &targets[i], platform.ReadAction); err != nil {
+			if platform.ErrorCode(err) == platform.EForbidden {
+				continue
+			}
+			EncodeError(ctx, err, w)
+			return
+		}
+		allowed = append(allowed, targets[i])
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newListTargetsResponse(allowed)); err != nil {
 		logEncodingError(h.Logger, r, err)
 		return
 	}
@@ -144,6 +209,9 @@ func decodeScraperTargetUpdateRequest(ctx context.Context, r *http.Request) (*pl
 		return nil, err
 	}
 	update.ID = *id
+	if err := update.ValidateSchedule(); err != nil {
+		return nil, err
+	}
 	return update, nil
 }
 
@@ -152,9 +220,79 @@ func decodeScraperTargetAddRequest(ctx context.Context, r *http.Request) (*platf
 	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
 		return nil, err
 	}
+	if err := req.ValidateSchedule(); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
+// authorizeTarget ensures the authorizer in ctx may perform action on the bucket of target.
+// When the organization or the bucket of the target no longer exists, a permission on
+// every bucket of the organization, or on every bucket, is required instead.
+func (h *ScraperHandler) authorizeTarget(ctx context.Context, target *platform.ScraperTarget, action platform.Action) error {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	var p *platform.Permission
+	o, b, err := h.findTargetBucket(ctx, target)
+	switch {
+	case err == nil:
+		p, err = platform.NewOrgPermissionAtID(o.ID, b.ID, action, platform.BucketsResource)
+	case platform.ErrorCode(err) != platform.ENotFound:
+		return err
+	case o != nil:
+		p, err = platform.NewOrgPermission(o.ID, action, platform.BucketsResource)
+	default:
+		p, err = platform.NewPermission(action, platform.BucketsResource)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !a.Allowed(*p) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  fmt.Sprintf("insufficient permissions to %s the bucket of scraper target %q", action, target.Name),
+		}
+	}
+	return nil
+}
+
+// authorizeNewTarget ensures the organization and the bucket of target exist,
+// and that the authorizer in ctx may write to the bucket.
+func (h *ScraperHandler) authorizeNewTarget(ctx context.Context, target *platform.ScraperTarget) error {
+	if _, _, err := h.findTargetBucket(ctx, target); err != nil {
+		if platform.ErrorCode(err) == platform.ENotFound {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  fmt.Sprintf("bucket %q of organization %q not found", target.BucketName, target.OrgName),
+				Err:  err,
+			}
+		}
+		return err
+	}
+	return h.authorizeTarget(ctx, target, platform.WriteAction)
+}
+
+// findTargetBucket returns the organization and the bucket of target. When only the bucket
+// is not found, the organization is returned with the error.
+func (h *ScraperHandler) findTargetBucket(ctx context.Context, target *platform.ScraperTarget) (*platform.Organization, *platform.Bucket, error) {
+	o, err := h.OrganizationService.FindOrganization(ctx, platform.OrganizationFilter{Name: &target.OrgName})
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+		OrganizationID: &o.ID,
+		Name:           &target.BucketName,
+	})
+	if err != nil {
+		return o, nil, err
+	}
+	return o, b, nil
+}
+
 func decodeScraperTargetIDRequest(ctx context.Context, r *http.Request) (*platform.ID, error) {
 	params := httprouter.ParamsFromContext(ctx)
 	id := params.ByName("id")
@@ -376,7 +514,8 @@ type targetLinks struct {
 
 type targetResponse struct {
 	platform.ScraperTarget
-	Links targetLinks `json:"links"`
+	Status *platform.ScraperTargetStatus `json:"status,omitempty"`
+	Links  targetLinks                   `json:"links"`
 }
 
 func newListTargetsResponse(targets []platform.ScraperTarget) getTargetsResponse {
@@ -9,8 +9,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
 	"github.com/influxdata/platform/inmem"
 	"github.com/influxdata/platform/mock"
 	platformtesting "github.com/influxdata/platform/testing"
@@ -27,6 +29,30 @@ var (
 	targetTwoID = platformtesting.MustIDBase16(targetTwoIDString)
 )
 
+// newScraperHandler returns a handler whose organizations and buckets always exist.
+func newScraperHandler() *ScraperHandler {
+	h := NewScraperHandler()
+	h.OrganizationService = &mock.OrganizationService{
+		FindOrganizationF: func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+			return &platform.Organization{ID: platformtesting.MustIDBase16("000000000000aaaa"), Name: *filter.Name}, nil
+		},
+	}
+	bs := mock.NewBucketService()
+	bs.FindBucketFn = func(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		return &platform.Bucket{ID: platformtesting.MustIDBase16("000000000000bbbb"), OrganizationID: *filter.OrganizationID, Name: *filter.Name}, nil
+	}
+	h.BucketService = bs
+	return h
+}
+
+// withOperator returns ctx with an authorizer that has every permission.
+func withOperator(ctx context.Context) context.Context {
+	return pcontext.SetAuthorizer(ctx, &platform.Authorization{
+		Status:      platform.Active,
+		Permissions: platform.OperPermissions(),
+	})
+}
+
 func TestService_handleGetScraperTargets(t *testing.T) {
 	type fields struct {
 		Service platform.ScraperTargetStoreService
@@ -82,7 +108,7 @@ func TestService_handleGetScraperTargets(t *testing.T) {
 					`
 					{
 					  "links": {
-					    "self": "/api/v2/scrapertargets"
+					    "self": "/api/v2/scrapers"
 					  },
 					  "scraper_targets": [
 					    {
@@ -93,7 +119,7 @@ func TestService_handleGetScraperTargets(t *testing.T) {
 						  "type": "prometheus",
 						  "url": "www.one.url",
 						  "links": {
-						    "self": "/api/v2/scrapertargets/0000000000000111"
+						    "self": "/api/v2/scrapers/0000000000000111"
 						  }
 						},
 						{
@@ -104,7 +130,7 @@ func TestService_handleGetScraperTargets(t *testing.T) {
 						  "type": "prometheus",
 						  "url": "www.two.url",
 						  "links": {
-						    "self": "/api/v2/scrapertargets/0000000000000222"
+						    "self": "/api/v2/scrapers/0000000000000222"
 						  }
                         }
 					  ]
@@ -131,7 +157,7 @@ func TestService_handleGetScraperTargets(t *testing.T) {
 				body: `
                 {
                   "links": {
-                    "self": "/api/v2/scrapertargets"
+                    "self": "/api/v2/scrapers"
                   },
                   "scraper_targets": []
                 }
@@ -142,10 +168,11 @@ func TestService_handleGetScraperTargets(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewScraperHandler()
+			h := newScraperHandler()
 			h.ScraperStorageService = tt.fields.Service
 
 			r := httptest.NewRequest("GET", "http://any.tld", nil)
+			r = r.WithContext(withOperator(r.Context()))
 
 			qp := r.URL.Query()
 			for k, vs := range tt.args.queryParams {
@@ -178,7 +205,8 @@ func TestService_handleGetScraperTargets(t *testing.T) {
 
 func TestService_handleGetScraperTarget(t *testing.T) {
 	type fields struct {
-		Service platform.ScraperTargetStoreService
+		Service       platform.ScraperTargetStoreService
+		StatusService platform.ScraperTargetStatusService
 	}
 
 	type args struct {
@@ -215,6 +243,66 @@ func TestService_handleGetScraperTarget(t *testing.T) {
 						return nil, fmt.Errorf("not found")
 					},
 				},
+				StatusService: &mock.ScraperTargetStatusService{
+					FindTargetStatusF: func(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+						return nil, &platform.Error{
+							Code: platform.ENotFound,
+							Msg:  "scraper target status is not found",
+						}
+					},
+				},
+			},
+			args: args{
+				id: targetOneIDString,
+			},
+			wants: wants{
+				statusCode:  http.StatusOK,
+				contentType: "application/json; charset=utf-8",
+				body: fmt.Sprintf(
+					`
+                    {
+                      "id": "%[1]s",
+                      "name": "target-1",
+                      "type": "prometheus",
+                      "url": "www.some.url",
+                      "bucket": "bkt-name",
+                      "org": "org-name",
+                      "links": {
+                        "self": "/api/v2/scrapers/%[1]s"
+                      }
+                    }
+                    `,
+					targetOneIDString,
+				),
+			},
+		},
+		{
+			name: "get a scraper target with the status of its last scrape",
+			fields: fields{
+				Service: &mock.ScraperTargetStoreService{
+					GetTargetByIDF: func(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+						return &platform.ScraperTarget{
+							ID:         targetOneID,
+							Name:       "target-1",
+							Type:       platform.PrometheusScraperType,
+							URL:        "www.some.url",
+							OrgName:    "org-name",
+							BucketName: "bkt-name",
+							Interval:   10 * time.Second,
+							Timeout:    5 * time.Second,
+						}, nil
+					},
+				},
+				StatusService: &mock.ScraperTargetStatusService{
+					FindTargetStatusF: func(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+						return &platform.ScraperTargetStatus{
+							TargetID:   id,
+							LastScrape: time.Date(2018, 12, 1, 10, 0, 0, 0, time.UTC),
+							Duration:   5 * time.Second,
+							LastError:  "context deadline exceeded",
+						}, nil
+					},
+				},
 			},
 			args: args{
 				id: targetOneIDString,
@@ -231,8 +319,17 @@ func TestService_handleGetScraperTarget(t *testing.T) {
                       "url": "www.some.url",
                       "bucket": "bkt-name",
                       "org": "org-name",
+                      "interval": 10000000000,
+                      "timeout": 5000000000,
+                      "status": {
+                        "targetID": "%[1]s",
+                        "lastScrape": "2018-12-01T10:00:00Z",
+                        "duration": 5000000000,
+                        "samples": 0,
+                        "lastError": "context deadline exceeded"
+                      },
                       "links": {
-                        "self": "/api/v2/scrapertargets/%[1]s"
+                        "self": "/api/v2/scrapers/%[1]s"
                       }
                     }
                     `,
@@ -244,13 +341,14 @@ func TestService_handleGetScraperTarget(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewScraperHandler()
+			h := newScraperHandler()
 			h.ScraperStorageService = tt.fields.Service
+			h.ScraperTargetStatusService = tt.fields.StatusService
 
 			r := httptest.NewRequest("GET", "http://any.tld", nil)
 
 			r = r.WithContext(context.WithValue(
-				context.Background(),
+				withOperator(context.Background()),
 				httprouter.ParamsKey,
 				httprouter.Params{
 					{
@@ -305,6 +403,14 @@ func TestService_handleDeleteScraperTarget(t *testing.T) {
 			name: "delete a scraper target by id",
 			fields: fields{
 				Service: &mock.ScraperTargetStoreService{
+					GetTargetByIDF: func(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+						return &platform.ScraperTarget{
+							ID:         id,
+							Name:       "target-1",
+							OrgName:    "org-name",
+							BucketName: "bkt-name",
+						}, nil
+					},
 					RemoveTargetF: func(ctx context.Context, id platform.ID) error {
 						if id == targetOneID {
 							return nil
@@ -325,8 +431,8 @@ func TestService_handleDeleteScraperTarget(t *testing.T) {
 			name: "scraper target not found",
 			fields: fields{
 				Service: &mock.ScraperTargetStoreService{
-					RemoveTargetF: func(ctx context.Context, id platform.ID) error {
-						return &platform.Error{
+					GetTargetByIDF: func(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+						return nil, &platform.Error{
 							Code: platform.ENotFound,
 							Msg:  platform.ErrScraperTargetNotFound,
 						}
@@ -344,13 +450,13 @@ func TestService_handleDeleteScraperTarget(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewScraperHandler()
+			h := newScraperHandler()
 			h.ScraperStorageService = tt.fields.Service
 
 			r := httptest.NewRequest("GET", "http://any.tld", nil)
 
 			r = r.WithContext(context.WithValue(
-				context.Background(),
+				withOperator(context.Background()),
 				httprouter.ParamsKey,
 				httprouter.Params{
 					{
@@ -433,7 +539,7 @@ func TestService_handlePostScraperTarget(t *testing.T) {
                       "org": "org-name",
                       "bucket": "bkt-name",
                       "links": {
-                        "self": "/api/v2/scrapertargets/%[1]s"
+                        "self": "/api/v2/scrapers/%[1]s"
                       }
                     }
                     `,
@@ -445,7 +551,7 @@ func TestService_handlePostScraperTarget(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewScraperHandler()
+			h := newScraperHandler()
 			h.ScraperStorageService = tt.fields.Service
 
 			st, err := json.Marshal(tt.args.target)
@@ -454,6 +560,7 @@ func TestService_handlePostScraperTarget(t *testing.T) {
 			}
 
 			r := httptest.NewRequest("GET", "http://any.tld", bytes.NewReader(st))
+			r = r.WithContext(withOperator(r.Context()))
 			w := httptest.NewRecorder()
 
 			h.handlePostScraperTarget(w, r)
@@ -501,6 +608,14 @@ func TestService_handlePatchScraperTarget(t *testing.T) {
 			name: "update a scraper target",
 			fields: fields{
 				Service: &mock.ScraperTargetStoreService{
+					GetTargetByIDF: func(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+						return &platform.ScraperTarget{
+							ID:         id,
+							Name:       "target-1",
+							OrgName:    "orgg",
+							BucketName: "buck",
+						}, nil
+					},
 					UpdateTargetF: func(ctx context.Context, t *platform.ScraperTarget) (*platform.ScraperTarget, error) {
 						if t.ID == targetOneID {
 							return t, nil
@@ -534,7 +649,7 @@ func TestService_handlePatchScraperTarget(t *testing.T) {
 		              "org":"orgg",
 		              "bucket":"buck",
 		              "links":{
-		                "self":"/api/v2/scrapertargets/%[1]s"
+		                "self":"/api/v2/scrapers/%[1]s"
 		              }
 		            }
 		            `,
@@ -546,7 +661,7 @@ func TestService_handlePatchScraperTarget(t *testing.T) {
 			name: "scraper target not found",
 			fields: fields{
 				Service: &mock.ScraperTargetStoreService{
-					UpdateTargetF: func(ctx context.Context, upd *platform.ScraperTarget) (*platform.ScraperTarget, error) {
+					GetTargetByIDF: func(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
 						return nil, &platform.Error{
 							Code: platform.ENotFound,
 							Msg:  platform.ErrScraperTargetNotFound,
@@ -573,7 +688,7 @@ func TestService_handlePatchScraperTarget(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewScraperHandler()
+			h := newScraperHandler()
 			h.ScraperStorageService = tt.fields.Service
 
 			var err error
@@ -588,7 +703,7 @@ func TestService_handlePatchScraperTarget(t *testing.T) {
 			r := httptest.NewRequest("GET", "http://any.tld", bytes.NewReader(st))
 
 			r = r.WithContext(context.WithValue(
-				context.Background(),
+				withOperator(context.Background()),
 				httprouter.ParamsKey,
 				httprouter.Params{
 					{
@@ -630,9 +745,12 @@ func initScraperService(f platformtesting.TargetFields, t *testing.T) (platform.
 		}
 	}
 
-	handler := NewScraperHandler()
+	handler := newScraperHandler()
 	handler.ScraperStorageService = svc
-	server := httptest.NewServer(handler)
+	handler.ScraperTargetStatusService = svc
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(withOperator(r.Context())))
+	}))
 	client := ScraperService{
 		Addr:     server.URL,
 		OpPrefix: inmem.OpPrefix,
@@ -645,3 +763,228 @@ func initScraperService(f platformtesting.TargetFields, t *testing.T) (platform.
 func TestScraperService(t *testing.T) {
 	platformtesting.ScraperService(initScraperService, t)
 }
+
+func TestScraperHandler_Authorization(t *testing.T) {
+	ctx := context.Background()
+	svc := inmem.NewService()
+	org := &platform.Organization{Name: "org1"}
+	if err := svc.CreateOrganization(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+	bucket1 := &platform.Bucket{OrganizationID: org.ID, Name: "bucket1"}
+	if err := svc.CreateBucket(ctx, bucket1); err != nil {
+		t.Fatal(err)
+	}
+	bucket2 := &platform.Bucket{OrganizationID: org.ID, Name: "bucket2"}
+	if err := svc.CreateBucket(ctx, bucket2); err != nil {
+		t.Fatal(err)
+	}
+	target1 := &platform.ScraperTarget{Name: "target1", Type: platform.PrometheusScraperType, URL: "http://localhost:9090/metrics", OrgName: "org1", BucketName: "bucket1"}
+	if err := svc.AddTarget(ctx, target1); err != nil {
+		t.Fatal(err)
+	}
+	target2 := &platform.ScraperTarget{Name: "target2", Type: platform.PrometheusScraperType, URL: "http://localhost:9090/metrics", OrgName: "org1", BucketName: "bucket2"}
+	if err := svc.AddTarget(ctx, target2); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewScraperHandler()
+	h.ScraperStorageService = svc
+	h.ScraperTargetStatusService = svc
+	h.OrganizationService = svc
+	h.BucketService = svc
+
+	readBucket1, err := platform.NewOrgPermissionAtID(org.ID, bucket1.ID, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeBucket1, err := platform.NewOrgPermissionAtID(org.ID, bucket1.ID, platform.WriteAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serve := func(method, url, body string, ps ...platform.Permission) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(method, "http://any.url"+url, bytes.NewBufferString(body))
+		r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+			Status:      platform.Active,
+			OrgID:       org.ID,
+			Permissions: ps,
+		}))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve("GET", targetPath, "", *readBucket1)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d listing targets: %s", w.Code, w.Body.String())
+	}
+	var resp getTargetsResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Targets) != 1 || resp.Targets[0].ID != target1.ID {
+		t.Fatalf("got targets %v, want only %s", resp.Targets, target1.ID)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		ps     []platform.Permission
+		want   int
+	}{
+		{
+			name:   "read a target",
+			method: "GET",
+			url:    targetPath + "/" + target1.ID.String(),
+			ps:     []platform.Permission{*readBucket1},
+			want:   http.StatusOK,
+		},
+		{
+			name:   "read a target of another bucket",
+			method: "GET",
+			url:    targetPath + "/" + target2.ID.String(),
+			ps:     []platform.Permission{*readBucket1},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "create a target without write permission",
+			method: "POST",
+			url:    targetPath,
+			body:   `{"name":"target3","type":"prometheus","url":"http://localhost:9090/metrics","org":"org1","bucket":"bucket1"}`,
+			ps:     []platform.Permission{*readBucket1},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "create a target for another bucket",
+			method: "POST",
+			url:    targetPath,
+			body:   `{"name":"target3","type":"prometheus","url":"http://localhost:9090/metrics","org":"org1","bucket":"bucket2"}`,
+			ps:     []platform.Permission{*writeBucket1},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "create a target for a missing bucket",
+			method: "POST",
+			url:    targetPath,
+			body:   `{"name":"target3","type":"prometheus","url":"http://localhost:9090/metrics","org":"org1","bucket":"bucket3"}`,
+			ps:     platform.OperPermissions(),
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "move a target to another bucket",
+			method: "PATCH",
+			url:    targetPath + "/" + target1.ID.String(),
+			body:   `{"name":"target1","type":"prometheus","url":"http://localhost:9090/metrics","org":"org1","bucket":"bucket2"}`,
+			ps:     []platform.Permission{*writeBucket1},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "update a target of another bucket",
+			method: "PATCH",
+			url:    targetPath + "/" + target2.ID.String(),
+			body:   `{"name":"target2","type":"prometheus","url":"http://localhost:9090/metrics","org":"org1","bucket":"bucket1"}`,
+			ps:     []platform.Permission{*writeBucket1},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "delete a target of another bucket",
+			method: "DELETE",
+			url:    targetPath + "/" + target2.ID.String(),
+			ps:     []platform.Permission{*writeBucket1},
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "update a target",
+			method: "PATCH",
+			url:    targetPath + "/" + target1.ID.String(),
+			body:   `{"name":"target1","type":"prometheus","url":"http://localhost:9090/metrics","org":"org1","bucket":"bucket1","interval":10000000000}`,
+			ps:     []platform.Permission{*writeBucket1},
+			want:   http.StatusOK,
+		},
+		{
+			name:   "delete a target",
+			method: "DELETE",
+			url:    targetPath + "/" + target1.ID.String(),
+			ps:     []platform.Permission{*writeBucket1},
+			want:   http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := serve(tt.method, tt.url, tt.body, tt.ps...); w.Code != tt.want {
+				t.Errorf("got status %d, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestScraperHandler_ValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		timeout  time.Duration
+		want     int
+	}{
+		{name: "default interval and timeout", want: http.StatusCreated},
+		{name: "interval and timeout", interval: 10 * time.Second, timeout: 10 * time.Second, want: http.StatusCreated},
+		{name: "timeout with the default interval", timeout: 5 * time.Second, want: http.StatusCreated},
+		{name: "negative interval", interval: -time.Second, want: http.StatusBadRequest},
+		{name: "negative timeout", interval: 10 * time.Second, timeout: -time.Second, want: http.StatusBadRequest},
+		{name: "sub-second interval", interval: 500 * time.Millisecond, want: http.StatusBadRequest},
+		{name: "timeout longer than interval", interval: 10 * time.Second, timeout: 11 * time.Second, want: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newScraperHandler()
+			h.ScraperStorageService = &mock.ScraperTargetStoreService{
+				AddTargetF: func(ctx context.Context, st *platform.ScraperTarget) error {
+					st.ID = targetOneID
+					return nil
+				},
+				GetTargetByIDF: func(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+					return &platform.ScraperTarget{ID: id, Name: "target", OrgName: "org", BucketName: "bucket"}, nil
+				},
+				UpdateTargetF: func(ctx context.Context, st *platform.ScraperTarget) (*platform.ScraperTarget, error) {
+					return st, nil
+				},
+			}
+
+			body, err := json.Marshal(platform.ScraperTarget{
+				Name:       "target",
+				Type:       platform.PrometheusScraperType,
+				URL:        "http://localhost:9090/metrics",
+				OrgName:    "org",
+				BucketName: "bucket",
+				Interval:   tt.interval,
+				Timeout:    tt.timeout,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest("POST", "http://any.url"+targetPath, bytes.NewReader(body))
+			r = r.WithContext(withOperator(r.Context()))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tt.want {
+				t.Errorf("got status %d creating a target, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+
+			want := tt.want
+			if want == http.StatusCreated {
+				want = http.StatusOK
+			}
+			r = httptest.NewRequest("PATCH", "http://any.url"+targetPath+"/"+targetOneIDString, bytes.NewReader(body))
+			r = r.WithContext(withOperator(r.Context()))
+			w = httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != want {
+				t.Errorf("got status %d updating a target, want %d: %s", w.Code, want, w.Body.String())
+			}
+		})
+	}
+}
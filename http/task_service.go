@@ -37,6 +37,7 @@ type TaskHandler struct {
 const (
 	tasksPath              = "/api/v2/tasks"
 	tasksIDPath            = "/api/v2/tasks/:id"
+	tasksIDBackfillPath    = "/api/v2/tasks/:id/backfill"
 	tasksIDLogsPath        = "/api/v2/tasks/:id/logs"
 	tasksIDMembersPath     = "/api/v2/tasks/:id/members"
 	tasksIDMembersIDPath   = "/api/v2/tasks/:id/members/:userID"
@@ -85,6 +86,8 @@ func NewTaskHandler(mappingService platform.UserResourceMappingService, labelSer
 	h.HandlerFunc("POST", tasksIDRunsIDRetryPath, h.handleRetryRun)
 	h.HandlerFunc("DELETE", tasksIDRunsIDPath, h.handleCancelRun)
 
+	h.HandlerFunc("POST", tasksIDBackfillPath, h.handleBackfillRuns)
+
 	h.HandlerFunc("GET", tasksIDLabelsPath, newGetLabelsHandler(h.LabelService))
 	h.HandlerFunc("POST", tasksIDLabelsPath, newPostLabelHandler(h.LabelService))
 	h.HandlerFunc("DELETE", tasksIDLabelsNamePath, newDeleteLabelHandler(h.LabelService))
@@ -485,12 +488,23 @@ func (h *TaskHandler) handleGetLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := encodeResponse(ctx, w, http.StatusOK, logs); err != nil {
+	if err := encodeResponse(ctx, w, http.StatusOK, newGetLogsResponse(logs)); err != nil {
 		logEncodingError(h.logger, r, err)
 		return
 	}
 }
 
+type getLogsResponse struct {
+	Events []*platform.Log `json:"events"`
+}
+
+func newGetLogsResponse(logs []*platform.Log) getLogsResponse {
+	if logs == nil {
+		logs = []*platform.Log{}
+	}
+	return getLogsResponse{Events: logs}
+}
+
 type getLogsRequest struct {
 	filter platform.LogFilter
 }
@@ -700,6 +714,70 @@ func decodeForceRunRequest(ctx context.Context, r *http.Request) (forceRunReques
 	}, nil
 }
 
+func (h *TaskHandler) handleBackfillRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeBackfillRunsRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.TaskService.BackfillRuns(ctx, req.TaskID, req.Start, req.Stop); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type backfillRunsRequest struct {
+	TaskID      platform.ID
+	Start, Stop int64
+}
+
+func decodeBackfillRunsRequest(ctx context.Context, r *http.Request) (backfillRunsRequest, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	tid := params.ByName("id")
+	if tid == "" {
+		return backfillRunsRequest{}, kerrors.InvalidDataf("you must provide a task ID")
+	}
+
+	var ti platform.ID
+	if err := ti.DecodeFromString(tid); err != nil {
+		return backfillRunsRequest{}, err
+	}
+
+	var req struct {
+		Start string `json:"start"`
+		Stop  string `json:"stop"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return backfillRunsRequest{}, err
+	}
+
+	if req.Start == "" || req.Stop == "" {
+		return backfillRunsRequest{}, kerrors.InvalidDataf("you must provide a start and stop time")
+	}
+	start, err := time.Parse(time.RFC3339, req.Start)
+	if err != nil {
+		return backfillRunsRequest{}, err
+	}
+	stop, err := time.Parse(time.RFC3339, req.Stop)
+	if err != nil {
+		return backfillRunsRequest{}, err
+	}
+	if stop.Before(start) {
+		return backfillRunsRequest{}, kerrors.InvalidDataf("stop must not be before start")
+	}
+
+	return backfillRunsRequest{
+		TaskID: ti,
+		Start:  start.Unix(),
+		Stop:   stop.Unix(),
+	}, nil
+}
+
 func (h *TaskHandler) handleGetRun(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -1092,12 +1170,12 @@ func (t TaskService) FindLogs(ctx context.Context, filter platform.LogFilter) ([
 		return nil, 0, err
 	}
 
-	var logs []*platform.Log
-	if err := json.NewDecoder(resp.Body).Decode(&logs); err != nil {
+	var lr getLogsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
 		return nil, 0, err
 	}
 
-	return logs, len(logs), nil
+	return lr.Events, len(lr.Events), nil
 }
 
 // FindRuns returns a list of runs that match a filter and the total count of returned runs.
@@ -1279,6 +1357,43 @@ func (t TaskService) ForceRun(ctx context.Context, taskID platform.ID, scheduled
 	return &rs.Run, nil
 }
 
+func (t TaskService) BackfillRuns(ctx context.Context, taskID platform.ID, start, stop int64) error {
+	u, err := newURL(t.Addr, taskIDBackfillPath(taskID))
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf(`{"start": %q, "stop": %q}`,
+		time.Unix(start, 0).UTC().Format(time.RFC3339),
+		time.Unix(stop, 0).UTC().Format(time.RFC3339))
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(t.Token, req)
+
+	hc := newClient(u.Scheme, t.InsecureSkipVerify)
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp); err != nil {
+		// RequestStillQueuedError is part of the contract.
+		if e := backend.ParseRequestStillQueuedError(err.Error()); e != nil {
+			return *e
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func cancelPath(taskID, runID platform.ID) string {
 	return path.Join(taskID.String(), runID.String())
 }
@@ -1315,6 +1430,10 @@ func taskIDPath(id platform.ID) string {
 	return path.Join(tasksPath, id.String())
 }
 
+func taskIDBackfillPath(id platform.ID) string {
+	return path.Join(tasksPath, id.String(), "backfill")
+}
+
 func taskIDRunsPath(id platform.ID) string {
 	return path.Join(tasksPath, id.String(), "runs")
 }
@@ -27,6 +27,22 @@ func GetToken(r *http.Request) (string, error) {
 	return header[len(tokenScheme):], nil
 }
 
+// GetV1Token parses the token from the credentials of an InfluxDB 1.X request.
+// Besides the Authorization header with the token scheme, the token may be the password of
+// basic authentication or the p parameter of the URL. The username is ignored.
+func GetV1Token(r *http.Request) (string, error) {
+	if t, err := GetToken(r); err == nil {
+		return t, nil
+	}
+	if _, p, ok := r.BasicAuth(); ok {
+		return p, nil
+	}
+	if p := r.URL.Query().Get("p"); p != "" {
+		return p, nil
+	}
+	return "", ErrAuthHeaderMissing
+}
+
 // SetToken adds the token to the request.
 func SetToken(token string, req *http.Request) {
 	req.Header.Set("Authorization", fmt.Sprintf("%s%s", tokenScheme, token))
@@ -2,11 +2,20 @@ package inmem
 
 import (
 	"context"
+	"time"
 
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorization"
 )
 
-func (s *Service) loadAuthorization(ctx context.Context, id platform.ID) (*platform.Authorization, *platform.Error) {
+// authorizationRecord is the stored form of an authorization.
+// The token itself is never stored, only a salted hash of it.
+type authorizationRecord struct {
+	platform.Authorization
+	tokenHash *authorization.HashedToken
+}
+
+func (s *Service) loadAuthorizationRecord(ctx context.Context, id platform.ID) (*authorizationRecord, *platform.Error) {
 	i, ok := s.authorizationKV.Load(id.String())
 	if !ok {
 		return nil, &platform.Error{
@@ -15,7 +24,7 @@ func (s *Service) loadAuthorization(ctx context.Context, id platform.ID) (*platf
 		}
 	}
 
-	a, ok := i.(platform.Authorization)
+	r, ok := i.(authorizationRecord)
 	if !ok {
 		return nil, &platform.Error{
 			Code: platform.EInternal,
@@ -23,19 +32,44 @@ func (s *Service) loadAuthorization(ctx context.Context, id platform.ID) (*platf
 		}
 	}
 
-	if a.Status == "" {
-		a.Status = platform.Active
+	if r.Status == "" {
+		r.Status = platform.Active
 	}
 
-	return &a, nil
+	return &r, nil
+}
+
+func (s *Service) loadAuthorization(ctx context.Context, id platform.ID) (*platform.Authorization, *platform.Error) {
+	r, pe := s.loadAuthorizationRecord(ctx, id)
+	if pe != nil {
+		return nil, pe
+	}
+	return &r.Authorization, nil
 }
 
 // PutAuthorization overwrites the authorization with the contents of a.
+// If a has no token, the token of the stored authorization is kept.
 func (s *Service) PutAuthorization(ctx context.Context, a *platform.Authorization) error {
 	if a.Status == "" {
 		a.Status = platform.Active
 	}
-	s.authorizationKV.Store(a.ID.String(), *a)
+
+	r := authorizationRecord{Authorization: *a}
+	r.Token = ""
+	if a.Token != "" {
+		h, err := authorization.HashToken(a.Token)
+		if err != nil {
+			return &platform.Error{
+				Code: platform.EInternal,
+				Err:  err,
+			}
+		}
+		r.tokenHash = h
+	} else if prev, pe := s.loadAuthorizationRecord(ctx, a.ID); pe == nil {
+		r.tokenHash = prev.tokenHash
+	}
+
+	s.authorizationKV.Store(a.ID.String(), r)
 	return nil
 }
 
@@ -51,6 +85,7 @@ func (s *Service) FindAuthorizationByID(ctx context.Context, id platform.ID) (*p
 }
 
 // FindAuthorizationByToken returns an authorization given a token.
+// Only the hash of the token is stored, so the token of the returned authorization is t.
 func (s *Service) FindAuthorizationByToken(ctx context.Context, t string) (*platform.Authorization, error) {
 	var err error
 	op := OpPrefix + platform.OpFindAuthorizationByToken
@@ -73,26 +108,26 @@ func (s *Service) FindAuthorizationByToken(ctx context.Context, t string) (*plat
 	return as[0], nil
 }
 
-func filterAuthorizationsFn(filter platform.AuthorizationFilter) func(a *platform.Authorization) bool {
+func filterAuthorizationsFn(filter platform.AuthorizationFilter) func(r *authorizationRecord) bool {
 	if filter.ID != nil {
-		return func(a *platform.Authorization) bool {
-			return a.ID == *filter.ID
+		return func(r *authorizationRecord) bool {
+			return r.ID == *filter.ID
 		}
 	}
 
 	if filter.Token != nil {
-		return func(a *platform.Authorization) bool {
-			return a.Token == *filter.Token
+		return func(r *authorizationRecord) bool {
+			return r.tokenHash.Matches(*filter.Token)
 		}
 	}
 
 	if filter.UserID != nil {
-		return func(a *platform.Authorization) bool {
-			return a.UserID == *filter.UserID
+		return func(r *authorizationRecord) bool {
+			return r.UserID == *filter.UserID
 		}
 	}
 
-	return func(a *platform.Authorization) bool { return true }
+	return func(r *authorizationRecord) bool { return true }
 }
 
 // FindAuthorizations returns all authorizations matching the filter.
@@ -124,7 +159,7 @@ func (s *Service) FindAuthorizations(ctx context.Context, filter platform.Author
 	var err error
 	filterF := filterAuthorizationsFn(filter)
 	s.authorizationKV.Range(func(k, v interface{}) bool {
-		a, ok := v.(platform.Authorization)
+		r, ok := v.(authorizationRecord)
 		if !ok {
 			err = &platform.Error{
 				Code: platform.EInternal,
@@ -134,7 +169,11 @@ func (s *Service) FindAuthorizations(ctx context.Context, filter platform.Author
 			return false
 		}
 
-		if filterF(&a) {
+		if filterF(&r) {
+			a := r.Authorization
+			if filter.Token != nil {
+				a.Token = *filter.Token
+			}
 			as = append(as, &a)
 		}
 
@@ -173,6 +212,7 @@ func (s *Service) CreateAuthorization(ctx context.Context, a *platform.Authoriza
 
 	a.ID = s.IDGenerator.ID()
 	a.Status = platform.Active
+	a.LastUsedAt = nil
 
 	return s.PutAuthorization(ctx, a)
 }
@@ -218,3 +258,44 @@ func (s *Service) SetAuthorizationStatus(ctx context.Context, id platform.ID, st
 	a.Status = status
 	return s.PutAuthorization(ctx, a)
 }
+
+// RotateAuthorization replaces the token of the authorization associated with id with a newly generated one.
+func (s *Service) RotateAuthorization(ctx context.Context, id platform.ID, expiresAt *time.Time) (*platform.Authorization, error) {
+	op := OpPrefix + platform.OpRotateAuthorization
+	a, err := s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return nil, &platform.Error{
+			Err: err,
+			Op:  op,
+		}
+	}
+
+	a.Token, err = s.TokenGenerator.Token()
+	if err != nil {
+		return nil, &platform.Error{
+			Err: err,
+			Op:  op,
+		}
+	}
+	a.ExpiresAt = expiresAt
+	a.LastUsedAt = nil
+
+	if err := s.PutAuthorization(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// SetAuthorizationLastUsed records the last time the token of the authorization associated with id was used.
+func (s *Service) SetAuthorizationLastUsed(ctx context.Context, id platform.ID, at time.Time) error {
+	a, err := s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return &platform.Error{
+			Err: err,
+			Op:  OpPrefix + platform.OpSetAuthorizationLastUsed,
+		}
+	}
+
+	a.LastUsedAt = &at
+	return s.PutAuthorization(ctx, a)
+}
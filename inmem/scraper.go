@@ -71,6 +71,7 @@ func (s *Service) RemoveTarget(ctx context.Context, id platform.ID) error {
 		}
 	}
 	s.scraperTargetKV.Delete(id.String())
+	s.scraperStatusKV.Delete(id.String())
 	return nil
 }
 
@@ -0,0 +1,50 @@
+package inmem
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/platform"
+)
+
+const (
+	errScraperTargetStatusNotFound = "scraper target status is not found"
+)
+
+var _ platform.ScraperTargetStatusService = (*Service)(nil)
+
+// FindTargetStatus retrieves the status of the last scrape of the target with id.
+func (s *Service) FindTargetStatus(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+	op := OpPrefix + platform.OpFindTargetStatus
+	i, ok := s.scraperStatusKV.Load(id.String())
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   op,
+			Msg:  errScraperTargetStatusNotFound,
+		}
+	}
+
+	status, ok := i.(platform.ScraperTargetStatus)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   op,
+			Msg:  fmt.Sprintf("type %T is not a scraper target status", i),
+		}
+	}
+	return &status, nil
+}
+
+// PutTargetStatus replaces the status of a scraper target.
+func (s *Service) PutTargetStatus(ctx context.Context, status *platform.ScraperTargetStatus) error {
+	// The status of a target removed during its scrape is dropped.
+	if _, pe := s.loadScraperTarget(status.TargetID); pe != nil {
+		return &platform.Error{
+			Op:  OpPrefix + platform.OpPutTargetStatus,
+			Err: pe,
+		}
+	}
+	s.scraperStatusKV.Store(status.TargetID.String(), *status)
+	return nil
+}
@@ -0,0 +1,29 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func initScraperTargetStatusService(f platformtesting.TargetStatusFields, t *testing.T) (platform.ScraperTargetStatusService, string, func()) {
+	s := NewService()
+	ctx := context.Background()
+	for _, target := range f.Targets {
+		if err := s.PutTarget(ctx, target); err != nil {
+			t.Fatalf("failed to populate scraper targets")
+		}
+	}
+	for _, status := range f.Statuses {
+		if err := s.PutTargetStatus(ctx, status); err != nil {
+			t.Fatalf("failed to populate scraper target statuses")
+		}
+	}
+	return s, OpPrefix, func() {}
+}
+
+func TestScraperTargetStatusService(t *testing.T) {
+	platformtesting.ScraperTargetStatusService(initScraperTargetStatusService, t)
+}
@@ -25,6 +25,7 @@ type Service struct {
 	userResourceMappingKV sync.Map
 	labelKV               sync.Map
 	scraperTargetKV       sync.Map
+	scraperStatusKV       sync.Map
 	telegrafConfigKV      sync.Map
 	onboardingKV          sync.Map
 	basicAuthKV           sync.Map
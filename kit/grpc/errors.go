@@ -25,6 +25,8 @@ func ToStatus(err *platform.Error) (*status.Status, error) {
 		c = codes.InvalidArgument
 	case platform.EUnavailable:
 		c = codes.Unavailable
+	case platform.ETooManyRequests:
+		c = codes.ResourceExhausted
 	}
 
 	buf, jerr := json.Marshal(err)
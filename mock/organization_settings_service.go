@@ -0,0 +1,38 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.OrganizationSettingsService = (*OrganizationSettingsService)(nil)
+
+// OrganizationSettingsService is a mock implementation of a platform.OrganizationSettingsService.
+type OrganizationSettingsService struct {
+	FindOrganizationSettingsFn func(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error)
+	PutOrganizationSettingsFn  func(ctx context.Context, s *platform.OrganizationSettings) error
+}
+
+// NewOrganizationSettingsService returns a mock OrganizationSettingsService where its methods
+// return the default settings and store nothing.
+func NewOrganizationSettingsService() *OrganizationSettingsService {
+	return &OrganizationSettingsService{
+		FindOrganizationSettingsFn: func(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error) {
+			return &platform.OrganizationSettings{OrganizationID: orgID}, nil
+		},
+		PutOrganizationSettingsFn: func(ctx context.Context, s *platform.OrganizationSettings) error {
+			return nil
+		},
+	}
+}
+
+// FindOrganizationSettings returns the settings of the organization with orgID.
+func (s *OrganizationSettingsService) FindOrganizationSettings(ctx context.Context, orgID platform.ID) (*platform.OrganizationSettings, error) {
+	return s.FindOrganizationSettingsFn(ctx, orgID)
+}
+
+// PutOrganizationSettings replaces the settings of an organization.
+func (s *OrganizationSettingsService) PutOrganizationSettings(ctx context.Context, settings *platform.OrganizationSettings) error {
+	return s.PutOrganizationSettingsFn(ctx, settings)
+}
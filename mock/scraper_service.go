@@ -41,3 +41,21 @@ func (s *ScraperTargetStoreService) RemoveTarget(ctx context.Context, id platfor
 func (s *ScraperTargetStoreService) UpdateTarget(ctx context.Context, t *platform.ScraperTarget) (*platform.ScraperTarget, error) {
 	return s.UpdateTargetF(ctx, t)
 }
+
+var _ platform.ScraperTargetStatusService = &ScraperTargetStatusService{}
+
+// ScraperTargetStatusService is a mock implementation of a platform.ScraperTargetStatusService.
+type ScraperTargetStatusService struct {
+	FindTargetStatusF func(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error)
+	PutTargetStatusF  func(ctx context.Context, s *platform.ScraperTargetStatus) error
+}
+
+// FindTargetStatus retrieves the status of the last scrape of a target.
+func (s *ScraperTargetStatusService) FindTargetStatus(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+	return s.FindTargetStatusF(ctx, id)
+}
+
+// PutTargetStatus replaces the status of a target.
+func (s *ScraperTargetStatusService) PutTargetStatus(ctx context.Context, status *platform.ScraperTargetStatus) error {
+	return s.PutTargetStatusF(ctx, status)
+}
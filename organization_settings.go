@@ -0,0 +1,53 @@
+package platform
+
+import (
+	"context"
+	"time"
+)
+
+// ops for organization settings.
+const (
+	OpFindOrganizationSettings = "FindOrganizationSettings"
+	OpPutOrganizationSettings  = "PutOrganizationSettings"
+)
+
+// OrganizationSettings are the settings of an organization.
+type OrganizationSettings struct {
+	OrganizationID ID         `json:"orgID"`
+	QueryQuota     QueryQuota `json:"queryQuota"`
+}
+
+// QueryQuota limits the resources used by the queries of an organization.
+// A zero value means no limit.
+type QueryQuota struct {
+	// MaxConcurrentQueries is the number of queries of the organization executed at once.
+	MaxConcurrentQueries int `json:"maxConcurrentQueries"`
+	// MaxQueuedQueries is the number of queries of the organization waiting for
+	// a running query to finish; queries beyond it are rejected.
+	MaxQueuedQueries int `json:"maxQueuedQueries"`
+	// MaxMemoryBytes is the memory that the running queries of the organization may allocate together.
+	MaxMemoryBytes int64 `json:"maxMemoryBytes"`
+	// MaxQueryDuration is how long a single query of the organization may run.
+	MaxQueryDuration time.Duration `json:"maxQueryDuration"`
+}
+
+// Valid returns an error if the quota has negative limits.
+func (q QueryQuota) Valid() error {
+	if q.MaxConcurrentQueries < 0 || q.MaxQueuedQueries < 0 || q.MaxMemoryBytes < 0 || q.MaxQueryDuration < 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "query quota limits must not be negative",
+		}
+	}
+	return nil
+}
+
+// OrganizationSettingsService stores the settings of organizations.
+type OrganizationSettingsService interface {
+	// FindOrganizationSettings returns the settings of the organization with orgID.
+	// Organizations without stored settings have the default settings, without any query quota.
+	FindOrganizationSettings(ctx context.Context, orgID ID) (*OrganizationSettings, error)
+
+	// PutOrganizationSettings replaces the settings of an organization.
+	PutOrganizationSettings(ctx context.Context, s *OrganizationSettings) error
+}
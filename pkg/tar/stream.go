@@ -0,0 +1,119 @@
+// Package tar provides helpers for streaming directories of storage files in
+// and out of tar archives.
+package tar
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// WriteFunc is called by Stream for every regular file found beneath the
+// streamed directory. It may write the file to tw, write a modified copy of it
+// or skip it entirely.
+type WriteFunc func(f os.FileInfo, relativePath, fullPath string, tw *tar.Writer) error
+
+// Stream walks dir and writes each regular file to tw. Entries are named by
+// joining relativePath with the path of the file relative to dir. If
+// writeFunc is nil, StreamFile is used and every file is written.
+func Stream(tw *tar.Writer, dir, relativePath string, writeFunc WriteFunc) error {
+	if writeFunc == nil {
+		writeFunc = StreamFile
+	}
+
+	return filepath.Walk(dir, func(fullPath string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Only regular files are archived; directories are implied by the
+		// entry names.
+		if !f.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, fullPath)
+		if err != nil {
+			return err
+		}
+		return writeFunc(f, path.Join(relativePath, filepath.ToSlash(rel)), fullPath, tw)
+	})
+}
+
+// StreamFile writes the file at fullPath to tw using relativePath as the
+// entry name.
+func StreamFile(f os.FileInfo, relativePath, fullPath string, tw *tar.Writer) error {
+	h, err := tar.FileInfoHeader(f, f.Name())
+	if err != nil {
+		return err
+	}
+	h.Name = relativePath
+
+	if err := tw.WriteHeader(h); err != nil {
+		return err
+	}
+
+	fr, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer fr.Close()
+
+	// Only copy the size recorded in the header. Files that are appended to
+	// after the header is written would otherwise corrupt the archive.
+	_, err = io.CopyN(tw, fr, h.Size)
+	return err
+}
+
+// Restore extracts every regular file in the tar archive read from r into
+// dir, creating intermediate directories as required. Entries that would be
+// written outside of dir are rejected.
+func Restore(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if h.Typeflag != tar.TypeReg && h.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		if err := restoreFile(tr, h, dir); err != nil {
+			return err
+		}
+	}
+}
+
+func restoreFile(tr *tar.Reader, h *tar.Header, dir string) error {
+	dst := filepath.Join(dir, filepath.FromSlash(h.Name))
+	if dst != dir && !strings.HasPrefix(dst, filepath.Clean(dir)+string(filepath.Separator)) {
+		return fmt.Errorf("invalid archive entry %q", h.Name)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, tr); err != nil {
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return f.Close()
+}
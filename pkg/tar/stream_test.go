@@ -0,0 +1,73 @@
+package tar_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ptar "github.com/influxdata/platform/pkg/tar"
+)
+
+func TestStreamRestore(t *testing.T) {
+	src, err := ioutil.TempDir("", "tar-src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(src)
+
+	if err := os.MkdirAll(filepath.Join(src, "a", "b"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(src, "a", "b", "c"), []byte("hello"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := ptar.Stream(tw, src, "root", nil); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := ioutil.TempDir("", "tar-dst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dst)
+
+	if err := ptar.Restore(&buf, dst); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, err := ioutil.ReadFile(filepath.Join(dst, "root", "a", "b", "c")); err != nil {
+		t.Fatal(err)
+	} else if got, exp := string(data), "hello"; got != exp {
+		t.Fatalf("got %q, exp %q", got, exp)
+	}
+}
+
+func TestRestore_InvalidPath(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{Name: "../escape", Mode: 0600, Size: 1, Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	} else if _, err := tw.Write([]byte("x")); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := ioutil.TempDir("", "tar-dst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dst)
+
+	if err := ptar.Restore(&buf, dst); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
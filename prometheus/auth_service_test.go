@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/kit/prom"
@@ -42,6 +43,14 @@ func (a *authzSvc) SetAuthorizationStatus(context.Context, platform.ID, platform
 	return a.Err
 }
 
+func (a *authzSvc) RotateAuthorization(context.Context, platform.ID, *time.Time) (*platform.Authorization, error) {
+	return nil, a.Err
+}
+
+func (a *authzSvc) SetAuthorizationLastUsed(context.Context, platform.ID, time.Time) error {
+	return a.Err
+}
+
 func TestAuthorizationService_Metrics(t *testing.T) {
 	a := new(authzSvc)
 
@@ -0,0 +1,139 @@
+package remote
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/iocounter"
+)
+
+const (
+	DialectType = "prometheus"
+
+	// ContentType is the media type of the read responses.
+	ContentType = "application/x-protobuf"
+)
+
+// Dialect describes the read response of the results of the specification of a read request.
+type Dialect struct {
+	// Queries is the number of queries of the read request.
+	Queries int
+}
+
+func (d *Dialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Encoding", "snappy")
+}
+
+func (d *Dialect) Encoder() flux.MultiResultEncoder {
+	return &ReadResponseEncoder{Queries: d.Queries}
+}
+
+func (d *Dialect) DialectType() flux.DialectType {
+	return DialectType
+}
+
+// ReadResponseEncoder encodes the results of the specification of a read request
+// as a snappy compressed read response.
+// The whole response is built before it is written, so an error of the results is returned without writing.
+type ReadResponseEncoder struct {
+	Queries int
+}
+
+func (e *ReadResponseEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	resp := &ReadResponse{
+		Results: make([]*QueryResult, e.Queries),
+	}
+	for i := range resp.Results {
+		resp.Results[i] = &QueryResult{}
+	}
+
+	for results.More() {
+		res := results.Next()
+		i, err := strconv.Atoi(res.Name())
+		if err != nil || i < 0 || i >= e.Queries {
+			results.Release()
+			return 0, fmt.Errorf("unexpected result %q", res.Name())
+		}
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			ts, err := timeSeries(tbl)
+			if err != nil {
+				return err
+			}
+			resp.Results[i].Timeseries = append(resp.Results[i].Timeseries, ts)
+			return nil
+		}); err != nil {
+			results.Release()
+			return 0, err
+		}
+	}
+	if err := results.Err(); err != nil {
+		return 0, err
+	}
+
+	data, err := resp.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	wc := &iocounter.Writer{Writer: w}
+	_, err = wc.Write(snappy.Encode(nil, data))
+	return wc.Count(), err
+}
+
+// timeSeries returns the series of a table of a query.
+// Its labels are the tags of the group key, and the measurement as metric name.
+func timeSeries(tbl flux.Table) (*TimeSeries, error) {
+	ts := &TimeSeries{}
+	key := tbl.Key()
+	for j, c := range key.Cols() {
+		name := c.Label
+		switch c.Label {
+		case execute.DefaultStartColLabel, execute.DefaultStopColLabel, "_field":
+			continue
+		case "_measurement":
+			name = MetricNameLabel
+		}
+		if c.Type != flux.TString {
+			continue
+		}
+		ts.Labels = append(ts.Labels, Label{Name: name, Value: key.ValueString(j)})
+	}
+	sort.Slice(ts.Labels, func(i, j int) bool {
+		return ts.Labels[i].Name < ts.Labels[j].Name
+	})
+
+	err := tbl.Do(func(cr flux.ColReader) error {
+		timeIdx := execute.ColIdx(execute.DefaultTimeColLabel, cr.Cols())
+		valueIdx := execute.ColIdx(execute.DefaultValueColLabel, cr.Cols())
+		if timeIdx < 0 || valueIdx < 0 {
+			return fmt.Errorf("table without %s and %s columns", execute.DefaultTimeColLabel, execute.DefaultValueColLabel)
+		}
+		times := cr.Times(timeIdx)
+		for i := 0; i < cr.Len(); i++ {
+			var v float64
+			switch typ := cr.Cols()[valueIdx].Type; typ {
+			case flux.TFloat:
+				v = cr.Floats(valueIdx)[i]
+			case flux.TInt:
+				v = float64(cr.Ints(valueIdx)[i])
+			case flux.TUInt:
+				v = float64(cr.UInts(valueIdx)[i])
+			default:
+				return fmt.Errorf("unsupported value type %s", typ)
+			}
+			ts.Samples = append(ts.Samples, Sample{
+				Value:     v,
+				Timestamp: int64(times[i]) / int64(time.Millisecond),
+			})
+		}
+		return nil
+	})
+	return ts, err
+}
@@ -0,0 +1,130 @@
+package remote
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/platform"
+)
+
+var matcherOperators = map[LabelMatcher_Type]ast.OperatorKind{
+	LabelMatcher_EQ:  ast.EqualOperator,
+	LabelMatcher_NEQ: ast.NotEqualOperator,
+	LabelMatcher_RE:  ast.RegexpMatchOperator,
+	LabelMatcher_NRE: ast.NotRegexpMatchOperator,
+}
+
+// QuerySpec returns the specification of the queries of a read request in a bucket.
+// The series are read as remote write stores them, and the result of each query is named after its index.
+func QuerySpec(bucketID platform.ID, req *ReadRequest) (*flux.Spec, error) {
+	spec := &flux.Spec{}
+	for i, q := range req.Queries {
+		fn, err := matchersFunction(q.Matchers)
+		if err != nil {
+			return nil, err
+		}
+
+		n := strconv.Itoa(i)
+		ops := []*flux.Operation{
+			{
+				ID:   flux.OperationID("from" + n),
+				Spec: &inputs.FromOpSpec{BucketID: bucketID.String()},
+			},
+			{
+				ID: flux.OperationID("range" + n),
+				Spec: &transformations.RangeOpSpec{
+					Start: flux.Time{Absolute: msToTime(q.StartTimestampMs)},
+					// The end of a query is inclusive.
+					Stop: flux.Time{Absolute: msToTime(q.EndTimestampMs + 1)},
+				},
+			},
+			{
+				ID:   flux.OperationID("filter" + n),
+				Spec: &transformations.FilterOpSpec{Fn: fn},
+			},
+			{
+				ID:   flux.OperationID("yield" + n),
+				Spec: &transformations.YieldOpSpec{Name: n},
+			},
+		}
+		spec.Operations = append(spec.Operations, ops...)
+		for j := 1; j < len(ops); j++ {
+			spec.Edges = append(spec.Edges, flux.Edge{
+				Parent: ops[j-1].ID,
+				Child:  ops[j].ID,
+			})
+		}
+	}
+	return spec, nil
+}
+
+// matchersFunction returns the function of the rows of the series that the label matchers match.
+func matchersFunction(matchers []*LabelMatcher) (*semantic.FunctionExpression, error) {
+	var body semantic.Expression = columnEqual("_field", ValueField)
+	for _, m := range matchers {
+		op, ok := matcherOperators[m.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown label matcher type %d", m.Type)
+		}
+
+		column := m.Name
+		if m.Name == MetricNameLabel {
+			column = "_measurement"
+		}
+
+		var value semantic.Expression = &semantic.StringLiteral{Value: m.Value}
+		if m.Type == LabelMatcher_RE || m.Type == LabelMatcher_NRE {
+			// Regular expressions of Prometheus are fully anchored.
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression of label %s: %v", m.Name, err)
+			}
+			value = &semantic.RegexpLiteral{Value: re}
+		}
+
+		body = &semantic.LogicalExpression{
+			Operator: ast.AndOperator,
+			Left:     body,
+			Right: &semantic.BinaryExpression{
+				Operator: op,
+				Left:     columnRef(column),
+				Right:    value,
+			},
+		}
+	}
+
+	return &semantic.FunctionExpression{
+		Block: &semantic.FunctionBlock{
+			Parameters: &semantic.FunctionParameters{
+				List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+			},
+			Body: body,
+		},
+	}, nil
+}
+
+func columnRef(column string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: "r"},
+		Property: column,
+	}
+}
+
+func columnEqual(column, s string) *semantic.BinaryExpression {
+	return &semantic.BinaryExpression{
+		Operator: ast.EqualOperator,
+		Left:     columnRef(column),
+		Right:    &semantic.StringLiteral{Value: s},
+	}
+}
+
+func msToTime(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,121 @@
+package remote_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/semantic/semantictest"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/prometheus/remote"
+)
+
+func TestQuerySpec(t *testing.T) {
+	member := func(column string) *semantic.MemberExpression {
+		return &semantic.MemberExpression{
+			Object:   &semantic.IdentifierExpression{Name: "r"},
+			Property: column,
+		}
+	}
+
+	req := &remote.ReadRequest{
+		Queries: []*remote.Query{
+			{
+				StartTimestampMs: 1000,
+				EndTimestampMs:   2000,
+				Matchers: []*remote.LabelMatcher{
+					{Type: remote.LabelMatcher_EQ, Name: "__name__", Value: "http_requests_total"},
+					{Type: remote.LabelMatcher_NRE, Name: "code", Value: "5.."},
+				},
+			},
+		},
+	}
+	want := &flux.Spec{
+		Operations: []*flux.Operation{
+			{
+				ID:   "from0",
+				Spec: &inputs.FromOpSpec{BucketID: "aaaaaaaaaaaaaaaa"},
+			},
+			{
+				ID: "range0",
+				Spec: &transformations.RangeOpSpec{
+					Start: flux.Time{Absolute: time.Unix(1, 0).UTC()},
+					Stop:  flux.Time{Absolute: time.Unix(2, int64(time.Millisecond)).UTC()},
+				},
+			},
+			{
+				ID: "filter0",
+				Spec: &transformations.FilterOpSpec{
+					Fn: &semantic.FunctionExpression{
+						Block: &semantic.FunctionBlock{
+							Parameters: &semantic.FunctionParameters{
+								List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+							},
+							Body: &semantic.LogicalExpression{
+								Operator: ast.AndOperator,
+								Left: &semantic.LogicalExpression{
+									Operator: ast.AndOperator,
+									Left: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left:     member("_field"),
+										Right:    &semantic.StringLiteral{Value: "value"},
+									},
+									Right: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left:     member("_measurement"),
+										Right:    &semantic.StringLiteral{Value: "http_requests_total"},
+									},
+								},
+								Right: &semantic.BinaryExpression{
+									Operator: ast.NotRegexpMatchOperator,
+									Left:     member("code"),
+									Right:    &semantic.RegexpLiteral{Value: regexp.MustCompile(`^(?:5..)$`)},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				ID:   "yield0",
+				Spec: &transformations.YieldOpSpec{Name: "0"},
+			},
+		},
+		Edges: []flux.Edge{
+			{Parent: "from0", Child: "range0"},
+			{Parent: "range0", Child: "filter0"},
+			{Parent: "filter0", Child: "yield0"},
+		},
+	}
+
+	got, err := remote.QuerySpec(platform.ID(0xaaaaaaaaaaaaaaaa), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := append(semantictest.CmpOptions, cmpopts.IgnoreUnexported(flux.Spec{}))
+	if !cmp.Equal(want, got, opts...) {
+		t.Errorf("QuerySpec() -want/+got\n%s", cmp.Diff(want, got, opts...))
+	}
+}
+
+func TestQuerySpec_InvalidRegexp(t *testing.T) {
+	req := &remote.ReadRequest{
+		Queries: []*remote.Query{
+			{
+				Matchers: []*remote.LabelMatcher{
+					{Type: remote.LabelMatcher_RE, Name: "code", Value: "("},
+				},
+			},
+		},
+	}
+	if _, err := remote.QuerySpec(platform.ID(1), req); err == nil {
+		t.Error("expected an error")
+	}
+}
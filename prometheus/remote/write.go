@@ -0,0 +1,53 @@
+package remote
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/influxdata/platform/models"
+)
+
+const (
+	// MetricNameLabel is the label of the metric name of a series.
+	MetricNameLabel = "__name__"
+
+	// ValueField is the field of the samples of the series.
+	// It is the field of untyped metrics of the scrapers, since remote write does not send the type of metrics.
+	ValueField = "value"
+)
+
+// PointsFromWriteRequest returns the points of the samples of a write request.
+// As the scrapers store metrics, the metric name of a series is the measurement of its points
+// and its other labels are tags.
+// Samples that the storage does not support are skipped: the staleness markers of Prometheus,
+// which are not a number, and infinite values.
+func PointsFromWriteRequest(req *WriteRequest) ([]models.Point, error) {
+	var points []models.Point
+	for _, ts := range req.Timeseries {
+		var name string
+		tags := make(map[string]string, len(ts.Labels))
+		for _, l := range ts.Labels {
+			if l.Name == MetricNameLabel {
+				name = l.Value
+				continue
+			}
+			tags[l.Name] = l.Value
+		}
+		if name == "" {
+			return nil, fmt.Errorf("series without %s label", MetricNameLabel)
+		}
+
+		for _, s := range ts.Samples {
+			if math.IsNaN(s.Value) || math.IsInf(s.Value, 0) {
+				continue
+			}
+			p, err := models.NewPoint(name, models.NewTags(tags), models.Fields{ValueField: s.Value}, time.Unix(0, s.Timestamp*int64(time.Millisecond)))
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, p)
+		}
+	}
+	return points, nil
+}
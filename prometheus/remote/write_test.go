@@ -0,0 +1,101 @@
+package remote_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform/prometheus/remote"
+)
+
+func TestPointsFromWriteRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *remote.WriteRequest
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "labels are tags",
+			req: &remote.WriteRequest{
+				Timeseries: []remote.TimeSeries{
+					{
+						Labels: []remote.Label{
+							{Name: "__name__", Value: "http_requests_total"},
+							{Name: "code", Value: "200"},
+							{Name: "job", Value: "api"},
+						},
+						Samples: []remote.Sample{
+							{Value: 1, Timestamp: 1000},
+							{Value: 2.5, Timestamp: 2000},
+						},
+					},
+					{
+						Labels: []remote.Label{
+							{Name: "__name__", Value: "up"},
+						},
+						Samples: []remote.Sample{
+							{Value: 1, Timestamp: 1000},
+						},
+					},
+				},
+			},
+			want: []string{
+				"http_requests_total,code=200,job=api value=1 1000000000",
+				"http_requests_total,code=200,job=api value=2.5 2000000000",
+				"up value=1 1000000000",
+			},
+		},
+		{
+			name: "unsupported values are skipped",
+			req: &remote.WriteRequest{
+				Timeseries: []remote.TimeSeries{
+					{
+						Labels: []remote.Label{
+							{Name: "__name__", Value: "up"},
+						},
+						Samples: []remote.Sample{
+							{Value: math.NaN(), Timestamp: 1000},
+							{Value: math.Inf(1), Timestamp: 2000},
+							{Value: 0, Timestamp: 3000},
+						},
+					},
+				},
+			},
+			want: []string{
+				"up value=0 3000000000",
+			},
+		},
+		{
+			name: "series without metric name",
+			req: &remote.WriteRequest{
+				Timeseries: []remote.TimeSeries{
+					{
+						Labels: []remote.Label{
+							{Name: "job", Value: "api"},
+						},
+						Samples: []remote.Sample{
+							{Value: 1, Timestamp: 1000},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := remote.PointsFromWriteRequest(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PointsFromWriteRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, p := range points {
+				got = append(got, p.String())
+			}
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("PointsFromWriteRequest() -want/+got\n%s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/platform"
+)
+
+// ActiveQuery describes a query that is being executed.
+type ActiveQuery struct {
+	ID             platform.ID       `json:"id"`
+	OrganizationID platform.ID       `json:"orgID"`
+	UserID         platform.ID       `json:"userID,omitempty"`
+	CompilerType   flux.CompilerType `json:"compilerType"`
+	// Query is the text of the query, as given to its compiler.
+	Query     string    `json:"query"`
+	StartTime time.Time `json:"startTime"`
+	State     string    `json:"state"`
+	// MaxAllocated is the most memory, in bytes, allocated at once by the query so far.
+	MaxAllocated int64 `json:"maxAllocated"`
+}
+
+// ActiveQueryFilter selects active queries.
+type ActiveQueryFilter struct {
+	OrganizationID *platform.ID
+}
+
+// ActiveQueryService lists and cancels the queries being executed.
+type ActiveQueryService interface {
+	// FindActiveQueries returns the queries being executed that match filter.
+	FindActiveQueries(ctx context.Context, filter ActiveQueryFilter) ([]*ActiveQuery, error)
+
+	// FindActiveQueryByID returns the query being executed with id.
+	FindActiveQueryByID(ctx context.Context, id platform.ID) (*ActiveQuery, error)
+
+	// CancelQuery stops the execution of the query with id.
+	CancelQuery(ctx context.Context, id platform.ID) error
+}
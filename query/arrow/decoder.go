@@ -0,0 +1,321 @@
+package arrow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	arrowmemory "github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// MultiResultDecoder decodes results encoded by the MultiResultEncoder.
+type MultiResultDecoder struct{}
+
+func NewMultiResultDecoder() *MultiResultDecoder {
+	return new(MultiResultDecoder)
+}
+
+// Decode reads all the streams from r and converts them to flux results.
+// Each record batch is a table, of the result named by the metadata of its stream.
+// The consecutive streams of a result make up its tables.
+func (d *MultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	defer r.Close()
+
+	mem := arrowmemory.NewGoAllocator()
+	a := &memory.Allocator{}
+	ri := &resultIterator{}
+	var res *result
+	for {
+		rdr, err := ipc.NewReader(r, ipc.WithAllocator(mem))
+		if err != nil {
+			if pkgerrors.Cause(err) == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		md := rdr.Schema().Metadata()
+		if idx := md.FindKey(errorMetadataKey); idx >= 0 {
+			rdr.Release()
+			ri.err = errors.New(md.Values()[idx])
+			break
+		}
+
+		var name string
+		if idx := md.FindKey(resultMetadataKey); idx >= 0 {
+			name = md.Values()[idx]
+		}
+		if res == nil || res.name != name {
+			res = &result{name: name}
+			ri.results = append(ri.results, res)
+		}
+
+		err = decodeStream(rdr, res, a)
+		rdr.Release()
+		if err != nil {
+			return nil, fmt.Errorf("result %q: %v", res.name, err)
+		}
+	}
+	return ri, nil
+}
+
+// decodeStream appends the tables of the record batches of a stream to res.
+func decodeStream(rdr *ipc.Reader, res *result, a *memory.Allocator) error {
+	schema := rdr.Schema()
+	fields := schema.Fields()
+	cols := make([]flux.ColMeta, len(fields))
+	for j, f := range fields {
+		typ, err := columnType(f.Type)
+		if err != nil {
+			return fmt.Errorf("column %q: %v", f.Name, err)
+		}
+		cols[j] = flux.ColMeta{Label: f.Name, Type: typ}
+	}
+
+	md := schema.Metadata()
+	var groupKey, keyValues []string
+	if idx := md.FindKey(groupKeyMetadataKey); idx >= 0 {
+		if err := json.Unmarshal([]byte(md.Values()[idx]), &groupKey); err != nil {
+			return fmt.Errorf("invalid group key metadata: %v", err)
+		}
+	}
+	if idx := md.FindKey(keyValuesMetadataKey); idx >= 0 {
+		if err := json.Unmarshal([]byte(md.Values()[idx]), &keyValues); err != nil {
+			return fmt.Errorf("invalid key values metadata: %v", err)
+		}
+		if len(keyValues) != len(groupKey) {
+			return fmt.Errorf("got %d group key values for %d columns", len(keyValues), len(groupKey))
+		}
+	}
+	keyIdx := make([]int, len(groupKey))
+	for k, label := range groupKey {
+		if keyIdx[k] = execute.ColIdx(label, cols); keyIdx[k] < 0 {
+			return fmt.Errorf("unknown group key column %q", label)
+		}
+	}
+
+	for rdr.Next() {
+		tbl, err := decodeTable(cols, keyIdx, keyValues, rdr.Record(), a)
+		if err != nil {
+			return err
+		}
+		res.tables = append(res.tables, tbl)
+	}
+	return rdr.Err()
+}
+
+// decodeTable converts rec to a flux table with cols. The values of the group key columns
+// at keyIdx are read from the first row, or parsed from keyValues if rec has no rows.
+func decodeTable(cols []flux.ColMeta, keyIdx []int, keyValues []string, rec array.Record, a *memory.Allocator) (flux.Table, error) {
+	keyCols := make([]flux.ColMeta, len(keyIdx))
+	vs := make([]values.Value, len(keyIdx))
+	for k, j := range keyIdx {
+		keyCols[k] = cols[j]
+		if rec.NumRows() > 0 {
+			v, err := arrayValue(cols[j].Type, rec.Column(j), 0)
+			if err != nil {
+				return nil, err
+			}
+			vs[k] = v
+			continue
+		} else if keyValues == nil {
+			return nil, fmt.Errorf("empty table without group key values")
+		}
+		v, err := parseValue(cols[j].Type, keyValues[k])
+		if err != nil {
+			return nil, fmt.Errorf("group key column %q: %v", cols[j].Label, err)
+		}
+		vs[k] = v
+	}
+
+	b := execute.NewColListTableBuilder(execute.NewGroupKey(keyCols, vs), a)
+	for _, c := range cols {
+		if _, err := b.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	for j, c := range cols {
+		if err := appendColumn(b, j, c.Type, rec.Column(j)); err != nil {
+			return nil, err
+		}
+	}
+	return b.Table()
+}
+
+// arrayValue returns the value at i of arr, an array of a column of typ.
+func arrayValue(typ flux.ColType, arr array.Interface, i int) (values.Value, error) {
+	switch typ {
+	case flux.TBool:
+		return values.NewBool(arr.(*array.Boolean).Value(i)), nil
+	case flux.TInt:
+		return values.NewInt(arr.(*array.Int64).Value(i)), nil
+	case flux.TUInt:
+		return values.NewUInt(arr.(*array.Uint64).Value(i)), nil
+	case flux.TFloat:
+		return values.NewFloat(arr.(*array.Float64).Value(i)), nil
+	case flux.TString:
+		return values.NewString(arr.(*array.String).Value(i)), nil
+	case flux.TTime:
+		return values.NewTime(execute.Time(arr.(*array.Timestamp).Value(i))), nil
+	default:
+		return nil, fmt.Errorf("unsupported column type: %s", typ)
+	}
+}
+
+func appendColumn(b *execute.ColListTableBuilder, j int, typ flux.ColType, arr array.Interface) error {
+	switch typ {
+	case flux.TBool:
+		vs := arr.(*array.Boolean)
+		for i := 0; i < vs.Len(); i++ {
+			if err := b.AppendBool(j, vs.Value(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case flux.TInt:
+		return b.AppendInts(j, arr.(*array.Int64).Int64Values())
+	case flux.TUInt:
+		return b.AppendUInts(j, arr.(*array.Uint64).Uint64Values())
+	case flux.TFloat:
+		return b.AppendFloats(j, arr.(*array.Float64).Float64Values())
+	case flux.TString:
+		vs := arr.(*array.String)
+		for i := 0; i < vs.Len(); i++ {
+			if err := b.AppendString(j, vs.Value(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case flux.TTime:
+		vs := arr.(*array.Timestamp).TimestampValues()
+		ts := make([]execute.Time, len(vs))
+		for i, v := range vs {
+			ts[i] = execute.Time(v)
+		}
+		return b.AppendTimes(j, ts)
+	default:
+		return fmt.Errorf("unsupported column type: %s", typ)
+	}
+}
+
+func columnType(typ arrow.DataType) (flux.ColType, error) {
+	switch typ.ID() {
+	case arrow.BOOL:
+		return flux.TBool, nil
+	case arrow.INT64:
+		return flux.TInt, nil
+	case arrow.UINT64:
+		return flux.TUInt, nil
+	case arrow.FLOAT64:
+		return flux.TFloat, nil
+	case arrow.STRING:
+		return flux.TString, nil
+	case arrow.TIMESTAMP:
+		if unit := typ.(*arrow.TimestampType).Unit; unit != arrow.Nanosecond {
+			return flux.TInvalid, fmt.Errorf("unsupported timestamp unit %s", unit)
+		}
+		return flux.TTime, nil
+	default:
+		return flux.TInvalid, fmt.Errorf("unsupported data type %s", typ.Name())
+	}
+}
+
+// parseValue parses a group key value formatted by formatValue.
+func parseValue(typ flux.ColType, s string) (values.Value, error) {
+	switch typ {
+	case flux.TBool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewBool(v), nil
+	case flux.TInt:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewInt(v), nil
+	case flux.TUInt:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewUInt(v), nil
+	case flux.TFloat:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewFloat(v), nil
+	case flux.TString:
+		return values.NewString(s), nil
+	case flux.TTime:
+		v, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewTime(values.ConvertTime(v)), nil
+	default:
+		return nil, fmt.Errorf("unsupported column type: %s", typ)
+	}
+}
+
+type resultIterator struct {
+	results []*result
+	err     error
+}
+
+func (ri *resultIterator) More() bool {
+	return len(ri.results) > 0
+}
+
+func (ri *resultIterator) Next() flux.Result {
+	res := ri.results[0]
+	ri.results = ri.results[1:]
+	return res
+}
+
+func (ri *resultIterator) Release() {
+	ri.results = nil
+}
+
+func (ri *resultIterator) Err() error {
+	return ri.err
+}
+
+func (ri *resultIterator) Statistics() flux.Statistics { return flux.Statistics{} }
+
+type result struct {
+	name   string
+	tables []flux.Table
+}
+
+func (r *result) Name() string {
+	return r.name
+}
+
+func (r *result) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *result) Do(f func(tbl flux.Table) error) error {
+	for _, tbl := range r.tables {
+		if err := f(tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *result) Statistics() flux.Statistics { return flux.Statistics{} }
@@ -0,0 +1,38 @@
+// Package arrow encodes and decodes flux query results as Apache Arrow IPC streams.
+package arrow
+
+import (
+	"net/http"
+
+	"github.com/influxdata/flux"
+)
+
+const (
+	DialectType = "arrow"
+
+	// ContentType is the media type of the results encoded by the arrow dialect.
+	ContentType = "application/vnd.influx.arrow"
+)
+
+// AddDialectMappings adds the arrow specific dialect mappings.
+func AddDialectMappings(mappings flux.DialectMappings) error {
+	return mappings.Add(DialectType, func() flux.Dialect {
+		return new(Dialect)
+	})
+}
+
+// Dialect describes the Apache Arrow output format of flux queries.
+type Dialect struct{}
+
+func (d *Dialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Transfer-Encoding", "chunked")
+}
+
+func (d *Dialect) Encoder() flux.MultiResultEncoder {
+	return NewMultiResultEncoder()
+}
+
+func (d *Dialect) DialectType() flux.DialectType {
+	return DialectType
+}
@@ -0,0 +1,320 @@
+package arrow
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	// resultMetadataKey is the schema metadata key of the name of the result of a stream.
+	resultMetadataKey = "flux.result"
+	// groupKeyMetadataKey is the schema metadata key of the group key columns of the tables
+	// of a stream, as a JSON array.
+	groupKeyMetadataKey = "flux.group_key"
+	// keyValuesMetadataKey is the schema metadata key of the group key values of the empty
+	// table of a stream, as a JSON array of values formatted by formatValue.
+	keyValuesMetadataKey = "flux.key_values"
+	// errorMetadataKey is the schema metadata key of the error of the query.
+	errorMetadataKey = "flux.error"
+)
+
+// MultiResultEncoder encodes results as a sequence of Arrow IPC streams.
+// Each table is a record batch, written as soon as the table is read. The tables of a result
+// share a stream while they have the same columns, types and group key columns, and a new
+// stream starts when they change. The schema metadata of a stream has the name of its result
+// and the group key columns of its tables, whose values are read from the first row of each
+// record batch. An empty table has no row to read them from, so it is a stream of its own
+// with the key values in its metadata. A result without tables is a stream without fields.
+// An error of the results is encoded as a last stream, without fields, that has the error in its metadata.
+type MultiResultEncoder struct{}
+
+func NewMultiResultEncoder() *MultiResultEncoder {
+	return new(MultiResultEncoder)
+}
+
+// Encode writes the results to w.
+// The returned error is only an error writing to w.
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	mem := memory.NewGoAllocator()
+
+	var err error
+	for results.More() {
+		if err = encodeResult(wc, mem, results.Next()); err != nil {
+			results.Release()
+			break
+		}
+	}
+	if err == nil {
+		err = results.Err()
+	}
+	if err != nil {
+		if err := encodeError(wc, mem, err); err != nil {
+			return wc.Count(), err
+		}
+	}
+	return wc.Count(), nil
+}
+
+// resultWriter writes the tables of a result to the stream of their schema.
+type resultWriter struct {
+	w    io.Writer
+	mem  memory.Allocator
+	name string
+
+	schema *arrow.Schema
+	wr     *ipc.Writer
+}
+
+func encodeResult(w io.Writer, mem memory.Allocator, res flux.Result) error {
+	rw := &resultWriter{w: w, mem: mem, name: res.Name()}
+	err := res.Tables().Do(rw.writeTable)
+	if err == nil && rw.wr == nil {
+		schema, err := rw.newSchema(nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		rw.start(schema)
+	}
+	// Closing the writer ends the stream, even when a table failed,
+	// so the error can follow it.
+	if cerr := rw.close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// writeTable writes tbl as a record batch, starting a new stream if its schema
+// differs from that of the previous table.
+func (rw *resultWriter) writeTable(tbl flux.Table) error {
+	cols := tbl.Cols()
+	fields := make([]arrow.Field, len(cols))
+	for j, c := range cols {
+		typ, err := dataType(c.Type)
+		if err != nil {
+			return err
+		}
+		fields[j] = arrow.Field{Name: c.Label, Type: typ}
+	}
+
+	arrs, n, err := readTable(rw.mem, tbl)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, arr := range arrs {
+			arr.Release()
+		}
+	}()
+
+	key := tbl.Key()
+	var keyValues []string
+	if n == 0 {
+		keyValues = make([]string, len(key.Cols()))
+		for j := range key.Cols() {
+			if keyValues[j], err = formatValue(key.Value(j)); err != nil {
+				return err
+			}
+		}
+	}
+	schema, err := rw.newSchema(fields, key.Cols(), keyValues)
+	if err != nil {
+		return err
+	}
+	if rw.wr == nil || !sameSchema(rw.schema, schema) {
+		if err := rw.close(); err != nil {
+			return err
+		}
+		rw.start(schema)
+	}
+
+	rec := array.NewRecord(rw.schema, arrs, int64(n))
+	defer rec.Release()
+	return rw.wr.Write(rec)
+}
+
+// newSchema returns the schema of a stream of tables with fields and the group key columns keyCols.
+// The key values are only set for an empty table.
+func (rw *resultWriter) newSchema(fields []arrow.Field, keyCols []flux.ColMeta, keyValues []string) (*arrow.Schema, error) {
+	labels := make([]string, len(keyCols))
+	for j, c := range keyCols {
+		labels[j] = c.Label
+	}
+	b, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{resultMetadataKey, groupKeyMetadataKey}
+	vals := []string{rw.name, string(b)}
+	if keyValues != nil {
+		b, err := json.Marshal(keyValues)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, keyValuesMetadataKey)
+		vals = append(vals, string(b))
+	}
+	md := arrow.NewMetadata(keys, vals)
+	return arrow.NewSchema(fields, &md), nil
+}
+
+// start begins a new stream of schema. The schema is written with the first record batch.
+func (rw *resultWriter) start(schema *arrow.Schema) {
+	rw.schema = schema
+	rw.wr = ipc.NewWriter(rw.w, ipc.WithSchema(schema), ipc.WithAllocator(rw.mem))
+}
+
+// close ends the current stream, if any.
+func (rw *resultWriter) close() error {
+	if rw.wr == nil {
+		return nil
+	}
+	err := rw.wr.Close()
+	rw.wr = nil
+	return err
+}
+
+// sameSchema returns true if a and b have the same fields and metadata.
+func sameSchema(a, b *arrow.Schema) bool {
+	if !a.Equal(b) {
+		return false
+	}
+	amd, bmd := a.Metadata(), b.Metadata()
+	if amd.Len() != bmd.Len() {
+		return false
+	}
+	for i, k := range amd.Keys() {
+		if j := bmd.FindKey(k); j < 0 || bmd.Values()[j] != amd.Values()[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// readTable reads the columns of tbl into arrays and returns them with the number of rows.
+func readTable(mem memory.Allocator, tbl flux.Table) ([]array.Interface, int, error) {
+	cols := tbl.Cols()
+	builders := make([]array.Builder, len(cols))
+	for j, c := range cols {
+		builders[j] = newBuilder(mem, c.Type)
+	}
+	defer func() {
+		for _, b := range builders {
+			b.Release()
+		}
+	}()
+
+	n := 0
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		n += cr.Len()
+		for j, c := range cols {
+			appendValues(builders[j], c.Type, cr, j)
+		}
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	arrs := make([]array.Interface, len(cols))
+	for j, b := range builders {
+		arrs[j] = b.NewArray()
+	}
+	return arrs, n, nil
+}
+
+func encodeError(w io.Writer, mem memory.Allocator, err error) error {
+	md := arrow.NewMetadata([]string{errorMetadataKey}, []string{err.Error()})
+	wr := ipc.NewWriter(w, ipc.WithSchema(arrow.NewSchema(nil, &md)), ipc.WithAllocator(mem))
+	return wr.Close()
+}
+
+func dataType(typ flux.ColType) (arrow.DataType, error) {
+	switch typ {
+	case flux.TBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case flux.TInt:
+		return arrow.PrimitiveTypes.Int64, nil
+	case flux.TUInt:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case flux.TFloat:
+		return arrow.PrimitiveTypes.Float64, nil
+	case flux.TString:
+		return arrow.BinaryTypes.String, nil
+	case flux.TTime:
+		return arrow.FixedWidthTypes.Timestamp_ns, nil
+	default:
+		return nil, fmt.Errorf("unsupported column type: %s", typ)
+	}
+}
+
+func newBuilder(mem memory.Allocator, typ flux.ColType) array.Builder {
+	switch typ {
+	case flux.TBool:
+		return array.NewBooleanBuilder(mem)
+	case flux.TInt:
+		return array.NewInt64Builder(mem)
+	case flux.TUInt:
+		return array.NewUint64Builder(mem)
+	case flux.TFloat:
+		return array.NewFloat64Builder(mem)
+	case flux.TString:
+		return array.NewStringBuilder(mem)
+	case flux.TTime:
+		return array.NewTimestampBuilder(mem, arrow.FixedWidthTypes.Timestamp_ns.(*arrow.TimestampType))
+	default:
+		panic(fmt.Sprintf("unsupported column type: %s", typ))
+	}
+}
+
+// appendValues appends the values of column j of cr to b, a builder of typ.
+func appendValues(b array.Builder, typ flux.ColType, cr flux.ColReader, j int) {
+	switch typ {
+	case flux.TBool:
+		b.(*array.BooleanBuilder).AppendValues(cr.Bools(j), nil)
+	case flux.TInt:
+		b.(*array.Int64Builder).AppendValues(cr.Ints(j), nil)
+	case flux.TUInt:
+		b.(*array.Uint64Builder).AppendValues(cr.UInts(j), nil)
+	case flux.TFloat:
+		b.(*array.Float64Builder).AppendValues(cr.Floats(j), nil)
+	case flux.TString:
+		b.(*array.StringBuilder).AppendValues(cr.Strings(j), nil)
+	case flux.TTime:
+		ts := cr.Times(j)
+		vs := make([]arrow.Timestamp, len(ts))
+		for i, t := range ts {
+			vs[i] = arrow.Timestamp(t)
+		}
+		b.(*array.TimestampBuilder).AppendValues(vs, nil)
+	}
+}
+
+// formatValue formats a group key value for the metadata of its field.
+func formatValue(v values.Value) (string, error) {
+	switch typ := flux.ColumnType(v.Type()); typ {
+	case flux.TBool:
+		return strconv.FormatBool(v.Bool()), nil
+	case flux.TInt:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case flux.TUInt:
+		return strconv.FormatUint(v.UInt(), 10), nil
+	case flux.TFloat:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case flux.TString:
+		return v.Str(), nil
+	case flux.TTime:
+		return v.Time().Time().UTC().Format(time.RFC3339Nano), nil
+	default:
+		return "", fmt.Errorf("unsupported value type: %s", typ)
+	}
+}
@@ -0,0 +1,309 @@
+package arrow_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform/query/arrow"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func TestMultiResultEncoder_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*executetest.Result
+		err     error
+	}{
+		{
+			name: "multiple results",
+			results: []*executetest.Result{
+				{
+					Nm: "_result",
+					Tbls: []*executetest.Table{
+						{
+							KeyCols: []string{"_measurement", "host"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_time", Type: flux.TTime},
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "host", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+							Data: [][]interface{}{
+								{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 1, time.UTC)), "cpu", "A", 42.5},
+								{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 0, time.UTC)), "cpu", "A", math.Inf(-1)},
+							},
+						},
+						{
+							KeyCols:   []string{"_measurement", "host"},
+							KeyValues: []interface{}{"cpu", "B"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_time", Type: flux.TTime},
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "host", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+						},
+					},
+				},
+				{
+					Nm: "counts",
+					Tbls: []*executetest.Table{{
+						KeyCols: []string{"_start"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_start", Type: flux.TTime},
+							{Label: "n", Type: flux.TInt},
+							{Label: "u", Type: flux.TUInt},
+							{Label: "ok", Type: flux.TBool},
+						},
+						Data: [][]interface{}{
+							{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC)), int64(-9007199254740993), uint64(18446744073709551615), true},
+							{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC)), int64(7), uint64(0), false},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "tables with different columns",
+			results: []*executetest.Result{
+				{
+					Nm: "_result",
+					Tbls: []*executetest.Table{
+						{
+							KeyCols: []string{"_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_field", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+							Data: [][]interface{}{
+								{"usage", 1.5},
+								{"usage", 2.5},
+							},
+						},
+						{
+							KeyCols: []string{"_field", "host"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_field", Type: flux.TString},
+								{Label: "host", Type: flux.TString},
+								{Label: "n", Type: flux.TInt},
+							},
+							Data: [][]interface{}{
+								{"count", "A", int64(3)},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "mixed value types",
+			results: []*executetest.Result{
+				{
+					Nm: "_result",
+					Tbls: []*executetest.Table{
+						{
+							KeyCols: []string{"_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_field", Type: flux.TString},
+								{Label: "_value", Type: flux.TInt},
+							},
+							Data: [][]interface{}{
+								{"n", int64(1)},
+								{"n", int64(2)},
+							},
+						},
+						{
+							KeyCols: []string{"_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_field", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+							Data: [][]interface{}{
+								{"usage", 1.5},
+							},
+						},
+						{
+							KeyCols:   []string{"_field"},
+							KeyValues: []interface{}{"free"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_field", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+						},
+						{
+							KeyCols: []string{"_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_field", Type: flux.TString},
+								{Label: "_value", Type: flux.TInt},
+							},
+							Data: [][]interface{}{
+								{"m", int64(3)},
+							},
+						},
+					},
+				},
+				{
+					Nm: "empty",
+				},
+			},
+		},
+		{
+			name: "error",
+			results: []*executetest.Result{
+				{
+					Nm: "_result",
+					Tbls: []*executetest.Table{{
+						ColMeta: []flux.ColMeta{
+							{Label: "_value", Type: flux.TInt},
+						},
+						Data: [][]interface{}{
+							{int64(1)},
+						},
+					}},
+				},
+			},
+			err: errors.New("expected error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := make([]flux.Result, len(tt.results))
+			for i, res := range tt.results {
+				res.Normalize()
+				results[i] = res
+			}
+			var ri flux.ResultIterator = flux.NewSliceResultIterator(results)
+			if tt.err != nil {
+				ri = &errResultIterator{ResultIterator: ri, err: tt.err}
+			}
+
+			var buf bytes.Buffer
+			n, err := arrow.NewMultiResultEncoder().Encode(&buf, ri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, exp := n, int64(buf.Len()); got != exp {
+				t.Errorf("got %d bytes written, exp %d", got, exp)
+			}
+
+			dec, err := arrow.NewMultiResultDecoder().Decode(ioutil.NopCloser(&buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []*executetest.Result
+			for dec.More() {
+				res := dec.Next()
+				r := &executetest.Result{Nm: res.Name()}
+				if err := res.Tables().Do(func(tbl flux.Table) error {
+					t, err := executetest.ConvertTable(tbl)
+					if err != nil {
+						return err
+					}
+					r.Tbls = append(r.Tbls, t)
+					return nil
+				}); err != nil {
+					t.Fatal(err)
+				}
+				r.Normalize()
+				got = append(got, r)
+			}
+			if !cmp.Equal(tt.results, got) {
+				t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(tt.results, got))
+			}
+
+			if got, exp := errString(dec.Err()), errString(tt.err); got != exp {
+				t.Errorf("unexpected error: got %q, exp %q", got, exp)
+			}
+		})
+	}
+}
+
+func TestMultiResultEncoder_Streams(t *testing.T) {
+	table := func(key string, typ flux.ColType, v interface{}) *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: typ},
+			},
+			Data: [][]interface{}{
+				{key, v},
+				{key, v},
+			},
+		}
+	}
+	results := []flux.Result{
+		&executetest.Result{Nm: "a", Tbls: []*executetest.Table{
+			table("A", flux.TInt, int64(1)),
+			table("B", flux.TInt, int64(1)),
+			table("C", flux.TInt, int64(1)),
+		}},
+		&executetest.Result{Nm: "b", Tbls: []*executetest.Table{
+			table("A", flux.TInt, int64(1)),
+			table("B", flux.TFloat, 1.0),
+			table("C", flux.TFloat, 1.0),
+			table("D", flux.TInt, int64(1)),
+		}},
+	}
+	for _, res := range results {
+		res.(*executetest.Result).Normalize()
+	}
+
+	var buf bytes.Buffer
+	if _, err := arrow.NewMultiResultEncoder().Encode(&buf, flux.NewSliceResultIterator(results)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each table is a record batch, and a new stream starts
+	// with each result and when the type of a column changes.
+	var got []int
+	for {
+		rdr, err := ipc.NewReader(&buf)
+		if err != nil {
+			if pkgerrors.Cause(err) == io.EOF {
+				break
+			}
+			t.Fatal(err)
+		}
+		n := 0
+		for rdr.Next() {
+			if rows := rdr.Record().NumRows(); rows != 2 {
+				t.Errorf("got %d rows in record batch %d, exp 2", rows, n)
+			}
+			n++
+		}
+		rdr.Release()
+		got = append(got, n)
+	}
+	if exp := []int{3, 1, 2, 1}; !cmp.Equal(exp, got) {
+		t.Errorf("unexpected record batches per stream -want/+got:\n%s", cmp.Diff(exp, got))
+	}
+}
+
+type errResultIterator struct {
+	flux.ResultIterator
+	err error
+}
+
+func (ri *errResultIterator) Err() error {
+	return ri.err
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,551 @@
+// Package cache provides a cache of query results.
+package cache
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	finputs "github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions/inputs"
+	"github.com/influxdata/platform/query/functions/outputs"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ProxyQueryService caches the encoded results of the queries of a ProxyQueryService.
+//
+// Results are keyed on the organization, the dialect and the query as written,
+// with Flux queries in their canonical format, so a cached result is found without
+// compiling the query or looking up its buckets. The query includes its time bounds;
+// relative bounds are resolved when the result is computed, so TTL also bounds how far
+// behind the present a cached result may be.
+// Only queries that read buckets of the storage engine, and write nothing, are cached;
+// the other queries are remembered for TTL so that they are not compiled on every request.
+// Results are invalidated when points are written to or deleted from the buckets they read,
+// or the buckets are updated or deleted, through the PointsWriter, PointsDeleter and
+// BucketService returned by the service, and when Invalidate is called.
+type ProxyQueryService struct {
+	// ProxyQueryService performs the queries whose results are not cached.
+	ProxyQueryService query.ProxyQueryService
+
+	bucketService platform.BucketService
+
+	ttl      time.Duration
+	maxBytes int64
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*entry
+	lru     *list.List // of *entry, the most recently used first
+	size    int64
+	// byBucket holds the entries of the results reading each bucket.
+	byBucket map[platform.ID]map[*entry]struct{}
+	// versions counts the writes to each bucket, to discard results computed during a write.
+	versions map[platform.ID]uint64
+	// generation counts the invalidations of all results.
+	generation uint64
+
+	requests      *prometheus.CounterVec
+	evictions     *prometheus.CounterVec
+	sizeBytes     prometheus.Gauge
+	cachedEntries prometheus.Gauge
+}
+
+var _ query.ProxyQueryService = (*ProxyQueryService)(nil)
+
+// NewProxyQueryService returns a ProxyQueryService caching results for ttl, up to maxBytes of results.
+// The buckets read by queries are found with bucketService.
+func NewProxyQueryService(bucketService platform.BucketService, ttl time.Duration, maxBytes int64) *ProxyQueryService {
+	const (
+		namespace = "query"
+		subsystem = "cache"
+	)
+	return &ProxyQueryService{
+		bucketService: bucketService,
+		ttl:           ttl,
+		maxBytes:      maxBytes,
+		now:           time.Now,
+		entries:       make(map[[sha256.Size]byte]*entry),
+		lru:           list.New(),
+		byBucket:      make(map[platform.ID]map[*entry]struct{}),
+		versions:      make(map[platform.ID]uint64),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "requests_total",
+			Help:      "Number of queries looked up in the cache, by result: hit, miss or bypass for queries that cannot be cached",
+		}, []string{"result"}),
+		evictions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "evictions_total",
+			Help:      "Number of results removed from the cache, by reason: expired, invalidated or size",
+		}, []string{"reason"}),
+		sizeBytes: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "size_bytes",
+			Help:      "Size of the cached results",
+		}),
+		cachedEntries: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "entries",
+			Help:      "Number of cached results",
+		}),
+	}
+}
+
+// PrometheusCollectors satisfies the prom.PrometheusCollector interface.
+func (s *ProxyQueryService) PrometheusCollectors() []prometheus.Collector {
+	return []prometheus.Collector{s.requests, s.evictions, s.sizeBytes, s.cachedEntries}
+}
+
+// entry is a cached query result, or records that the result of a query cannot be cached.
+type entry struct {
+	key     [sha256.Size]byte
+	data    []byte
+	buckets []*platform.Bucket
+	bypass  bool
+	expires time.Time
+	elem    *list.Element
+}
+
+// size returns the number of bytes e accounts for in the cache.
+func (e *entry) size() int64 {
+	if e.bypass {
+		return int64(len(e.key))
+	}
+	return int64(len(e.data))
+}
+
+// Query writes the cached result of req to w, or performs the query and caches its result.
+func (s *ProxyQueryService) Query(ctx context.Context, w io.Writer, req *query.ProxyRequest) (int64, error) {
+	key, ok := requestKey(req)
+	if !ok {
+		s.requests.WithLabelValues("bypass").Inc()
+		return s.ProxyQueryService.Query(ctx, w, req)
+	}
+
+	data, found, bypass := s.get(key, req.Request.Authorization)
+	switch {
+	case bypass:
+		s.requests.WithLabelValues("bypass").Inc()
+		return s.ProxyQueryService.Query(ctx, w, req)
+	case found:
+		s.requests.WithLabelValues("hit").Inc()
+		n, err := w.Write(data)
+		return int64(n), err
+	}
+
+	buckets, ok := s.readBuckets(ctx, req)
+	if !ok {
+		s.putBypass(key)
+		s.requests.WithLabelValues("bypass").Inc()
+		return s.ProxyQueryService.Query(ctx, w, req)
+	}
+	s.requests.WithLabelValues("miss").Inc()
+
+	versions, generation := s.bucketVersions(buckets)
+	rec := &recorder{w: w, max: s.maxBytes}
+	n, err := s.ProxyQueryService.Query(ctx, rec, req)
+	if err != nil || rec.overflow {
+		return n, err
+	}
+	s.put(key, rec.buf.Bytes(), buckets, versions, generation)
+	return n, nil
+}
+
+// requestKey returns the cache key of req, if its result may be cached.
+func requestKey(req *query.ProxyRequest) ([sha256.Size]byte, bool) {
+	var key [sha256.Size]byte
+	if req.Request.Authorization == nil || req.Request.Compiler == nil || req.Dialect == nil {
+		return key, false
+	}
+
+	var compiler []byte
+	switch c := req.Request.Compiler.(type) {
+	case lang.FluxCompiler:
+		q, ok := formatQuery(c.Query)
+		if !ok {
+			return key, false
+		}
+		compiler = []byte(q)
+	case *lang.FluxCompiler:
+		q, ok := formatQuery(c.Query)
+		if !ok {
+			return key, false
+		}
+		compiler = []byte(q)
+	default:
+		b, err := json.Marshal(c)
+		if err != nil {
+			return key, false
+		}
+		compiler = b
+	}
+	dialectJSON, err := json.Marshal(req.Dialect)
+	if err != nil {
+		return key, false
+	}
+
+	h := sha256.New()
+	h.Write([]byte(req.Request.OrganizationID.String()))
+	h.Write([]byte{0})
+	h.Write([]byte(req.Request.Compiler.CompilerType()))
+	h.Write(compiler)
+	h.Write([]byte{0})
+	h.Write([]byte(req.Dialect.DialectType()))
+	h.Write(dialectJSON)
+	copy(key[:], h.Sum(nil))
+	return key, true
+}
+
+// formatQuery returns the Flux query q in its canonical format, unless q cannot be parsed.
+func formatQuery(q string) (string, bool) {
+	pkg := parser.ParseSource(q)
+	if ast.Check(pkg) > 0 {
+		return "", false
+	}
+	return ast.Format(pkg), true
+}
+
+// readBuckets compiles the query of req and returns the buckets it reads,
+// if its result may be cached.
+func (s *ProxyQueryService) readBuckets(ctx context.Context, req *query.ProxyRequest) ([]*platform.Bucket, bool) {
+	spec, err := req.Request.Compiler.Compile(ctx)
+	if err != nil {
+		return nil, false
+	}
+
+	filters, ok := readBuckets(spec, req.Request.OrganizationID)
+	if !ok {
+		return nil, false
+	}
+	buckets := make([]*platform.Bucket, 0, len(filters))
+	for _, f := range filters {
+		b, err := s.bucketService.FindBucket(ctx, f)
+		if err != nil {
+			return nil, false
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, true
+}
+
+// readBuckets returns the buckets of orgID read by spec.
+// It reports false if spec reads anything but storage buckets, or writes.
+func readBuckets(spec *flux.Spec, orgID platform.ID) ([]platform.BucketFilter, bool) {
+	hasParent := make(map[flux.OperationID]bool, len(spec.Edges))
+	for _, e := range spec.Edges {
+		hasParent[e.Child] = true
+	}
+
+	var filters []platform.BucketFilter
+	for _, op := range spec.Operations {
+		// Queries writing to a remote host report no written buckets.
+		if op.Spec.Kind() == outputs.ToKind {
+			return nil, false
+		}
+		if bs, ok := op.Spec.(query.BucketAwareOperationSpec); ok {
+			if _, written := bs.BucketsAccessed(); len(written) > 0 {
+				return nil, false
+			}
+		}
+		if hasParent[op.ID] {
+			continue
+		}
+
+		var bucket, bucketID string
+		switch s := op.Spec.(type) {
+		case *finputs.FromOpSpec:
+			bucket, bucketID = s.Bucket, s.BucketID
+		case *inputs.TagKeysOpSpec:
+			bucket, bucketID = s.Bucket, s.BucketID
+		case *inputs.TagValuesOpSpec:
+			bucket, bucketID = s.Bucket, s.BucketID
+		case *inputs.MeasurementsOpSpec:
+			bucket, bucketID = s.Bucket, s.BucketID
+		default:
+			return nil, false
+		}
+
+		f := platform.BucketFilter{OrganizationID: &orgID}
+		if bucketID != "" {
+			id, err := platform.IDFromString(bucketID)
+			if err != nil {
+				return nil, false
+			}
+			f.ID = id
+		} else {
+			name := bucket
+			f.Name = &name
+		}
+		filters = append(filters, f)
+	}
+	return filters, len(filters) > 0
+}
+
+// get returns the cached result with key, if it has not expired and auth may read it,
+// or reports bypass if the result of the query with key cannot be cached.
+func (s *ProxyQueryService) get(key [sha256.Size]byte, auth platform.Authorizer) (data []byte, found, bypass bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false, false
+	}
+	if !s.now().Before(e.expires) {
+		s.remove(e, "expired")
+		return nil, false, false
+	}
+	s.lru.MoveToFront(e.elem)
+	if e.bypass {
+		return nil, false, true
+	}
+	for _, b := range e.buckets {
+		p, err := platform.NewOrgPermissionAtID(b.OrganizationID, b.ID, platform.ReadAction, platform.BucketsResource)
+		if err != nil || !auth.Allowed(*p) {
+			return nil, false, false
+		}
+	}
+	return e.data, true, false
+}
+
+// put caches data as the result with key, unless one of buckets was written since versions,
+// or all results were invalidated since generation.
+func (s *ProxyQueryService) put(key [sha256.Size]byte, data []byte, buckets []*platform.Bucket, versions []uint64, generation uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.generation != generation {
+		return
+	}
+	for i, b := range buckets {
+		if s.versions[b.ID] != versions[i] {
+			return
+		}
+	}
+	s.add(&entry{
+		key:     key,
+		data:    data,
+		buckets: buckets,
+		expires: s.now().Add(s.ttl),
+	})
+}
+
+// putBypass records that the result of the query with key cannot be cached.
+func (s *ProxyQueryService) putBypass(key [sha256.Size]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.add(&entry{
+		key:     key,
+		bypass:  true,
+		expires: s.now().Add(s.ttl),
+	})
+}
+
+// add caches e, evicting the least recently used entries beyond maxBytes.
+// It must be called with the lock held.
+func (s *ProxyQueryService) add(e *entry) {
+	if old, ok := s.entries[e.key]; ok {
+		s.remove(old, "replaced")
+	}
+
+	e.elem = s.lru.PushFront(e)
+	s.entries[e.key] = e
+	s.size += e.size()
+	for _, b := range e.buckets {
+		entries, ok := s.byBucket[b.ID]
+		if !ok {
+			entries = make(map[*entry]struct{})
+			s.byBucket[b.ID] = entries
+		}
+		entries[e] = struct{}{}
+	}
+
+	for s.size > s.maxBytes {
+		s.remove(s.lru.Back().Value.(*entry), "size")
+	}
+	s.sizeBytes.Set(float64(s.size))
+	s.cachedEntries.Set(float64(len(s.entries)))
+}
+
+// remove evicts e from the cache. It must be called with the lock held.
+func (s *ProxyQueryService) remove(e *entry, reason string) {
+	delete(s.entries, e.key)
+	s.lru.Remove(e.elem)
+	s.size -= e.size()
+	for _, b := range e.buckets {
+		entries := s.byBucket[b.ID]
+		delete(entries, e)
+		if len(entries) == 0 {
+			delete(s.byBucket, b.ID)
+		}
+	}
+	if reason != "replaced" {
+		s.evictions.WithLabelValues(reason).Inc()
+	}
+	s.sizeBytes.Set(float64(s.size))
+	s.cachedEntries.Set(float64(len(s.entries)))
+}
+
+// bucketVersions returns the current write versions of buckets and the current generation.
+func (s *ProxyQueryService) bucketVersions(buckets []*platform.Bucket) ([]uint64, uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	versions := make([]uint64, len(buckets))
+	for i, b := range buckets {
+		versions[i] = s.versions[b.ID]
+	}
+	return versions, s.generation
+}
+
+// Invalidate evicts the cached results that read any of the buckets with bucketIDs.
+func (s *ProxyQueryService) Invalidate(bucketIDs ...platform.ID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range bucketIDs {
+		s.versions[id]++
+		for e := range s.byBucket[id] {
+			s.remove(e, "invalidated")
+		}
+	}
+}
+
+// InvalidateAll evicts every cached result, for when the data of all buckets may have changed.
+func (s *ProxyQueryService) InvalidateAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	for _, e := range s.entries {
+		s.remove(e, "invalidated")
+	}
+}
+
+// PointsWriter returns a PointsWriter writing to w, which invalidates the cached results
+// that read the buckets written to.
+func (s *ProxyQueryService) PointsWriter(w storage.PointsWriter) storage.PointsWriter {
+	return &invalidatingPointsWriter{w: w, s: s}
+}
+
+type invalidatingPointsWriter struct {
+	w storage.PointsWriter
+	s *ProxyQueryService
+}
+
+// WritePoints writes the points, whose names encode their organization and bucket, and invalidates
+// the results reading their buckets. Results are invalidated even if the write fails,
+// since some of the points may have been written.
+func (w *invalidatingPointsWriter) WritePoints(points []models.Point) error {
+	seen := make(map[platform.ID]bool)
+	var ids []platform.ID
+	for _, p := range points {
+		var name [16]byte
+		if copy(name[:], p.Name()) < len(name) {
+			continue
+		}
+		_, bucketID := tsdb.DecodeName(name)
+		if !seen[bucketID] {
+			seen[bucketID] = true
+			ids = append(ids, bucketID)
+		}
+	}
+
+	err := w.w.WritePoints(points)
+	w.s.Invalidate(ids...)
+	return err
+}
+
+// PointsDeleter returns a PointsDeleter deleting with d, which invalidates the cached results
+// that read the buckets points are deleted from.
+func (s *ProxyQueryService) PointsDeleter(d storage.PointsDeleter) storage.PointsDeleter {
+	return &invalidatingPointsDeleter{d: d, s: s}
+}
+
+type invalidatingPointsDeleter struct {
+	d storage.PointsDeleter
+	s *ProxyQueryService
+}
+
+// DeleteBucketRangePredicate deletes the points and invalidates the results reading the bucket.
+func (d *invalidatingPointsDeleter) DeleteBucketRangePredicate(orgID, bucketID platform.ID, min, max int64, pred influxql.Expr) error {
+	err := d.d.DeleteBucketRangePredicate(orgID, bucketID, min, max, pred)
+	d.s.Invalidate(bucketID)
+	return err
+}
+
+// BucketService returns a BucketService updating and deleting buckets with bs, which
+// invalidates the cached results that read the buckets updated or deleted,
+// as the names in their queries may now refer to other buckets.
+func (s *ProxyQueryService) BucketService(bs platform.BucketService) platform.BucketService {
+	return &invalidatingBucketService{BucketService: bs, s: s}
+}
+
+type invalidatingBucketService struct {
+	platform.BucketService
+	s *ProxyQueryService
+}
+
+// UpdateBucket updates the bucket and invalidates the results reading it.
+func (bs *invalidatingBucketService) UpdateBucket(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+	b, err := bs.BucketService.UpdateBucket(ctx, id, upd)
+	bs.s.Invalidate(id)
+	return b, err
+}
+
+// DeleteBucket deletes the bucket and invalidates the results reading it.
+func (bs *invalidatingBucketService) DeleteBucket(ctx context.Context, id platform.ID) error {
+	err := bs.BucketService.DeleteBucket(ctx, id)
+	bs.s.Invalidate(id)
+	return err
+}
+
+// recorder writes to w and records what it writes, until more than max bytes are written.
+type recorder struct {
+	w        io.Writer
+	buf      bytes.Buffer
+	max      int64
+	overflow bool
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	n, err := r.w.Write(p)
+	if !r.overflow {
+		if int64(r.buf.Len()+n) > r.max {
+			r.overflow = true
+			r.buf = bytes.Buffer{}
+		} else {
+			r.buf.Write(p[:n])
+		}
+	}
+	return n, err
+}
+
+// Flush flushes the writer of the response, if it can be flushed.
+func (r *recorder) Flush() {
+	if f, ok := r.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,265 @@
+package cache_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux/csv"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/kit/prom"
+	"github.com/influxdata/platform/kit/prom/promtest"
+	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/query"
+	_ "github.com/influxdata/platform/query/builtin"
+	"github.com/influxdata/platform/query/cache"
+	qmock "github.com/influxdata/platform/query/mock"
+	"github.com/influxdata/platform/tsdb"
+)
+
+const (
+	orgID    = platform.ID(1)
+	bucketID = platform.ID(2)
+)
+
+type fixture struct {
+	cache   *cache.ProxyQueryService
+	buckets *mock.BucketService
+	queries int
+	lookups int
+}
+
+func newFixture(maxBytes int64) *fixture {
+	f := &fixture{}
+
+	bs := mock.NewBucketService()
+	bs.FindBucketFn = func(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		f.lookups++
+		if filter.Name == nil || *filter.Name != "telegraf" {
+			return nil, &platform.Error{Code: platform.ENotFound}
+		}
+		return &platform.Bucket{ID: bucketID, OrganizationID: orgID, Name: "telegraf"}, nil
+	}
+	bs.UpdateBucketFn = func(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+		return &platform.Bucket{ID: id, OrganizationID: orgID, Name: *upd.Name}, nil
+	}
+	bs.DeleteBucketFn = func(ctx context.Context, id platform.ID) error {
+		return nil
+	}
+	f.buckets = bs
+
+	f.cache = cache.NewProxyQueryService(bs, time.Minute, maxBytes)
+	f.cache.ProxyQueryService = &qmock.ProxyQueryService{
+		QueryF: func(ctx context.Context, w io.Writer, req *query.ProxyRequest) (int64, error) {
+			f.queries++
+			n, err := w.Write([]byte("result\n"))
+			return int64(n), err
+		},
+	}
+	return f
+}
+
+func (f *fixture) query(t *testing.T, q string, auth *platform.Authorization) string {
+	t.Helper()
+	req := &query.ProxyRequest{
+		Request: query.Request{
+			Authorization:  auth,
+			OrganizationID: orgID,
+			Compiler:       lang.FluxCompiler{Query: q},
+		},
+		Dialect: csv.DefaultDialect(),
+	}
+	var buf bytes.Buffer
+	if _, err := f.cache.Query(context.Background(), &buf, req); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func readAuthorization(t *testing.T) *platform.Authorization {
+	p, err := platform.NewOrgPermissionAtID(orgID, bucketID, platform.ReadAction, platform.BucketsResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &platform.Authorization{Status: platform.Active, Permissions: []platform.Permission{*p}}
+}
+
+func TestProxyQueryService_Query(t *testing.T) {
+	f := newFixture(1024)
+	reg := prom.NewRegistry()
+	reg.MustRegister(f.cache.PrometheusCollectors()...)
+	auth := readAuthorization(t)
+
+	if got := f.query(t, `from(bucket:"telegraf") |> range(start:-1h)`, auth); got != "result\n" {
+		t.Fatalf("got result %q", got)
+	}
+	// The same query, formatted differently.
+	if got := f.query(t, `from(bucket: "telegraf")
+	|> range(start: -1h)`, auth); got != "result\n" {
+		t.Fatalf("got cached result %q", got)
+	}
+	if f.queries != 1 {
+		t.Fatalf("got %d queries, want 1", f.queries)
+	}
+	// Cached results are found without looking up their buckets.
+	f.query(t, `from(bucket:"telegraf") |> range(start:-1h)`, auth)
+	if f.lookups != 1 {
+		t.Fatalf("got %d bucket lookups, want 1", f.lookups)
+	}
+
+	// Other time bounds are another query.
+	f.query(t, `from(bucket:"telegraf") |> range(start:-2h)`, auth)
+	if f.queries != 2 {
+		t.Fatalf("got %d queries, want 2", f.queries)
+	}
+
+	// Tokens without read permission on the bucket do not get cached results.
+	f.query(t, `from(bucket:"telegraf") |> range(start:-1h)`, &platform.Authorization{Status: platform.Active})
+	if f.queries != 3 {
+		t.Fatalf("got %d queries, want 3", f.queries)
+	}
+
+	mfs := promtest.MustGather(t, reg)
+	for result, want := range map[string]float64{"hit": 2, "miss": 3} {
+		m := promtest.MustFindMetric(t, mfs, "query_cache_requests_total", map[string]string{"result": result})
+		if got := m.GetCounter().GetValue(); got != want {
+			t.Errorf("got %v %s requests, want %v", got, result, want)
+		}
+	}
+}
+
+func TestProxyQueryService_Invalidate(t *testing.T) {
+	f := newFixture(1024)
+	auth := readAuthorization(t)
+	q := `from(bucket:"telegraf") |> range(start:-1h)`
+
+	f.query(t, q, auth)
+	f.query(t, q, auth)
+	if f.queries != 1 {
+		t.Fatalf("got %d queries, want 1", f.queries)
+	}
+
+	pw := f.cache.PointsWriter(&mock.PointsWriter{})
+	points, err := models.ParsePointsString("cpu value=1 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Writes to other buckets keep the result.
+	exploded, err := tsdb.ExplodePoints(orgID, bucketID+1, points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pw.WritePoints(exploded); err != nil {
+		t.Fatal(err)
+	}
+	f.query(t, q, auth)
+	if f.queries != 1 {
+		t.Fatalf("got %d queries after a write to another bucket, want 1", f.queries)
+	}
+
+	exploded, err = tsdb.ExplodePoints(orgID, bucketID, points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pw.WritePoints(exploded); err != nil {
+		t.Fatal(err)
+	}
+	f.query(t, q, auth)
+	if f.queries != 2 {
+		t.Fatalf("got %d queries after a write to the bucket, want 2", f.queries)
+	}
+}
+
+func TestProxyQueryService_BucketService(t *testing.T) {
+	auth := readAuthorization(t)
+	q := `from(bucket:"telegraf") |> range(start:-1h)`
+	name := "renamed"
+
+	for _, tt := range []struct {
+		name string
+		fn   func(bs platform.BucketService) error
+	}{
+		{
+			name: "update",
+			fn: func(bs platform.BucketService) error {
+				_, err := bs.UpdateBucket(context.Background(), bucketID, platform.BucketUpdate{Name: &name})
+				return err
+			},
+		},
+		{
+			name: "delete",
+			fn: func(bs platform.BucketService) error {
+				return bs.DeleteBucket(context.Background(), bucketID)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(1024)
+			f.query(t, q, auth)
+			if err := tt.fn(f.cache.BucketService(f.buckets)); err != nil {
+				t.Fatal(err)
+			}
+			f.query(t, q, auth)
+			if f.queries != 2 {
+				t.Fatalf("got %d queries after the bucket changed, want 2", f.queries)
+			}
+		})
+	}
+}
+
+func TestProxyQueryService_InvalidateAll(t *testing.T) {
+	f := newFixture(1024)
+	auth := readAuthorization(t)
+	q := `from(bucket:"telegraf") |> range(start:-1h)`
+
+	f.query(t, q, auth)
+	f.cache.InvalidateAll()
+	f.query(t, q, auth)
+	if f.queries != 2 {
+		t.Fatalf("got %d queries after invalidating all results, want 2", f.queries)
+	}
+}
+
+func TestProxyQueryService_MaxBytes(t *testing.T) {
+	// Room for a single result.
+	f := newFixture(10)
+	auth := readAuthorization(t)
+
+	f.query(t, `from(bucket:"telegraf") |> range(start:-1h)`, auth)
+	f.query(t, `from(bucket:"telegraf") |> range(start:-2h)`, auth)
+	f.query(t, `from(bucket:"telegraf") |> range(start:-2h)`, auth)
+	if f.queries != 2 {
+		t.Fatalf("got %d queries, want 2", f.queries)
+	}
+	f.query(t, `from(bucket:"telegraf") |> range(start:-1h)`, auth)
+	if f.queries != 3 {
+		t.Fatalf("got %d queries for an evicted result, want 3", f.queries)
+	}
+}
+
+func TestProxyQueryService_Bypass(t *testing.T) {
+	f := newFixture(1024)
+	auth := readAuthorization(t)
+
+	for _, q := range []string{
+		`from(bucket:"telegraf") |> range(start:-1h) |> to(bucket:"other", org:"org")`,
+		`buckets()`,
+		`from(bucket:"missing") |> range(start:-1h)`,
+	} {
+		f.queries, f.lookups = 0, 0
+		f.query(t, q, auth)
+		f.query(t, q, auth)
+		if f.queries != 2 {
+			t.Errorf("got %d queries for %s, want it to bypass the cache", f.queries, q)
+		}
+		// Queries that cannot be cached are compiled once.
+		if f.lookups > 1 {
+			t.Errorf("got %d bucket lookups for %s, want at most 1", f.lookups, q)
+		}
+	}
+}
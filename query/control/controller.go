@@ -3,6 +3,10 @@ package control
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/control"
@@ -15,15 +19,30 @@ import (
 const orgLabel = "org"
 
 // Controller implements AsyncQueryService by consuming a control.Controller.
+// It also implements ActiveQueryService for the queries it is executing.
 type Controller struct {
 	c *control.Controller
+
+	// OrganizationSettingsService provides the query quota of each organization.
+	// Queries are only limited by the global quotas of the controller when it is nil.
+	OrganizationSettingsService platform.OrganizationSettingsService
+
+	mu     sync.RWMutex
+	active map[platform.ID]*activeQuery
+	orgs   map[platform.ID]*orgQueries
 }
 
+var _ query.ActiveQueryService = (*Controller)(nil)
+
 // NewController creates a new Controller specific to platform.
 func New(config control.Config) *Controller {
 	config.MetricLabelKeys = append(config.MetricLabelKeys, orgLabel)
 	c := control.New(config)
-	return &Controller{c: c}
+	return &Controller{
+		c:      c,
+		active: make(map[platform.ID]*activeQuery),
+		orgs:   make(map[platform.ID]*orgQueries),
+	}
 }
 
 // Query satisfies the AsyncQueryService while ensuring the request is propagated on the context.
@@ -32,8 +51,18 @@ func (c *Controller) Query(ctx context.Context, req *query.Request) (flux.Query,
 	ctx = query.ContextWithRequest(ctx, req)
 	// Set the org label value for controller metrics
 	ctx = context.WithValue(ctx, orgLabel, req.OrganizationID.String())
+
+	quota, err := c.queryQuota(ctx, req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.admit(ctx, req.OrganizationID, quota); err != nil {
+		return nil, err
+	}
+
 	q, err := c.c.Query(ctx, req.Compiler)
 	if err != nil {
+		c.release(req.OrganizationID)
 		// If the controller reports an error, it's usually because of a syntax error
 		// or other problem that the client must fix.
 		return q, &platform.Error{
@@ -42,7 +71,147 @@ func (c *Controller) Query(ctx context.Context, req *query.Request) (flux.Query,
 		}
 	}
 
-	return q, nil
+	aq := &activeQuery{
+		Query: q,
+		req:   req,
+		start: time.Now().UTC(),
+		c:     c,
+		done:  make(chan struct{}),
+	}
+	// The ID of the underlying query identifies it among the active queries.
+	if idq, ok := q.(interface{ ID() control.QueryID }); ok {
+		aq.id = platform.ID(idq.ID())
+		c.mu.Lock()
+		c.active[aq.id] = aq
+		c.mu.Unlock()
+	}
+	aq.enforce(quota)
+	return aq, nil
+}
+
+// FindActiveQueries returns the queries being executed that match filter.
+func (c *Controller) FindActiveQueries(ctx context.Context, filter query.ActiveQueryFilter) ([]*query.ActiveQuery, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	qs := make([]*query.ActiveQuery, 0, len(c.active))
+	for _, aq := range c.active {
+		if filter.OrganizationID != nil && *filter.OrganizationID != aq.req.OrganizationID {
+			continue
+		}
+		qs = append(qs, aq.describe())
+	}
+	sort.Slice(qs, func(i, j int) bool {
+		return qs[i].ID < qs[j].ID
+	})
+	return qs, nil
+}
+
+// FindActiveQueryByID returns the query being executed with id.
+func (c *Controller) FindActiveQueryByID(ctx context.Context, id platform.ID) (*query.ActiveQuery, error) {
+	c.mu.RLock()
+	aq, ok := c.active[id]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   "query/control.FindActiveQueryByID",
+			Msg:  "query not found",
+		}
+	}
+	return aq.describe(), nil
+}
+
+// CancelQuery stops the execution of the query with id.
+func (c *Controller) CancelQuery(ctx context.Context, id platform.ID) error {
+	c.mu.RLock()
+	aq, ok := c.active[id]
+	c.mu.RUnlock()
+	if !ok {
+		return &platform.Error{
+			Code: platform.ENotFound,
+			Op:   "query/control.CancelQuery",
+			Msg:  "query not found",
+		}
+	}
+	aq.Cancel()
+	return nil
+}
+
+// activeQuery tracks a query of the controller until it is done.
+type activeQuery struct {
+	flux.Query
+
+	id    platform.ID
+	req   *query.Request
+	start time.Time
+	c     *Controller
+
+	// done is closed once the query is done.
+	done     chan struct{}
+	doneOnce sync.Once
+
+	mu sync.Mutex
+	// quotaErr is the error of a quota the query exceeded.
+	quotaErr error
+}
+
+// Done removes the query from the active queries and frees its resources.
+func (q *activeQuery) Done() {
+	q.doneOnce.Do(func() {
+		q.c.mu.Lock()
+		delete(q.c.active, q.id)
+		q.c.mu.Unlock()
+		close(q.done)
+		q.c.release(q.req.OrganizationID)
+	})
+	q.Query.Done()
+}
+
+// Err reports the quota exceeded by the query, if any, or the error of the query.
+func (q *activeQuery) Err() error {
+	q.mu.Lock()
+	err := q.quotaErr
+	q.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return q.Query.Err()
+}
+
+func (q *activeQuery) describe() *query.ActiveQuery {
+	aq := &query.ActiveQuery{
+		ID:             q.id,
+		OrganizationID: q.req.OrganizationID,
+		CompilerType:   q.req.Compiler.CompilerType(),
+		Query:          compilerText(q.req.Compiler),
+		StartTime:      q.start,
+		MaxAllocated:   q.Statistics().MaxAllocated,
+	}
+	if q.req.Authorization != nil {
+		aq.UserID = q.req.Authorization.UserID
+	}
+	if sq, ok := q.Query.(interface{ State() control.State }); ok {
+		aq.State = sq.State().String()
+	}
+	return aq
+}
+
+// compilerText returns the query text of the compiler c.
+// Compilers of query languages hold their text in a query field;
+// the whole compiler describes any other query.
+func compilerText(c flux.Compiler) string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	var text struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(data, &text); err == nil && text.Query != "" {
+		return text.Query
+	}
+	return string(data)
 }
 
 // PrometheusCollectors satisifies the prom.PrometheusCollector interface.
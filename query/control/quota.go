@@ -0,0 +1,185 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+// memoryCheckInterval is how often the memory used by the queries of an organization
+// is compared with its quota.
+var memoryCheckInterval = 100 * time.Millisecond
+
+// orgQueries counts the queries of an organization admitted by the controller.
+type orgQueries struct {
+	running int
+	queued  int
+	// freed is closed, and replaced, each time a running query finishes.
+	freed chan struct{}
+}
+
+// queryQuota returns the query quota of the organization with orgID.
+func (c *Controller) queryQuota(ctx context.Context, orgID platform.ID) (platform.QueryQuota, error) {
+	if c.OrganizationSettingsService == nil {
+		return platform.QueryQuota{}, nil
+	}
+	s, err := c.OrganizationSettingsService.FindOrganizationSettings(ctx, orgID)
+	if err != nil {
+		return platform.QueryQuota{}, &platform.Error{
+			Op:  "query/control.queryQuota",
+			Msg: "failed to find the query quota of the organization",
+			Err: err,
+		}
+	}
+	return s.QueryQuota, nil
+}
+
+// admit waits until the organization with orgID may run another query.
+// It fails if too many queries of the organization are waiting already,
+// or if ctx is done first.
+func (c *Controller) admit(ctx context.Context, orgID platform.ID, quota platform.QueryQuota) error {
+	c.mu.Lock()
+	o, ok := c.orgs[orgID]
+	if !ok {
+		o = &orgQueries{freed: make(chan struct{})}
+		c.orgs[orgID] = o
+	}
+	for quota.MaxConcurrentQueries > 0 && o.running >= quota.MaxConcurrentQueries {
+		if quota.MaxQueuedQueries > 0 && o.queued >= quota.MaxQueuedQueries {
+			c.forget(orgID, o)
+			c.mu.Unlock()
+			return &platform.Error{
+				Code: platform.ETooManyRequests,
+				Op:   "query/control.admit",
+				Msg: fmt.Sprintf("organization %s has reached its limit of %d running and %d queued queries",
+					orgID, quota.MaxConcurrentQueries, quota.MaxQueuedQueries),
+			}
+		}
+
+		o.queued++
+		freed := o.freed
+		c.mu.Unlock()
+		select {
+		case <-freed:
+		case <-ctx.Done():
+			c.mu.Lock()
+			o.queued--
+			c.forget(orgID, o)
+			c.mu.Unlock()
+			return ctx.Err()
+		}
+		c.mu.Lock()
+		o.queued--
+	}
+	o.running++
+	c.mu.Unlock()
+	return nil
+}
+
+// release frees the place of a finished query of the organization with orgID.
+func (c *Controller) release(orgID platform.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	o, ok := c.orgs[orgID]
+	if !ok {
+		return
+	}
+	o.running--
+	close(o.freed)
+	o.freed = make(chan struct{})
+	c.forget(orgID, o)
+}
+
+// forget removes the counts of an organization without queries.
+// It must be called with the lock held.
+func (c *Controller) forget(orgID platform.ID, o *orgQueries) {
+	if o.running == 0 && o.queued == 0 {
+		delete(c.orgs, orgID)
+	}
+}
+
+// enforce cancels the query once it exceeds the duration or memory of quota.
+func (q *activeQuery) enforce(quota platform.QueryQuota) {
+	if d := quota.MaxQueryDuration; d > 0 {
+		t := time.AfterFunc(d, func() {
+			q.exceed(&platform.Error{
+				Code: platform.ETooManyRequests,
+				Op:   "query/control.enforce",
+				Msg:  fmt.Sprintf("query exceeded the limit of %s per query of its organization", d),
+			})
+		})
+		go func() {
+			<-q.done
+			t.Stop()
+		}()
+	}
+
+	if limit := quota.MaxMemoryBytes; limit > 0 {
+		go q.watchMemory(limit)
+	}
+}
+
+// watchMemory cancels the query when the running queries of its organization allocate more than limit bytes,
+// and it is the most recent of them.
+func (q *activeQuery) watchMemory(limit int64) {
+	ticker := time.NewTicker(memoryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-ticker.C:
+		}
+
+		if q.c.exceedsMemory(q, limit) {
+			q.exceed(&platform.Error{
+				Code: platform.ETooManyRequests,
+				Op:   "query/control.watchMemory",
+				Msg:  fmt.Sprintf("queries of the organization exceeded the limit of %d allocated bytes", limit),
+			})
+			return
+		}
+	}
+}
+
+// exceedsMemory reports whether the queries of the organization of q allocate more than limit bytes,
+// and q is the most recent of them.
+// The most memory allocated at once by each query is counted.
+func (c *Controller) exceedsMemory(q *activeQuery, limit int64) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var total int64
+	newest := q
+	for _, aq := range c.active {
+		if aq.req.OrganizationID != q.req.OrganizationID || aq.exceeded() {
+			continue
+		}
+		total += aq.Statistics().MaxAllocated
+		if aq.start.After(newest.start) {
+			newest = aq
+		}
+	}
+	return total > limit && newest == q
+}
+
+// exceed cancels the query because of err.
+func (q *activeQuery) exceed(err error) {
+	q.mu.Lock()
+	if q.quotaErr == nil {
+		q.quotaErr = err
+	}
+	q.mu.Unlock()
+	q.Cancel()
+}
+
+// exceeded reports whether the query exceeded a quota.
+func (q *activeQuery) exceeded() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.quotaErr != nil
+}
@@ -0,0 +1,178 @@
+package control
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/query"
+)
+
+func newTestController() *Controller {
+	return &Controller{
+		active: make(map[platform.ID]*activeQuery),
+		orgs:   make(map[platform.ID]*orgQueries),
+	}
+}
+
+func TestController_admit(t *testing.T) {
+	c := newTestController()
+	ctx := context.Background()
+	orgID, otherOrgID := platform.ID(1), platform.ID(2)
+	quota := platform.QueryQuota{MaxConcurrentQueries: 1, MaxQueuedQueries: 1}
+
+	if err := c.admit(ctx, orgID, quota); err != nil {
+		t.Fatal(err)
+	}
+
+	queued := make(chan error, 1)
+	go func() {
+		queued <- c.admit(ctx, orgID, quota)
+	}()
+	waitFor(t, func() bool {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		return c.orgs[orgID].queued == 1
+	})
+
+	if err := c.admit(ctx, orgID, quota); platform.ErrorCode(err) != platform.ETooManyRequests {
+		t.Fatalf("got error %v, want code %q", err, platform.ETooManyRequests)
+	}
+	if err := c.admit(ctx, otherOrgID, quota); err != nil {
+		t.Fatalf("query of another organization was not admitted: %v", err)
+	}
+
+	c.release(orgID)
+	select {
+	case err := <-queued:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("queued query was not admitted after a query finished")
+	}
+
+	c.release(orgID)
+	c.release(otherOrgID)
+	if len(c.orgs) != 0 {
+		t.Fatalf("got counts of %d organizations without queries, want none", len(c.orgs))
+	}
+}
+
+func TestController_admitCanceled(t *testing.T) {
+	c := newTestController()
+	orgID := platform.ID(1)
+	quota := platform.QueryQuota{MaxConcurrentQueries: 1}
+
+	if err := c.admit(context.Background(), orgID, quota); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := c.admit(ctx, orgID, quota); err != context.DeadlineExceeded {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	c.mu.RLock()
+	o := c.orgs[orgID]
+	c.mu.RUnlock()
+	if o.running != 1 || o.queued != 0 {
+		t.Fatalf("got %d running and %d queued queries, want 1 and 0", o.running, o.queued)
+	}
+}
+
+func TestActiveQuery_enforce(t *testing.T) {
+	c := newTestController()
+	orgID := platform.ID(1)
+	start := time.Now()
+
+	newQuery := func(id platform.ID, allocated int64, started time.Time) (*activeQuery, *fakeQuery) {
+		fq := &fakeQuery{stats: flux.Statistics{MaxAllocated: allocated}}
+		aq := &activeQuery{
+			Query: fq,
+			id:    id,
+			req:   &query.Request{OrganizationID: orgID},
+			start: started,
+			c:     c,
+			done:  make(chan struct{}),
+		}
+		c.mu.Lock()
+		c.active[id] = aq
+		c.mu.Unlock()
+		if err := c.admit(context.Background(), orgID, platform.QueryQuota{}); err != nil {
+			t.Fatal(err)
+		}
+		return aq, fq
+	}
+
+	old, oldq := newQuery(1, 600, start)
+	recent, recentq := newQuery(2, 600, start.Add(time.Second))
+	defer old.Done()
+	defer recent.Done()
+
+	quota := platform.QueryQuota{MaxMemoryBytes: 1000}
+	old.enforce(quota)
+	recent.enforce(quota)
+
+	waitFor(t, recentq.isCanceled)
+	if platform.ErrorCode(recent.Err()) != platform.ETooManyRequests {
+		t.Fatalf("got error %v, want code %q", recent.Err(), platform.ETooManyRequests)
+	}
+
+	time.Sleep(3 * memoryCheckInterval)
+	if oldq.isCanceled() {
+		t.Fatal("older query was canceled although the organization is within its memory quota without the recent one")
+	}
+	if err := old.Err(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	short, shortq := newQuery(3, 0, start)
+	defer short.Done()
+	short.enforce(platform.QueryQuota{MaxQueryDuration: time.Millisecond})
+	waitFor(t, shortq.isCanceled)
+	if platform.ErrorCode(short.Err()) != platform.ETooManyRequests {
+		t.Fatalf("got error %v, want code %q", short.Err(), platform.ETooManyRequests)
+	}
+}
+
+// waitFor fails the test if cond is not true within a second.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+type fakeQuery struct {
+	flux.Query
+
+	stats flux.Statistics
+
+	mu       sync.Mutex
+	canceled bool
+}
+
+func (q *fakeQuery) Statistics() flux.Statistics { return q.stats }
+func (q *fakeQuery) Err() error                  { return nil }
+func (q *fakeQuery) Done()                       {}
+
+func (q *fakeQuery) Cancel() {
+	q.mu.Lock()
+	q.canceled = true
+	q.mu.Unlock()
+}
+
+func (q *fakeQuery) isCanceled() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.canceled
+}
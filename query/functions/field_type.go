@@ -0,0 +1,153 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+)
+
+// FieldTypeKind is the kind of the `fieldType` function.
+// It replaces each non-empty table with a single row whose `_value` column is the
+// InfluxQL name of the type of the table's `_value` column, e.g. "float" or "integer".
+const FieldTypeKind = "fieldType"
+
+// FieldTypeOpSpec is the flux.OperationSpec for the `fieldType` function.
+type FieldTypeOpSpec struct{}
+
+func init() {
+	fieldTypeSignature := flux.FunctionSignature(nil, nil)
+
+	flux.RegisterFunction(FieldTypeKind, createFieldTypeOpSpec, fieldTypeSignature)
+	flux.RegisterOpSpec(FieldTypeKind, newFieldTypeOp)
+	plan.RegisterProcedureSpec(FieldTypeKind, newFieldTypeProcedure, FieldTypeKind)
+	execute.RegisterTransformation(FieldTypeKind, createFieldTypeTransformation)
+}
+
+func createFieldTypeOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	return new(FieldTypeOpSpec), nil
+}
+
+func newFieldTypeOp() flux.OperationSpec {
+	return new(FieldTypeOpSpec)
+}
+
+func (s *FieldTypeOpSpec) Kind() flux.OperationKind {
+	return FieldTypeKind
+}
+
+// FieldTypeProcedureSpec is the plan.ProcedureSpec for the `fieldType` function.
+type FieldTypeProcedureSpec struct {
+	plan.DefaultCost
+}
+
+func newFieldTypeProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	if _, ok := qs.(*FieldTypeOpSpec); !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return new(FieldTypeProcedureSpec), nil
+}
+
+func (s *FieldTypeProcedureSpec) Kind() plan.ProcedureKind {
+	return FieldTypeKind
+}
+
+func (s *FieldTypeProcedureSpec) Copy() plan.ProcedureSpec {
+	return new(FieldTypeProcedureSpec)
+}
+
+func createFieldTypeTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	if _, ok := spec.(*FieldTypeProcedureSpec); !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewFieldTypeTransformation(d, cache)
+	return t, d, nil
+}
+
+// NewFieldTypeTransformation returns the transformation of the `fieldType` function.
+func NewFieldTypeTransformation(d execute.Dataset, cache execute.TableBuilderCache) execute.Transformation {
+	return &fieldTypeTransformation{d: d, cache: cache}
+}
+
+type fieldTypeTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+}
+
+func (t *fieldTypeTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *fieldTypeTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	idx := execute.ColIdx(execute.DefaultValueColLabel, tbl.Cols())
+	if idx < 0 {
+		return fmt.Errorf("fieldType: no column %q", execute.DefaultValueColLabel)
+	}
+	typ, err := fieldTypeName(tbl.Cols()[idx].Type)
+	if err != nil {
+		return err
+	}
+
+	n := 0
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		n += cr.Len()
+		return nil
+	}); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("fieldType found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+		return err
+	}
+	valueIdx, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultValueColLabel, Type: flux.TString})
+	if err != nil {
+		return err
+	}
+	if err := execute.AppendKeyValues(tbl.Key(), builder); err != nil {
+		return err
+	}
+	return builder.AppendString(valueIdx, typ)
+}
+
+// fieldTypeName returns the name InfluxQL uses for fields with values of type typ.
+func fieldTypeName(typ flux.ColType) (string, error) {
+	switch typ {
+	case flux.TFloat:
+		return "float", nil
+	case flux.TInt:
+		return "integer", nil
+	case flux.TUInt:
+		return "unsigned", nil
+	case flux.TString:
+		return "string", nil
+	case flux.TBool:
+		return "boolean", nil
+	default:
+		return "", fmt.Errorf("fieldType: unsupported value type %s", typ)
+	}
+}
+
+func (t *fieldTypeTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *fieldTypeTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *fieldTypeTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,174 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// FillTagsKind is the kind of the `fillTags` function.
+// It adds each of the tags to the group key of the tables without it, with an empty value.
+// InfluxQL considers a tag that a series does not have to be empty; after fillTags,
+// a filter may refer to the tags of any series without failing on the tables that lack them.
+const FillTagsKind = "fillTags"
+
+// FillTagsOpSpec is the flux.OperationSpec for the `fillTags` function.
+type FillTagsOpSpec struct {
+	Tags []string `json:"tags"`
+}
+
+func init() {
+	fillTagsSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"tags": semantic.NewArrayPolyType(semantic.String),
+		},
+		[]string{"tags"},
+	)
+
+	flux.RegisterFunction(FillTagsKind, createFillTagsOpSpec, fillTagsSignature)
+	flux.RegisterOpSpec(FillTagsKind, newFillTagsOp)
+	plan.RegisterProcedureSpec(FillTagsKind, newFillTagsProcedure, FillTagsKind)
+	execute.RegisterTransformation(FillTagsKind, createFillTagsTransformation)
+}
+
+func createFillTagsOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	array, err := args.GetRequiredArray("tags", semantic.String)
+	if err != nil {
+		return nil, err
+	}
+	spec := new(FillTagsOpSpec)
+	if spec.Tags, err = interpreter.ToStringArray(array); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func newFillTagsOp() flux.OperationSpec {
+	return new(FillTagsOpSpec)
+}
+
+func (s *FillTagsOpSpec) Kind() flux.OperationKind {
+	return FillTagsKind
+}
+
+// FillTagsProcedureSpec is the plan.ProcedureSpec for the `fillTags` function.
+type FillTagsProcedureSpec struct {
+	plan.DefaultCost
+	Tags []string
+}
+
+func newFillTagsProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FillTagsOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &FillTagsProcedureSpec{Tags: spec.Tags}, nil
+}
+
+func (s *FillTagsProcedureSpec) Kind() plan.ProcedureKind {
+	return FillTagsKind
+}
+
+func (s *FillTagsProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(FillTagsProcedureSpec)
+	ns.Tags = make([]string, len(s.Tags))
+	copy(ns.Tags, s.Tags)
+	return ns
+}
+
+func createFillTagsTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*FillTagsProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewFillTagsTransformation(d, cache, s)
+	return t, d, nil
+}
+
+// NewFillTagsTransformation returns the transformation of the `fillTags` function.
+func NewFillTagsTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *FillTagsProcedureSpec) execute.Transformation {
+	return &fillTagsTransformation{d: d, cache: cache, tags: spec.Tags}
+}
+
+type fillTagsTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	tags []string
+}
+
+func (t *fillTagsTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *fillTagsTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	key := tbl.Key()
+	var missing []string
+	for _, tag := range t.tags {
+		if !execute.HasCol(tag, tbl.Cols()) {
+			missing = append(missing, tag)
+		}
+	}
+	if len(missing) > 0 {
+		cols := append([]flux.ColMeta(nil), key.Cols()...)
+		vs := append([]values.Value(nil), key.Values()...)
+		for _, tag := range missing {
+			cols = append(cols, flux.ColMeta{Label: tag, Type: flux.TString})
+			vs = append(vs, values.NewString(""))
+		}
+		key = execute.NewGroupKey(cols, vs)
+	}
+
+	builder, created := t.cache.TableBuilder(key)
+	if !created {
+		return fmt.Errorf("fillTags found duplicate table with key: %v", key)
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+	for _, tag := range missing {
+		if _, err := builder.AddCol(flux.ColMeta{Label: tag, Type: flux.TString}); err != nil {
+			return err
+		}
+	}
+
+	n := len(tbl.Cols())
+	return tbl.DoArrow(func(cr flux.ArrowColReader) error {
+		for j := range cr.Cols() {
+			if err := execute.AppendColArrow(j, j, cr, builder); err != nil {
+				return err
+			}
+		}
+		for j := range missing {
+			for i := 0; i < cr.Len(); i++ {
+				if err := builder.AppendString(n+j, ""); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (t *fillTagsTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *fillTagsTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *fillTagsTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
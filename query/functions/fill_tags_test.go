@@ -0,0 +1,118 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func TestFillTags_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *functions.FillTagsProcedureSpec
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "missing tag",
+			spec: &functions.FillTagsProcedureSpec{Tags: []string{"host"}},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu", 2.0},
+					{execute.Time(2), "cpu", 3.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu", 2.0, ""},
+					{execute.Time(2), "cpu", 3.0, ""},
+				},
+			}},
+		},
+		{
+			name: "present tag",
+			spec: &functions.FillTagsProcedureSpec{Tags: []string{"host", "region"}},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu", "server01", 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "host", "region"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "region", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu", "server01", 2.0, ""},
+				},
+			}},
+		},
+		{
+			name: "no missing tags",
+			spec: &functions.FillTagsProcedureSpec{Tags: []string{"host"}},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "server01", int64(2)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "server01", int64(2)},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				nil,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return functions.NewFillTagsTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
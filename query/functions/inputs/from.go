@@ -27,22 +27,27 @@ func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execu
 		return nil, errors.New("nil bounds passed to from")
 	}
 
+	duration := execute.Duration(bounds.Stop) - execute.Duration(bounds.Start)
+	w = execute.Window{
+		Every:  duration,
+		Period: duration,
+		Start:  bounds.Start,
+	}
+	currentTime := w.Start + execute.Time(w.Period)
+
+	// Windows are read in a single pass over the bounds, see storage.ReadSpec.
+	var windowEvery, windowOffset int64
 	if spec.WindowSet {
-		w = execute.Window{
-			Every:  execute.Duration(spec.Window.Every),
-			Period: execute.Duration(spec.Window.Period),
-			Round:  execute.Duration(spec.Window.Round),
-			Start:  bounds.Start,
+		every := execute.Duration(spec.Window.Every)
+		if every <= 0 || spec.Window.Period != spec.Window.Every || spec.Window.Round != 0 {
+			return nil, fmt.Errorf("unsupported window for from: %v", spec.Window)
 		}
-	} else {
-		duration := execute.Duration(bounds.Stop) - execute.Duration(bounds.Start)
-		w = execute.Window{
-			Every:  duration,
-			Period: duration,
-			Start:  bounds.Start,
+		windowEvery = int64(every)
+		if !spec.Window.Start.IsZero() {
+			start := a.ResolveTime(spec.Window.Start)
+			windowOffset = int64(start - start.Truncate(every))
 		}
 	}
-	currentTime := w.Start + execute.Time(w.Period)
 
 	deps := a.Dependencies()[inputs.FromKind].(storage.Dependencies)
 	req := query.RequestFromContext(a.Context())
@@ -82,6 +87,8 @@ func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execu
 			GroupMode:       storage.ToGroupMode(spec.GroupMode),
 			GroupKeys:       spec.GroupKeys,
 			AggregateMethod: spec.AggregateMethod,
+			WindowEvery:     windowEvery,
+			WindowOffset:    windowOffset,
 		},
 		*bounds,
 		w,
@@ -0,0 +1,441 @@
+package inputs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions/inputs/storage"
+)
+
+// The schema functions list the tag keys, tag values and measurements of a bucket
+// directly from the storage index, instead of reading and de-duplicating its points.
+const (
+	TagKeysKind      = "tagKeys"
+	TagValuesKind    = "tagValues"
+	MeasurementsKind = "measurements"
+)
+
+func init() {
+	params := func(extra map[string]semantic.PolyType) map[string]semantic.PolyType {
+		m := map[string]semantic.PolyType{
+			"bucket":   semantic.String,
+			"bucketID": semantic.String,
+			"start":    semantic.Tvar(1),
+			"stop":     semantic.Tvar(2),
+			"predicate": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{
+					"r": semantic.Tvar(3),
+				},
+				Required: semantic.LabelSet{"r"},
+				Return:   semantic.Bool,
+			}),
+		}
+		for k, v := range extra {
+			m[k] = v
+		}
+		return m
+	}
+
+	flux.RegisterFunction(TagKeysKind, createTagKeysOpSpec, semantic.FunctionPolySignature{
+		Parameters: params(map[string]semantic.PolyType{"byMeasurement": semantic.Bool}),
+		Return:     flux.TableObjectType,
+	})
+	flux.RegisterOpSpec(TagKeysKind, func() flux.OperationSpec { return new(TagKeysOpSpec) })
+	plan.RegisterProcedureSpec(TagKeysKind, newSchemaProcedure, TagKeysKind)
+	execute.RegisterSource(TagKeysKind, createSchemaSource)
+
+	flux.RegisterFunction(TagValuesKind, createTagValuesOpSpec, semantic.FunctionPolySignature{
+		Parameters: params(map[string]semantic.PolyType{"tag": semantic.String}),
+		Required:   semantic.LabelSet{"tag"},
+		Return:     flux.TableObjectType,
+	})
+	flux.RegisterOpSpec(TagValuesKind, func() flux.OperationSpec { return new(TagValuesOpSpec) })
+	plan.RegisterProcedureSpec(TagValuesKind, newSchemaProcedure, TagValuesKind)
+	execute.RegisterSource(TagValuesKind, createSchemaSource)
+
+	flux.RegisterFunction(MeasurementsKind, createMeasurementsOpSpec, semantic.FunctionPolySignature{
+		Parameters: params(nil),
+		Return:     flux.TableObjectType,
+	})
+	flux.RegisterOpSpec(MeasurementsKind, func() flux.OperationSpec { return new(MeasurementsOpSpec) })
+	plan.RegisterProcedureSpec(MeasurementsKind, newSchemaProcedure, MeasurementsKind)
+	execute.RegisterSource(MeasurementsKind, createSchemaSource)
+}
+
+// SchemaSpec holds the arguments shared by the schema functions.
+type SchemaSpec struct {
+	Bucket    string                       `json:"bucket,omitempty"`
+	BucketID  string                       `json:"bucketID,omitempty"`
+	Start     flux.Time                    `json:"start"`
+	Stop      flux.Time                    `json:"stop"`
+	Predicate *semantic.FunctionExpression `json:"predicate,omitempty"`
+}
+
+// readArgs reads the shared arguments of the schema functions.
+// Without a start, every point of the bucket is considered; stop defaults to now.
+func (s *SchemaSpec) readArgs(args flux.Arguments) error {
+	var err error
+	var ok bool
+
+	if s.Bucket, ok, err = args.GetString("bucket"); err != nil {
+		return err
+	}
+	if s.BucketID, _, err = args.GetString("bucketID"); err != nil {
+		return err
+	}
+	if ok && s.BucketID != "" {
+		return errors.New("must specify only one of bucket or bucketID")
+	}
+	if s.Bucket == "" && s.BucketID == "" {
+		return errors.New("must specify one of bucket or bucketID")
+	}
+
+	if s.Start, _, err = args.GetTime("start"); err != nil {
+		return err
+	}
+	if s.Stop, ok, err = args.GetTime("stop"); err != nil {
+		return err
+	} else if !ok {
+		s.Stop = flux.Now
+	}
+
+	if fn, ok, err := args.GetFunction("predicate"); err != nil {
+		return err
+	} else if ok {
+		if s.Predicate, err = interpreter.ResolveFunction(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s SchemaSpec) copy() SchemaSpec {
+	ns := s
+	if s.Predicate != nil {
+		ns.Predicate = s.Predicate.Copy().(*semantic.FunctionExpression)
+	}
+	return ns
+}
+
+// TagKeysOpSpec is the flux.OperationSpec for the `tagKeys` flux function.
+type TagKeysOpSpec struct {
+	SchemaSpec
+
+	// ByMeasurement lists the tag keys of each measurement in its own table,
+	// grouped by the _measurement column, instead of listing them in a single table.
+	ByMeasurement bool `json:"byMeasurement,omitempty"`
+}
+
+func createTagKeysOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(TagKeysOpSpec)
+	if err := spec.readArgs(args); err != nil {
+		return nil, err
+	}
+
+	if byMeasurement, ok, err := args.GetBool("byMeasurement"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ByMeasurement = byMeasurement
+	}
+	return spec, nil
+}
+
+func (s *TagKeysOpSpec) Kind() flux.OperationKind {
+	return TagKeysKind
+}
+
+// TagValuesOpSpec is the flux.OperationSpec for the `tagValues` flux function.
+type TagValuesOpSpec struct {
+	SchemaSpec
+	Tag string `json:"tag"`
+}
+
+func createTagValuesOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(TagValuesOpSpec)
+	if err := spec.readArgs(args); err != nil {
+		return nil, err
+	}
+
+	tag, err := args.GetRequiredString("tag")
+	if err != nil {
+		return nil, err
+	}
+	spec.Tag = tag
+	return spec, nil
+}
+
+func (s *TagValuesOpSpec) Kind() flux.OperationKind {
+	return TagValuesKind
+}
+
+// MeasurementsOpSpec is the flux.OperationSpec for the `measurements` flux function.
+type MeasurementsOpSpec struct {
+	SchemaSpec
+}
+
+func createMeasurementsOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(MeasurementsOpSpec)
+	if err := spec.readArgs(args); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (s *MeasurementsOpSpec) Kind() flux.OperationKind {
+	return MeasurementsKind
+}
+
+// SchemaProcedureSpec is the procedure spec of the schema functions.
+type SchemaProcedureSpec struct {
+	plan.DefaultCost
+	SchemaSpec
+
+	// Tag is the tag key whose values are listed by `tagValues`.
+	Tag string
+
+	// ByMeasurement lists the values of each measurement in its own table.
+	ByMeasurement bool
+
+	kind plan.ProcedureKind
+}
+
+func newSchemaProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	switch spec := qs.(type) {
+	case *TagKeysOpSpec:
+		return &SchemaProcedureSpec{SchemaSpec: spec.SchemaSpec, ByMeasurement: spec.ByMeasurement, kind: TagKeysKind}, nil
+	case *TagValuesOpSpec:
+		return &SchemaProcedureSpec{SchemaSpec: spec.SchemaSpec, Tag: spec.Tag, kind: TagValuesKind}, nil
+	case *MeasurementsOpSpec:
+		return &SchemaProcedureSpec{SchemaSpec: spec.SchemaSpec, kind: MeasurementsKind}, nil
+	default:
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+}
+
+func (s *SchemaProcedureSpec) Kind() plan.ProcedureKind {
+	return s.kind
+}
+
+func (s *SchemaProcedureSpec) Copy() plan.ProcedureSpec {
+	return &SchemaProcedureSpec{
+		SchemaSpec:    s.SchemaSpec.copy(),
+		Tag:           s.Tag,
+		ByMeasurement: s.ByMeasurement,
+		kind:          s.kind,
+	}
+}
+
+// SchemaDecoder produces a table of the values listed by a schema function,
+// or a table per measurement when the values are listed by measurement.
+type SchemaDecoder struct {
+	ctx          context.Context
+	spec         *SchemaProcedureSpec
+	reader       storage.Reader
+	readSpec     storage.ReadSpec
+	start, stop  execute.Time
+	values       []string
+	measurements []string
+	measurement  string
+	fetched      bool
+	alloc        *memory.Allocator
+}
+
+func (sd *SchemaDecoder) Connect() error {
+	return nil
+}
+
+func (sd *SchemaDecoder) Fetch() (bool, error) {
+	if sd.spec.ByMeasurement {
+		return sd.fetchMeasurement()
+	}
+
+	// The values are listed in a single table, so they are only fetched once.
+	if sd.fetched {
+		return false, nil
+	}
+	sd.fetched = true
+
+	var err error
+	switch sd.spec.kind {
+	case TagKeysKind:
+		sd.values, err = sd.reader.TagKeys(sd.ctx, sd.readSpec, sd.start, sd.stop)
+	case TagValuesKind:
+		sd.values, err = sd.reader.TagValues(sd.ctx, sd.readSpec, sd.spec.Tag, sd.start, sd.stop)
+	case MeasurementsKind:
+		sd.values, err = sd.reader.MeasurementNames(sd.ctx, sd.readSpec, sd.start, sd.stop)
+	default:
+		err = fmt.Errorf("unknown schema function %q", sd.spec.kind)
+	}
+	return false, err
+}
+
+// fetchMeasurement fetches the values of the next measurement of the bucket,
+// listing the measurements on the first call. It reports whether there are values to decode.
+func (sd *SchemaDecoder) fetchMeasurement() (bool, error) {
+	if !sd.fetched {
+		sd.fetched = true
+		names, err := sd.reader.MeasurementNames(sd.ctx, sd.readSpec, sd.start, sd.stop)
+		if err != nil {
+			return false, err
+		}
+		sd.measurements = names
+	}
+
+	if len(sd.measurements) == 0 {
+		sd.measurement, sd.values = "", nil
+		return false, nil
+	}
+	sd.measurement, sd.measurements = sd.measurements[0], sd.measurements[1:]
+
+	predicate, err := measurementPredicate(sd.spec.Predicate, sd.measurement)
+	if err != nil {
+		return false, err
+	}
+	rs := sd.readSpec
+	rs.Predicate = predicate
+
+	switch sd.spec.kind {
+	case TagKeysKind:
+		sd.values, err = sd.reader.TagKeys(sd.ctx, rs, sd.start, sd.stop)
+	default:
+		err = fmt.Errorf("cannot list %q by measurement", sd.spec.kind)
+	}
+	return err == nil, err
+}
+
+func (sd *SchemaDecoder) Decode() (flux.Table, error) {
+	var key flux.GroupKey
+	if sd.measurement != "" {
+		key = execute.NewGroupKey(
+			[]flux.ColMeta{{Label: "_measurement", Type: flux.TString}},
+			[]values.Value{values.NewString(sd.measurement)},
+		)
+	} else {
+		key = execute.NewGroupKey(nil, nil)
+	}
+
+	b := execute.NewColListTableBuilder(key, sd.alloc)
+	if err := execute.AddTableKeyCols(key, b); err != nil {
+		return nil, err
+	}
+	valueIdx, err := b.AddCol(flux.ColMeta{
+		Label: execute.DefaultValueColLabel,
+		Type:  flux.TString,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range sd.values {
+		if err := execute.AppendKeyValues(key, b); err != nil {
+			return nil, err
+		}
+		_ = b.AppendString(valueIdx, v)
+	}
+
+	return b.Table()
+}
+
+// measurementPredicate returns predicate restricted to the series of the measurement name.
+func measurementPredicate(predicate *semantic.FunctionExpression, name string) (*semantic.FunctionExpression, error) {
+	param := "r"
+	if predicate != nil {
+		if params := predicate.Block.Parameters; params == nil || len(params.List) != 1 {
+			return nil, errors.New("predicate functions must have exactly one parameter")
+		}
+		param = predicate.Block.Parameters.List[0].Key.Name
+	}
+
+	var expr semantic.Expression = &semantic.BinaryExpression{
+		Operator: ast.EqualOperator,
+		Left: &semantic.MemberExpression{
+			Object:   &semantic.IdentifierExpression{Name: param},
+			Property: "_measurement",
+		},
+		Right: &semantic.StringLiteral{Value: name},
+	}
+	if predicate != nil {
+		body, ok := predicate.Block.Body.(semantic.Expression)
+		if !ok {
+			return nil, fmt.Errorf("unsupported predicate body %T", predicate.Block.Body)
+		}
+		expr = &semantic.LogicalExpression{
+			Operator: ast.AndOperator,
+			Left:     expr,
+			Right:    body.Copy().(semantic.Expression),
+		}
+	}
+
+	return &semantic.FunctionExpression{
+		Block: &semantic.FunctionBlock{
+			Parameters: &semantic.FunctionParameters{
+				List: []*semantic.FunctionParameter{
+					{Key: &semantic.Identifier{Name: param}},
+				},
+			},
+			Body: expr,
+		},
+	}, nil
+}
+
+func createSchemaSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*SchemaProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", prSpec)
+	}
+
+	// the schema functions read from the same storage as from
+	deps := a.Dependencies()[inputs.FromKind].(storage.Dependencies)
+	req := query.RequestFromContext(a.Context())
+	if req == nil {
+		return nil, errors.New("missing request on context")
+	}
+	orgID := req.OrganizationID
+
+	var bucketID platform.ID
+	switch {
+	case spec.Bucket != "":
+		b, ok := deps.BucketLookup.Lookup(orgID, spec.Bucket)
+		if !ok {
+			return nil, fmt.Errorf("could not find bucket %q", spec.Bucket)
+		}
+		bucketID = b
+	default:
+		if err := bucketID.DecodeFromString(spec.BucketID); err != nil {
+			return nil, err
+		}
+	}
+
+	var start execute.Time
+	if !spec.Start.IsZero() {
+		start = a.ResolveTime(spec.Start)
+	}
+
+	sd := &SchemaDecoder{
+		ctx:    a.Context(),
+		spec:   spec,
+		reader: deps.Reader,
+		readSpec: storage.ReadSpec{
+			OrganizationID: orgID,
+			BucketID:       bucketID,
+			Predicate:      spec.Predicate,
+		},
+		start: start,
+		stop:  a.ResolveTime(spec.Stop),
+		alloc: a.Allocator(),
+	}
+
+	return inputs.CreateSourceFromDecoder(sd, dsid, a)
+}
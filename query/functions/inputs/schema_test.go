@@ -0,0 +1,127 @@
+package inputs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/platform/query/functions/inputs"
+)
+
+func TestSchema_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "tagKeys no bucket",
+			Raw:     `tagKeys()`,
+			WantErr: true,
+		},
+		{
+			Name:    "tagKeys bucket and bucket ID",
+			Raw:     `tagKeys(bucket:"telegraf", bucketID:"aaaabbbbccccdddd")`,
+			WantErr: true,
+		},
+		{
+			Name:    "tagValues no tag",
+			Raw:     `tagValues(bucket:"telegraf")`,
+			WantErr: true,
+		},
+		{
+			Name: "tagKeys",
+			Raw:  `tagKeys(bucket:"telegraf", start:-1h)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "tagKeys0",
+						Spec: &inputs.TagKeysOpSpec{
+							SchemaSpec: inputs.SchemaSpec{
+								Bucket: "telegraf",
+								Start:  flux.Time{IsRelative: true, Relative: -time.Hour},
+								Stop:   flux.Now,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "tagKeys by measurement",
+			Raw:  `tagKeys(bucket:"telegraf", byMeasurement:true)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "tagKeys0",
+						Spec: &inputs.TagKeysOpSpec{
+							SchemaSpec: inputs.SchemaSpec{
+								Bucket: "telegraf",
+								Stop:   flux.Now,
+							},
+							ByMeasurement: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "tagValues with predicate",
+			Raw:  `tagValues(bucketID:"aaaabbbbccccdddd", tag:"host", predicate: (r) => r._measurement == "cpu")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "tagValues0",
+						Spec: &inputs.TagValuesOpSpec{
+							SchemaSpec: inputs.SchemaSpec{
+								BucketID: "aaaabbbbccccdddd",
+								Stop:     flux.Now,
+								Predicate: &semantic.FunctionExpression{
+									Block: &semantic.FunctionBlock{
+										Parameters: &semantic.FunctionParameters{
+											List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+										},
+										Body: &semantic.BinaryExpression{
+											Operator: ast.EqualOperator,
+											Left: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "_measurement",
+											},
+											Right: &semantic.StringLiteral{Value: "cpu"},
+										},
+									},
+								},
+							},
+							Tag: "host",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "measurements",
+			Raw:  `measurements(bucket:"telegraf", start:2018-12-01T00:00:00Z, stop:2018-12-02T00:00:00Z)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "measurements0",
+						Spec: &inputs.MeasurementsOpSpec{
+							SchemaSpec: inputs.SchemaSpec{
+								Bucket: "telegraf",
+								Start:  flux.Time{Absolute: time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)},
+								Stop:   flux.Time{Absolute: time.Date(2018, 12, 2, 0, 0, 0, 0, time.UTC)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
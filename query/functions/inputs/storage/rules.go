@@ -0,0 +1,302 @@
+package storage
+
+import (
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/plan"
+)
+
+func init() {
+	plan.RegisterPhysicalRules(
+		PushDownSelectorRule{Kind: transformations.MinKind, Method: "min"},
+		PushDownSelectorRule{Kind: transformations.MaxKind, Method: "max"},
+		PushDownSelectorRule{Kind: transformations.FirstKind, Method: "first"},
+		PushDownSelectorRule{Kind: transformations.LastKind, Method: "last"},
+		PushDownAggregateRule{Kind: transformations.MeanKind, Method: "mean"},
+		PushDownWindowSelectorRule{Kind: transformations.MinKind, Method: "min"},
+		PushDownWindowSelectorRule{Kind: transformations.MaxKind, Method: "max"},
+		PushDownWindowSelectorRule{Kind: transformations.FirstKind, Method: "first"},
+		PushDownWindowSelectorRule{Kind: transformations.LastKind, Method: "last"},
+		PushDownWindowAggregateRule{Kind: transformations.MeanKind, Method: "mean"},
+	)
+}
+
+// PushDownSelectorRule pushes a selector applied directly to a `from` into the storage read,
+// so that storage returns only the selected point of each series.
+type PushDownSelectorRule struct {
+	Kind   plan.ProcedureKind
+	Method string
+}
+
+func (r PushDownSelectorRule) Name() string {
+	return "PushDownSelectorRule(" + r.Method + ")"
+}
+
+func (r PushDownSelectorRule) Pattern() plan.Pattern {
+	return plan.Pat(r.Kind, plan.Pat(inputs.FromKind))
+}
+
+func (r PushDownSelectorRule) Rewrite(node plan.PlanNode) (plan.PlanNode, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*inputs.FromProcedureSpec)
+	if !canPushDownAggregate(fromNode, fromSpec) {
+		return node, false, nil
+	}
+	// Storage only reads the value column; an empty column selects it by default.
+	if col := selectorColumn(node.ProcedureSpec()); col != "" && col != execute.DefaultValueColLabel {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*inputs.FromProcedureSpec)
+	newFromSpec.AggregateSet = true
+	newFromSpec.AggregateMethod = r.Method
+	merged, err := plan.MergePhysicalPlanNodes(node, fromNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+// PushDownAggregateRule pushes an aggregate applied directly to a `from` into the storage read,
+// so that storage returns only the aggregated value of each series.
+//
+// Storage reports the aggregated value at the time of the first point it covers,
+// so the aggregate is replaced by a `drop` of the time column to produce the same tables.
+type PushDownAggregateRule struct {
+	Kind   plan.ProcedureKind
+	Method string
+}
+
+func (r PushDownAggregateRule) Name() string {
+	return "PushDownAggregateRule(" + r.Method + ")"
+}
+
+func (r PushDownAggregateRule) Pattern() plan.Pattern {
+	return plan.Pat(r.Kind, plan.Pat(inputs.FromKind))
+}
+
+func (r PushDownAggregateRule) Rewrite(node plan.PlanNode) (plan.PlanNode, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*inputs.FromProcedureSpec)
+	if !canPushDownAggregate(fromNode, fromSpec) {
+		return node, false, nil
+	}
+	// Storage only reads the value column; no columns select it by default.
+	if cols := aggregateColumns(node.ProcedureSpec()); len(cols) > 1 || len(cols) == 1 && cols[0] != execute.DefaultValueColLabel {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*inputs.FromProcedureSpec)
+	newFromSpec.AggregateSet = true
+	newFromSpec.AggregateMethod = r.Method
+	if err := fromNode.ReplaceSpec(newFromSpec); err != nil {
+		return nil, false, err
+	}
+
+	if err := node.ReplaceSpec(dropTimeSpec()); err != nil {
+		return nil, false, err
+	}
+	return node, true, nil
+}
+
+// PushDownWindowSelectorRule pushes a selector applied to the windows of a `from` into the storage read,
+// so that storage returns only the selected point of each window of each series.
+type PushDownWindowSelectorRule struct {
+	Kind   plan.ProcedureKind
+	Method string
+}
+
+func (r PushDownWindowSelectorRule) Name() string {
+	return "PushDownWindowSelectorRule(" + r.Method + ")"
+}
+
+func (r PushDownWindowSelectorRule) Pattern() plan.Pattern {
+	return plan.Pat(r.Kind, plan.Pat(transformations.WindowKind, plan.Pat(inputs.FromKind)))
+}
+
+func (r PushDownWindowSelectorRule) Rewrite(node plan.PlanNode) (plan.PlanNode, bool, error) {
+	windowNode := node.Predecessors()[0]
+	fromNode := windowNode.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*inputs.FromProcedureSpec)
+	if !canPushDownAggregate(fromNode, fromSpec) || !canPushDownWindow(windowNode) {
+		return node, false, nil
+	}
+	if col := selectorColumn(node.ProcedureSpec()); col != "" && col != execute.DefaultValueColLabel {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*inputs.FromProcedureSpec)
+	newFromSpec.WindowSet = true
+	newFromSpec.Window = windowNode.ProcedureSpec().(*transformations.WindowProcedureSpec).Window
+	newFromSpec.AggregateSet = true
+	newFromSpec.AggregateMethod = r.Method
+
+	// merge the selector into the window, then the result into the from
+	merged, err := plan.MergePhysicalPlanNodes(node, windowNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	merged, err = plan.MergePhysicalPlanNodes(merged, fromNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+// PushDownWindowAggregateRule pushes an aggregate applied to the windows of a `from` into the storage read,
+// so that storage returns only the aggregated value of each window of each series.
+//
+// Like PushDownAggregateRule, the aggregate is replaced by a `drop` of the time column.
+type PushDownWindowAggregateRule struct {
+	Kind   plan.ProcedureKind
+	Method string
+}
+
+func (r PushDownWindowAggregateRule) Name() string {
+	return "PushDownWindowAggregateRule(" + r.Method + ")"
+}
+
+func (r PushDownWindowAggregateRule) Pattern() plan.Pattern {
+	return plan.Pat(r.Kind, plan.Pat(transformations.WindowKind, plan.Pat(inputs.FromKind)))
+}
+
+func (r PushDownWindowAggregateRule) Rewrite(node plan.PlanNode) (plan.PlanNode, bool, error) {
+	windowNode := node.Predecessors()[0]
+	fromNode := windowNode.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*inputs.FromProcedureSpec)
+	if !canPushDownAggregate(fromNode, fromSpec) || !canPushDownWindow(windowNode) {
+		return node, false, nil
+	}
+	if cols := aggregateColumns(node.ProcedureSpec()); len(cols) > 1 || len(cols) == 1 && cols[0] != execute.DefaultValueColLabel {
+		return node, false, nil
+	}
+	if !canPushDownAggregateTypes(r.Method, node.ProcedureSpec()) {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*inputs.FromProcedureSpec)
+	newFromSpec.WindowSet = true
+	newFromSpec.Window = windowNode.ProcedureSpec().(*transformations.WindowProcedureSpec).Window
+	newFromSpec.AggregateSet = true
+	newFromSpec.AggregateMethod = r.Method
+	if err := fromNode.ReplaceSpec(newFromSpec); err != nil {
+		return nil, false, err
+	}
+
+	merged, err := plan.MergePhysicalPlanNodes(node, windowNode, dropTimeSpec())
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+// canPushDownAggregate reports whether storage can aggregate the series read by a `from`
+// exactly like an aggregate applied to its output would.
+// Storage aggregates each series over the whole range,
+// which matches only when the `from` produces a table per series, in ascending time order.
+func canPushDownAggregate(fromNode plan.PlanNode, fromSpec *inputs.FromProcedureSpec) bool {
+	return len(fromNode.Successors()) == 1 &&
+		fromSpec.BoundsSet &&
+		!fromSpec.AggregateSet &&
+		!fromSpec.GroupingSet &&
+		!fromSpec.WindowSet &&
+		!fromSpec.LimitSet &&
+		!fromSpec.DescendingSet
+}
+
+// canPushDownWindow reports whether storage can produce the same windows as windowNode.
+// Storage produces adjacent windows, containing only the windows that hold points,
+// described by the default columns.
+func canPushDownWindow(windowNode plan.PlanNode) bool {
+	spec := windowNode.ProcedureSpec().(*transformations.WindowProcedureSpec)
+	w := spec.Window
+	return len(windowNode.Successors()) == 1 &&
+		w.Every > 0 &&
+		w.Every != flux.Duration(math.MaxInt64) &&
+		w.Period == w.Every &&
+		w.Round == 0 &&
+		!spec.CreateEmpty &&
+		spec.TimeColumn == execute.DefaultTimeColLabel &&
+		spec.StartColumn == execute.DefaultStartColLabel &&
+		spec.StopColumn == execute.DefaultStopColLabel
+}
+
+// storageAggregateTypes are the value types storage can aggregate with each method.
+// Storage fails the read of a series of any other type.
+var storageAggregateTypes = map[string][]flux.ColType{
+	"mean": {flux.TFloat, flux.TInt, flux.TUInt},
+}
+
+// canPushDownAggregateTypes reports whether storage aggregates with method exactly
+// the value types that the aggregate of spec accepts,
+// so that a series of an unsupported type fails the query whether or not the aggregate is pushed down.
+func canPushDownAggregateTypes(method string, spec plan.ProcedureSpec) bool {
+	agg := newAggregate(spec)
+	if agg == nil {
+		return false
+	}
+	accepted := map[flux.ColType]bool{
+		flux.TBool:   agg.NewBoolAgg() != nil,
+		flux.TInt:    agg.NewIntAgg() != nil,
+		flux.TUInt:   agg.NewUIntAgg() != nil,
+		flux.TFloat:  agg.NewFloatAgg() != nil,
+		flux.TString: agg.NewStringAgg() != nil,
+	}
+	for _, typ := range storageAggregateTypes[method] {
+		if !accepted[typ] {
+			return false
+		}
+		delete(accepted, typ)
+	}
+	for _, ok := range accepted {
+		if ok {
+			return false
+		}
+	}
+	return true
+}
+
+func dropTimeSpec() *transformations.SchemaMutationProcedureSpec {
+	return &transformations.SchemaMutationProcedureSpec{
+		Mutations: []transformations.SchemaMutation{
+			&transformations.DropOpSpec{Columns: []string{execute.DefaultTimeColLabel}},
+		},
+	}
+}
+
+func selectorColumn(spec plan.ProcedureSpec) string {
+	switch spec := spec.(type) {
+	case *transformations.MinProcedureSpec:
+		return spec.Column
+	case *transformations.MaxProcedureSpec:
+		return spec.Column
+	case *transformations.FirstProcedureSpec:
+		return spec.Column
+	case *transformations.LastProcedureSpec:
+		return spec.Column
+	default:
+		return ""
+	}
+}
+
+func aggregateColumns(spec plan.ProcedureSpec) []string {
+	switch spec := spec.(type) {
+	case *transformations.MeanProcedureSpec:
+		return spec.Columns
+	default:
+		return nil
+	}
+}
+
+func newAggregate(spec plan.ProcedureSpec) execute.Aggregate {
+	switch spec.(type) {
+	case *transformations.MeanProcedureSpec:
+		return new(transformations.MeanAgg)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,268 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/platform/query/functions/inputs/storage"
+)
+
+func TestPushDownAggregateRules(t *testing.T) {
+	from := func(mod func(spec *inputs.FromProcedureSpec)) *inputs.FromProcedureSpec {
+		spec := &inputs.FromProcedureSpec{Bucket: "b", BoundsSet: true}
+		if mod != nil {
+			mod(spec)
+		}
+		return spec
+	}
+	aggregated := func(method string) *inputs.FromProcedureSpec {
+		return from(func(spec *inputs.FromProcedureSpec) {
+			spec.AggregateSet = true
+			spec.AggregateMethod = method
+		})
+	}
+
+	maxRule := storage.PushDownSelectorRule{Kind: transformations.MaxKind, Method: "max"}
+	meanRule := storage.PushDownAggregateRule{Kind: transformations.MeanKind, Method: "mean"}
+
+	dropTime := &transformations.SchemaMutationProcedureSpec{
+		Mutations: []transformations.SchemaMutation{
+			&transformations.DropOpSpec{Columns: []string{execute.DefaultTimeColLabel}},
+		},
+	}
+
+	window := func(mod func(spec *transformations.WindowProcedureSpec)) *transformations.WindowProcedureSpec {
+		spec := &transformations.WindowProcedureSpec{
+			Window: plan.WindowSpec{
+				Every:  flux.Duration(time.Minute),
+				Period: flux.Duration(time.Minute),
+			},
+			TimeColumn:  execute.DefaultTimeColLabel,
+			StartColumn: execute.DefaultStartColLabel,
+			StopColumn:  execute.DefaultStopColLabel,
+		}
+		if mod != nil {
+			mod(spec)
+		}
+		return spec
+	}
+	windowed := func(method string) *inputs.FromProcedureSpec {
+		spec := aggregated(method)
+		spec.WindowSet = true
+		spec.Window = window(nil).Window
+		return spec
+	}
+
+	windowMaxRule := storage.PushDownWindowSelectorRule{Kind: transformations.MaxKind, Method: "max"}
+	windowMeanRule := storage.PushDownWindowAggregateRule{Kind: transformations.MeanKind, Method: "mean"}
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name: "from max",
+			// from -> max  =>  from
+			Rules: []plan.Rule{maxRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("merged_from_max", aggregated("max")),
+				},
+			},
+		},
+		{
+			Name: "from mean",
+			// from -> mean  =>  from -> drop
+			Rules: []plan.Rule{meanRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{
+						AggregateConfig: execute.AggregateConfig{Columns: []string{execute.DefaultValueColLabel}},
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", aggregated("mean")),
+					plan.CreatePhysicalNode("mean", dropTime),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "from group max",
+			Rules: []plan.Rule{maxRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(func(spec *inputs.FromProcedureSpec) {
+						spec.GroupingSet = true
+						spec.GroupKeys = []string{"host"}
+					})),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "from max of other column",
+			Rules: []plan.Rule{maxRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{
+						SelectorConfig: execute.SelectorConfig{Column: "other"},
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name: "from with multiple successors",
+			// max   mean
+			//    \  /
+			//    from
+			Rules: []plan.Rule{maxRule, meanRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{}),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}},
+			},
+			NoChange: true,
+		},
+		{
+			Name: "from window max",
+			// from -> window -> max  =>  from
+			Rules: []plan.Rule{windowMaxRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(nil)),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("merged_from_window_max", windowed("max")),
+				},
+			},
+		},
+		{
+			Name: "from window mean",
+			// from -> window -> mean  =>  from -> drop
+			Rules: []plan.Rule{windowMeanRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(nil)),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", windowed("mean")),
+					plan.CreatePhysicalNode("merged_window_mean", dropTime),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "from sliding window max",
+			Rules: []plan.Rule{windowMaxRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(func(spec *transformations.WindowProcedureSpec) {
+						spec.Window.Period = flux.Duration(5 * time.Minute)
+					})),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			// WindowProcedureSpec.Copy drops the columns, so the plan is restated instead of using NoChange.
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(func(spec *transformations.WindowProcedureSpec) {
+						spec.Window.Period = flux.Duration(5 * time.Minute)
+					})),
+					plan.CreatePhysicalNode("max", &transformations.MaxProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+		},
+		{
+			Name:  "from window with empty windows mean",
+			Rules: []plan.Rule{windowMeanRule},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(func(spec *transformations.WindowProcedureSpec) {
+						spec.CreateEmpty = true
+					})),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			// WindowProcedureSpec.Copy drops the columns, so the plan is restated instead of using NoChange.
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(func(spec *transformations.WindowProcedureSpec) {
+						spec.CreateEmpty = true
+					})),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+		},
+		{
+			Name: "from window mean with unsupported types",
+			// storage cannot aggregate every type mean accepts with sum
+			Rules: []plan.Rule{storage.PushDownWindowAggregateRule{Kind: transformations.MeanKind, Method: "sum"}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(nil)),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			// WindowProcedureSpec.Copy drops the columns, so the plan is restated instead of using NoChange.
+			After: &plantest.PlanSpec{
+				Nodes: []plan.PlanNode{
+					plan.CreatePhysicalNode("from", from(nil)),
+					plan.CreatePhysicalNode("window", window(nil)),
+					plan.CreatePhysicalNode("mean", &transformations.MeanProcedureSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.RuleTestHelper(t, &tc)
+		})
+	}
+}
@@ -211,6 +211,11 @@ type ReadSpec struct {
 	Descending   bool
 
 	AggregateMethod string
+	// WindowEvery, when non-zero, applies AggregateMethod to consecutive windows
+	// of this duration, in nanoseconds, producing a table for each window of each series.
+	WindowEvery int64
+	// WindowOffset shifts the window boundaries from the Unix epoch, in nanoseconds.
+	WindowOffset int64
 
 	// OrderByTime indicates that series reads should produce all
 	// series for a time before producing any series for a larger time.
@@ -231,5 +236,16 @@ type ReadSpec struct {
 
 type Reader interface {
 	Read(ctx context.Context, rs ReadSpec, start, stop execute.Time) (flux.TableIterator, error)
+
+	// TagKeys returns the sorted tag keys of the series matching rs.Predicate
+	// that have points between start and stop.
+	TagKeys(ctx context.Context, rs ReadSpec, start, stop execute.Time) ([]string, error)
+	// TagValues returns the sorted values of tagKey of the series matching rs.Predicate
+	// that have points between start and stop.
+	TagValues(ctx context.Context, rs ReadSpec, tagKey string, start, stop execute.Time) ([]string, error)
+	// MeasurementNames returns the sorted measurement names of the series matching rs.Predicate
+	// that have points between start and stop.
+	MeasurementNames(ctx context.Context, rs ReadSpec, start, stop execute.Time) ([]string, error)
+
 	Close()
 }
@@ -0,0 +1,178 @@
+package functions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+// LimitSeriesKind is the kind of the `limitSeries` function.
+// It orders the tables by their series key, as seriesKey computes it, skips the first
+// offset tables and keeps the next n. An n of zero keeps all of the remaining tables.
+// Every table must be read before any of them can be kept, so the tables are copied and
+// held until the input finishes.
+const LimitSeriesKind = "limitSeries"
+
+// LimitSeriesOpSpec is the flux.OperationSpec for the `limitSeries` function.
+type LimitSeriesOpSpec struct {
+	N      int64 `json:"n"`
+	Offset int64 `json:"offset"`
+}
+
+func init() {
+	limitSeriesSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"n":      semantic.Int,
+			"offset": semantic.Int,
+		},
+		[]string{"n"},
+	)
+
+	flux.RegisterFunction(LimitSeriesKind, createLimitSeriesOpSpec, limitSeriesSignature)
+	flux.RegisterOpSpec(LimitSeriesKind, newLimitSeriesOp)
+	plan.RegisterProcedureSpec(LimitSeriesKind, newLimitSeriesProcedure, LimitSeriesKind)
+	execute.RegisterTransformation(LimitSeriesKind, createLimitSeriesTransformation)
+}
+
+func createLimitSeriesOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(LimitSeriesOpSpec)
+	n, err := args.GetRequiredInt("n")
+	if err != nil {
+		return nil, err
+	}
+	spec.N = n
+	if offset, ok, err := args.GetInt("offset"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Offset = offset
+	}
+	return spec, nil
+}
+
+func newLimitSeriesOp() flux.OperationSpec {
+	return new(LimitSeriesOpSpec)
+}
+
+func (s *LimitSeriesOpSpec) Kind() flux.OperationKind {
+	return LimitSeriesKind
+}
+
+// LimitSeriesProcedureSpec is the plan.ProcedureSpec for the `limitSeries` function.
+type LimitSeriesProcedureSpec struct {
+	plan.DefaultCost
+	N      int64
+	Offset int64
+}
+
+func newLimitSeriesProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*LimitSeriesOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	if spec.N < 0 || spec.Offset < 0 {
+		return nil, fmt.Errorf("limitSeries: n and offset must not be negative")
+	}
+	return &LimitSeriesProcedureSpec{N: spec.N, Offset: spec.Offset}, nil
+}
+
+func (s *LimitSeriesProcedureSpec) Kind() plan.ProcedureKind {
+	return LimitSeriesKind
+}
+
+func (s *LimitSeriesProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(LimitSeriesProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createLimitSeriesTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*LimitSeriesProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewLimitSeriesTransformation(d, cache, a.Allocator(), s)
+	return t, d, nil
+}
+
+// NewLimitSeriesTransformation returns the transformation of the `limitSeries` function.
+// The tables are copied with alloc until the input finishes.
+func NewLimitSeriesTransformation(d execute.Dataset, cache execute.TableBuilderCache, alloc *memory.Allocator, spec *LimitSeriesProcedureSpec) execute.Transformation {
+	return &limitSeriesTransformation{d: d, cache: cache, alloc: alloc, n: spec.N, offset: spec.Offset}
+}
+
+type limitSeriesTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	alloc *memory.Allocator
+
+	n, offset int64
+	tables    []flux.Table
+}
+
+func (t *limitSeriesTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *limitSeriesTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	cpy, err := execute.CopyTable(tbl, t.alloc)
+	if err != nil {
+		return err
+	}
+	t.tables = append(t.tables, cpy)
+	return nil
+}
+
+// UpdateWatermark does not pass the watermark on, since it would trigger the tables
+// before it is known which of them are kept.
+func (t *limitSeriesTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return nil
+}
+
+func (t *limitSeriesTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return nil
+}
+
+func (t *limitSeriesTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.keep()
+	}
+	t.d.Finish(err)
+}
+
+// keep adds the tables within the limit to the cache.
+func (t *limitSeriesTransformation) keep() error {
+	sort.SliceStable(t.tables, func(i, j int) bool {
+		ki, kj := t.tables[i].Key(), t.tables[j].Key()
+		if si, sj := seriesKey(ki), seriesKey(kj); si != sj {
+			return si < sj
+		}
+		return ki.Less(kj)
+	})
+	for i, tbl := range t.tables {
+		if n := int64(i) - t.offset; n < 0 || (t.n > 0 && n >= t.n) {
+			continue
+		}
+		builder, created := t.cache.TableBuilder(tbl.Key())
+		if !created {
+			return fmt.Errorf("limitSeries found duplicate table with key: %v", tbl.Key())
+		}
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+		if err := execute.AppendTable(tbl, builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package functions_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func TestLimitSeries_Process(t *testing.T) {
+	series := func(measurement, host string) *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"_measurement", "host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1), measurement, host, 1.0},
+				{execute.Time(2), measurement, host, 2.0},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		spec *functions.LimitSeriesProcedureSpec
+		data []*executetest.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "n",
+			spec: &functions.LimitSeriesProcedureSpec{N: 2},
+			data: []*executetest.Table{
+				series("mem", "a"),
+				series("cpu", "b"),
+				series("cpu", "a"),
+			},
+			want: []*executetest.Table{
+				series("cpu", "a"),
+				series("cpu", "b"),
+			},
+		},
+		{
+			name: "offset",
+			spec: &functions.LimitSeriesProcedureSpec{N: 1, Offset: 1},
+			data: []*executetest.Table{
+				series("mem", "a"),
+				series("cpu", "b"),
+				series("cpu", "a"),
+			},
+			want: []*executetest.Table{
+				series("cpu", "b"),
+			},
+		},
+		{
+			name: "offset without n",
+			spec: &functions.LimitSeriesProcedureSpec{Offset: 1},
+			data: []*executetest.Table{
+				series("mem", "a"),
+				series("cpu", "b"),
+				series("cpu", "a"),
+			},
+			want: []*executetest.Table{
+				series("cpu", "b"),
+				series("mem", "a"),
+			},
+		},
+		{
+			name: "offset past the end",
+			spec: &functions.LimitSeriesProcedureSpec{N: 1, Offset: 3},
+			data: []*executetest.Table{
+				series("mem", "a"),
+				series("cpu", "b"),
+				series("cpu", "a"),
+			},
+			want: []*executetest.Table(nil),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// The tables are only kept when the input finishes, which
+			// executetest.ProcessTestHelper does not do.
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(execute.DefaultTriggerSpec)
+			tx := functions.NewLimitSeriesTransformation(d, c, executetest.UnlimitedAllocator, tc.spec)
+
+			parentID := executetest.RandomDatasetID()
+			for _, tbl := range tc.data {
+				if err := tx.Process(parentID, tbl); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got, err := executetest.TablesFromCache(c); err != nil {
+				t.Fatal(err)
+			} else if len(got) != 0 {
+				t.Fatalf("got %d tables before the input finished", len(got))
+			}
+			tx.Finish(parentID, nil)
+			if d.FinishedErr != nil {
+				t.Fatal(d.FinishedErr)
+			}
+
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+			sort.Sort(executetest.SortedTables(got))
+			sort.Sort(executetest.SortedTables(tc.want))
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
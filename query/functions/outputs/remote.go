@@ -0,0 +1,163 @@
+package outputs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/influxdata/platform/models"
+)
+
+const (
+	// DefaultRemoteBatchSize is the number of points sent in each remote write request by default.
+	DefaultRemoteBatchSize = 5000
+	// DefaultRemoteMaxRetries is the number of times a failed remote write request is retried by default.
+	DefaultRemoteMaxRetries = 3
+	// DefaultRemoteRetryInterval is the wait before the first retry of a remote write request by default.
+	// The wait doubles with each retry.
+	DefaultRemoteRetryInterval = time.Second
+	// DefaultRemoteTimeout is the time limit of each remote write request by default.
+	DefaultRemoteTimeout = 30 * time.Second
+
+	remoteWritePath = "/api/v2/write"
+)
+
+// RemotePointsWriter writes points as line protocol to the write endpoint of another platform host.
+// Points are buffered and sent in gzipped batches of BatchSize points;
+// requests that fail because of the network or the server are retried.
+// An empty Token sends the requests without authorization.
+type RemotePointsWriter struct {
+	Host  string
+	Token string
+
+	// Org and Bucket identify the destination on the remote host, by name or ID.
+	Org    string
+	Bucket string
+
+	BatchSize     int
+	MaxRetries    int
+	RetryInterval time.Duration
+
+	Client *http.Client
+
+	buf bytes.Buffer
+	n   int
+}
+
+// NewRemotePointsWriter returns a RemotePointsWriter to bucket in org of host,
+// using the default batching, retries and request timeout.
+func NewRemotePointsWriter(host, token, org, bucket string) *RemotePointsWriter {
+	return &RemotePointsWriter{
+		Host:          host,
+		Token:         token,
+		Org:           org,
+		Bucket:        bucket,
+		BatchSize:     DefaultRemoteBatchSize,
+		MaxRetries:    DefaultRemoteMaxRetries,
+		RetryInterval: DefaultRemoteRetryInterval,
+		Client:        &http.Client{Timeout: DefaultRemoteTimeout},
+	}
+}
+
+// WritePoints buffers points, sending a batch each time BatchSize points are buffered.
+func (w *RemotePointsWriter) WritePoints(ctx context.Context, points []models.Point) error {
+	for _, p := range points {
+		w.buf.WriteString(p.String())
+		w.buf.WriteByte('\n')
+		w.n++
+		if w.n >= w.BatchSize {
+			if err := w.Flush(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush sends the buffered points, giving up on retries once ctx is done.
+func (w *RemotePointsWriter) Flush(ctx context.Context) error {
+	if w.n == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	gw := gzip.NewWriter(&body)
+	if _, err := gw.Write(w.buf.Bytes()); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	w.buf.Reset()
+	w.n = 0
+
+	wait := w.RetryInterval
+	for i := 0; ; i++ {
+		retry, err := w.post(ctx, body.Bytes())
+		if err == nil || !retry || i >= w.MaxRetries {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// post sends a single write request with the gzipped line protocol in body,
+// and reports whether a failed request may succeed when retried.
+func (w *RemotePointsWriter) post(ctx context.Context, body []byte) (bool, error) {
+	u, err := url.Parse(strings.TrimSuffix(w.Host, "/") + remoteWritePath)
+	if err != nil {
+		return false, err
+	}
+	params := url.Values{}
+	params.Set("org", w.Org)
+	params.Set("bucket", w.Bucket)
+	params.Set("precision", "ns")
+	u.RawQuery = params.Encode()
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	req.Header.Set("Content-Encoding", "gzip")
+	if w.Token != "" {
+		req.Header.Set("Authorization", "Token "+w.Token)
+	}
+
+	resp, err := w.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		// a request abandoned because ctx is done must not be retried
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+
+	msg := resp.Status
+	b, _ := ioutil.ReadAll(resp.Body)
+	var e struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(b, &e) == nil && e.Message != "" {
+		msg = e.Message
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, fmt.Errorf("failed to write to %s: %s", w.Host, msg)
+}
@@ -0,0 +1,268 @@
+package outputs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	phttp "github.com/influxdata/platform/http"
+	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/query/functions/outputs"
+)
+
+const (
+	remoteOrgID    = platform.ID(10)
+	remoteBucketID = platform.ID(20)
+	remoteToken    = "remote-token"
+)
+
+// newRemoteServer returns a server running the write handler of a remote host,
+// which writes the points to pw.
+func newRemoteServer(pw *mock.PointsWriter) *httptest.Server {
+	h := phttp.NewWriteHandler(pw)
+	h.OrganizationService = &mock.OrganizationService{
+		FindOrganizationByIDF: func(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+			return nil, &platform.Error{Code: platform.ENotFound}
+		},
+		FindOrganizationF: func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+			if filter.Name == nil || *filter.Name != "remote-org" {
+				return nil, &platform.Error{Code: platform.ENotFound}
+			}
+			return &platform.Organization{ID: remoteOrgID, Name: "remote-org"}, nil
+		},
+	}
+	bs := mock.NewBucketService()
+	bs.FindBucketFn = func(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		if filter.Name == nil || *filter.Name != "remote-bucket" {
+			return nil, &platform.Error{Code: platform.ENotFound}
+		}
+		return &platform.Bucket{ID: remoteBucketID, OrganizationID: remoteOrgID, Name: "remote-bucket"}, nil
+	}
+	h.BucketService = bs
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token "+remoteToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		auth := &platform.Authorization{Status: platform.Active, Permissions: platform.OperPermissions()}
+		h.ServeHTTP(w, r.WithContext(pcontext.SetAuthorizer(r.Context(), auth)))
+	}))
+}
+
+func TestTo_ProcessRemote(t *testing.T) {
+	remote := new(mock.PointsWriter)
+	server := newRemoteServer(remote)
+	defer server.Close()
+
+	spec := &outputs.ToProcedureSpec{
+		Spec: &outputs.ToOpSpec{
+			Org:               "remote-org",
+			Bucket:            "remote-bucket",
+			Host:              server.URL,
+			Token:             remoteToken,
+			TimeColumn:        "_time",
+			MeasurementColumn: "_measurement",
+		},
+	}
+	table := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_measurement", Type: flux.TString},
+			{Label: "_field", Type: flux.TString},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{execute.Time(11), "a", "_value", 2.0},
+			{execute.Time(21), "b", "_value", 1.0},
+		},
+	}
+
+	deps := mockDependencies()
+	var tx *outputs.ToTransformation
+	executetest.ProcessTestHelper(
+		t,
+		[]flux.Table{executetest.MustCopyTable(table)},
+		[]*executetest.Table{table},
+		nil,
+		func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+			tx, _ = outputs.NewToTransformation(context.Background(), d, c, spec, deps)
+			return tx
+		},
+	)
+
+	if len(remote.Points) != 0 {
+		t.Fatalf("expected points to be sent when the result is finished, got %d", len(remote.Points))
+	}
+	tx.Finish(executetest.RandomDatasetID(), nil)
+
+	if local := deps.PointsWriter.(*mock.PointsWriter); len(local.Points) != 0 {
+		t.Errorf("expected no local points, got %d", len(local.Points))
+	}
+
+	gotStr := pointsToStr(remote.Points)
+	wantStr := pointsToStr(mockPoints(remoteOrgID, remoteBucketID, "a _value=2.0 11\nb _value=1.0 21"))
+	if !cmp.Equal(gotStr, wantStr) {
+		t.Errorf("unexpected remote points -got/+want\n%s", cmp.Diff(gotStr, wantStr))
+	}
+}
+
+func TestRemotePointsWriter_Batches(t *testing.T) {
+	remote := new(mock.PointsWriter)
+	server := newRemoteServer(remote)
+	defer server.Close()
+
+	var mu sync.Mutex
+	var requests int
+	w := outputs.NewRemotePointsWriter(server.URL, remoteToken, "remote-org", "remote-bucket")
+	w.BatchSize = 2
+	w.Client = &http.Client{Transport: countingTransport(func() {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+	})}
+
+	points, err := models.ParsePointsString("m v=1 1\nm v=2 2\nm v=3 3\nm v=4 4\nm v=5 5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WritePoints(context.Background(), points); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 3 {
+		t.Errorf("got %d requests, want 3", requests)
+	}
+	if len(remote.Points) != 5 {
+		t.Errorf("got %d points, want 5", len(remote.Points))
+	}
+}
+
+func TestRemotePointsWriter_Retries(t *testing.T) {
+	remote := new(mock.PointsWriter)
+	server := newRemoteServer(remote)
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		failures int
+		status   int
+		wantErr  bool
+		wantReqs int
+	}{
+		{name: "unavailable then success", failures: 2, status: http.StatusServiceUnavailable, wantReqs: 3},
+		{name: "unavailable too often", failures: 5, status: http.StatusServiceUnavailable, wantErr: true, wantReqs: 4},
+		{name: "bad request", failures: 1, status: http.StatusBadRequest, wantErr: true, wantReqs: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= tt.failures {
+					w.WriteHeader(tt.status)
+					return
+				}
+				req, _ := http.NewRequest(r.Method, server.URL+r.URL.RequestURI(), r.Body)
+				req.Header = r.Header
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				resp.Body.Close()
+				w.WriteHeader(resp.StatusCode)
+			}))
+			defer flaky.Close()
+
+			w := outputs.NewRemotePointsWriter(flaky.URL, remoteToken, "remote-org", "remote-bucket")
+			w.RetryInterval = time.Millisecond
+
+			points, err := models.ParsePointsString("m v=1 1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := w.WritePoints(context.Background(), points); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Flush(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error %v", err)
+			}
+			if requests != tt.wantReqs {
+				t.Errorf("got %d requests, want %d", requests, tt.wantReqs)
+			}
+		})
+	}
+}
+
+func TestRemotePointsWriter_RetryCancelled(t *testing.T) {
+	var requests int
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	w := outputs.NewRemotePointsWriter(unavailable.URL, remoteToken, "remote-org", "remote-bucket")
+	w.RetryInterval = time.Hour
+
+	points, err := models.ParsePointsString("m v=1 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := w.WritePoints(ctx, points); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
+
+func TestRemotePointsWriter_NoToken(t *testing.T) {
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header["Authorization"]
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	w := outputs.NewRemotePointsWriter(server.URL, "", "remote-org", "remote-bucket")
+	points, err := models.ParsePointsString("m v=1 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WritePoints(context.Background(), points); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if auth != nil {
+		t.Errorf("expected no Authorization header, got %q", auth)
+	}
+}
+
+type countingTransport func()
+
+func (c countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c()
+	return http.DefaultTransport.RoundTrip(r)
+}
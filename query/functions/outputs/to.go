@@ -171,7 +171,11 @@ func (ToOpSpec) Kind() flux.OperationKind {
 }
 
 // BucketsAccessed returns the buckets accessed by the spec.
+// Buckets on a remote host are authorized by that host's token instead.
 func (o *ToOpSpec) BucketsAccessed() (readBuckets, writeBuckets []platform.BucketFilter) {
+	if o.Host != "" {
+		return readBuckets, writeBuckets
+	}
 	bf := platform.BucketFilter{Name: &o.Bucket, Organization: &o.Org}
 	writeBuckets = append(writeBuckets, bf)
 	return readBuckets, writeBuckets
@@ -229,7 +233,7 @@ func createToTransformation(id execute.DatasetID, mode execute.AccumulationMode,
 	d := execute.NewDataset(id, mode, cache)
 	deps := a.Dependencies()[ToKind].(ToDependencies)
 
-	t, err := NewToTransformation(d, cache, s, deps)
+	t, err := NewToTransformation(a.Context(), d, cache, s, deps)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -238,11 +242,16 @@ func createToTransformation(id execute.DatasetID, mode execute.AccumulationMode,
 
 // ToTransformation is the transformation for the `to` flux function.
 type ToTransformation struct {
+	ctx   context.Context
 	d     execute.Dataset
 	fn    *execute.RowMapFn
 	cache execute.TableBuilderCache
 	spec  *ToProcedureSpec
 	deps  ToDependencies
+
+	// remote writes the points to another host, when the spec has one.
+	// The points of all tables are flushed once the result is finished.
+	remote *RemotePointsWriter
 }
 
 // RetractTable retracts the table for the transformation for the `to` flux function.
@@ -251,7 +260,7 @@ func (t *ToTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey)
 }
 
 // NewToTransformation returns a new *ToTransformation with the appropriate fields set.
-func NewToTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *ToProcedureSpec, deps ToDependencies) (*ToTransformation, error) {
+func NewToTransformation(ctx context.Context, d execute.Dataset, cache execute.TableBuilderCache, spec *ToProcedureSpec, deps ToDependencies) (*ToTransformation, error) {
 	var fn *execute.RowMapFn
 	var err error
 
@@ -261,12 +270,26 @@ func NewToTransformation(d execute.Dataset, cache execute.TableBuilderCache, spe
 		}
 	}
 
+	var remote *RemotePointsWriter
+	if s := spec.Spec; s.Host != "" {
+		org, bucket := s.Org, s.Bucket
+		if org == "" {
+			org = s.OrgID
+		}
+		if bucket == "" {
+			bucket = s.BucketID
+		}
+		remote = NewRemotePointsWriter(s.Host, s.Token, org, bucket)
+	}
+
 	return &ToTransformation{
-		d:     d,
-		fn:    fn,
-		cache: cache,
-		spec:  spec,
-		deps:  deps,
+		ctx:    ctx,
+		d:      d,
+		fn:     fn,
+		cache:  cache,
+		spec:   spec,
+		deps:   deps,
+		remote: remote,
 	}, nil
 }
 
@@ -366,6 +389,9 @@ func (t *ToTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute
 
 // Finish is called after the `to` flux function's transformation is done processing.
 func (t *ToTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil && t.remote != nil {
+		err = t.remote.Flush(t.ctx)
+	}
 	t.d.Finish(err)
 }
 
@@ -433,26 +459,29 @@ func writeTable(t *ToTransformation, tbl flux.Table) error {
 	d := t.deps
 	spec := t.spec.Spec
 
-	// Get organization ID
-	if spec.Org != "" {
-		oID, ok := d.OrganizationLookup.Lookup(context.TODO(), spec.Org)
-		if !ok {
-			return fmt.Errorf("failed to look up organization %q", spec.Org)
+	// The remote host resolves the organization and bucket itself.
+	if t.remote == nil {
+		// Get organization ID
+		if spec.Org != "" {
+			oID, ok := d.OrganizationLookup.Lookup(context.TODO(), spec.Org)
+			if !ok {
+				return fmt.Errorf("failed to look up organization %q", spec.Org)
+			}
+			orgID = &oID
+		} else if orgID, err = platform.IDFromString(spec.OrgID); err != nil {
+			return err
 		}
-		orgID = &oID
-	} else if orgID, err = platform.IDFromString(spec.OrgID); err != nil {
-		return err
-	}
 
-	// Get bucket ID
-	if spec.Bucket != "" {
-		bID, ok := d.BucketLookup.Lookup(*orgID, spec.Bucket)
-		if !ok {
-			return fmt.Errorf("failed to look up bucket %q in org %q", spec.Bucket, spec.Org)
+		// Get bucket ID
+		if spec.Bucket != "" {
+			bID, ok := d.BucketLookup.Lookup(*orgID, spec.Bucket)
+			if !ok {
+				return fmt.Errorf("failed to look up bucket %q in org %q", spec.Bucket, spec.Org)
+			}
+			bucketID = &bID
+		} else if bucketID, err = platform.IDFromString(spec.BucketID); err != nil {
+			return err
 		}
-		bucketID = &bID
-	} else if bucketID, err = platform.IDFromString(spec.BucketID); err != nil {
-		return err
 	}
 
 	// cache tag columns
@@ -572,6 +601,9 @@ func writeTable(t *ToTransformation, tbl flux.Table) error {
 				return err
 			}
 		}
+		if t.remote != nil {
+			return t.remote.WritePoints(t.ctx, points)
+		}
 		points, err = tsdb.ExplodePoints(*orgID, *bucketID, points)
 		return d.PointsWriter.WritePoints(points)
 	})
@@ -553,7 +553,7 @@ c,tag2=e humidity=65i,temperature=4.0 41`),
 				tc.want.tables,
 				nil,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
-					newT, _ := outputs.NewToTransformation(d, c, tc.spec, deps)
+					newT, _ := outputs.NewToTransformation(context.Background(), d, c, tc.spec, deps)
 					return newT
 				},
 			)
@@ -0,0 +1,146 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/platform/models"
+)
+
+// SeriesKeyKind is the kind of the `seriesKey` function.
+// It replaces each non-empty table with a single row whose `_value` column is the
+// series key of the table, e.g. "cpu,host=server01". The series key is made of the
+// `_measurement` and the string columns of the group key that do not start with an underscore.
+const SeriesKeyKind = "seriesKey"
+
+// SeriesKeyOpSpec is the flux.OperationSpec for the `seriesKey` function.
+type SeriesKeyOpSpec struct{}
+
+func init() {
+	seriesKeySignature := flux.FunctionSignature(nil, nil)
+
+	flux.RegisterFunction(SeriesKeyKind, createSeriesKeyOpSpec, seriesKeySignature)
+	flux.RegisterOpSpec(SeriesKeyKind, newSeriesKeyOp)
+	plan.RegisterProcedureSpec(SeriesKeyKind, newSeriesKeyProcedure, SeriesKeyKind)
+	execute.RegisterTransformation(SeriesKeyKind, createSeriesKeyTransformation)
+}
+
+func createSeriesKeyOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	return new(SeriesKeyOpSpec), nil
+}
+
+func newSeriesKeyOp() flux.OperationSpec {
+	return new(SeriesKeyOpSpec)
+}
+
+func (s *SeriesKeyOpSpec) Kind() flux.OperationKind {
+	return SeriesKeyKind
+}
+
+// SeriesKeyProcedureSpec is the plan.ProcedureSpec for the `seriesKey` function.
+type SeriesKeyProcedureSpec struct {
+	plan.DefaultCost
+}
+
+func newSeriesKeyProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	if _, ok := qs.(*SeriesKeyOpSpec); !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return new(SeriesKeyProcedureSpec), nil
+}
+
+func (s *SeriesKeyProcedureSpec) Kind() plan.ProcedureKind {
+	return SeriesKeyKind
+}
+
+func (s *SeriesKeyProcedureSpec) Copy() plan.ProcedureSpec {
+	return new(SeriesKeyProcedureSpec)
+}
+
+func createSeriesKeyTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	if _, ok := spec.(*SeriesKeyProcedureSpec); !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewSeriesKeyTransformation(d, cache)
+	return t, d, nil
+}
+
+// NewSeriesKeyTransformation returns the transformation of the `seriesKey` function.
+func NewSeriesKeyTransformation(d execute.Dataset, cache execute.TableBuilderCache) execute.Transformation {
+	return &seriesKeyTransformation{d: d, cache: cache}
+}
+
+type seriesKeyTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+}
+
+func (t *seriesKeyTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *seriesKeyTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	n := 0
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		n += cr.Len()
+		return nil
+	}); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("seriesKey found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+		return err
+	}
+	valueIdx, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultValueColLabel, Type: flux.TString})
+	if err != nil {
+		return err
+	}
+	if err := execute.AppendKeyValues(tbl.Key(), builder); err != nil {
+		return err
+	}
+	return builder.AppendString(valueIdx, seriesKey(tbl.Key()))
+}
+
+// seriesKey returns the series key for the group key of a table.
+func seriesKey(key flux.GroupKey) string {
+	var name string
+	tags := make(map[string]string)
+	for j, c := range key.Cols() {
+		if c.Type != flux.TString {
+			continue
+		}
+		if c.Label == "_measurement" {
+			name = key.ValueString(j)
+		} else if !strings.HasPrefix(c.Label, "_") {
+			tags[c.Label] = key.ValueString(j)
+		}
+	}
+	return string(models.MakeKey([]byte(name), models.NewTags(tags)))
+}
+
+func (t *seriesKeyTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *seriesKeyTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *seriesKeyTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
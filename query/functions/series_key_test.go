@@ -0,0 +1,105 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func TestSeriesKey_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "sorted tags",
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_measurement", "region", "_field", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "region", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(1), "cpu", "west", "usage", "server01", 2.0},
+					{execute.Time(0), execute.Time(2), "cpu", "west", "usage", "server01", 3.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_measurement", "region", "_field", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "region", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "cpu", "west", "usage", "server01", "cpu,host=server01,region=west"},
+				},
+			}},
+		},
+		{
+			name: "escaped values",
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu load", "a,b", 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"cpu load", "a,b", `cpu\ load,host=a\,b`},
+				},
+			}},
+		},
+		{
+			name: "empty table",
+			data: []flux.Table{&executetest.Table{
+				KeyCols:   []string{"_measurement"},
+				KeyValues: []interface{}{"cpu"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+			}},
+			want: []*executetest.Table(nil),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				nil,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return functions.NewSeriesKeyTransformation(d, c)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package functions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform/models"
+)
+
+// ShiftTimeZoneKind is the kind of the `shiftTimeZone` function.
+// It shifts the times of the columns by the offset of the time zone at each time, so that
+// they read as the wall clock time of the location in UTC. Windows of the shifted times are
+// aligned to the days of the location, across changes of its offset like daylight saving time.
+// With toUTC, it shifts the wall clock times of the location back to UTC.
+//
+// The columns that a table does not have are skipped, and the time bounds of the query are
+// shifted alike. The minimum and maximum times, which stand for unbounded times, are not shifted.
+const ShiftTimeZoneKind = "shiftTimeZone"
+
+// ShiftTimeZoneOpSpec is the flux.OperationSpec for the `shiftTimeZone` function.
+type ShiftTimeZoneOpSpec struct {
+	Location string   `json:"location"`
+	Columns  []string `json:"columns"`
+	ToUTC    bool     `json:"toUTC"`
+}
+
+func init() {
+	shiftTimeZoneSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"location": semantic.String,
+			"columns":  semantic.NewArrayPolyType(semantic.String),
+			"toUTC":    semantic.Bool,
+		},
+		[]string{"location"},
+	)
+
+	flux.RegisterFunction(ShiftTimeZoneKind, createShiftTimeZoneOpSpec, shiftTimeZoneSignature)
+	flux.RegisterOpSpec(ShiftTimeZoneKind, newShiftTimeZoneOp)
+	plan.RegisterProcedureSpec(ShiftTimeZoneKind, newShiftTimeZoneProcedure, ShiftTimeZoneKind)
+	execute.RegisterTransformation(ShiftTimeZoneKind, createShiftTimeZoneTransformation)
+}
+
+func createShiftTimeZoneOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &ShiftTimeZoneOpSpec{
+		Columns: []string{execute.DefaultTimeColLabel},
+	}
+	location, err := args.GetRequiredString("location")
+	if err != nil {
+		return nil, err
+	}
+	spec.Location = location
+
+	if array, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.Columns, err = interpreter.ToStringArray(array); err != nil {
+			return nil, err
+		}
+	}
+	if toUTC, ok, err := args.GetBool("toUTC"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ToUTC = toUTC
+	}
+	return spec, nil
+}
+
+func newShiftTimeZoneOp() flux.OperationSpec {
+	return new(ShiftTimeZoneOpSpec)
+}
+
+func (s *ShiftTimeZoneOpSpec) Kind() flux.OperationKind {
+	return ShiftTimeZoneKind
+}
+
+// ShiftTimeZoneProcedureSpec is the plan.ProcedureSpec for the `shiftTimeZone` function.
+type ShiftTimeZoneProcedureSpec struct {
+	plan.DefaultCost
+	Location *time.Location
+	Columns  []string
+	ToUTC    bool
+}
+
+func newShiftTimeZoneProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ShiftTimeZoneOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	loc, err := time.LoadLocation(spec.Location)
+	if err != nil {
+		return nil, fmt.Errorf("shiftTimeZone: %v", err)
+	}
+	return &ShiftTimeZoneProcedureSpec{
+		Location: loc,
+		Columns:  spec.Columns,
+		ToUTC:    spec.ToUTC,
+	}, nil
+}
+
+func (s *ShiftTimeZoneProcedureSpec) Kind() plan.ProcedureKind {
+	return ShiftTimeZoneKind
+}
+
+func (s *ShiftTimeZoneProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(ShiftTimeZoneProcedureSpec)
+	*ns = *s
+	ns.Columns = make([]string, len(s.Columns))
+	copy(ns.Columns, s.Columns)
+	return ns
+}
+
+// TimeBounds implements plan.BoundsAwareProcedureSpec.
+func (s *ShiftTimeZoneProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	if predecessorBounds == nil {
+		return nil
+	}
+	return &plan.Bounds{
+		Start: values.Time(s.shift(execute.Time(predecessorBounds.Start))),
+		Stop:  values.Time(s.shift(execute.Time(predecessorBounds.Stop))),
+	}
+}
+
+// shift returns the wall clock time of the location at the UTC time tm, read as UTC,
+// or the UTC time of the wall clock time tm with toUTC.
+func (s *ShiftTimeZoneProcedureSpec) shift(tm execute.Time) execute.Time {
+	if !s.ToUTC {
+		return addOffset(tm, s.offset(tm))
+	}
+	// The offset of a wall clock time is that of the UTC time it reads as, unless
+	// the offset changes in between, in which case it is that of the corrected guess.
+	guess := addOffset(tm, -s.offset(tm))
+	return addOffset(tm, -s.offset(guess))
+}
+
+// offset returns the offset of the location from UTC at tm.
+func (s *ShiftTimeZoneProcedureSpec) offset(tm execute.Time) execute.Duration {
+	_, offset := tm.Time().In(s.Location).Zone()
+	return execute.Duration(time.Duration(offset) * time.Second)
+}
+
+func createShiftTimeZoneTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ShiftTimeZoneProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewShiftTimeZoneTransformation(d, cache, s)
+	return t, d, nil
+}
+
+// NewShiftTimeZoneTransformation returns the transformation of the `shiftTimeZone` function.
+func NewShiftTimeZoneTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *ShiftTimeZoneProcedureSpec) execute.Transformation {
+	return &shiftTimeZoneTransformation{d: d, cache: cache, spec: *spec}
+}
+
+type shiftTimeZoneTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	spec ShiftTimeZoneProcedureSpec
+}
+
+func (t *shiftTimeZoneTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *shiftTimeZoneTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	cols := tbl.Cols()
+	shifted := make([]bool, len(cols))
+	for _, label := range t.spec.Columns {
+		j := execute.ColIdx(label, cols)
+		if j < 0 {
+			continue
+		} else if cols[j].Type != flux.TTime {
+			return fmt.Errorf("shiftTimeZone: column %q is not of type time", label)
+		}
+		shifted[j] = true
+	}
+
+	// The group key holds the shifted times of its columns.
+	key := tbl.Key()
+	keyCols := key.Cols()
+	vs := make([]values.Value, len(keyCols))
+	for j, c := range keyCols {
+		vs[j] = key.Value(j)
+		if idx := execute.ColIdx(c.Label, cols); idx >= 0 && shifted[idx] {
+			vs[j] = values.NewTime(t.spec.shift(vs[j].Time()))
+		}
+	}
+	key = execute.NewGroupKey(keyCols, vs)
+
+	builder, created := t.cache.TableBuilder(key)
+	if !created {
+		return fmt.Errorf("shiftTimeZone found duplicate table with key: %v", key)
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+
+	return tbl.Do(func(cr flux.ColReader) error {
+		for j := range cols {
+			if !shifted[j] {
+				if err := execute.AppendCol(j, j, cr, builder); err != nil {
+					return err
+				}
+				continue
+			}
+			for _, tm := range cr.Times(j) {
+				if err := builder.AppendTime(j, t.spec.shift(tm)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// addOffset adds d to tm, except to the minimum and maximum times,
+// and saturates instead of overflowing.
+func addOffset(tm execute.Time, d execute.Duration) execute.Time {
+	switch {
+	case tm <= execute.Time(models.MinNanoTime) || tm >= execute.Time(models.MaxNanoTime):
+		return tm
+	case d > 0 && tm > execute.Time(models.MaxNanoTime-int64(d)):
+		return execute.Time(models.MaxNanoTime)
+	case d < 0 && tm < execute.Time(models.MinNanoTime-int64(d)):
+		return execute.Time(models.MinNanoTime)
+	}
+	return tm.Add(d)
+}
+
+func (t *shiftTimeZoneTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *shiftTimeZoneTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *shiftTimeZoneTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,156 @@
+package functions_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func TestShiftTimeZone_Process(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Daylight saving time starts at 2019-03-10T07:00:00Z in New York,
+	// when the offset changes from -5h to -4h.
+	testCases := []struct {
+		name    string
+		spec    *functions.ShiftTimeZoneProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "to location",
+			spec: &functions.ShiftTimeZoneProcedureSpec{
+				Location: loc,
+				Columns:  []string{"_start", "_stop", "_time"},
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-03-10T00:00:00Z"), execute.Time(execute.MaxTime), mustTime("2019-03-10T06:00:00Z"), 1.0},
+					{mustTime("2019-03-10T00:00:00Z"), execute.Time(execute.MaxTime), mustTime("2019-03-10T08:00:00Z"), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-03-09T19:00:00Z"), execute.Time(execute.MaxTime), mustTime("2019-03-10T01:00:00Z"), 1.0},
+					{mustTime("2019-03-09T19:00:00Z"), execute.Time(execute.MaxTime), mustTime("2019-03-10T04:00:00Z"), 2.0},
+				},
+			}},
+		},
+		{
+			name: "to UTC",
+			spec: &functions.ShiftTimeZoneProcedureSpec{
+				Location: loc,
+				Columns:  []string{"_time"},
+				ToUTC:    true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-03-10T01:00:00Z"), 1.0},
+					{mustTime("2019-03-10T04:00:00Z"), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-03-10T06:00:00Z"), 1.0},
+					{mustTime("2019-03-10T08:00:00Z"), 2.0},
+				},
+			}},
+		},
+		{
+			name: "missing column",
+			spec: &functions.ShiftTimeZoneProcedureSpec{
+				Location: loc,
+				Columns:  []string{"_start", "_time"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-07-01T12:00:00Z"), 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-07-01T08:00:00Z"), 1.0},
+				},
+			}},
+		},
+		{
+			name: "not a time",
+			spec: &functions.ShiftTimeZoneProcedureSpec{
+				Location: loc,
+				Columns:  []string{"_value"},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{mustTime("2019-07-01T12:00:00Z"), 1.0},
+				},
+			}},
+			wantErr: errors.New(`shiftTimeZone: column "_value" is not of type time`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return functions.NewShiftTimeZoneTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+func mustTime(s string) execute.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		panic(err)
+	}
+	return values.ConvertTime(t)
+}
@@ -0,0 +1,456 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// WindowFillKind is the kind of the `windowFill` function.
+// It adds a row to each table for every window between start and stop that has no rows,
+// where the windows are every long and aligned to offset. The time of an added row is the
+// start of its window and its value is given by the mode:
+//   - "value" uses the value of the function,
+//   - "previous" uses the value of the previous row,
+//   - "linear" interpolates between the values of the previous and next rows,
+//   - "null" uses NaN, the null of a float column, as tables cannot hold null values.
+//
+// The other columns of an added row are copied from the previous row, or the next one for
+// windows before the first row. Windows without a value, e.g. before the first row with
+// "previous", are not added. With "null", an integer column becomes a float column and
+// the empty windows of a column that is not numeric are not added.
+const WindowFillKind = "windowFill"
+
+// The modes of the `windowFill` function.
+const (
+	FillValue    = "value"
+	FillPrevious = "previous"
+	FillLinear   = "linear"
+	FillNull     = "null"
+)
+
+// WindowFillOpSpec is the flux.OperationSpec for the `windowFill` function.
+// When start is not set, the windows begin with the window of the first row of each table.
+// When stop is not set, they end with the window of the last row.
+// When max windows is set, a table that spans more windows is an error.
+type WindowFillOpSpec struct {
+	Column     string        `json:"column"`
+	TimeColumn string        `json:"timeColumn"`
+	Start      flux.Time     `json:"start"`
+	Stop       flux.Time     `json:"stop"`
+	Every      flux.Duration `json:"every"`
+	Offset     flux.Duration `json:"offset"`
+	Mode       string        `json:"mode"`
+	Value      float64       `json:"value"`
+	MaxWindows int64         `json:"maxWindows,omitempty"`
+}
+
+func init() {
+	windowFillSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"column":     semantic.String,
+			"timeColumn": semantic.String,
+			"start":      semantic.Tvar(1),
+			"stop":       semantic.Tvar(2),
+			"every":      semantic.Duration,
+			"offset":     semantic.Duration,
+			"mode":       semantic.String,
+			"value":      semantic.Float,
+			"maxWindows": semantic.Int,
+		},
+		[]string{"every", "mode"},
+	)
+
+	flux.RegisterFunction(WindowFillKind, createWindowFillOpSpec, windowFillSignature)
+	flux.RegisterOpSpec(WindowFillKind, newWindowFillOp)
+	plan.RegisterProcedureSpec(WindowFillKind, newWindowFillProcedure, WindowFillKind)
+	execute.RegisterTransformation(WindowFillKind, createWindowFillTransformation)
+}
+
+func createWindowFillOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &WindowFillOpSpec{
+		Column:     execute.DefaultValueColLabel,
+		TimeColumn: execute.DefaultTimeColLabel,
+	}
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+	if col, ok, err := args.GetString("timeColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TimeColumn = col
+	}
+	if start, ok, err := args.GetTime("start"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Start = start
+	}
+	if stop, ok, err := args.GetTime("stop"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Stop = stop
+	}
+
+	every, err := args.GetRequiredDuration("every")
+	if err != nil {
+		return nil, err
+	}
+	spec.Every = every
+	if offset, ok, err := args.GetDuration("offset"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Offset = offset
+	}
+
+	if spec.Mode, err = args.GetRequiredString("mode"); err != nil {
+		return nil, err
+	}
+	if value, ok, err := args.GetFloat("value"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Value = value
+	}
+	if maxWindows, ok, err := args.GetInt("maxWindows"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.MaxWindows = maxWindows
+	}
+	return spec, nil
+}
+
+func newWindowFillOp() flux.OperationSpec {
+	return new(WindowFillOpSpec)
+}
+
+func (s *WindowFillOpSpec) Kind() flux.OperationKind {
+	return WindowFillKind
+}
+
+// WindowFillProcedureSpec is the plan.ProcedureSpec for the `windowFill` function.
+type WindowFillProcedureSpec struct {
+	plan.DefaultCost
+	Column     string
+	TimeColumn string
+	Start      execute.Time
+	Stop       execute.Time
+	HasStart   bool
+	HasStop    bool
+	Every      execute.Duration
+	Offset     execute.Duration
+	Mode       string
+	Value      float64
+	MaxWindows int64
+}
+
+func newWindowFillProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*WindowFillOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	if spec.Every <= 0 {
+		return nil, fmt.Errorf("windowFill: every must be positive, got %v", spec.Every)
+	}
+	switch spec.Mode {
+	case FillValue, FillPrevious, FillLinear, FillNull:
+	default:
+		return nil, fmt.Errorf("windowFill: unknown mode %q", spec.Mode)
+	}
+
+	p := &WindowFillProcedureSpec{
+		Column:     spec.Column,
+		TimeColumn: spec.TimeColumn,
+		Every:      execute.Duration(spec.Every),
+		Offset:     execute.Duration(spec.Offset),
+		Mode:       spec.Mode,
+		Value:      spec.Value,
+		MaxWindows: spec.MaxWindows,
+	}
+	if p.Column == "" {
+		p.Column = execute.DefaultValueColLabel
+	}
+	if p.TimeColumn == "" {
+		p.TimeColumn = execute.DefaultTimeColLabel
+	}
+	if !spec.Start.IsZero() {
+		p.Start = values.ConvertTime(spec.Start.Time(pa.Now()))
+		p.HasStart = true
+	}
+	if !spec.Stop.IsZero() {
+		p.Stop = values.ConvertTime(spec.Stop.Time(pa.Now()))
+		p.HasStop = true
+	}
+	return p, nil
+}
+
+func (s *WindowFillProcedureSpec) Kind() plan.ProcedureKind {
+	return WindowFillKind
+}
+
+func (s *WindowFillProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(WindowFillProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createWindowFillTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*WindowFillProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewWindowFillTransformation(d, cache, s)
+	return t, d, nil
+}
+
+// NewWindowFillTransformation returns the transformation of the `windowFill` function.
+func NewWindowFillTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *WindowFillProcedureSpec) execute.Transformation {
+	return &windowFillTransformation{d: d, cache: cache, spec: *spec}
+}
+
+type windowFillTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	spec WindowFillProcedureSpec
+}
+
+func (t *windowFillTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *windowFillTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	cols := tbl.Cols()
+	timeIdx := execute.ColIdx(t.spec.TimeColumn, cols)
+	if timeIdx < 0 {
+		return fmt.Errorf("windowFill: no column %q", t.spec.TimeColumn)
+	} else if cols[timeIdx].Type != flux.TTime {
+		return fmt.Errorf("windowFill: column %q is not of type time", t.spec.TimeColumn)
+	}
+	valueIdx := execute.ColIdx(t.spec.Column, cols)
+	if valueIdx < 0 {
+		return fmt.Errorf("windowFill: no column %q", t.spec.Column)
+	}
+	typ := cols[valueIdx].Type
+	numeric := typ == flux.TFloat || typ == flux.TInt || typ == flux.TUInt
+	if t.spec.Mode == FillNull && !numeric {
+		// The column has no null value, so the empty windows are left out.
+		return t.copyTable(tbl)
+	} else if t.spec.Mode != FillPrevious && !numeric {
+		return fmt.Errorf("windowFill: cannot fill column %q of type %s with mode %q", t.spec.Column, typ, t.spec.Mode)
+	}
+
+	var rows [][]values.Value
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			row := make([]values.Value, len(cols))
+			for j := range cols {
+				row[j] = execute.ValueForRow(cr, i, j)
+			}
+			rows = append(rows, row)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][timeIdx].Time() < rows[j][timeIdx].Time()
+	})
+
+	// NaN is only a value of float columns.
+	if t.spec.Mode == FillNull && typ != flux.TFloat {
+		cols = append([]flux.ColMeta(nil), cols...)
+		cols[valueIdx].Type = flux.TFloat
+		for _, row := range rows {
+			row[valueIdx] = values.NewFloat(numberValue(row[valueIdx]))
+		}
+		typ = flux.TFloat
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("windowFill found duplicate table with key: %v", tbl.Key())
+	}
+	for _, c := range cols {
+		if _, err := builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	appendRow := func(row []values.Value) error {
+		for j, v := range row {
+			if err := builder.AppendValue(j, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	start, stop := t.spec.Start, t.spec.Stop
+	if !t.spec.HasStart {
+		start = rows[0][timeIdx].Time()
+	}
+	if !t.spec.HasStop {
+		stop = rows[len(rows)-1][timeIdx].Time() + 1
+	}
+	if n := t.windows(start, stop); t.spec.MaxWindows > 0 && n > uint64(t.spec.MaxWindows) {
+		return fmt.Errorf("windowFill: %d windows exceed the maximum of %d", n, t.spec.MaxWindows)
+	}
+
+	var (
+		i    int
+		prev []values.Value
+	)
+	for w := t.windowStart(start); w < stop; w += execute.Time(t.spec.Every) {
+		// The first window is cut at start, like the windows of the window function.
+		tm := w
+		if tm < start {
+			tm = start
+		}
+
+		found := false
+		for ; i < len(rows) && rows[i][timeIdx].Time() < w+execute.Time(t.spec.Every); i++ {
+			if err := appendRow(rows[i]); err != nil {
+				return err
+			}
+			prev, found = rows[i], true
+		}
+		if found {
+			continue
+		}
+
+		var next []values.Value
+		if i < len(rows) {
+			next = rows[i]
+		}
+		v, ok := t.fill(tm, prev, next, timeIdx, valueIdx, typ)
+		if !ok {
+			continue
+		}
+		template := prev
+		if template == nil {
+			template = next
+		}
+		row := make([]values.Value, len(template))
+		copy(row, template)
+		row[timeIdx] = values.NewTime(tm)
+		row[valueIdx] = v
+		if err := appendRow(row); err != nil {
+			return err
+		}
+	}
+	for ; i < len(rows); i++ {
+		if err := appendRow(rows[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// windowStart returns the start of the window that contains tm.
+func (t *windowFillTransformation) windowStart(tm execute.Time) execute.Time {
+	d := (tm - execute.Time(t.spec.Offset)) % execute.Time(t.spec.Every)
+	if d < 0 {
+		d += execute.Time(t.spec.Every)
+	}
+	return tm - d
+}
+
+// copyTable adds tbl to the output as it is.
+func (t *windowFillTransformation) copyTable(tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("windowFill found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+	return execute.AppendTable(tbl, builder)
+}
+
+// windows returns the number of windows between start and stop.
+func (t *windowFillTransformation) windows(start, stop execute.Time) uint64 {
+	first := t.windowStart(start)
+	if stop <= first {
+		return 0
+	}
+	// The difference is computed unsigned, since it may not fit in an int64.
+	every := uint64(t.spec.Every)
+	return (uint64(stop) - uint64(first) + every - 1) / every
+}
+
+// fill returns the value of an empty window at tm between the rows prev and next,
+// either of which may be nil. It returns false if the window has no value.
+func (t *windowFillTransformation) fill(tm execute.Time, prev, next []values.Value, timeIdx, valueIdx int, typ flux.ColType) (values.Value, bool) {
+	switch t.spec.Mode {
+	case FillValue:
+		return fillNumber(t.spec.Value, typ), true
+	case FillPrevious:
+		if prev == nil {
+			return nil, false
+		}
+		return prev[valueIdx], true
+	case FillLinear:
+		if prev == nil || next == nil {
+			return nil, false
+		}
+		pt, nt := prev[timeIdx].Time(), next[timeIdx].Time()
+		pv, nv := numberValue(prev[valueIdx]), numberValue(next[valueIdx])
+		return fillNumber(pv+(nv-pv)*float64(tm-pt)/float64(nt-pt), typ), true
+	case FillNull:
+		return values.NewFloat(math.NaN()), true
+	default:
+		return nil, false
+	}
+}
+
+// fillNumber converts v to a value of the numeric column type typ.
+func fillNumber(v float64, typ flux.ColType) values.Value {
+	switch typ {
+	case flux.TInt:
+		return values.NewInt(int64(v))
+	case flux.TUInt:
+		return values.NewUInt(uint64(v))
+	default:
+		return values.NewFloat(v)
+	}
+}
+
+// numberValue returns the numeric value v as a float.
+func numberValue(v values.Value) float64 {
+	switch v.Type() {
+	case semantic.Int:
+		return float64(v.Int())
+	case semantic.UInt:
+		return float64(v.UInt())
+	default:
+		return v.Float()
+	}
+}
+
+func (t *windowFillTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *windowFillTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *windowFillTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,276 @@
+package functions_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func TestWindowFill_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *functions.WindowFillProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "linear",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Every:      execute.Duration(10),
+				Mode:       functions.FillLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(30), "cpu", 3.0},
+					{execute.Time(0), "cpu", 0.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "cpu", 0.0},
+					{execute.Time(10), "cpu", 1.0},
+					{execute.Time(20), "cpu", 2.0},
+					{execute.Time(30), "cpu", 3.0},
+				},
+			}},
+		},
+		{
+			name: "linear integers",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Every:      execute.Duration(10),
+				Mode:       functions.FillLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), int64(0)},
+					{execute.Time(35), int64(10)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), int64(0)},
+					{execute.Time(10), int64(1)},
+					{execute.Time(20), int64(5)},
+					{execute.Time(35), int64(10)},
+				},
+			}},
+		},
+		{
+			name: "previous",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Start:      execute.Time(0),
+				Stop:       execute.Time(60),
+				HasStart:   true,
+				HasStop:    true,
+				Every:      execute.Duration(10),
+				Mode:       functions.FillPrevious,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), "cpu", "a"},
+					{execute.Time(40), "cpu", "b"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), "cpu", "a"},
+					{execute.Time(20), "cpu", "a"},
+					{execute.Time(30), "cpu", "a"},
+					{execute.Time(40), "cpu", "b"},
+					{execute.Time(50), "cpu", "b"},
+				},
+			}},
+		},
+		{
+			name: "value with offset",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Start:      execute.Time(0),
+				Stop:       execute.Time(30),
+				HasStart:   true,
+				HasStop:    true,
+				Every:      execute.Duration(10),
+				Offset:     execute.Duration(5),
+				Mode:       functions.FillValue,
+				Value:      -1,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(16), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), -1.0},
+					{execute.Time(5), -1.0},
+					{execute.Time(16), 2.0},
+					{execute.Time(25), -1.0},
+				},
+			}},
+		},
+		{
+			name: "null",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Every:      execute.Duration(10),
+				Mode:       functions.FillNull,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(1)},
+					{execute.Time(20), int64(3)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0},
+					{execute.Time(10), math.NaN()},
+					{execute.Time(20), 3.0},
+				},
+			}},
+		},
+		{
+			name: "max windows",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Start:      execute.Time(0),
+				Stop:       execute.Time(100),
+				HasStart:   true,
+				HasStop:    true,
+				Every:      execute.Duration(10),
+				Mode:       functions.FillPrevious,
+				MaxWindows: 5,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0},
+				},
+			}},
+			wantErr: errors.New("windowFill: 10 windows exceed the maximum of 5"),
+		},
+		{
+			name: "max windows unbounded",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Start:      execute.MinTime,
+				Stop:       execute.MaxTime,
+				HasStart:   true,
+				HasStop:    true,
+				Every:      execute.Duration(1),
+				Mode:       functions.FillPrevious,
+				MaxWindows: 5,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0},
+				},
+			}},
+			wantErr: errors.New("windowFill: 18446744073709551615 windows exceed the maximum of 5"),
+		},
+		{
+			name: "linear strings",
+			spec: &functions.WindowFillProcedureSpec{
+				Column:     "_value",
+				TimeColumn: "_time",
+				Every:      execute.Duration(10),
+				Mode:       functions.FillLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "a"},
+				},
+			}},
+			wantErr: errors.New(`windowFill: cannot fill column "_value" of type string with mode "linear"`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return functions.NewWindowFillTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
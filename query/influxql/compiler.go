@@ -21,6 +21,7 @@ type Compiler struct {
 	Cluster string `json:"cluster,omitempty"`
 	DB      string `json:"db,omitempty"`
 	RP      string `json:"rp,omitempty"`
+	Bucket  string `json:"bucket,omitempty"`
 	Query   string `json:"query"`
 
 	dbrpMappingSvc platform.DBRPMappingService
@@ -40,6 +41,7 @@ func (c *Compiler) Compile(ctx context.Context) (*flux.Spec, error) {
 			Cluster:                c.Cluster,
 			DefaultDatabase:        c.DB,
 			DefaultRetentionPolicy: c.RP,
+			DefaultBucket:          c.Bucket,
 		},
 	)
 	return transpiler.Transpile(ctx, c.Query)
@@ -8,6 +8,27 @@ import (
 type Config struct {
 	DefaultDatabase        string
 	DefaultRetentionPolicy string
-	NowFn                  func() time.Time
-	Cluster                string
+	// DefaultBucket is the name of the bucket to read the measurements without a database from.
+	// It takes precedence over the default database.
+	DefaultBucket string
+	NowFn         func() time.Time
+	Cluster       string
+	// MaxSelectBuckets is the maximum number of windows of a series that GROUP BY time()
+	// fills. Zero uses DefaultMaxSelectBuckets and a negative value removes the limit.
+	MaxSelectBuckets int
+}
+
+// DefaultMaxSelectBuckets is the default maximum number of windows of a series that GROUP BY time() fills.
+const DefaultMaxSelectBuckets = 1000000
+
+// maxSelectBuckets returns the maximum number of windows of a series, or zero if there is no limit.
+func (c *Config) maxSelectBuckets() int {
+	switch {
+	case c.MaxSelectBuckets == 0:
+		return DefaultMaxSelectBuckets
+	case c.MaxSelectBuckets < 0:
+		return 0
+	default:
+		return c.MaxSelectBuckets
+	}
 }
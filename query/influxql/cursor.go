@@ -41,10 +41,14 @@ func createVarRefCursor(t *transpilerState, ref *influxql.VarRef) (cursor, error
 		return nil, errors.New("unimplemented: only one source is allowed")
 	}
 
-	// Only support a direct measurement. Subqueries are not supported yet.
-	mm, ok := t.stmt.Sources[0].(*influxql.Measurement)
-	if !ok {
-		return nil, errors.New("unimplemented: source must be a measurement")
+	var mm *influxql.Measurement
+	switch source := t.stmt.Sources[0].(type) {
+	case *influxql.Measurement:
+		mm = source
+	case *influxql.SubQuery:
+		return createSubQueryCursor(t, source, ref)
+	default:
+		return nil, errors.New("unimplemented: source must be a measurement or a subquery")
 	}
 
 	// Create the from spec and add it to the list of operations.
@@ -53,20 +57,11 @@ func createVarRefCursor(t *transpilerState, ref *influxql.VarRef) (cursor, error
 		return nil, err
 	}
 
-	valuer := influxql.NowValuer{Now: t.spec.Now}
-	_, tr, err := influxql.ConditionExpr(t.stmt.Condition, &valuer)
+	tr, err := t.timeRange()
 	if err != nil {
 		return nil, err
 	}
 
-	// If the maximum is not set and we have a windowing function, then
-	// the end time will be set to now.
-	if tr.Max.IsZero() {
-		if window, err := t.stmt.GroupByInterval(); err == nil && window > 0 {
-			tr.Max = t.spec.Now
-		}
-	}
-
 	range_ := t.op("range", &transformations.RangeOpSpec{
 		Start:       flux.Time{Absolute: tr.MinTime()},
 		Stop:        flux.Time{Absolute: tr.MaxTime()},
@@ -111,6 +106,28 @@ func createVarRefCursor(t *transpilerState, ref *influxql.VarRef) (cursor, error
 	}, nil
 }
 
+// valuer returns the valuer for evaluating the condition of the current statement.
+func (t *transpilerState) valuer() *influxql.NowValuer {
+	return &influxql.NowValuer{Now: t.spec.Now, Location: t.stmt.Location}
+}
+
+// timeRange returns the time range of the condition of the current statement.
+func (t *transpilerState) timeRange() (influxql.TimeRange, error) {
+	_, tr, err := influxql.ConditionExpr(t.stmt.Condition, t.valuer())
+	if err != nil {
+		return influxql.TimeRange{}, err
+	}
+
+	// If the maximum is not set and we have a windowing function, then
+	// the end time will be set to now.
+	if tr.Max.IsZero() {
+		if window, err := t.stmt.GroupByInterval(); err == nil && window > 0 {
+			tr.Max = t.spec.Now
+		}
+	}
+	return tr, nil
+}
+
 func (c *varRefCursor) ID() flux.OperationID {
 	return c.id
 }
@@ -4,12 +4,15 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
@@ -76,7 +79,6 @@ var skipTests = map[string]string{
 	"regex_tag_3":              "Transpiler: Returns results in wrong sort order for regex filter on tags (https://github.com/influxdata/platform/issues/1596)",
 	"explicit_type_0":          "Transpiler should remove _start column (https://github.com/influxdata/platform/issues/1360)",
 	"explicit_type_1":          "Transpiler should remove _start column (https://github.com/influxdata/platform/issues/1360)",
-	"fills_0":                  "need fill/Interpolate function (https://github.com/influxdata/platform/issues/272)",
 	"random_math_0":            "transpiler does not implement joining fields within a cursor (https://github.com/influxdata/platform/issues/1340)",
 	"selector_0":               "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
 	"selector_1":               "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
@@ -95,21 +97,16 @@ var skipTests = map[string]string{
 	"series_agg_7":             "Transpiler should remove _start column (https://github.com/influxdata/platform/issues/1360)",
 	"series_agg_8":             "Transpiler should remove _start column (https://github.com/influxdata/platform/issues/1360)",
 	"series_agg_9":             "Transpiler should remove _start column (https://github.com/influxdata/platform/issues/1360)",
-	"Subquery_0":               "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"Subquery_1":               "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"Subquery_2":               "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"Subquery_3":               "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"Subquery_4":               "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"Subquery_5":               "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"NestedSubquery_0":         "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"NestedSubquery_1":         "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"NestedSubquery_2":         "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"NestedSubquery_3":         "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"SimulatedHTTP_0":          "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"SimulatedHTTP_1":          "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"SimulatedHTTP_2":          "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"SimulatedHTTP_3":          "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
-	"SimulatedHTTP_4":          "Implement subqueries in the transpiler (https://github.com/influxdata/platform/issues/194)",
+	"Subquery_0":               "Implement subqueries in the transpiler: field wildcards (https://github.com/influxdata/platform/issues/194)",
+	"Subquery_2":               "transpiler does not implement joining fields within a cursor (https://github.com/influxdata/platform/issues/1340)",
+	"Subquery_4":               "transpiler does not implement joining fields within a cursor (https://github.com/influxdata/platform/issues/1340)",
+	"NestedSubquery_0":         "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
+	"NestedSubquery_1":         "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
+	"SimulatedHTTP_0":          "Implement subqueries in the transpiler: multiple sources (https://github.com/influxdata/platform/issues/194)",
+	"SimulatedHTTP_1":          "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
+	"SimulatedHTTP_2":          "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
+	"SimulatedHTTP_3":          "Implement subqueries in the transpiler: multiple sources (https://github.com/influxdata/platform/issues/194)",
+	"SimulatedHTTP_4":          "Implement subqueries in the transpiler: multiple sources (https://github.com/influxdata/platform/issues/194)",
 	"SelectorMath_0":           "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
 	"SelectorMath_1":           "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
 	"SelectorMath_2":           "Transpiler: unimplemented functions: top and bottom (https://github.com/influxdata/platform/issues/1601)",
@@ -213,7 +210,7 @@ func testGeneratedInfluxQL(t testing.TB, prefix, queryExt string) {
 		got = append(got, res.Next())
 	}
 
-	if ok, err := executetest.EqualResults(exp, got); !ok {
+	if ok, err := equalResults(exp, got); !ok {
 		t.Errorf("result not as expected: %v", err)
 
 		expBuffer := new(bytes.Buffer)
@@ -235,6 +232,57 @@ func testGeneratedInfluxQL(t testing.TB, prefix, queryExt string) {
 	}
 }
 
+// floatOptions compare floats within a relative tolerance, as the functions that
+// aggregate them may sum the values in another order than InfluxQL does.
+var floatOptions = cmp.Options{
+	cmpopts.EquateNaNs(),
+	cmpopts.EquateApprox(1e-12, 0),
+}
+
+// equalResults is executetest.EqualResults with the floats compared by floatOptions.
+func equalResults(want, got []flux.Result) (bool, error) {
+	if len(want) != len(got) {
+		return false, fmt.Errorf("unexpected number of results - want %d results, got %d results", len(want), len(got))
+	}
+	for i := range want {
+		if want[i].Name() != got[i].Name() {
+			return false, fmt.Errorf("unexpected result name - want %s, got %s", want[i].Name(), got[i].Name())
+		}
+		wt, err := resultTables(want[i])
+		if err != nil {
+			return false, err
+		}
+		gt, err := resultTables(got[i])
+		if err != nil {
+			return false, err
+		}
+		if len(wt) != len(gt) {
+			return false, fmt.Errorf("unexpected size for result %s - want %d tables, got %d tables", want[i].Name(), len(wt), len(gt))
+		}
+		if !cmp.Equal(wt, gt, floatOptions) {
+			return false, fmt.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(wt, gt, floatOptions))
+		}
+	}
+	return true, nil
+}
+
+// resultTables returns the normalized tables of r.
+func resultTables(r flux.Result) ([]*executetest.Table, error) {
+	var tables []*executetest.Table
+	if err := r.Tables().Do(func(tbl flux.Table) error {
+		t, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, t)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	executetest.NormalizeTables(tables)
+	return tables, nil
+}
+
 func resultsFromQuerier(querier *querytest.Querier, compiler flux.Compiler) (flux.ResultIterator, error) {
 	req := &query.ProxyRequest{
 		Request: query.Request{
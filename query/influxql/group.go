@@ -11,6 +11,7 @@ import (
 	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform/query/functions"
 	"github.com/pkg/errors"
 )
 
@@ -18,6 +19,9 @@ type groupInfo struct {
 	call     *influxql.Call
 	refs     []*influxql.VarRef
 	selector bool
+
+	// offset is the offset of the windows from the epoch, once the group is created.
+	offset time.Duration
 }
 
 type groupVisitor struct {
@@ -140,10 +144,9 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 		tags map[influxql.VarRef]struct{}
 		cond influxql.Expr
 	)
-	valuer := influxql.NowValuer{Now: t.spec.Now}
 	if t.stmt.Condition != nil {
 		var err error
-		if cond, _, err = influxql.ConditionExpr(t.stmt.Condition, &valuer); err != nil {
+		if cond, _, err = influxql.ConditionExpr(t.stmt.Condition, t.valuer()); err != nil {
 			return nil, err
 		} else if cond != nil {
 			tags = make(map[influxql.VarRef]struct{})
@@ -254,6 +257,21 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 				}, cur.ID()),
 				cursor: cur,
 			}
+			if cur, err = gr.fill(t, cur, interval); err != nil {
+				return nil, err
+			}
+
+			// The windows of a time zone were created from the wall clock times of its location.
+			if loc := t.stmt.Location; loc != nil {
+				cur = &opCursor{
+					id: t.op("shiftTimeZone", &functions.ShiftTimeZoneOpSpec{
+						Location: loc.String(),
+						Columns:  []string{execute.DefaultTimeColLabel},
+						ToUTC:    true,
+					}, cur.ID()),
+					cursor: cur,
+				}
+			}
 		}
 	} else {
 		// If we do not have a function, but we have a field option,
@@ -262,8 +280,6 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 			return nil, errors.New("using GROUP BY requires at least one aggregate function")
 		}
 
-		// TODO(jsternberg): Fill needs to be somewhere and it's probably here somewhere.
-		// Move this to the correct location once we've figured it out.
 		switch t.stmt.Fill {
 		case influxql.NoFill:
 			return nil, errors.New("fill(none) must be used with a function")
@@ -274,14 +290,96 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 	return cur, nil
 }
 
+// fill adds rows for the empty windows of the function call of the group with the fill option.
+func (gr *groupInfo) fill(t *transpilerState, in cursor, every time.Duration) (cursor, error) {
+	value, ok := in.Value(gr.call)
+	if !ok {
+		return nil, fmt.Errorf("undefined variable: %s", gr.call)
+	}
+	spec := &functions.WindowFillOpSpec{
+		Column:     value,
+		TimeColumn: execute.DefaultTimeColLabel,
+		Every:      flux.Duration(every),
+		Offset:     flux.Duration(gr.offset),
+		MaxWindows: int64(t.config.maxSelectBuckets()),
+	}
+	switch t.stmt.Fill {
+	case influxql.NumberFill:
+		spec.Mode = functions.FillValue
+		switch v := t.stmt.FillValue.(type) {
+		case int64:
+			spec.Value = float64(v)
+		case float64:
+			spec.Value = v
+		default:
+			return nil, fmt.Errorf("unsupported fill value: %v", v)
+		}
+	case influxql.PreviousFill:
+		spec.Mode = functions.FillPrevious
+	case influxql.LinearFill:
+		spec.Mode = functions.FillLinear
+	case influxql.NullFill:
+		// The outer query ignores the empty windows of a subquery, like the null values of 1.x.
+		if t.subquery {
+			return in, nil
+		}
+		spec.Mode = functions.FillNull
+	default:
+		return in, nil
+	}
+
+	tr, err := t.timeRange()
+	if err != nil {
+		return nil, err
+	}
+	// The maximum time of the condition is inclusive.
+	stop := t.wallClock(tr.MaxTime()).Add(1)
+	spec.Stop = flux.Time{Absolute: stop}
+	if !tr.Min.IsZero() {
+		start := t.wallClock(tr.Min)
+		spec.Start = flux.Time{Absolute: start}
+
+		// Without a start, the windows are counted by windowFill from the first row.
+		if max := t.config.maxSelectBuckets(); max > 0 {
+			first := start.Add(-windowDelta(start, every, gr.offset))
+			if n := (stop.Sub(first) + every - 1) / every; int64(n) > int64(max) {
+				return nil, fmt.Errorf("max-select-buckets limit exceeded: (%d/%d)", n, max)
+			}
+		}
+	}
+	return &opCursor{
+		id:     t.op("windowFill", spec, in.ID()),
+		cursor: in,
+	}, nil
+}
+
+// wallClock returns the wall clock time of the time zone of the statement at tm, read as UTC,
+// which is the time of the windows of the statement.
+func (t *transpilerState) wallClock(tm time.Time) time.Time {
+	loc := t.stmt.Location
+	if loc == nil || tm.UnixNano() <= influxql.MinTime || tm.UnixNano() >= influxql.MaxTime {
+		return tm
+	}
+	_, offset := tm.In(loc).Zone()
+	return tm.Add(time.Duration(offset) * time.Second)
+}
+
+// windowDelta returns the time between the start of the window of tm and tm.
+func windowDelta(tm time.Time, every, offset time.Duration) time.Duration {
+	d := (time.Duration(tm.UnixNano()) - offset) % every
+	if d < 0 {
+		d += every
+	}
+	return d
+}
+
 type groupCursor struct {
 	cursor
 	id flux.OperationID
 }
 
 func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
-	var windowEvery time.Duration
-	var windowStart time.Time
+	var windowEvery, windowOffset time.Duration
 	tags := []string{"_measurement", "_start"}
 	if len(t.stmt.Dimensions) > 0 {
 		// Maintain a set of the dimensions we have encountered.
@@ -313,7 +411,6 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 					return nil, errors.New("multiple time dimensions not allowed")
 				} else {
 					windowEvery = lit.Val
-					var windowOffset time.Duration
 					if len(expr.Args) == 2 {
 						switch lit2 := expr.Args[1].(type) {
 						case *influxql.DurationLiteral:
@@ -347,9 +444,6 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 						default:
 							return nil, errors.New("time dimension offset must be duration or now()")
 						}
-
-						//TODO set windowStart
-						windowStart = time.Unix(0, 0).Add(windowOffset)
 					}
 				}
 			case *influxql.Wildcard:
@@ -371,6 +465,21 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 	}, in.ID())
 
 	if windowEvery > 0 {
+		// The windows of a time zone are aligned to the wall clock times of its location,
+		// which the offset of the location at each time shifts the times to, even across
+		// daylight saving time. They are shifted back to UTC once the windows are filled.
+		if loc := t.stmt.Location; loc != nil {
+			id = t.op("shiftTimeZone", &functions.ShiftTimeZoneOpSpec{
+				Location: loc.String(),
+				Columns: []string{
+					execute.DefaultTimeColLabel,
+					execute.DefaultStartColLabel,
+					execute.DefaultStopColLabel,
+				},
+			}, id)
+		}
+		gr.offset = windowOffset
+
 		windowOp := &transformations.WindowOpSpec{
 			Every:       flux.Duration(windowEvery),
 			Period:      flux.Duration(windowEvery),
@@ -379,8 +488,8 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 			StopColumn:  execute.DefaultStopColLabel,
 		}
 
-		if !windowStart.IsZero() {
-			windowOp.Start = flux.Time{Absolute: windowStart}
+		if windowOffset != 0 {
+			windowOp.Start = flux.Time{Absolute: time.Unix(0, 0).Add(windowOffset)}
 		}
 
 		id = t.op("window", windowOp, id)
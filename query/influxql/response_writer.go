@@ -0,0 +1,158 @@
+package influxql
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/influxdata/platform/models"
+)
+
+// The formatters below are adapted from the response writers of the influxdb repo,
+// so the output matches the InfluxDB 1.X HTTP API.
+
+// responseWriter writes a response in one of the formats of the InfluxDB 1.X HTTP API.
+type responseWriter interface {
+	WriteResponse(resp Response) error
+}
+
+type jsonResponseWriter struct {
+	enc *json.Encoder
+}
+
+func newJSONResponseWriter(w io.Writer, pretty bool) *jsonResponseWriter {
+	enc := json.NewEncoder(w)
+	if pretty {
+		enc.SetIndent("", "    ")
+	}
+	return &jsonResponseWriter{enc: enc}
+}
+
+func (w *jsonResponseWriter) WriteResponse(resp Response) error {
+	return w.enc.Encode(resp)
+}
+
+// csvResponseWriter writes the rows of every statement as CSV with the columns name and tags
+// followed by the columns of the series. A header is written before the rows of each statement
+// and whenever the columns of the series change, after an empty line.
+type csvResponseWriter struct {
+	w           io.Writer
+	statementID int
+	columns     []string
+}
+
+func newCSVResponseWriter(w io.Writer) *csvResponseWriter {
+	return &csvResponseWriter{w: w, statementID: -1}
+}
+
+func (w *csvResponseWriter) WriteResponse(resp Response) error {
+	cw := csv.NewWriter(w.w)
+	if resp.Err != "" {
+		cw.Write([]string{"error"})
+		cw.Write([]string{resp.Err})
+		cw.Flush()
+		return cw.Error()
+	}
+
+	for _, result := range resp.Results {
+		if result.Err != "" {
+			cw.Write([]string{"error"})
+			cw.Write([]string{result.Err})
+			continue
+		}
+
+		if result.StatementID != w.statementID {
+			// If there are no series in the result, skip past this result.
+			if len(result.Series) == 0 {
+				continue
+			}
+
+			// Write an empty line between statements.
+			if w.statementID >= 0 {
+				if err := w.newline(cw); err != nil {
+					return err
+				}
+			}
+			w.statementID = result.StatementID
+			if err := w.header(cw, result.Series[0].Columns); err != nil {
+				return err
+			}
+		}
+
+		for i, row := range result.Series {
+			if i > 0 && !stringsEqual(result.Series[i-1].Columns, row.Columns) {
+				// The columns have changed, so write the header again.
+				if err := w.newline(cw); err != nil {
+					return err
+				}
+				if err := w.header(cw, row.Columns); err != nil {
+					return err
+				}
+			}
+
+			w.columns[0] = row.Name
+			if len(row.Tags) > 0 {
+				w.columns[1] = string(models.NewTags(row.Tags).HashKey()[1:])
+			} else {
+				w.columns[1] = ""
+			}
+			for _, values := range row.Values {
+				for i, value := range values {
+					w.columns[i+2] = formatCSVValue(value)
+				}
+				if err := cw.Write(w.columns); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (w *csvResponseWriter) header(cw *csv.Writer, columns []string) error {
+	w.columns = make([]string, 2+len(columns))
+	w.columns[0] = "name"
+	w.columns[1] = "tags"
+	copy(w.columns[2:], columns)
+	return cw.Write(w.columns)
+}
+
+func (w *csvResponseWriter) newline(cw *csv.Writer) error {
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w.w, "\n")
+	return err
+}
+
+func formatCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,172 +1,340 @@
 package influxql
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/influxql"
 )
 
 // MultiResultEncoder encodes results as InfluxQL JSON format.
-type MultiResultEncoder struct{}
+// The zero value writes a single JSON response with RFC3339 times.
+type MultiResultEncoder struct {
+	// Encoding is the format of the responses; JSON, JSONPretty or CSV.
+	Encoding EncodingFormat
+	// TimeFormat is the format of the times in the results.
+	TimeFormat TimeFormat
+	// ChunkSize is the maximum number of rows in each response when the results are chunked.
+	// The results are written as a single response when it is zero.
+	ChunkSize int
+}
 
 // Encode writes a collection of results to the influxdb 1.X http response format.
 // Expectations/Assumptions:
-//  1.  Each result will be published as a 'statement' in the top-level list of results. The result name
-//      will be interpreted as an integer and used as the statement id.
-//  2.  If the _measurement name is present in the group key, it will be used as the result name instead
-//      of as a normal tag.
-//  3.  All columns in the group key must be strings and they will be used as tags. There is no current way
-//      to have a tag and field be the same name in the results.
-//      TODO(jsternberg): For full compatibility, the above must be possible.
-//  4.  All other columns are fields and will be output in the order they are found.
-//      TODO(jsternberg): This function currently requires the first column to be a time field, but this isn't
-//      a strict requirement and will be lifted when we begin to work on transpiling meta queries.
+//  1. Each result will be published as a 'statement' in the top-level list of results. The result name
+//     will be interpreted as an integer and used as the statement id.
+//  2. If the _measurement name is present in the group key, it will be used as the result name instead
+//     of as a normal tag.
+//  3. All columns in the group key must be strings and they will be used as tags. There is no current way
+//     to have a tag and field be the same name in the results.
+//     TODO(jsternberg): For full compatibility, the above must be possible.
+//  4. All other columns are fields and will be output in the order they are found.
+//     TODO(jsternberg): This function currently requires the first column to be a time field, but this isn't
+//     a strict requirement and will be lifted when we begin to work on transpiling meta queries.
+//
+// When the results are chunked, every series is split into chunks of at most ChunkSize rows and
+// each chunk is written as its own response, the way InfluxDB 1.X does for chunked queries.
 func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
-	resp := Response{}
 	wc := &iocounter.Writer{Writer: w}
 
+	var rw responseWriter
+	switch e.Encoding {
+	case CSV:
+		rw = newCSVResponseWriter(wc)
+	default:
+		rw = newJSONResponseWriter(wc, e.Encoding == JSONPretty)
+	}
+
+	var err error
+	if e.ChunkSize > 0 {
+		// Flush each chunk so the client receives it as soon as it is encoded.
+		flusher, _ := w.(http.Flusher)
+		err = e.encodeChunks(rw, flusher, results)
+	} else {
+		err = rw.WriteResponse(e.response(results))
+	}
+	return wc.Count(), err
+}
+
+// response reads all of the results into a single response.
+func (e *MultiResultEncoder) response(results flux.ResultIterator) Response {
+	resp := Response{}
 	for results.More() {
 		res := results.Next()
-		name := res.Name()
-		id, err := strconv.Atoi(name)
+		id, err := strconv.Atoi(res.Name())
 		if err != nil {
 			resp.error(fmt.Errorf("unable to parse statement id from result name: %s", err))
 			results.Release()
 			break
 		}
 
-		tables := res.Tables()
-
 		result := Result{StatementID: id}
-		if err := tables.Do(func(tbl flux.Table) error {
-			var row Row
-
-			for j, c := range tbl.Key().Cols() {
-				if c.Type != flux.TString {
-					// Skip any columns that aren't strings. They are extra ones that
-					// flux includes by default like the start and end times that we do not
-					// care about.
-					continue
-				}
-				v := tbl.Key().Value(j).Str()
-				if c.Label == "_measurement" {
-					row.Name = v
-				} else if c.Label == "_field" {
-					// If the field key was not removed by a previous operation, we explicitly
-					// ignore it here when encoding the result back.
-				} else {
-					if row.Tags == nil {
-						row.Tags = make(map[string]string)
-					}
-					row.Tags[c.Label] = v
-				}
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			row, err := e.row(tbl)
+			if err != nil {
+				return err
 			}
+			result.Series = append(result.Series, row)
+			return nil
+		}); err != nil {
+			resp.error(err)
+			results.Release()
+			break
+		}
+		resp.Results = append(resp.Results, result)
+	}
 
-			// TODO: resultColMap should be constructed from query metadata once it is provided.
-			// for now we know that an influxql query ALWAYS has time first, so we put this placeholder
-			// here to catch this most obvious requirement.  Column orderings should be explicitly determined
-			// from the ordering given in the original flux.
-			resultColMap := map[string]int{}
-			j := 1
-			for _, c := range tbl.Cols() {
-				if c.Label == execute.DefaultTimeColLabel {
-					resultColMap[c.Label] = 0
-				} else if !tbl.Key().HasCol(c.Label) {
-					resultColMap[c.Label] = j
-					j++
-				}
-			}
+	if err := results.Err(); err != nil && resp.Err == "" {
+		resp.error(err)
+	}
+	return resp
+}
+
+// encodeChunks writes a response for each chunk of the results.
+// A chunk is only written once the next one is known, so the last chunk of a
+// statement can be written without the partial flag.
+func (e *MultiResultEncoder) encodeChunks(w responseWriter, flusher http.Flusher, results flux.ResultIterator) error {
+	write := func(resp Response) error {
+		if err := w.WriteResponse(resp); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	for results.More() {
+		res := results.Next()
+		id, err := strconv.Atoi(res.Name())
+		if err != nil {
+			results.Release()
+			resp := Response{}
+			resp.error(fmt.Errorf("unable to parse statement id from result name: %s", err))
+			return write(resp)
+		}
 
-			if _, ok := resultColMap[execute.DefaultTimeColLabel]; !ok {
-				for k, v := range resultColMap {
-					resultColMap[k] = v - 1
+		var (
+			pending *Row
+			werr    error
+		)
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			row, err := e.row(tbl)
+			if err != nil {
+				return err
+			}
+			for _, chunk := range chunkRow(row, e.ChunkSize) {
+				if pending != nil {
+					if werr = write(Response{Results: []Result{{
+						StatementID: id,
+						Series:      []*Row{pending},
+						Partial:     true,
+					}}}); werr != nil {
+						return werr
+					}
 				}
+				pending = chunk
+			}
+			return nil
+		}); err != nil {
+			results.Release()
+			if werr != nil {
+				return werr
 			}
+			return write(Response{Results: []Result{{StatementID: id, Err: err.Error()}}})
+		}
 
-			row.Columns = make([]string, len(resultColMap))
-			for k, v := range resultColMap {
-				if k == execute.DefaultTimeColLabel {
-					k = "time"
-				}
-				row.Columns[v] = k
+		result := Result{StatementID: id}
+		if pending != nil {
+			result.Series = []*Row{pending}
+		}
+		if err := write(Response{Results: []Result{result}}); err != nil {
+			results.Release()
+			return err
+		}
+	}
+
+	if err := results.Err(); err != nil {
+		resp := Response{}
+		resp.error(err)
+		return write(resp)
+	}
+	return nil
+}
+
+// chunkRow splits the values of row into rows of at most size values.
+// Every row except the last is partial.
+func chunkRow(row *Row, size int) []*Row {
+	if len(row.Values) <= size {
+		return []*Row{row}
+	}
+
+	var rows []*Row
+	for i := 0; i < len(row.Values); i += size {
+		end := i + size
+		if end > len(row.Values) {
+			end = len(row.Values)
+		}
+		rows = append(rows, &Row{
+			Name:    row.Name,
+			Tags:    row.Tags,
+			Columns: row.Columns,
+			Values:  row.Values[i:end],
+			Partial: end < len(row.Values),
+		})
+	}
+	return rows
+}
+
+// row converts a table to a series of the response.
+func (e *MultiResultEncoder) row(tbl flux.Table) (*Row, error) {
+	var row Row
+
+	for j, c := range tbl.Key().Cols() {
+		if c.Type != flux.TString {
+			// Skip any columns that aren't strings. They are extra ones that
+			// flux includes by default like the start and end times that we do not
+			// care about.
+			continue
+		}
+		v := tbl.Key().Value(j).Str()
+		if c.Label == "_measurement" {
+			row.Name = v
+		} else if c.Label == "_field" {
+			// If the field key was not removed by a previous operation, we explicitly
+			// ignore it here when encoding the result back.
+		} else {
+			if row.Tags == nil {
+				row.Tags = make(map[string]string)
 			}
+			row.Tags[c.Label] = v
+		}
+	}
 
-			if err := tbl.Do(func(cr flux.ColReader) error {
-				// Preallocate the number of rows for the response to make this section
-				// of code easier to read. Find a time column which should exist
-				// in the output.
-				values := make([][]interface{}, cr.Len())
-				for j := range values {
-					values[j] = make([]interface{}, len(row.Columns))
-				}
+	// TODO: resultColMap should be constructed from query metadata once it is provided.
+	// for now we know that an influxql query ALWAYS has time first, so we put this placeholder
+	// here to catch this most obvious requirement.  Column orderings should be explicitly determined
+	// from the ordering given in the original flux.
+	resultColMap := map[string]int{}
+	j := 1
+	for _, c := range tbl.Cols() {
+		if c.Label == execute.DefaultTimeColLabel {
+			resultColMap[c.Label] = 0
+		} else if !tbl.Key().HasCol(c.Label) {
+			resultColMap[c.Label] = j
+			j++
+		}
+	}
 
-				j := 0
-				for idx, c := range tbl.Cols() {
-					if cr.Key().HasCol(c.Label) {
-						continue
-					}
+	if _, ok := resultColMap[execute.DefaultTimeColLabel]; !ok {
+		for k, v := range resultColMap {
+			resultColMap[k] = v - 1
+		}
+	}
 
-					j = resultColMap[c.Label]
-					// Fill in the values for each column.
-					switch c.Type {
-					case flux.TFloat:
-						for i, v := range cr.Floats(idx) {
-							values[i][j] = v
-						}
-					case flux.TInt:
-						for i, v := range cr.Ints(idx) {
-							values[i][j] = v
-						}
-					case flux.TString:
-						for i, v := range cr.Strings(idx) {
-							values[i][j] = v
-						}
-					case flux.TUInt:
-						for i, v := range cr.UInts(idx) {
-							values[i][j] = v
-						}
-					case flux.TBool:
-						for i, v := range cr.Bools(idx) {
-							values[i][j] = v
-						}
-					case flux.TTime:
-						for i, v := range cr.Times(idx) {
-							values[i][j] = v.Time().Format(time.RFC3339Nano)
-						}
-					default:
-						return fmt.Errorf("unsupported column type: %s", c.Type)
-					}
+	row.Columns = make([]string, len(resultColMap))
+	for k, v := range resultColMap {
+		if k == execute.DefaultTimeColLabel {
+			k = "time"
+		}
+		row.Columns[v] = k
+	}
+
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		// Preallocate the number of rows for the response to make this section
+		// of code easier to read. Find a time column which should exist
+		// in the output.
+		values := make([][]interface{}, cr.Len())
+		for j := range values {
+			values[j] = make([]interface{}, len(row.Columns))
+		}
 
+		j := 0
+		for idx, c := range tbl.Cols() {
+			if cr.Key().HasCol(c.Label) {
+				continue
+			}
+
+			j = resultColMap[c.Label]
+			// Fill in the values for each column.
+			switch c.Type {
+			case flux.TFloat:
+				for i, v := range cr.Floats(idx) {
+					// NaN stands for the null values of fill(null).
+					if math.IsNaN(v) {
+						continue
+					}
+					values[i][j] = v
 				}
-				row.Values = append(row.Values, values...)
-				return nil
-			}); err != nil {
-				return err
+			case flux.TInt:
+				for i, v := range cr.Ints(idx) {
+					values[i][j] = v
+				}
+			case flux.TString:
+				for i, v := range cr.Strings(idx) {
+					values[i][j] = v
+				}
+			case flux.TUInt:
+				for i, v := range cr.UInts(idx) {
+					values[i][j] = v
+				}
+			case flux.TBool:
+				for i, v := range cr.Bools(idx) {
+					values[i][j] = v
+				}
+			case flux.TTime:
+				for i, v := range cr.Times(idx) {
+					values[i][j] = e.formatTime(v)
+				}
+			default:
+				return fmt.Errorf("unsupported column type: %s", c.Type)
 			}
 
-			result.Series = append(result.Series, &row)
-			return nil
-		}); err != nil {
-			resp.error(err)
-			results.Release()
-			break
 		}
-		resp.Results = append(resp.Results, result)
+		row.Values = append(row.Values, values...)
+		return nil
+	}); err != nil {
+		return nil, err
 	}
+	return &row, nil
+}
 
-	if err := results.Err(); err != nil && resp.Err == "" {
-		resp.error(err)
+// formatTime returns the time as a string or as the number of units since the epoch,
+// according to the time format.
+func (e *MultiResultEncoder) formatTime(t execute.Time) interface{} {
+	// The time of an aggregate over an unbounded range is the minimum time,
+	// which InfluxQL reports as the epoch.
+	if t == execute.Time(influxql.MinTime) {
+		t = 0
 	}
 
-	err := json.NewEncoder(wc).Encode(resp)
-	return wc.Count(), err
+	var unit time.Duration
+	switch e.TimeFormat {
+	case Hour:
+		unit = time.Hour
+	case Minute:
+		unit = time.Minute
+	case Second:
+		unit = time.Second
+	case Millisecond:
+		unit = time.Millisecond
+	case Microsecond:
+		unit = time.Microsecond
+	case Nanosecond:
+		unit = time.Nanosecond
+	default:
+		return t.Time().Format(time.RFC3339Nano)
+	}
+	return int64(t) / int64(unit)
 }
+
 func NewMultiResultEncoder() *MultiResultEncoder {
 	return new(MultiResultEncoder)
 }
@@ -16,6 +16,7 @@ import (
 func TestMultiResultEncoder_Encode(t *testing.T) {
 	for _, tt := range []struct {
 		name string
+		enc  *influxql.MultiResultEncoder
 		in   flux.ResultIterator
 		out  string
 	}{
@@ -83,6 +84,30 @@ func TestMultiResultEncoder_Encode(t *testing.T) {
 			in:   &resultErrorIterator{Error: "expected"},
 			out:  `{"error":"expected"}`,
 		},
+		{
+			name: "Epoch",
+			enc:  &influxql.MultiResultEncoder{TimeFormat: influxql.Second},
+			in:   serverResults(float64(2)),
+			out:  `{"results":[{"statement_id":0,"series":[{"name":"m0","tags":{"host":"server01"},"columns":["time","value"],"values":[[1527152400,2]]}]}]}`,
+		},
+		{
+			name: "CSV",
+			enc: &influxql.MultiResultEncoder{
+				Encoding:   influxql.CSV,
+				TimeFormat: influxql.Nanosecond,
+			},
+			in: serverResults(float64(2), float64(3)),
+			out: `name,tags,time,value
+m0,host=server01,1527152400000000000,2
+m0,host=server01,1527152410000000000,3`,
+		},
+		{
+			name: "Chunked",
+			enc:  &influxql.MultiResultEncoder{ChunkSize: 1},
+			in:   serverResults(float64(2), float64(3)),
+			out: `{"results":[{"statement_id":0,"series":[{"name":"m0","tags":{"host":"server01"},"columns":["time","value"],"values":[["2018-05-24T09:00:00Z",2]],"partial":true}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"m0","tags":{"host":"server01"},"columns":["time","value"],"values":[["2018-05-24T09:00:10Z",3]]}]}]}`,
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -90,7 +115,10 @@ func TestMultiResultEncoder_Encode(t *testing.T) {
 			tt.out += "\n"
 
 			var buf bytes.Buffer
-			enc := influxql.NewMultiResultEncoder()
+			enc := tt.enc
+			if enc == nil {
+				enc = influxql.NewMultiResultEncoder()
+			}
 			n, err := enc.Encode(&buf, tt.in)
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -106,6 +134,29 @@ func TestMultiResultEncoder_Encode(t *testing.T) {
 	}
 }
 
+// serverResults returns a single series with a value every 10 seconds from 2018-05-24T09:00:00Z.
+func serverResults(values ...interface{}) flux.ResultIterator {
+	data := make([][]interface{}, len(values))
+	for i, v := range values {
+		data[i] = []interface{}{ts("2018-05-24T09:00:00Z") + execute.Time(i)*execute.Time(10*time.Second), "m0", "server01", v}
+	}
+	return flux.NewSliceResultIterator(
+		[]flux.Result{&executetest.Result{
+			Nm: "0",
+			Tbls: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "value", Type: flux.TFloat},
+				},
+				Data: data,
+			}},
+		}},
+	)
+}
+
 type resultErrorIterator struct {
 	Error string
 }
@@ -0,0 +1,377 @@
+package influxql
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform/query/functions"
+	pinputs "github.com/influxdata/platform/query/functions/inputs"
+)
+
+// SHOW MEASUREMENTS and SHOW TAG KEYS list the measurements and tag keys from the storage index.
+// SHOW FIELD KEYS and SHOW SERIES read the points of the database and reduce them to the listing
+// that is requested, since the index holds neither the types of the fields nor the keys of the series.
+// Unlike SHOW TAG VALUES, they consider every point of the database unless the condition
+// restricts the time range.
+
+func (t *transpilerState) transpileShowMeasurements(ctx context.Context, stmt *influxql.ShowMeasurementsStatement) (flux.OperationID, error) {
+	if len(stmt.SortFields) > 0 {
+		return "", errors.New("unimplemented: ORDER BY in SHOW MEASUREMENTS")
+	}
+
+	var sources influxql.Sources
+	if stmt.Source != nil {
+		sources = influxql.Sources{stmt.Source}
+	}
+	spec, err := t.schemaSpec(stmt.Database, sources, stmt.Condition)
+	if err != nil {
+		return "", err
+	}
+
+	// The measurements are listed in a single table, sorted by name.
+	op := t.op("measurements", &pinputs.MeasurementsOpSpec{SchemaSpec: spec})
+	op = t.limitRows(op, stmt.Limit, stmt.Offset)
+
+	// SHOW MEASUREMENTS returns a single series named measurements with one column, name.
+	op = t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			execute.DefaultValueColLabel: "name",
+		},
+	}, op)
+	op = t.op("set", &transformations.SetOpSpec{
+		Key:   "_measurement",
+		Value: "measurements",
+	}, op)
+	return t.op("group", &transformations.GroupOpSpec{
+		Columns: []string{"_measurement"},
+		Mode:    "by",
+	}, op), nil
+}
+
+func (t *transpilerState) transpileShowTagKeys(ctx context.Context, stmt *influxql.ShowTagKeysStatement) (flux.OperationID, error) {
+	if len(stmt.SortFields) > 0 {
+		return "", errors.New("unimplemented: ORDER BY in SHOW TAG KEYS")
+	} else if stmt.SLimit > 0 || stmt.SOffset > 0 {
+		return "", errors.New("unimplemented: SLIMIT and SOFFSET in SHOW TAG KEYS")
+	}
+
+	spec, err := t.schemaSpec(stmt.Database, stmt.Sources, stmt.Condition)
+	if err != nil {
+		return "", err
+	}
+
+	// The keys are listed in a table per measurement, sorted by key.
+	// The measurement and the field of a series are not tags.
+	op := t.op("tagKeys", &pinputs.TagKeysOpSpec{
+		SchemaSpec:    spec,
+		ByMeasurement: true,
+	})
+	value := &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: "r"},
+		Property: execute.DefaultValueColLabel,
+	}
+	op = t.filter(&semantic.LogicalExpression{
+		Operator: ast.AndOperator,
+		Left: &semantic.BinaryExpression{
+			Operator: ast.NotEqualOperator,
+			Left:     value,
+			Right:    &semantic.StringLiteral{Value: "_field"},
+		},
+		Right: &semantic.BinaryExpression{
+			Operator: ast.NotEqualOperator,
+			Left:     value,
+			Right:    &semantic.StringLiteral{Value: "_measurement"},
+		},
+	}, op)
+	op = t.limitRows(op, stmt.Limit, stmt.Offset)
+
+	// SHOW TAG KEYS returns a series per measurement with one column, tagKey.
+	return t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			execute.DefaultValueColLabel: "tagKey",
+		},
+	}, op), nil
+}
+
+func (t *transpilerState) transpileShowFieldKeys(ctx context.Context, stmt *influxql.ShowFieldKeysStatement) (flux.OperationID, error) {
+	if len(stmt.SortFields) > 0 {
+		return "", errors.New("unimplemented: ORDER BY in SHOW FIELD KEYS")
+	}
+
+	op, _, err := t.metaSource(stmt.Database, stmt.Sources, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the type of every field of each series, then keep the first type found
+	// for each field of a measurement.
+	op = t.op("fieldType", &functions.FieldTypeOpSpec{}, op)
+	op = t.op("keep", &transformations.KeepOpSpec{
+		Columns: []string{"_measurement", "_field", execute.DefaultValueColLabel},
+	}, op)
+	op = t.op("group", &transformations.GroupOpSpec{
+		Columns: []string{"_measurement"},
+		Mode:    "by",
+	}, op)
+	op = t.op("unique", &transformations.UniqueOpSpec{Column: "_field"}, op)
+	op = t.sortAndLimit(op, "_field", stmt.Limit, stmt.Offset)
+
+	// SHOW FIELD KEYS returns a series per measurement with two columns, fieldKey and fieldType.
+	return t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			"_field":                     "fieldKey",
+			execute.DefaultValueColLabel: "fieldType",
+		},
+	}, op), nil
+}
+
+func (t *transpilerState) transpileShowSeries(ctx context.Context, stmt *influxql.ShowSeriesStatement) (flux.OperationID, error) {
+	if len(stmt.SortFields) > 0 {
+		return "", errors.New("unimplemented: ORDER BY in SHOW SERIES")
+	}
+
+	op, cond, err := t.metaSource(stmt.Database, stmt.Sources, stmt.Condition)
+	if err != nil {
+		return "", err
+	}
+	op = t.op("seriesKey", &functions.SeriesKeyOpSpec{}, op)
+	if op, err = t.metaFilter(op, cond); err != nil {
+		return "", err
+	}
+
+	// Find the distinct keys of every series in a single table.
+	op = t.op("keep", &transformations.KeepOpSpec{
+		Columns: []string{execute.DefaultValueColLabel},
+	}, op)
+	op = t.op("group", &transformations.GroupOpSpec{Mode: "by"}, op)
+	op = t.op("distinct", &transformations.DistinctOpSpec{Column: execute.DefaultValueColLabel}, op)
+	op = t.sortAndLimit(op, execute.DefaultValueColLabel, stmt.Limit, stmt.Offset)
+
+	// SHOW SERIES returns a single series without a name with one column, key.
+	return t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			execute.DefaultValueColLabel: "key",
+		},
+	}, op), nil
+}
+
+// schemaSpec returns the arguments of the schema functions that list the series of the database
+// that belong to the measurements in sources and match cond, within the time range of cond.
+// The variables in cond are tags, except _name, which is the measurement name.
+func (t *transpilerState) schemaSpec(db string, sources influxql.Sources, cond influxql.Expr) (pinputs.SchemaSpec, error) {
+	valuer := influxql.NowValuer{Now: t.spec.Now}
+	cond, tr, err := influxql.ConditionExpr(cond, &valuer)
+	if err != nil {
+		return pinputs.SchemaSpec{}, err
+	}
+
+	bucket, bucketID, err := t.bucket(&influxql.Measurement{Database: db})
+	if err != nil {
+		return pinputs.SchemaSpec{}, err
+	}
+	spec := pinputs.SchemaSpec{
+		Bucket:   bucket,
+		BucketID: bucketID,
+		Start:    flux.Time{Absolute: tr.MinTime()},
+		Stop:     flux.Time{Absolute: tr.MaxTime()},
+	}
+
+	expr, err := sourcesExpr(sources)
+	if err != nil {
+		return pinputs.SchemaSpec{}, err
+	}
+	if cond != nil {
+		e, err := t.mapField(cond, metaCursor{})
+		if err != nil {
+			return pinputs.SchemaSpec{}, err
+		}
+		if expr != nil {
+			e = &semantic.LogicalExpression{
+				Operator: ast.AndOperator,
+				Left:     expr,
+				Right:    e,
+			}
+		}
+		expr = e
+	}
+	if expr != nil {
+		spec.Predicate = &semantic.FunctionExpression{
+			Block: &semantic.FunctionBlock{
+				Parameters: &semantic.FunctionParameters{
+					List: []*semantic.FunctionParameter{
+						{Key: &semantic.Identifier{Name: "r"}},
+					},
+				},
+				Body: expr,
+			},
+		}
+	}
+	return spec, nil
+}
+
+// metaSource reads the points of the database that belong to the measurements in sources,
+// within the time range of cond. It returns the rest of cond, for metaFilter.
+func (t *transpilerState) metaSource(db string, sources influxql.Sources, cond influxql.Expr) (flux.OperationID, influxql.Expr, error) {
+	valuer := influxql.NowValuer{Now: t.spec.Now}
+	cond, tr, err := influxql.ConditionExpr(cond, &valuer)
+	if err != nil {
+		return "", nil, err
+	}
+
+	op, err := t.from(&influxql.Measurement{Database: db})
+	if err != nil {
+		return "", nil, err
+	}
+	op = t.op("range", &transformations.RangeOpSpec{
+		Start:       flux.Time{Absolute: tr.MinTime()},
+		Stop:        flux.Time{Absolute: tr.MaxTime()},
+		TimeColumn:  execute.DefaultTimeColLabel,
+		StartColumn: execute.DefaultStartColLabel,
+		StopColumn:  execute.DefaultStopColLabel,
+	}, op)
+
+	expr, err := sourcesExpr(sources)
+	if err != nil {
+		return "", nil, err
+	} else if expr == nil {
+		return op, cond, nil
+	}
+	return t.filter(expr, op), cond, nil
+}
+
+// sourcesExpr returns an expression matching the points of the measurements in sources,
+// or nil if there are no sources.
+func sourcesExpr(sources influxql.Sources) (semantic.Expression, error) {
+	var expr semantic.Expression
+	for _, source := range sources {
+		mm, ok := source.(*influxql.Measurement)
+		if !ok {
+			return nil, errors.New("unimplemented: source must be a measurement")
+		}
+		e := measurementExpr(mm)
+		if expr != nil {
+			e = &semantic.LogicalExpression{
+				Operator: ast.OrOperator,
+				Left:     expr,
+				Right:    e,
+			}
+		}
+		expr = e
+	}
+	return expr, nil
+}
+
+// metaFilter filters the tables of op by the condition of a meta query.
+// The variables in cond are tags, except _name, which is the measurement name.
+// The tables must have a row per series, since the tags that a series does not have
+// are added to its table as empty strings to evaluate the condition.
+func (t *transpilerState) metaFilter(op flux.OperationID, cond influxql.Expr) (flux.OperationID, error) {
+	if cond == nil {
+		return op, nil
+	}
+
+	var tags []string
+	seen := make(map[string]bool)
+	influxql.WalkFunc(cond, func(node influxql.Node) {
+		if ref, ok := node.(*influxql.VarRef); ok && ref.Val != "_name" && !seen[ref.Val] {
+			seen[ref.Val] = true
+			tags = append(tags, ref.Val)
+		}
+	})
+
+	expr, err := t.mapField(cond, metaCursor{})
+	if err != nil {
+		return "", err
+	}
+	if len(tags) > 0 {
+		op = t.op("fillTags", &functions.FillTagsOpSpec{Tags: tags}, op)
+	}
+	return t.filter(expr, op), nil
+}
+
+// filter keeps the rows of op for which expr is true.
+func (t *transpilerState) filter(expr semantic.Expression, op flux.OperationID) flux.OperationID {
+	return t.op("filter", &transformations.FilterOpSpec{
+		Fn: &semantic.FunctionExpression{
+			Block: &semantic.FunctionBlock{
+				Parameters: &semantic.FunctionParameters{
+					List: []*semantic.FunctionParameter{
+						{Key: &semantic.Identifier{Name: "r"}},
+					},
+				},
+				Body: expr,
+			},
+		},
+	}, op)
+}
+
+// measurementExpr returns an expression matching the points of the measurement mm,
+// which is either a name or a regex.
+func measurementExpr(mm *influxql.Measurement) semantic.Expression {
+	measurement := &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: "r"},
+		Property: "_measurement",
+	}
+	if mm.Regex != nil {
+		return &semantic.BinaryExpression{
+			Operator: ast.RegexpMatchOperator,
+			Left:     measurement,
+			Right:    &semantic.RegexpLiteral{Value: mm.Regex.Val},
+		}
+	}
+	return &semantic.BinaryExpression{
+		Operator: ast.EqualOperator,
+		Left:     measurement,
+		Right:    &semantic.StringLiteral{Value: mm.Name},
+	}
+}
+
+// sortAndLimit sorts the rows of each table by column and applies the limit and offset.
+func (t *transpilerState) sortAndLimit(op flux.OperationID, column string, limit, offset int) flux.OperationID {
+	op = t.op("sort", &transformations.SortOpSpec{
+		Columns: []string{column},
+	}, op)
+	return t.limitRows(op, limit, offset)
+}
+
+// limitRows applies the limit and offset to the rows of each table.
+func (t *transpilerState) limitRows(op flux.OperationID, limit, offset int) flux.OperationID {
+	if limit == 0 && offset == 0 {
+		return op
+	}
+
+	n := int64(limit)
+	if n == 0 {
+		n = math.MaxInt64
+	}
+	return t.op("limit", &transformations.LimitOpSpec{
+		N:      n,
+		Offset: int64(offset),
+	}, op)
+}
+
+// metaCursor is a pseudo-cursor for the condition of a meta query.
+// Each variable reference in it is a tag, except _name which is the measurement name.
+type metaCursor struct{}
+
+func (metaCursor) ID() flux.OperationID { panic("unimplemented") }
+
+func (metaCursor) Keys() []influxql.Expr { panic("unimplemented") }
+
+func (metaCursor) Value(expr influxql.Expr) (string, bool) {
+	ref, ok := expr.(*influxql.VarRef)
+	if !ok {
+		return "", false
+	}
+	if ref.Val == "_name" {
+		return "_measurement", true
+	}
+	return ref.Val, true
+}
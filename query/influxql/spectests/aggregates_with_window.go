@@ -14,6 +14,7 @@ import (
 	"github.com/influxdata/flux/execute"
 
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/platform/query/functions"
 )
 
 func init() {
@@ -114,6 +115,18 @@ func init() {
 								StopColumn:  execute.DefaultStopColLabel,
 							},
 						},
+						{
+							ID: "windowFill0",
+							Spec: &functions.WindowFillOpSpec{
+								Column:     execute.DefaultValueColLabel,
+								TimeColumn: execute.DefaultTimeColLabel,
+								Start:      flux.Time{Absolute: Now().Add(-10 * time.Minute)},
+								Stop:       flux.Time{Absolute: Now().Add(1)},
+								Every:      flux.Duration(time.Minute),
+								Mode:       functions.FillNull,
+								MaxWindows: 1000000,
+							},
+						},
 						{
 							ID: "map0",
 							Spec: &transformations.MapOpSpec{
@@ -166,7 +179,8 @@ func init() {
 						{Parent: "window0", Child: aggregate.ID},
 						{Parent: aggregate.ID, Child: "duplicate0"},
 						{Parent: "duplicate0", Child: "window1"},
-						{Parent: "window1", Child: "map0"},
+						{Parent: "window1", Child: "windowFill0"},
+						{Parent: "windowFill0", Child: "map0"},
 						{Parent: "map0", Child: "yield0"},
 					},
 					Now: Now(),
@@ -0,0 +1,95 @@
+package spectests
+
+import (
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func init() {
+	RegisterFixture(
+		NewFixture(
+			`SHOW FIELD KEYS`,
+			&flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &inputs.FromOpSpec{
+							BucketID: bucketID.String(),
+						},
+					},
+					{
+						ID: "range0",
+						Spec: &transformations.RangeOpSpec{
+							Start:       flux.Time{Absolute: time.Unix(0, influxql.MinTime)},
+							Stop:        flux.Time{Absolute: time.Unix(0, influxql.MaxTime)},
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+						},
+					},
+					{
+						ID:   "fieldType0",
+						Spec: &functions.FieldTypeOpSpec{},
+					},
+					{
+						ID: "keep0",
+						Spec: &transformations.KeepOpSpec{
+							Columns: []string{"_measurement", "_field", "_value"},
+						},
+					},
+					{
+						ID: "group0",
+						Spec: &transformations.GroupOpSpec{
+							Columns: []string{"_measurement"},
+							Mode:    "by",
+						},
+					},
+					{
+						ID: "unique0",
+						Spec: &transformations.UniqueOpSpec{
+							Column: "_field",
+						},
+					},
+					{
+						ID: "sort0",
+						Spec: &transformations.SortOpSpec{
+							Columns: []string{"_field"},
+						},
+					},
+					{
+						ID: "rename0",
+						Spec: &transformations.RenameOpSpec{
+							Columns: map[string]string{
+								"_field": "fieldKey",
+								"_value": "fieldType",
+							},
+						},
+					},
+					{
+						ID: "yield0",
+						Spec: &transformations.YieldOpSpec{
+							Name: "0",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range0"},
+					{Parent: "range0", Child: "fieldType0"},
+					{Parent: "fieldType0", Child: "keep0"},
+					{Parent: "keep0", Child: "group0"},
+					{Parent: "group0", Child: "unique0"},
+					{Parent: "unique0", Child: "sort0"},
+					{Parent: "sort0", Child: "rename0"},
+					{Parent: "rename0", Child: "yield0"},
+				},
+				Now: Now(),
+			},
+		),
+	)
+}
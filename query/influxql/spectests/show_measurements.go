@@ -0,0 +1,67 @@
+package spectests
+
+import (
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/influxql"
+	pinputs "github.com/influxdata/platform/query/functions/inputs"
+)
+
+func init() {
+	RegisterFixture(
+		NewFixture(
+			`SHOW MEASUREMENTS`,
+			&flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "measurements0",
+						Spec: &pinputs.MeasurementsOpSpec{
+							SchemaSpec: pinputs.SchemaSpec{
+								BucketID: bucketID.String(),
+								Start:    flux.Time{Absolute: time.Unix(0, influxql.MinTime)},
+								Stop:     flux.Time{Absolute: time.Unix(0, influxql.MaxTime)},
+							},
+						},
+					},
+					{
+						ID: "rename0",
+						Spec: &transformations.RenameOpSpec{
+							Columns: map[string]string{
+								"_value": "name",
+							},
+						},
+					},
+					{
+						ID: "set0",
+						Spec: &transformations.SetOpSpec{
+							Key:   "_measurement",
+							Value: "measurements",
+						},
+					},
+					{
+						ID: "group0",
+						Spec: &transformations.GroupOpSpec{
+							Columns: []string{"_measurement"},
+							Mode:    "by",
+						},
+					},
+					{
+						ID: "yield0",
+						Spec: &transformations.YieldOpSpec{
+							Name: "0",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "measurements0", Child: "rename0"},
+					{Parent: "rename0", Child: "set0"},
+					{Parent: "set0", Child: "group0"},
+					{Parent: "group0", Child: "yield0"},
+				},
+				Now: Now(),
+			},
+		),
+	)
+}
@@ -0,0 +1,148 @@
+package spectests
+
+import (
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform/query/functions"
+)
+
+func init() {
+	RegisterFixture(
+		NewFixture(
+			`SHOW SERIES FROM cpu WHERE host = 'server01'`,
+			&flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &inputs.FromOpSpec{
+							BucketID: bucketID.String(),
+						},
+					},
+					{
+						ID: "range0",
+						Spec: &transformations.RangeOpSpec{
+							Start:       flux.Time{Absolute: time.Unix(0, influxql.MinTime)},
+							Stop:        flux.Time{Absolute: time.Unix(0, influxql.MaxTime)},
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+						},
+					},
+					{
+						ID: "filter0",
+						Spec: &transformations.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Block: &semantic.FunctionBlock{
+									Parameters: &semantic.FunctionParameters{
+										List: []*semantic.FunctionParameter{
+											{Key: &semantic.Identifier{Name: "r"}},
+										},
+									},
+									Body: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "r"},
+											Property: "_measurement",
+										},
+										Right: &semantic.StringLiteral{Value: "cpu"},
+									},
+								},
+							},
+						},
+					},
+					{
+						ID:   "seriesKey0",
+						Spec: &functions.SeriesKeyOpSpec{},
+					},
+					{
+						ID: "fillTags0",
+						Spec: &functions.FillTagsOpSpec{
+							Tags: []string{"host"},
+						},
+					},
+					{
+						ID: "filter1",
+						Spec: &transformations.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Block: &semantic.FunctionBlock{
+									Parameters: &semantic.FunctionParameters{
+										List: []*semantic.FunctionParameter{
+											{Key: &semantic.Identifier{Name: "r"}},
+										},
+									},
+									Body: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "r"},
+											Property: "host",
+										},
+										Right: &semantic.StringLiteral{Value: "server01"},
+									},
+								},
+							},
+						},
+					},
+					{
+						ID: "keep0",
+						Spec: &transformations.KeepOpSpec{
+							Columns: []string{"_value"},
+						},
+					},
+					{
+						ID: "group0",
+						Spec: &transformations.GroupOpSpec{
+							Mode: "by",
+						},
+					},
+					{
+						ID: "distinct0",
+						Spec: &transformations.DistinctOpSpec{
+							Column: execute.DefaultValueColLabel,
+						},
+					},
+					{
+						ID: "sort0",
+						Spec: &transformations.SortOpSpec{
+							Columns: []string{execute.DefaultValueColLabel},
+						},
+					},
+					{
+						ID: "rename0",
+						Spec: &transformations.RenameOpSpec{
+							Columns: map[string]string{
+								"_value": "key",
+							},
+						},
+					},
+					{
+						ID: "yield0",
+						Spec: &transformations.YieldOpSpec{
+							Name: "0",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range0"},
+					{Parent: "range0", Child: "filter0"},
+					{Parent: "filter0", Child: "seriesKey0"},
+					{Parent: "seriesKey0", Child: "fillTags0"},
+					{Parent: "fillTags0", Child: "filter1"},
+					{Parent: "filter1", Child: "keep0"},
+					{Parent: "keep0", Child: "group0"},
+					{Parent: "group0", Child: "distinct0"},
+					{Parent: "distinct0", Child: "sort0"},
+					{Parent: "sort0", Child: "rename0"},
+					{Parent: "rename0", Child: "yield0"},
+				},
+				Now: Now(),
+			},
+		),
+	)
+}
@@ -0,0 +1,112 @@
+package spectests
+
+import (
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/influxql"
+	pinputs "github.com/influxdata/platform/query/functions/inputs"
+)
+
+func init() {
+	RegisterFixture(
+		NewFixture(
+			`SHOW TAG KEYS FROM cpu LIMIT 2`,
+			&flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "tagKeys0",
+						Spec: &pinputs.TagKeysOpSpec{
+							SchemaSpec: pinputs.SchemaSpec{
+								BucketID: bucketID.String(),
+								Start:    flux.Time{Absolute: time.Unix(0, influxql.MinTime)},
+								Stop:     flux.Time{Absolute: time.Unix(0, influxql.MaxTime)},
+								Predicate: &semantic.FunctionExpression{
+									Block: &semantic.FunctionBlock{
+										Parameters: &semantic.FunctionParameters{
+											List: []*semantic.FunctionParameter{
+												{Key: &semantic.Identifier{Name: "r"}},
+											},
+										},
+										Body: &semantic.BinaryExpression{
+											Operator: ast.EqualOperator,
+											Left: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "_measurement",
+											},
+											Right: &semantic.StringLiteral{Value: "cpu"},
+										},
+									},
+								},
+							},
+							ByMeasurement: true,
+						},
+					},
+					{
+						ID: "filter0",
+						Spec: &transformations.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Block: &semantic.FunctionBlock{
+									Parameters: &semantic.FunctionParameters{
+										List: []*semantic.FunctionParameter{
+											{Key: &semantic.Identifier{Name: "r"}},
+										},
+									},
+									Body: &semantic.LogicalExpression{
+										Operator: ast.AndOperator,
+										Left: &semantic.BinaryExpression{
+											Operator: ast.NotEqualOperator,
+											Left: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "_value",
+											},
+											Right: &semantic.StringLiteral{Value: "_field"},
+										},
+										Right: &semantic.BinaryExpression{
+											Operator: ast.NotEqualOperator,
+											Left: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "_value",
+											},
+											Right: &semantic.StringLiteral{Value: "_measurement"},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						ID: "limit0",
+						Spec: &transformations.LimitOpSpec{
+							N: 2,
+						},
+					},
+					{
+						ID: "rename0",
+						Spec: &transformations.RenameOpSpec{
+							Columns: map[string]string{
+								"_value": "tagKey",
+							},
+						},
+					},
+					{
+						ID: "yield0",
+						Spec: &transformations.YieldOpSpec{
+							Name: "0",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "tagKeys0", Child: "filter0"},
+					{Parent: "filter0", Child: "limit0"},
+					{Parent: "limit0", Child: "rename0"},
+					{Parent: "rename0", Child: "yield0"},
+				},
+				Now: Now(),
+			},
+		),
+	)
+}
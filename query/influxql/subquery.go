@@ -0,0 +1,97 @@
+package influxql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/influxql"
+)
+
+// createSubQueryCursor creates a cursor for a variable reference that reads the column
+// of the same name from the results of a subquery. The column becomes the value column
+// so the cursor can be used like the cursor of a field.
+func createSubQueryCursor(t *transpilerState, sq *influxql.SubQuery, ref *influxql.VarRef) (cursor, error) {
+	stmt := sq.Statement.Clone()
+	if len(stmt.SortFields) > 0 && stmt.SortFields[0].Ascending == t.descending() {
+		return nil, errors.New("subqueries must be ordered in the same direction as the query itself")
+	}
+
+	// The time range of the outer query also limits the subquery.
+	tr, err := t.timeRange()
+	if err != nil {
+		return nil, err
+	}
+	if !tr.Min.IsZero() {
+		stmt.Condition = and(stmt.Condition, &influxql.BinaryExpr{
+			Op:  influxql.GTE,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.TimeLiteral{Val: tr.Min},
+		})
+	}
+	if !tr.Max.IsZero() {
+		stmt.Condition = and(stmt.Condition, &influxql.BinaryExpr{
+			Op:  influxql.LTE,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.TimeLiteral{Val: tr.Max},
+		})
+	}
+
+	outer := t.subquery
+	t.subquery = true
+	cur, err := t.selectCursor(stmt)
+	t.subquery = outer
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the column of the subquery for the reference and the columns to drop.
+	var (
+		found bool
+		drop  []string
+	)
+	for _, name := range stmt.ColumnNames() {
+		if name == "time" {
+			continue
+		} else if name == ref.Val {
+			found = true
+			continue
+		}
+		drop = append(drop, name)
+	}
+	if !found {
+		return nil, fmt.Errorf("undefined variable: %s", ref)
+	}
+
+	// The outer query reads the rows of the subquery in time order.
+	id := t.op("sort", &transformations.SortOpSpec{
+		Columns: []string{execute.DefaultTimeColLabel},
+	}, cur.ID())
+	if len(drop) > 0 {
+		id = t.op("drop", &transformations.DropOpSpec{
+			Columns: drop,
+		}, id)
+	}
+	id = t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			ref.Val: execute.DefaultValueColLabel,
+		},
+	}, id)
+	return &varRefCursor{
+		id:  id,
+		ref: ref,
+	}, nil
+}
+
+// and combines two conditions, either of which may be nil.
+func and(lhs, rhs influxql.Expr) influxql.Expr {
+	if lhs == nil {
+		return rhs
+	}
+	return &influxql.BinaryExpr{
+		Op:  influxql.AND,
+		LHS: &influxql.ParenExpr{Expr: lhs},
+		RHS: rhs,
+	}
+}
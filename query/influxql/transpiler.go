@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/influxql"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/query/functions"
 	pinputs "github.com/influxdata/platform/query/functions/inputs"
 )
 
@@ -58,6 +60,9 @@ type transpilerState struct {
 	spec           *flux.Spec
 	nextID         map[string]int
 	dbrpMappingSvc platform.DBRPMappingService
+
+	// subquery is set while the statement of a subquery is transpiled.
+	subquery bool
 }
 
 func newTranspilerState(dbrpMappingSvc platform.DBRPMappingService, config *Config) *transpilerState {
@@ -97,6 +102,14 @@ func (t *transpilerState) transpile(ctx context.Context, s influxql.Statement) (
 		return t.transpileShowDatabases(ctx, stmt)
 	case *influxql.ShowRetentionPoliciesStatement:
 		return t.transpileShowRetentionPolicies(ctx, stmt)
+	case *influxql.ShowMeasurementsStatement:
+		return t.transpileShowMeasurements(ctx, stmt)
+	case *influxql.ShowTagKeysStatement:
+		return t.transpileShowTagKeys(ctx, stmt)
+	case *influxql.ShowFieldKeysStatement:
+		return t.transpileShowFieldKeys(ctx, stmt)
+	case *influxql.ShowSeriesStatement:
+		return t.transpileShowSeries(ctx, stmt)
 	default:
 		return "", fmt.Errorf("unknown statement type %T", s)
 	}
@@ -107,13 +120,6 @@ func (t *transpilerState) transpileShowTagValues(ctx context.Context, stmt *infl
 	// not actually contain the database and we do not factor in retention policies. So we are always going to use
 	// the default retention policy when evaluating which bucket we are querying and we do not have to consult
 	// the sources in the statement.
-	if stmt.Database == "" {
-		if t.config.DefaultDatabase == "" {
-			return "", errDatabaseNameRequired
-		}
-		stmt.Database = t.config.DefaultDatabase
-	}
-
 	op, err := t.from(&influxql.Measurement{Database: stmt.Database})
 	if err != nil {
 		return "", err
@@ -294,22 +300,34 @@ func (t *transpilerState) transpileShowRetentionPolicies(ctx context.Context, st
 }
 
 func (t *transpilerState) transpileSelect(ctx context.Context, stmt *influxql.SelectStatement) (flux.OperationID, error) {
+	cur, err := t.selectCursor(stmt)
+	if err != nil {
+		return "", err
+	}
+	return cur.ID(), nil
+}
+
+// selectCursor creates the cursor for a select statement. The statement becomes the
+// current statement until it returns, so subqueries may be transpiled within another statement.
+func (t *transpilerState) selectCursor(stmt *influxql.SelectStatement) (cursor, error) {
 	// Clone the select statement and omit the time from the list of column names.
+	outer := t.stmt
+	defer func() { t.stmt = outer }()
 	t.stmt = stmt.Clone()
 	t.stmt.OmitTime = true
 
 	groups, err := identifyGroups(t.stmt)
 	if err != nil {
-		return "", err
+		return nil, err
 	} else if len(groups) == 0 {
-		return "", errors.New("at least 1 non-time field must be queried")
+		return nil, errors.New("at least 1 non-time field must be queried")
 	}
 
 	cursors := make([]cursor, 0, len(groups))
 	for _, gr := range groups {
 		cur, err := gr.createCursor(t)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		cursors = append(cursors, cur)
 	}
@@ -321,9 +339,47 @@ func (t *transpilerState) transpileSelect(ctx context.Context, stmt *influxql.Se
 	// Map each of the fields into another cursor. This evaluates any lingering expressions.
 	cur, err = t.mapFields(cur)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return cur.ID(), nil
+	return t.limit(cur)
+}
+
+// limit applies the ORDER BY, LIMIT, OFFSET, SLIMIT and SOFFSET clauses of the current statement.
+func (t *transpilerState) limit(in cursor) (cursor, error) {
+	id := in.ID()
+	if limit := t.stmt.Limit > 0 || t.stmt.Offset > 0; limit || t.descending() {
+		// The rows of a series are sorted by time to find the rows within the limit.
+		id = t.op("sort", &transformations.SortOpSpec{
+			Columns: []string{execute.DefaultTimeColLabel},
+			Desc:    t.descending(),
+		}, id)
+	}
+	if t.stmt.Limit > 0 || t.stmt.Offset > 0 {
+		n := int64(t.stmt.Limit)
+		if n == 0 {
+			n = math.MaxInt64
+		}
+		id = t.op("limit", &transformations.LimitOpSpec{
+			N:      n,
+			Offset: int64(t.stmt.Offset),
+		}, id)
+	}
+	if t.stmt.SLimit > 0 || t.stmt.SOffset > 0 {
+		id = t.op("limitSeries", &functions.LimitSeriesOpSpec{
+			N:      int64(t.stmt.SLimit),
+			Offset: int64(t.stmt.SOffset),
+		}, id)
+	}
+	if id == in.ID() {
+		return in, nil
+	}
+	return &opCursor{id: id, cursor: in}, nil
+}
+
+// descending reports whether the current statement orders its rows by descending time.
+// The parser only allows ordering by time.
+func (t *transpilerState) descending() bool {
+	return len(t.stmt.SortFields) > 0 && !t.stmt.SortFields[0].Ascending
 }
 
 func (t *transpilerState) mapType(ref *influxql.VarRef) influxql.DataType {
@@ -332,10 +388,26 @@ func (t *transpilerState) mapType(ref *influxql.VarRef) influxql.DataType {
 }
 
 func (t *transpilerState) from(m *influxql.Measurement) (flux.OperationID, error) {
+	bucket, bucketID, err := t.bucket(m)
+	if err != nil {
+		return "", err
+	}
+	return t.op("from", &inputs.FromOpSpec{
+		Bucket:   bucket,
+		BucketID: bucketID,
+	}), nil
+}
+
+// bucket returns the name or the ID of the bucket that holds the measurement m.
+func (t *transpilerState) bucket(m *influxql.Measurement) (name, id string, err error) {
 	db, rp := m.Database, m.RetentionPolicy
 	if db == "" {
+		if t.config.DefaultBucket != "" {
+			// Measurements without a database are read from the bucket of the query.
+			return t.config.DefaultBucket, "", nil
+		}
 		if t.config.DefaultDatabase == "" {
-			return "", errors.New("database is required")
+			return "", "", errDatabaseNameRequired
 		}
 		db = t.config.DefaultDatabase
 	}
@@ -357,13 +429,9 @@ func (t *transpilerState) from(m *influxql.Measurement) (flux.OperationID, error
 	filter.Default = &defaultRP
 	mapping, err := t.dbrpMappingSvc.Find(context.TODO(), filter)
 	if err != nil {
-		return "", err
-	}
-
-	spec := &inputs.FromOpSpec{
-		BucketID: mapping.BucketID.String(),
+		return "", "", err
 	}
-	return t.op("from", spec), nil
+	return "", mapping.BucketID.String(), nil
 }
 
 func (t *transpilerState) op(name string, spec flux.OperationSpec, parents ...flux.OperationID) flux.OperationID {
@@ -5,8 +5,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/influxdata/flux/functions/inputs"
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/mock"
+	pinputs "github.com/influxdata/platform/query/functions/inputs"
 	"github.com/influxdata/platform/query/influxql"
 	"github.com/influxdata/platform/query/influxql/spectests"
 	platformtesting "github.com/influxdata/platform/testing"
@@ -379,6 +381,7 @@ func TestTranspiler_Compile(t *testing.T) {
 		{s: `SELECT atan2(value, 3, 3) FROM cpu`, err: `invalid number of arguments for atan2, expected 2, got 3`},
 		{s: `SELECT sin(1.3) FROM cpu`, err: `field must contain at least one variable`},
 		{s: `SELECT nofunc(1.3) FROM cpu`, err: `undefined function nofunc()`},
+		{s: `SELECT mean(value) FROM cpu WHERE time >= '2000-01-01T00:00:00Z' AND time < '2001-01-01T00:00:00Z' GROUP BY time(1s)`, err: `max-select-buckets limit exceeded: (31622400/1000000)`},
 	} {
 		t.Run(tt.s, func(t *testing.T) {
 			defer func() {
@@ -406,3 +409,42 @@ func TestTranspiler_Compile(t *testing.T) {
 		})
 	}
 }
+
+func TestTranspiler_DefaultBucket(t *testing.T) {
+	for _, tt := range []struct {
+		s        string
+		bucket   string
+		bucketID string
+	}{
+		{s: `SELECT value FROM cpu`, bucket: "telegraf"},
+		{s: `SELECT value FROM db0..cpu`, bucketID: "bbbbbbbbbbbbbbbb"},
+		{s: `SHOW TAG VALUES WITH KEY = host`, bucket: "telegraf"},
+		{s: `SHOW MEASUREMENTS`, bucket: "telegraf"},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			transpiler := influxql.NewTranspilerWithConfig(
+				dbrpMappingSvc,
+				influxql.Config{
+					DefaultBucket: "telegraf",
+				},
+			)
+			spec, err := transpiler.Transpile(context.Background(), tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var bucket, bucketID string
+			switch spec := spec.Operations[0].Spec.(type) {
+			case *inputs.FromOpSpec:
+				bucket, bucketID = spec.Bucket, spec.BucketID
+			case *pinputs.MeasurementsOpSpec:
+				bucket, bucketID = spec.Bucket, spec.BucketID
+			default:
+				t.Fatalf("unexpected first operation %T", spec)
+			}
+			if bucket != tt.bucket || bucketID != tt.bucketID {
+				t.Errorf("got bucket %q and bucket id %q, exp %q and %q", bucket, bucketID, tt.bucket, tt.bucketID)
+			}
+		})
+	}
+}
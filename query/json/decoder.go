@@ -0,0 +1,220 @@
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+)
+
+// MultiResultDecoder decodes the results of a JSON Response.
+type MultiResultDecoder struct{}
+
+func NewMultiResultDecoder() *MultiResultDecoder {
+	return new(MultiResultDecoder)
+}
+
+// Decode reads the whole response from r and converts its tables to flux tables.
+func (d *MultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	defer r.Close()
+
+	var resp Response
+	dec := json.NewDecoder(r)
+	// Decode the numbers as json.Number so integers keep their precision.
+	dec.UseNumber()
+	if err := dec.Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	a := &memory.Allocator{}
+	ri := &resultIterator{}
+	for _, res := range resp.Results {
+		tables := make([]flux.Table, 0, len(res.Tables))
+		for _, t := range res.Tables {
+			tbl, err := decodeTable(t, a)
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, tbl)
+		}
+		ri.results = append(ri.results, &result{name: res.Name, tables: tables})
+	}
+	if resp.Err != "" {
+		ri.err = errors.New(resp.Err)
+	}
+	return ri, nil
+}
+
+func decodeTable(t Table, a *memory.Allocator) (flux.Table, error) {
+	cols := make([]flux.ColMeta, len(t.Columns))
+	var (
+		keyCols   []flux.ColMeta
+		keyValues []values.Value
+	)
+	for j, c := range t.Columns {
+		typ, err := columnType(c.Type)
+		if err != nil {
+			return nil, err
+		}
+		cols[j] = flux.ColMeta{Label: c.Label, Type: typ}
+		if !c.Group {
+			continue
+		}
+
+		v, err := decodeValue(typ, t.GroupKey[c.Label])
+		if err != nil {
+			return nil, fmt.Errorf("group key column %q: %v", c.Label, err)
+		}
+		keyCols = append(keyCols, cols[j])
+		keyValues = append(keyValues, v)
+	}
+
+	b := execute.NewColListTableBuilder(execute.NewGroupKey(keyCols, keyValues), a)
+	for _, c := range cols {
+		if _, err := b.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	for _, rec := range t.Records {
+		for j, c := range cols {
+			v, err := decodeValue(c.Type, rec[c.Label])
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %v", c.Label, err)
+			}
+			if err := b.AppendValue(j, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b.Table()
+}
+
+func columnType(typ string) (flux.ColType, error) {
+	switch typ {
+	case "bool":
+		return flux.TBool, nil
+	case "int":
+		return flux.TInt, nil
+	case "uint":
+		return flux.TUInt, nil
+	case "float":
+		return flux.TFloat, nil
+	case "string":
+		return flux.TString, nil
+	case "time":
+		return flux.TTime, nil
+	default:
+		return flux.TInvalid, fmt.Errorf("unknown column type %q", typ)
+	}
+}
+
+// decodeValue converts a value decoded from JSON to a value of the column type.
+func decodeValue(typ flux.ColType, v interface{}) (values.Value, error) {
+	switch typ {
+	case flux.TBool:
+		if b, ok := v.(bool); ok {
+			return values.NewBool(b), nil
+		}
+	case flux.TInt:
+		if n, ok := v.(json.Number); ok {
+			i, err := strconv.ParseInt(string(n), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewInt(i), nil
+		}
+	case flux.TUInt:
+		if n, ok := v.(json.Number); ok {
+			u, err := strconv.ParseUint(string(n), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewUInt(u), nil
+		}
+	case flux.TFloat:
+		// The floats that are not finite are strings.
+		var s string
+		switch v := v.(type) {
+		case json.Number:
+			s = string(v)
+		case string:
+			s = v
+		default:
+			return nil, fmt.Errorf("invalid float value %v", v)
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return values.NewFloat(f), nil
+	case flux.TString:
+		if s, ok := v.(string); ok {
+			return values.NewString(s), nil
+		}
+	case flux.TTime:
+		if s, ok := v.(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewTime(values.ConvertTime(t)), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid %s value %v", typ, v)
+}
+
+type resultIterator struct {
+	results []flux.Result
+	err     error
+}
+
+func (ri *resultIterator) More() bool {
+	return len(ri.results) > 0
+}
+
+func (ri *resultIterator) Next() flux.Result {
+	res := ri.results[0]
+	ri.results = ri.results[1:]
+	return res
+}
+
+func (ri *resultIterator) Release() {
+	ri.results = nil
+}
+
+func (ri *resultIterator) Err() error {
+	return ri.err
+}
+
+func (ri *resultIterator) Statistics() flux.Statistics { return flux.Statistics{} }
+
+type result struct {
+	name   string
+	tables []flux.Table
+}
+
+func (r *result) Name() string {
+	return r.name
+}
+
+func (r *result) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *result) Do(f func(tbl flux.Table) error) error {
+	for _, tbl := range r.tables {
+		if err := f(tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *result) Statistics() flux.Statistics { return flux.Statistics{} }
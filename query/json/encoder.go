@@ -0,0 +1,206 @@
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/values"
+)
+
+// MultiResultEncoder encodes results as a JSON Response.
+// The response is streamed: each table is written as soon as it is encoded.
+type MultiResultEncoder struct{}
+
+func NewMultiResultEncoder() *MultiResultEncoder {
+	return new(MultiResultEncoder)
+}
+
+// Encode writes the results to w as a JSON Response.
+// An error of the results is encoded in the response after the tables that were read before it.
+// The returned error is only an error writing to w.
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	bw := bufio.NewWriter(wc)
+
+	var (
+		buf bytes.Buffer
+		err error
+	)
+	bw.WriteString(`{"results":[`)
+	for i := 0; results.More(); i++ {
+		res := results.Next()
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		bw.WriteString(`{"name":`)
+		writeString(bw, res.Name())
+		bw.WriteString(`,"tables":[`)
+
+		n := 0
+		err = res.Tables().Do(func(tbl flux.Table) error {
+			// Encode the table in a buffer first, so a table that fails
+			// part of the way through does not corrupt the response.
+			buf.Reset()
+			if err := encodeTable(&buf, tbl); err != nil {
+				return err
+			}
+			if n > 0 {
+				bw.WriteByte(',')
+			}
+			n++
+			_, err := buf.WriteTo(bw)
+			return err
+		})
+		bw.WriteString("]}")
+		if err != nil {
+			results.Release()
+			break
+		}
+	}
+	if err == nil {
+		err = results.Err()
+	}
+	bw.WriteByte(']')
+	if err != nil {
+		bw.WriteString(`,"error":`)
+		writeString(bw, err.Error())
+	}
+	bw.WriteString("}\n")
+
+	if err := bw.Flush(); err != nil {
+		return wc.Count(), err
+	}
+	return wc.Count(), nil
+}
+
+func encodeTable(w *bytes.Buffer, tbl flux.Table) error {
+	cols := tbl.Cols()
+	key := tbl.Key()
+
+	w.WriteString(`{"columns":[`)
+	for j, c := range cols {
+		if j > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteString(`{"label":`)
+		writeString(w, c.Label)
+		w.WriteString(`,"type":`)
+		writeString(w, c.Type.String())
+		w.WriteString(`,"group":`)
+		w.WriteString(strconv.FormatBool(key.HasCol(c.Label)))
+		w.WriteByte('}')
+	}
+
+	w.WriteString(`],"group_key":{`)
+	for j, c := range key.Cols() {
+		if j > 0 {
+			w.WriteByte(',')
+		}
+		writeString(w, c.Label)
+		w.WriteByte(':')
+		if err := writeValue(w, key.Value(j)); err != nil {
+			return err
+		}
+	}
+
+	w.WriteString(`},"records":[`)
+	n := 0
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			if n > 0 {
+				w.WriteByte(',')
+			}
+			n++
+			w.WriteByte('{')
+			for j, c := range cols {
+				if j > 0 {
+					w.WriteByte(',')
+				}
+				writeString(w, c.Label)
+				w.WriteByte(':')
+				switch c.Type {
+				case flux.TBool:
+					w.WriteString(strconv.FormatBool(cr.Bools(j)[i]))
+				case flux.TInt:
+					w.WriteString(strconv.FormatInt(cr.Ints(j)[i], 10))
+				case flux.TUInt:
+					w.WriteString(strconv.FormatUint(cr.UInts(j)[i], 10))
+				case flux.TFloat:
+					writeFloat(w, cr.Floats(j)[i])
+				case flux.TString:
+					writeString(w, cr.Strings(j)[i])
+				case flux.TTime:
+					writeTime(w, cr.Times(j)[i])
+				default:
+					return fmt.Errorf("unsupported column type: %s", c.Type)
+				}
+			}
+			w.WriteByte('}')
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	w.WriteString("]}")
+	return nil
+}
+
+func writeValue(w *bytes.Buffer, v values.Value) error {
+	switch typ := flux.ColumnType(v.Type()); typ {
+	case flux.TBool:
+		w.WriteString(strconv.FormatBool(v.Bool()))
+	case flux.TInt:
+		w.WriteString(strconv.FormatInt(v.Int(), 10))
+	case flux.TUInt:
+		w.WriteString(strconv.FormatUint(v.UInt(), 10))
+	case flux.TFloat:
+		writeFloat(w, v.Float())
+	case flux.TString:
+		writeString(w, v.Str())
+	case flux.TTime:
+		writeTime(w, v.Time())
+	default:
+		return fmt.Errorf("unsupported value type: %s", typ)
+	}
+	return nil
+}
+
+// writeFloat writes f as a number, or as a string when it is not finite since JSON has no representation for it.
+func writeFloat(w *bytes.Buffer, f float64) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		writeString(w, formatNonFinite(f))
+		return
+	}
+	w.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+func formatNonFinite(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	default:
+		return "NaN"
+	}
+}
+
+func writeTime(w *bytes.Buffer, t values.Time) {
+	w.WriteByte('"')
+	w.WriteString(t.Time().UTC().Format(time.RFC3339Nano))
+	w.WriteByte('"')
+}
+
+func writeString(w io.Writer, s string) {
+	// Marshaling a string does not fail.
+	b, _ := json.Marshal(s)
+	w.Write(b)
+}
@@ -0,0 +1,201 @@
+package json_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform/query/json"
+)
+
+func TestMultiResultEncoder_Encode(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []flux.Result
+		want    string
+	}{
+		{
+			name: "multiple results",
+			results: []flux.Result{
+				&executetest.Result{
+					Nm: "_result",
+					Tbls: []*executetest.Table{
+						{
+							KeyCols: []string{"_measurement", "host"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_time", Type: flux.TTime},
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "host", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+							Data: [][]interface{}{
+								{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC)), "cpu", "A", 42.0},
+								{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 0, time.UTC)), "cpu", "A", math.NaN()},
+							},
+						},
+						{
+							KeyCols:   []string{"_measurement", "host"},
+							KeyValues: []interface{}{"cpu", "B"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_time", Type: flux.TTime},
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "host", Type: flux.TString},
+								{Label: "_value", Type: flux.TFloat},
+							},
+						},
+					},
+				},
+				&executetest.Result{
+					Nm: "counts",
+					Tbls: []*executetest.Table{{
+						ColMeta: []flux.ColMeta{
+							{Label: "n", Type: flux.TInt},
+							{Label: "u", Type: flux.TUInt},
+							{Label: "ok", Type: flux.TBool},
+						},
+						Data: [][]interface{}{
+							{int64(-9007199254740993), uint64(18446744073709551615), true},
+						},
+					}},
+				},
+			},
+			want: `{"results":[` +
+				`{"name":"_result","tables":[` +
+				`{"columns":[{"label":"_time","type":"time","group":false},{"label":"_measurement","type":"string","group":true},{"label":"host","type":"string","group":true},{"label":"_value","type":"float","group":false}],` +
+				`"group_key":{"_measurement":"cpu","host":"A"},` +
+				`"records":[{"_time":"2018-04-17T00:00:00Z","_measurement":"cpu","host":"A","_value":42},{"_time":"2018-04-17T00:00:01Z","_measurement":"cpu","host":"A","_value":"NaN"}]},` +
+				`{"columns":[{"label":"_time","type":"time","group":false},{"label":"_measurement","type":"string","group":true},{"label":"host","type":"string","group":true},{"label":"_value","type":"float","group":false}],` +
+				`"group_key":{"_measurement":"cpu","host":"B"},` +
+				`"records":[]}]},` +
+				`{"name":"counts","tables":[` +
+				`{"columns":[{"label":"n","type":"int","group":false},{"label":"u","type":"uint","group":false},{"label":"ok","type":"bool","group":false}],` +
+				`"group_key":{},` +
+				`"records":[{"n":-9007199254740993,"u":18446744073709551615,"ok":true}]}]}` +
+				`]}` + "\n",
+		},
+		{
+			name: "error",
+			results: []flux.Result{
+				&executetest.Result{
+					Nm:  "_result",
+					Err: errors.New("expected error"),
+				},
+			},
+			want: `{"results":[{"name":"_result","tables":[]}],"error":"expected error"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, res := range tt.results {
+				res.(*executetest.Result).Normalize()
+			}
+
+			var buf bytes.Buffer
+			enc := json.NewMultiResultEncoder()
+			n, err := enc.Encode(&buf, flux.NewSliceResultIterator(tt.results))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, exp := n, int64(buf.Len()); got != exp {
+				t.Errorf("got %d bytes written, exp %d", got, exp)
+			}
+			if got, exp := buf.String(), tt.want; got != exp {
+				t.Errorf("unexpected encoding -want/+got:\n%s", cmp.Diff(exp, got))
+			}
+		})
+	}
+}
+
+func TestMultiResultDecoder_Decode(t *testing.T) {
+	want := []*executetest.Result{
+		{
+			Nm: "_result",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 1, time.UTC)), "cpu", "A", 42.5},
+						{values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 0, time.UTC)), "cpu", "A", math.Inf(-1)},
+					},
+				},
+				{
+					KeyCols:   []string{"_measurement", "host"},
+					KeyValues: []interface{}{"cpu", "B"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+				},
+			},
+		},
+		{
+			Nm: "counts",
+			Tbls: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "n", Type: flux.TInt},
+					{Label: "u", Type: flux.TUInt},
+					{Label: "ok", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{int64(-9007199254740993), uint64(18446744073709551615), false},
+				},
+			}},
+		},
+	}
+	results := make([]flux.Result, len(want))
+	for i, res := range want {
+		res.Normalize()
+		results[i] = res
+	}
+
+	var buf bytes.Buffer
+	if _, err := json.NewMultiResultEncoder().Encode(&buf, flux.NewSliceResultIterator(results)); err != nil {
+		t.Fatal(err)
+	}
+
+	ri, err := json.NewMultiResultDecoder().Decode(ioutil.NopCloser(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*executetest.Result
+	for ri.More() {
+		res := ri.Next()
+		r := &executetest.Result{Nm: res.Name()}
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			t, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			r.Tbls = append(r.Tbls, t)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		r.Normalize()
+		got = append(got, r)
+	}
+	if err := ri.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
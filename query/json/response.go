@@ -0,0 +1,31 @@
+package json
+
+// Response is the JSON body of the results of a query.
+//
+// The values of the group keys and the records have the type of their column:
+// times are RFC3339Nano strings, and the floats that are not finite are the strings NaN, +Inf and -Inf.
+type Response struct {
+	Results []Result `json:"results"`
+	Err     string   `json:"error,omitempty"`
+}
+
+// Result is a result of the query, with its tables.
+type Result struct {
+	Name   string  `json:"name"`
+	Tables []Table `json:"tables"`
+}
+
+// Table is a table of a result as an array of records.
+type Table struct {
+	Columns  []Column                 `json:"columns"`
+	GroupKey map[string]interface{}   `json:"group_key"`
+	Records  []map[string]interface{} `json:"records"`
+}
+
+// Column describes a column of a table.
+type Column struct {
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	// Group reports whether the column is part of the group key of the table.
+	Group bool `json:"group"`
+}
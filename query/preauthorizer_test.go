@@ -54,9 +54,11 @@ func TestPreAuthorizer_PreAuthorize(t *testing.T) {
 	// Try to authorize with a bucket service that knows about one bucket
 	// (still no authorization)
 	id, _ := platform.IDFromString("deadbeefdeadbeef")
+	orgID, _ := platform.IDFromString("baadf00dbaadf00d")
 	bucketService := newBucketServiceWithOneBucket(platform.Bucket{
-		Name: "my_bucket",
-		ID:   *id,
+		Name:           "my_bucket",
+		ID:             *id,
+		OrganizationID: *orgID,
 	})
 
 	preAuthorizer = query.NewPreAuthorizer(bucketService)
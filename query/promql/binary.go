@@ -0,0 +1,328 @@
+package promql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+)
+
+type BinaryOperatorKind int
+
+const (
+	UnknownBinaryOpKind BinaryOperatorKind = iota
+	AddKind
+	SubKind
+	MulKind
+	DivKind
+	ModKind
+	PowKind
+	EqualKind
+	NotEqualKind
+	GreaterKind
+	GreaterEqualKind
+	LessKind
+	LessEqualKind
+)
+
+var binaryOperators = map[string]BinaryOperatorKind{
+	"+":  AddKind,
+	"-":  SubKind,
+	"*":  MulKind,
+	"/":  DivKind,
+	"%":  ModKind,
+	"^":  PowKind,
+	"==": EqualKind,
+	"!=": NotEqualKind,
+	">":  GreaterKind,
+	">=": GreaterEqualKind,
+	"<":  LessKind,
+	"<=": LessEqualKind,
+}
+
+func ToBinaryOperatorKind(op string) BinaryOperatorKind {
+	return binaryOperators[op]
+}
+
+func (k BinaryOperatorKind) String() string {
+	for op, kind := range binaryOperators {
+		if kind == k {
+			return op
+		}
+	}
+	return fmt.Sprintf("unknown binary operator %d", int(k))
+}
+
+func (k BinaryOperatorKind) isComparison() bool {
+	return k >= EqualKind
+}
+
+// fluxOperators are the flux operators of the binary operators.
+// Flux has no modulo and power operators.
+var fluxOperators = map[BinaryOperatorKind]ast.OperatorKind{
+	AddKind:          ast.AdditionOperator,
+	SubKind:          ast.SubtractionOperator,
+	MulKind:          ast.MultiplicationOperator,
+	DivKind:          ast.DivisionOperator,
+	EqualKind:        ast.EqualOperator,
+	NotEqualKind:     ast.NotEqualOperator,
+	GreaterKind:      ast.GreaterThanOperator,
+	GreaterEqualKind: ast.GreaterThanEqualOperator,
+	LessKind:         ast.LessThanOperator,
+	LessEqualKind:    ast.LessThanEqualOperator,
+}
+
+// VectorMatching is the matching of the series of the vectors of a binary expression.
+type VectorMatching struct {
+	// On reports whether the series match on the labels, rather than ignoring them.
+	On     bool          `json:"on,omitempty"`
+	Labels []*Identifier `json:"labels,omitempty"`
+}
+
+func NewVectorMatching(kind string, labels []*Identifier) (*VectorMatching, error) {
+	return &VectorMatching{
+		On:     strings.ToLower(kind) == "on",
+		Labels: labels,
+	}, nil
+}
+
+type BinaryExpr struct {
+	Op  BinaryOperatorKind `json:"op,omitempty"`
+	LHS Expr               `json:"lhs,omitempty"`
+	RHS Expr               `json:"rhs,omitempty"`
+	// ReturnBool reports whether a comparison returns 0 or 1 rather than filtering.
+	ReturnBool bool            `json:"return_bool,omitempty"`
+	Matching   *VectorMatching `json:"matching,omitempty"`
+}
+
+// NewBinaryExpr returns the binary expression of an operator and its right hand side.
+// Its left hand side is set by ChainBinaryExprs.
+func NewBinaryExpr(op BinaryOperatorKind, returnBool, matching interface{}, rhs Expr) (*BinaryExpr, error) {
+	expr := &BinaryExpr{
+		Op:  op,
+		RHS: rhs,
+	}
+	if returnBool != nil {
+		expr.ReturnBool = returnBool.(bool)
+	}
+	if matching != nil {
+		expr.Matching = matching.(*VectorMatching)
+	}
+	return expr, nil
+}
+
+// ChainBinaryExprs returns the left associative chain of the binary expressions in rest,
+// whose first left hand side is first.
+func ChainBinaryExprs(first Expr, rest interface{}) (Expr, error) {
+	lhs := first
+	for _, r := range toIfaceSlice(rest) {
+		expr := r.(*BinaryExpr)
+		expr.LHS = lhs
+		lhs = expr
+	}
+	return lhs, nil
+}
+
+func (e *BinaryExpr) QuerySpec() (*flux.Spec, error) {
+	return buildSpec(e)
+}
+
+func (e *BinaryExpr) build(b *specBuilder) (*value, error) {
+	lhs, err := e.LHS.build(b)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := e.RHS.build(b)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range []*value{lhs, rhs} {
+		if v.typ != scalarType && v.typ != instantVectorType {
+			return nil, fmt.Errorf("binary operator %s expects scalars or instant vectors, got %s", e.Op, v.typ)
+		}
+	}
+
+	if lhs.typ == scalarType && rhs.typ == scalarType {
+		return e.evalScalars(lhs.scalar, rhs.scalar)
+	}
+
+	op, ok := fluxOperators[e.Op]
+	if !ok {
+		return nil, fmt.Errorf("binary operator %s is not supported", e.Op)
+	}
+	if e.Op.isComparison() && e.ReturnBool {
+		return nil, fmt.Errorf("bool modifier is only supported between scalars")
+	}
+
+	switch {
+	case rhs.typ == scalarType:
+		return e.buildVectorScalar(b, op, lhs, columnRef(execute.DefaultValueColLabel), &semantic.FloatLiteral{Value: rhs.scalar})
+	case lhs.typ == scalarType:
+		return e.buildVectorScalar(b, op, rhs, &semantic.FloatLiteral{Value: lhs.scalar}, columnRef(execute.DefaultValueColLabel))
+	default:
+		return e.buildVectors(b, op, lhs, rhs)
+	}
+}
+
+// evalScalars evaluates the operator between two scalars.
+func (e *BinaryExpr) evalScalars(lhs, rhs float64) (*value, error) {
+	var v float64
+	switch e.Op {
+	case AddKind:
+		v = lhs + rhs
+	case SubKind:
+		v = lhs - rhs
+	case MulKind:
+		v = lhs * rhs
+	case DivKind:
+		v = lhs / rhs
+	case ModKind:
+		v = math.Mod(lhs, rhs)
+	case PowKind:
+		v = math.Pow(lhs, rhs)
+	default:
+		if !e.ReturnBool {
+			return nil, fmt.Errorf("comparisons between scalars must use the bool modifier")
+		}
+		var ok bool
+		switch e.Op {
+		case EqualKind:
+			ok = lhs == rhs
+		case NotEqualKind:
+			ok = lhs != rhs
+		case GreaterKind:
+			ok = lhs > rhs
+		case GreaterEqualKind:
+			ok = lhs >= rhs
+		case LessKind:
+			ok = lhs < rhs
+		case LessEqualKind:
+			ok = lhs <= rhs
+		}
+		if ok {
+			v = 1
+		}
+	}
+	return &value{typ: scalarType, scalar: v}, nil
+}
+
+// buildVectorScalar applies the operator between the value of each series of v and a scalar.
+// Comparisons filter the series; the other operators map their value.
+func (e *BinaryExpr) buildVectorScalar(b *specBuilder, op ast.OperatorKind, v *value, left, right semantic.Expression) (*value, error) {
+	expr := &semantic.BinaryExpression{
+		Operator: op,
+		Left:     left,
+		Right:    right,
+	}
+	out := *v
+	if e.Op.isComparison() {
+		out.id = b.op("filter", &transformations.FilterOpSpec{
+			Fn: rowFunction(expr),
+		}, v.id)
+	} else {
+		out.id = b.op("map", mapValue(expr, v.hasTime), v.id)
+	}
+	return &out, nil
+}
+
+// buildVectors joins the series of the vectors that have the same matching labels
+// and applies the operator between their values.
+// Comparisons keep the value of the left hand side.
+//
+// The labels are known only after an aggregation, so the series of other vectors
+// must be matched on explicit labels.
+func (e *BinaryExpr) buildVectors(b *specBuilder, op ast.OperatorKind, lhs, rhs *value) (*value, error) {
+	labels, err := e.matchingLabels(lhs, rhs)
+	if err != nil {
+		return nil, err
+	}
+
+	const left, right = "lhs", "rhs"
+	id := b.op("join", &transformations.JoinOpSpec{
+		TableNames: map[flux.OperationID]string{
+			lhs.id: left,
+			rhs.id: right,
+		},
+		On:     labels,
+		Method: "inner",
+	}, lhs.id, rhs.id)
+
+	// The columns of the vectors that are not joined on are suffixed with the name of their vector.
+	lvalue := columnRef(execute.DefaultValueColLabel + "_" + left)
+	rvalue := columnRef(execute.DefaultValueColLabel + "_" + right)
+	result := semantic.Expression(&semantic.BinaryExpression{
+		Operator: op,
+		Left:     lvalue,
+		Right:    rvalue,
+	})
+	if e.Op.isComparison() {
+		id = b.op("filter", &transformations.FilterOpSpec{
+			Fn: rowFunction(result),
+		}, id)
+		result = lvalue
+	}
+
+	// Keep the matching labels and the result.
+	properties := make([]*semantic.Property, 0, len(labels)+1)
+	for _, label := range labels {
+		properties = append(properties, &semantic.Property{
+			Key:   &semantic.Identifier{Name: label},
+			Value: columnRef(label),
+		})
+	}
+	properties = append(properties, &semantic.Property{
+		Key:   &semantic.Identifier{Name: execute.DefaultValueColLabel},
+		Value: result,
+	})
+	id = b.op("map", &transformations.MapOpSpec{
+		Fn: rowFunction(&semantic.ObjectExpression{
+			Properties: properties,
+		}),
+	}, id)
+	return &value{typ: instantVectorType, id: id, labels: labels, labelsKnown: true}, nil
+}
+
+// matchingLabels returns the labels that the series of the vectors match on.
+func (e *BinaryExpr) matchingLabels(lhs, rhs *value) ([]string, error) {
+	if e.Matching != nil && e.Matching.On {
+		return identifierNames(e.Matching.Labels), nil
+	}
+
+	if !lhs.labelsKnown || !rhs.labelsKnown {
+		return nil, fmt.Errorf("binary operator %s between vectors requires on() unless both vectors are aggregated", e.Op)
+	}
+	l, r := withoutLabels(lhs.labels, e.Matching), withoutLabels(rhs.labels, e.Matching)
+	if len(l) != len(r) {
+		return nil, fmt.Errorf("binary operator %s between vectors with different labels requires on()", e.Op)
+	}
+	for i := range l {
+		if l[i] != r[i] {
+			return nil, fmt.Errorf("binary operator %s between vectors with different labels requires on()", e.Op)
+		}
+	}
+	return l, nil
+}
+
+// withoutLabels returns the sorted labels without the ones ignored by the matching.
+func withoutLabels(labels []string, matching *VectorMatching) []string {
+	ignored := make(map[string]bool)
+	if matching != nil {
+		for _, id := range matching.Labels {
+			ignored[id.Name] = true
+		}
+	}
+	out := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if !ignored[label] {
+			out = append(out, label)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,178 @@
+package promql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+)
+
+// DefaultBucket is the bucket the series are read from.
+const DefaultBucket = "prometheus"
+
+// lookbackDelta is how far back an instant vector selector looks for the latest sample of a series.
+// It is the default of Prometheus.
+const lookbackDelta = 5 * time.Minute
+
+// The columns of the metric name and the type of the value of the series.
+const (
+	measurementColumn = "_measurement"
+	fieldColumn       = "_field"
+)
+
+// Expr is a PromQL expression.
+type Expr interface {
+	QueryBuilder
+	build(b *specBuilder) (*value, error)
+}
+
+type valueType int
+
+const (
+	scalarType valueType = iota
+	stringType
+	instantVectorType
+	rangeVectorType
+)
+
+func (t valueType) String() string {
+	switch t {
+	case scalarType:
+		return "scalar"
+	case stringType:
+		return "string"
+	case instantVectorType:
+		return "instant vector"
+	case rangeVectorType:
+		return "range vector"
+	default:
+		return fmt.Sprintf("unknown value type %d", int(t))
+	}
+}
+
+// value is the result of an expression in the specification.
+type value struct {
+	typ valueType
+	// id is the operation that returns the series of a vector.
+	id flux.OperationID
+	// scalar is the value of a scalar. Only literal scalars are supported.
+	scalar float64
+	// rng is the range of a range vector.
+	rng time.Duration
+	// labels are the labels of the series of a vector when they are known,
+	// which is after an aggregation by labels or a vector matching.
+	labels      []string
+	labelsKnown bool
+	// hasTime reports whether the series of a vector have their time column.
+	hasTime bool
+}
+
+// specBuilder adds the operations of expressions to a specification.
+type specBuilder struct {
+	spec   *flux.Spec
+	nextID map[string]int
+}
+
+func buildSpec(e Expr) (*flux.Spec, error) {
+	b := &specBuilder{
+		spec:   &flux.Spec{},
+		nextID: make(map[string]int),
+	}
+	v, err := e.build(b)
+	if err != nil {
+		return nil, err
+	}
+	if v.typ != instantVectorType && v.typ != rangeVectorType {
+		return nil, fmt.Errorf("unable to query a %s", v.typ)
+	}
+	return b.spec, nil
+}
+
+// op adds an operation with the parents to the specification and returns its ID.
+// The ID is the name of the operation, suffixed by a number for the operations after the first with the name.
+func (b *specBuilder) op(name string, spec flux.OperationSpec, parents ...flux.OperationID) flux.OperationID {
+	id := flux.OperationID(name)
+	if n := b.nextID[name]; n > 0 {
+		id = flux.OperationID(fmt.Sprintf("%s%d", name, n))
+	}
+	b.nextID[name]++
+
+	b.spec.Operations = append(b.spec.Operations, &flux.Operation{
+		ID:   id,
+		Spec: spec,
+	})
+	for _, parent := range parents {
+		b.spec.Edges = append(b.spec.Edges, flux.Edge{
+			Parent: parent,
+			Child:  id,
+		})
+	}
+	return id
+}
+
+// columnRef returns the reference to a column of the row r.
+func columnRef(column string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.IdentifierExpression{
+			Name: "r",
+		},
+		Property: column,
+	}
+}
+
+// compareColumn returns the comparison of a column of the row r to a string.
+func compareColumn(op ast.OperatorKind, column, s string) *semantic.BinaryExpression {
+	return &semantic.BinaryExpression{
+		Operator: op,
+		Left:     columnRef(column),
+		Right: &semantic.StringLiteral{
+			Value: s,
+		},
+	}
+}
+
+// rowFunction returns the function of the row r with the body.
+func rowFunction(body semantic.Node) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Block: &semantic.FunctionBlock{
+			Parameters: &semantic.FunctionParameters{
+				List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+			},
+			Body: body,
+		},
+	}
+}
+
+// mapValue returns the map of the value column of the series to expr.
+// The series keep their labels and their time column if they have it.
+func mapValue(expr semantic.Expression, hasTime bool) *transformations.MapOpSpec {
+	var properties []*semantic.Property
+	if hasTime {
+		properties = append(properties, &semantic.Property{
+			Key:   &semantic.Identifier{Name: execute.DefaultTimeColLabel},
+			Value: columnRef(execute.DefaultTimeColLabel),
+		})
+	}
+	properties = append(properties, &semantic.Property{
+		Key:   &semantic.Identifier{Name: execute.DefaultValueColLabel},
+		Value: expr,
+	})
+	return &transformations.MapOpSpec{
+		Fn: rowFunction(&semantic.ObjectExpression{
+			Properties: properties,
+		}),
+		MergeKey: true,
+	}
+}
+
+func identifierNames(ids []*Identifier) []string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = id.Name
+	}
+	return names
+}
@@ -0,0 +1,45 @@
+package promql
+
+import (
+	"context"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/functions/inputs"
+)
+
+const CompilerType = "promql"
+
+// AddCompilerMappings adds the promql specific compiler mappings.
+func AddCompilerMappings(mappings flux.CompilerMappings) error {
+	return mappings.Add(CompilerType, func() flux.Compiler {
+		return new(Compiler)
+	})
+}
+
+// Compiler is the transpiler to convert PromQL to a Flux specification.
+type Compiler struct {
+	// Bucket is the name of the bucket to read the series from.
+	// The series are read from the prometheus bucket when it is empty.
+	Bucket string `json:"bucket,omitempty"`
+	Query  string `json:"query"`
+}
+
+// Compile transpiles the query into a specification.
+func (c *Compiler) Compile(ctx context.Context) (*flux.Spec, error) {
+	spec, err := Build(c.Query)
+	if err != nil {
+		return nil, err
+	}
+	if c.Bucket != "" {
+		for _, op := range spec.Operations {
+			if from, ok := op.Spec.(*inputs.FromOpSpec); ok {
+				from.Bucket = c.Bucket
+			}
+		}
+	}
+	return spec, nil
+}
+
+func (c *Compiler) CompilerType() flux.CompilerType {
+	return CompilerType
+}
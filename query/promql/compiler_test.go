@@ -0,0 +1,52 @@
+package promql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/platform/query/promql"
+)
+
+func TestCompiler(t *testing.T) {
+	var _ flux.Compiler = (*promql.Compiler)(nil)
+}
+
+func TestCompiler_Compile(t *testing.T) {
+	tests := []struct {
+		name   string
+		bucket string
+		want   string
+	}{
+		{
+			name: "default bucket",
+			want: "prometheus",
+		},
+		{
+			name:   "bucket of the compiler",
+			bucket: "telegraf",
+			want:   "telegraf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &promql.Compiler{
+				Bucket: tt.bucket,
+				Query:  `node_cpu{mode="idle"}`,
+			}
+			spec, err := c.Compile(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			from, ok := spec.Operations[0].Spec.(*inputs.FromOpSpec)
+			if !ok {
+				t.Fatalf("unexpected first operation %T", spec.Operations[0].Spec)
+			}
+			if got, exp := from.Bucket, tt.want; got != exp {
+				t.Errorf("got bucket %q, exp %q", got, exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package promql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+)
+
+// Call is a call of a PromQL function.
+type Call struct {
+	Func string `json:"func,omitempty"`
+	Args []Expr `json:"args,omitempty"`
+}
+
+func NewCall(name string, args interface{}) (*Call, error) {
+	call := &Call{
+		Func: name,
+	}
+	if args != nil {
+		call.Args = args.([]Expr)
+	}
+	return call, nil
+}
+
+func NewExprList(first Expr, rest interface{}) ([]Expr, error) {
+	exprs := []Expr{first}
+	for _, e := range toIfaceSlice(rest) {
+		exprs = append(exprs, e.(Expr))
+	}
+	return exprs, nil
+}
+
+func (c *Call) QuerySpec() (*flux.Spec, error) {
+	return buildSpec(c)
+}
+
+// overTimeAggregates are the functions that aggregate the samples of each series of a range vector.
+var overTimeAggregates = map[string]func() flux.OperationSpec{
+	"avg_over_time": func() flux.OperationSpec {
+		return &transformations.MeanOpSpec{AggregateConfig: execute.DefaultAggregateConfig}
+	},
+	"sum_over_time": func() flux.OperationSpec {
+		return &transformations.SumOpSpec{AggregateConfig: execute.DefaultAggregateConfig}
+	},
+	"count_over_time": func() flux.OperationSpec {
+		return &transformations.CountOpSpec{AggregateConfig: execute.DefaultAggregateConfig}
+	},
+	"stddev_over_time": func() flux.OperationSpec {
+		return &transformations.StddevOpSpec{AggregateConfig: execute.DefaultAggregateConfig}
+	},
+	"min_over_time": func() flux.OperationSpec {
+		return &transformations.MinOpSpec{SelectorConfig: execute.SelectorConfig{Column: execute.DefaultValueColLabel}}
+	},
+	"max_over_time": func() flux.OperationSpec {
+		return &transformations.MaxOpSpec{SelectorConfig: execute.SelectorConfig{Column: execute.DefaultValueColLabel}}
+	},
+}
+
+func (c *Call) build(b *specBuilder) (*value, error) {
+	args := make([]*value, len(c.Args))
+	for i, arg := range c.Args {
+		v, err := arg.build(b)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	switch c.Func {
+	case "rate", "increase", "delta", "irate", "idelta":
+		if err := c.checkArgs(args, rangeVectorType); err != nil {
+			return nil, err
+		}
+		return c.buildChange(b, args[0])
+	case "quantile_over_time":
+		if err := c.checkArgs(args, scalarType, rangeVectorType); err != nil {
+			return nil, err
+		}
+		return &value{
+			typ: instantVectorType,
+			id: b.op("percentile", &transformations.PercentileOpSpec{
+				Percentile:      args[0].scalar,
+				Method:          "exact_mean",
+				AggregateConfig: execute.DefaultAggregateConfig,
+			}, args[1].id),
+		}, nil
+	case "histogram_quantile":
+		if err := c.checkArgs(args, scalarType, instantVectorType); err != nil {
+			return nil, err
+		}
+		return buildHistogramQuantile(b, args[0].scalar, args[1])
+	}
+
+	if newSpec, ok := overTimeAggregates[c.Func]; ok {
+		if err := c.checkArgs(args, rangeVectorType); err != nil {
+			return nil, err
+		}
+		return &value{typ: instantVectorType, id: b.op(c.Func, newSpec(), args[0].id)}, nil
+	}
+	return nil, fmt.Errorf("function %s is not supported", c.Func)
+}
+
+// checkArgs checks the number and the types of the arguments of the call.
+func (c *Call) checkArgs(args []*value, types ...valueType) error {
+	if len(args) != len(types) {
+		return fmt.Errorf("function %s expects %d arguments, got %d", c.Func, len(types), len(args))
+	}
+	for i, v := range args {
+		if v.typ != types[i] {
+			return fmt.Errorf("argument %d of function %s must be a %s, got %s", i+1, c.Func, types[i], v.typ)
+		}
+	}
+	return nil
+}
+
+// buildChange computes the change of the samples of each series of a range vector.
+//
+// The increase of a counter is the sum of the differences between its samples;
+// a sample lower than the previous one is a reset of the counter and counts as its whole value.
+// Unlike Prometheus, the increase is not extrapolated to the boundaries of the range.
+func (c *Call) buildChange(b *specBuilder, v *value) (*value, error) {
+	out := &value{typ: instantVectorType}
+	switch c.Func {
+	case "irate":
+		id := b.op("derivative", &transformations.DerivativeOpSpec{
+			Unit:        flux.Duration(time.Second),
+			NonNegative: true,
+			Columns:     []string{execute.DefaultValueColLabel},
+			TimeColumn:  execute.DefaultTimeColLabel,
+		}, v.id)
+		out.id = b.op("last", &transformations.LastOpSpec{
+			SelectorConfig: execute.SelectorConfig{
+				Column: execute.DefaultValueColLabel,
+			},
+		}, id)
+		out.hasTime = true
+	case "idelta":
+		id := b.op("difference", &transformations.DifferenceOpSpec{
+			Columns: []string{execute.DefaultValueColLabel},
+		}, v.id)
+		out.id = b.op("last", &transformations.LastOpSpec{
+			SelectorConfig: execute.SelectorConfig{
+				Column: execute.DefaultValueColLabel,
+			},
+		}, id)
+		out.hasTime = true
+	default:
+		id := b.op("difference", &transformations.DifferenceOpSpec{
+			NonNegative: c.Func != "delta",
+			Columns:     []string{execute.DefaultValueColLabel},
+		}, v.id)
+		out.id = b.op("sum", &transformations.SumOpSpec{
+			AggregateConfig: execute.DefaultAggregateConfig,
+		}, id)
+		if c.Func == "rate" {
+			// The rate is per second.
+			out.id = b.op("map", mapValue(&semantic.BinaryExpression{
+				Operator: ast.DivisionOperator,
+				Left:     columnRef(execute.DefaultValueColLabel),
+				Right:    &semantic.FloatLiteral{Value: v.rng.Seconds()},
+			}, false), out.id)
+		}
+	}
+	return out, nil
+}
+
+// buildHistogramQuantile computes the quantile phi of the histograms of an instant vector of buckets.
+// The series of a histogram have the same labels, but their upper bound.
+func buildHistogramQuantile(b *specBuilder, phi float64, v *value) (*value, error) {
+	id := b.op("group", &transformations.GroupOpSpec{
+		Columns: append([]string{bucketLabel}, nonLabelColumns...),
+		Mode:    "except",
+	}, v.id)
+
+	// The upper bounds are the names of the bucket fields.
+	id = b.op("map", &transformations.MapOpSpec{
+		Fn: rowFunction(&semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{
+					Key: &semantic.Identifier{Name: bucketLabel},
+					Value: &semantic.CallExpression{
+						Callee: &semantic.IdentifierExpression{Name: "float"},
+						Arguments: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{{
+								Key:   &semantic.Identifier{Name: "v"},
+								Value: columnRef(bucketLabel),
+							}},
+						},
+					},
+				},
+				{
+					Key:   &semantic.Identifier{Name: execute.DefaultValueColLabel},
+					Value: columnRef(execute.DefaultValueColLabel),
+				},
+			},
+		}),
+		MergeKey: true,
+	}, id)
+
+	id = b.op("histogramQuantile", &transformations.HistogramQuantileOpSpec{
+		Quantile:         phi,
+		CountColumn:      execute.DefaultValueColLabel,
+		UpperBoundColumn: bucketLabel,
+		ValueColumn:      execute.DefaultValueColLabel,
+	}, id)
+
+	out := &value{typ: instantVectorType, id: id}
+	if v.labelsKnown {
+		out.labels = withoutLabels(v.labels, &VectorMatching{Labels: []*Identifier{{Name: bucketLabel}}})
+		out.labelsKnown = true
+	}
+	return out, nil
+}
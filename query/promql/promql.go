@@ -48,17 +48,17 @@ var g = &grammar{
 									},
 									&ruleRefExpr{
 										pos:  position{line: 11, col: 32, offset: 265},
-										name: "AggregateExpression",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 11, col: 54, offset: 287},
-										name: "VectorSelector",
+										name: "Expression",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 11, col: 71, offset: 304},
+							pos:  position{line: 11, col: 45, offset: 278},
+							name: "__",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 11, col: 48, offset: 281},
 							name: "EOF",
 						},
 					},
@@ -67,39 +67,39 @@ var g = &grammar{
 		},
 		{
 			name: "SourceChar",
-			pos:  position{line: 15, col: 1, offset: 337},
+			pos:  position{line: 15, col: 1, offset: 314},
 			expr: &anyMatcher{
-				line: 15, col: 14, offset: 350,
+				line: 15, col: 14, offset: 327,
 			},
 		},
 		{
 			name: "Comment",
-			pos:  position{line: 17, col: 1, offset: 353},
+			pos:  position{line: 17, col: 1, offset: 330},
 			expr: &actionExpr{
-				pos: position{line: 17, col: 11, offset: 363},
+				pos: position{line: 17, col: 11, offset: 340},
 				run: (*parser).callonComment1,
 				expr: &seqExpr{
-					pos: position{line: 17, col: 11, offset: 363},
+					pos: position{line: 17, col: 11, offset: 340},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 17, col: 11, offset: 363},
+							pos:        position{line: 17, col: 11, offset: 340},
 							val:        "#",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 17, col: 15, offset: 367},
+							pos: position{line: 17, col: 15, offset: 344},
 							expr: &seqExpr{
-								pos: position{line: 17, col: 17, offset: 369},
+								pos: position{line: 17, col: 17, offset: 346},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 17, col: 17, offset: 369},
+										pos: position{line: 17, col: 17, offset: 346},
 										expr: &ruleRefExpr{
-											pos:  position{line: 17, col: 18, offset: 370},
+											pos:  position{line: 17, col: 18, offset: 347},
 											name: "EOL",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 22, offset: 374},
+										pos:  position{line: 17, col: 22, offset: 351},
 										name: "SourceChar",
 									},
 								},
@@ -111,15 +111,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 21, col: 1, offset: 434},
+			pos:  position{line: 21, col: 1, offset: 411},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 14, offset: 447},
+				pos: position{line: 21, col: 14, offset: 424},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 21, col: 14, offset: 447},
+					pos:   position{line: 21, col: 14, offset: 424},
 					label: "ident",
 					expr: &ruleRefExpr{
-						pos:  position{line: 21, col: 20, offset: 453},
+						pos:  position{line: 21, col: 20, offset: 430},
 						name: "IdentifierName",
 					},
 				},
@@ -127,21 +127,21 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 29, col: 1, offset: 637},
+			pos:  position{line: 28, col: 1, offset: 603},
 			expr: &actionExpr{
-				pos: position{line: 29, col: 18, offset: 654},
+				pos: position{line: 28, col: 18, offset: 620},
 				run: (*parser).callonIdentifierName1,
 				expr: &seqExpr{
-					pos: position{line: 29, col: 18, offset: 654},
+					pos: position{line: 28, col: 18, offset: 620},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 29, col: 18, offset: 654},
+							pos:  position{line: 28, col: 18, offset: 620},
 							name: "IdentifierStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 29, col: 34, offset: 670},
+							pos: position{line: 28, col: 34, offset: 636},
 							expr: &ruleRefExpr{
-								pos:  position{line: 29, col: 34, offset: 670},
+								pos:  position{line: 28, col: 34, offset: 636},
 								name: "IdentifierPart",
 							},
 						},
@@ -151,9 +151,9 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 32, col: 1, offset: 721},
+			pos:  position{line: 31, col: 1, offset: 687},
 			expr: &charClassMatcher{
-				pos:        position{line: 32, col: 19, offset: 739},
+				pos:        position{line: 31, col: 19, offset: 705},
 				val:        "[\\pL_]",
 				chars:      []rune{'_'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -163,16 +163,16 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierPart",
-			pos:  position{line: 33, col: 1, offset: 746},
+			pos:  position{line: 32, col: 1, offset: 712},
 			expr: &choiceExpr{
-				pos: position{line: 33, col: 18, offset: 763},
+				pos: position{line: 32, col: 18, offset: 729},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 33, col: 18, offset: 763},
+						pos:  position{line: 32, col: 18, offset: 729},
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 33, col: 36, offset: 781},
+						pos:        position{line: 32, col: 36, offset: 747},
 						val:        "[\\p{Nd}]",
 						classes:    []*unicode.RangeTable{rangeTable("Nd")},
 						ignoreCase: false,
@@ -183,74 +183,74 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 35, col: 1, offset: 791},
+			pos:  position{line: 34, col: 1, offset: 757},
 			expr: &choiceExpr{
-				pos: position{line: 35, col: 17, offset: 807},
+				pos: position{line: 34, col: 17, offset: 773},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 35, col: 17, offset: 807},
+						pos: position{line: 34, col: 17, offset: 773},
 						run: (*parser).callonStringLiteral2,
 						expr: &choiceExpr{
-							pos: position{line: 35, col: 19, offset: 809},
+							pos: position{line: 34, col: 19, offset: 775},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 35, col: 19, offset: 809},
+									pos: position{line: 34, col: 19, offset: 775},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 35, col: 19, offset: 809},
+											pos:        position{line: 34, col: 19, offset: 775},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 35, col: 23, offset: 813},
+											pos: position{line: 34, col: 23, offset: 779},
 											expr: &ruleRefExpr{
-												pos:  position{line: 35, col: 23, offset: 813},
+												pos:  position{line: 34, col: 23, offset: 779},
 												name: "DoubleStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 35, col: 41, offset: 831},
+											pos:        position{line: 34, col: 41, offset: 797},
 											val:        "\"",
 											ignoreCase: false,
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 35, col: 47, offset: 837},
+									pos: position{line: 34, col: 47, offset: 803},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 35, col: 47, offset: 837},
+											pos:        position{line: 34, col: 47, offset: 803},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 35, col: 51, offset: 841},
+											pos:  position{line: 34, col: 51, offset: 807},
 											name: "SingleStringChar",
 										},
 										&litMatcher{
-											pos:        position{line: 35, col: 68, offset: 858},
+											pos:        position{line: 34, col: 68, offset: 824},
 											val:        "'",
 											ignoreCase: false,
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 35, col: 74, offset: 864},
+									pos: position{line: 34, col: 74, offset: 830},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 35, col: 74, offset: 864},
+											pos:        position{line: 34, col: 74, offset: 830},
 											val:        "`",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 35, col: 78, offset: 868},
+											pos: position{line: 34, col: 78, offset: 834},
 											expr: &ruleRefExpr{
-												pos:  position{line: 35, col: 78, offset: 868},
+												pos:  position{line: 34, col: 78, offset: 834},
 												name: "RawStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 35, col: 93, offset: 883},
+											pos:        position{line: 34, col: 93, offset: 849},
 											val:        "`",
 											ignoreCase: false,
 										},
@@ -260,35 +260,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 41, col: 5, offset: 1029},
+						pos: position{line: 40, col: 5, offset: 995},
 						run: (*parser).callonStringLiteral18,
 						expr: &choiceExpr{
-							pos: position{line: 41, col: 7, offset: 1031},
+							pos: position{line: 40, col: 7, offset: 997},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 41, col: 9, offset: 1033},
+									pos: position{line: 40, col: 9, offset: 999},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 41, col: 9, offset: 1033},
+											pos:        position{line: 40, col: 9, offset: 999},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 41, col: 13, offset: 1037},
+											pos: position{line: 40, col: 13, offset: 1003},
 											expr: &ruleRefExpr{
-												pos:  position{line: 41, col: 13, offset: 1037},
+												pos:  position{line: 40, col: 13, offset: 1003},
 												name: "DoubleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 41, col: 33, offset: 1057},
+											pos: position{line: 40, col: 33, offset: 1023},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 33, offset: 1057},
+													pos:  position{line: 40, col: 33, offset: 1023},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 39, offset: 1063},
+													pos:  position{line: 40, col: 39, offset: 1029},
 													name: "EOF",
 												},
 											},
@@ -296,29 +296,29 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 41, col: 51, offset: 1075},
+									pos: position{line: 40, col: 51, offset: 1041},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 41, col: 51, offset: 1075},
+											pos:        position{line: 40, col: 51, offset: 1041},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 41, col: 55, offset: 1079},
+											pos: position{line: 40, col: 55, offset: 1045},
 											expr: &ruleRefExpr{
-												pos:  position{line: 41, col: 55, offset: 1079},
+												pos:  position{line: 40, col: 55, offset: 1045},
 												name: "SingleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 41, col: 75, offset: 1099},
+											pos: position{line: 40, col: 75, offset: 1065},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 75, offset: 1099},
+													pos:  position{line: 40, col: 75, offset: 1065},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 81, offset: 1105},
+													pos:  position{line: 40, col: 81, offset: 1071},
 													name: "EOF",
 												},
 											},
@@ -326,22 +326,22 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 41, col: 91, offset: 1115},
+									pos: position{line: 40, col: 91, offset: 1081},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 41, col: 91, offset: 1115},
+											pos:        position{line: 40, col: 91, offset: 1081},
 											val:        "`",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 41, col: 95, offset: 1119},
+											pos: position{line: 40, col: 95, offset: 1085},
 											expr: &ruleRefExpr{
-												pos:  position{line: 41, col: 95, offset: 1119},
+												pos:  position{line: 40, col: 95, offset: 1085},
 												name: "RawStringChar",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 41, col: 110, offset: 1134},
+											pos:  position{line: 40, col: 110, offset: 1100},
 											name: "EOF",
 										},
 									},
@@ -354,51 +354,51 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringChar",
-			pos:  position{line: 45, col: 1, offset: 1205},
+			pos:  position{line: 44, col: 1, offset: 1171},
 			expr: &choiceExpr{
-				pos: position{line: 45, col: 20, offset: 1224},
+				pos: position{line: 44, col: 20, offset: 1190},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 45, col: 20, offset: 1224},
+						pos: position{line: 44, col: 20, offset: 1190},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 45, col: 20, offset: 1224},
+								pos: position{line: 44, col: 20, offset: 1190},
 								expr: &choiceExpr{
-									pos: position{line: 45, col: 23, offset: 1227},
+									pos: position{line: 44, col: 23, offset: 1193},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 45, col: 23, offset: 1227},
+											pos:        position{line: 44, col: 23, offset: 1193},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 45, col: 29, offset: 1233},
+											pos:        position{line: 44, col: 29, offset: 1199},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 45, col: 36, offset: 1240},
+											pos:  position{line: 44, col: 36, offset: 1206},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 45, col: 42, offset: 1246},
+								pos:  position{line: 44, col: 42, offset: 1212},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 45, col: 55, offset: 1259},
+						pos: position{line: 44, col: 55, offset: 1225},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 45, col: 55, offset: 1259},
+								pos:        position{line: 44, col: 55, offset: 1225},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 45, col: 60, offset: 1264},
+								pos:  position{line: 44, col: 60, offset: 1230},
 								name: "DoubleStringEscape",
 							},
 						},
@@ -408,51 +408,51 @@ var g = &grammar{
 		},
 		{
 			name: "SingleStringChar",
-			pos:  position{line: 46, col: 1, offset: 1283},
+			pos:  position{line: 45, col: 1, offset: 1249},
 			expr: &choiceExpr{
-				pos: position{line: 46, col: 20, offset: 1302},
+				pos: position{line: 45, col: 20, offset: 1268},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 46, col: 20, offset: 1302},
+						pos: position{line: 45, col: 20, offset: 1268},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 46, col: 20, offset: 1302},
+								pos: position{line: 45, col: 20, offset: 1268},
 								expr: &choiceExpr{
-									pos: position{line: 46, col: 23, offset: 1305},
+									pos: position{line: 45, col: 23, offset: 1271},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 46, col: 23, offset: 1305},
+											pos:        position{line: 45, col: 23, offset: 1271},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 46, col: 29, offset: 1311},
+											pos:        position{line: 45, col: 29, offset: 1277},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 46, col: 36, offset: 1318},
+											pos:  position{line: 45, col: 36, offset: 1284},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 46, col: 42, offset: 1324},
+								pos:  position{line: 45, col: 42, offset: 1290},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 46, col: 55, offset: 1337},
+						pos: position{line: 45, col: 55, offset: 1303},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 46, col: 55, offset: 1337},
+								pos:        position{line: 45, col: 55, offset: 1303},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 46, col: 60, offset: 1342},
+								pos:  position{line: 45, col: 60, offset: 1308},
 								name: "SingleStringEscape",
 							},
 						},
@@ -462,20 +462,20 @@ var g = &grammar{
 		},
 		{
 			name: "RawStringChar",
-			pos:  position{line: 47, col: 1, offset: 1361},
+			pos:  position{line: 46, col: 1, offset: 1327},
 			expr: &seqExpr{
-				pos: position{line: 47, col: 17, offset: 1377},
+				pos: position{line: 46, col: 17, offset: 1343},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 47, col: 17, offset: 1377},
+						pos: position{line: 46, col: 17, offset: 1343},
 						expr: &litMatcher{
-							pos:        position{line: 47, col: 18, offset: 1378},
+							pos:        position{line: 46, col: 18, offset: 1344},
 							val:        "`",
 							ignoreCase: false,
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 47, col: 22, offset: 1382},
+						pos:  position{line: 46, col: 22, offset: 1348},
 						name: "SourceChar",
 					},
 				},
@@ -483,40 +483,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringEscape",
-			pos:  position{line: 49, col: 1, offset: 1394},
+			pos:  position{line: 48, col: 1, offset: 1360},
 			expr: &choiceExpr{
-				pos: position{line: 49, col: 22, offset: 1415},
+				pos: position{line: 48, col: 22, offset: 1381},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 49, col: 24, offset: 1417},
+						pos: position{line: 48, col: 24, offset: 1383},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 49, col: 24, offset: 1417},
+								pos:        position{line: 48, col: 24, offset: 1383},
 								val:        "\"",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 49, col: 30, offset: 1423},
+								pos:  position{line: 48, col: 30, offset: 1389},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 50, col: 7, offset: 1452},
+						pos: position{line: 49, col: 7, offset: 1418},
 						run: (*parser).callonDoubleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 50, col: 9, offset: 1454},
+							pos: position{line: 49, col: 9, offset: 1420},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 50, col: 9, offset: 1454},
+									pos:  position{line: 49, col: 9, offset: 1420},
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 50, col: 22, offset: 1467},
+									pos:  position{line: 49, col: 22, offset: 1433},
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 50, col: 28, offset: 1473},
+									pos:  position{line: 49, col: 28, offset: 1439},
 									name: "EOF",
 								},
 							},
@@ -527,40 +527,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleStringEscape",
-			pos:  position{line: 53, col: 1, offset: 1538},
+			pos:  position{line: 52, col: 1, offset: 1504},
 			expr: &choiceExpr{
-				pos: position{line: 53, col: 22, offset: 1559},
+				pos: position{line: 52, col: 22, offset: 1525},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 53, col: 24, offset: 1561},
+						pos: position{line: 52, col: 24, offset: 1527},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 53, col: 24, offset: 1561},
+								pos:        position{line: 52, col: 24, offset: 1527},
 								val:        "'",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 53, col: 30, offset: 1567},
+								pos:  position{line: 52, col: 30, offset: 1533},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 54, col: 7, offset: 1596},
+						pos: position{line: 53, col: 7, offset: 1562},
 						run: (*parser).callonSingleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 54, col: 9, offset: 1598},
+							pos: position{line: 53, col: 9, offset: 1564},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 54, col: 9, offset: 1598},
+									pos:  position{line: 53, col: 9, offset: 1564},
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 54, col: 22, offset: 1611},
+									pos:  position{line: 53, col: 22, offset: 1577},
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 54, col: 28, offset: 1617},
+									pos:  position{line: 53, col: 28, offset: 1583},
 									name: "EOF",
 								},
 							},
@@ -571,28 +571,28 @@ var g = &grammar{
 		},
 		{
 			name: "CommonEscapeSequence",
-			pos:  position{line: 58, col: 1, offset: 1683},
+			pos:  position{line: 57, col: 1, offset: 1649},
 			expr: &choiceExpr{
-				pos: position{line: 58, col: 24, offset: 1706},
+				pos: position{line: 57, col: 24, offset: 1672},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 24, offset: 1706},
+						pos:  position{line: 57, col: 24, offset: 1672},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 43, offset: 1725},
+						pos:  position{line: 57, col: 43, offset: 1691},
 						name: "OctalEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 57, offset: 1739},
+						pos:  position{line: 57, col: 57, offset: 1705},
 						name: "HexEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 69, offset: 1751},
+						pos:  position{line: 57, col: 69, offset: 1717},
 						name: "LongUnicodeEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 89, offset: 1771},
+						pos:  position{line: 57, col: 89, offset: 1737},
 						name: "ShortUnicodeEscape",
 					},
 				},
@@ -600,47 +600,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 59, col: 1, offset: 1790},
+			pos:  position{line: 58, col: 1, offset: 1756},
 			expr: &choiceExpr{
-				pos: position{line: 59, col: 20, offset: 1809},
+				pos: position{line: 58, col: 20, offset: 1775},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 59, col: 20, offset: 1809},
+						pos:        position{line: 58, col: 20, offset: 1775},
 						val:        "a",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 26, offset: 1815},
+						pos:        position{line: 58, col: 26, offset: 1781},
 						val:        "b",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 32, offset: 1821},
+						pos:        position{line: 58, col: 32, offset: 1787},
 						val:        "n",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 38, offset: 1827},
+						pos:        position{line: 58, col: 38, offset: 1793},
 						val:        "f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 44, offset: 1833},
+						pos:        position{line: 58, col: 44, offset: 1799},
 						val:        "r",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 50, offset: 1839},
+						pos:        position{line: 58, col: 50, offset: 1805},
 						val:        "t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 56, offset: 1845},
+						pos:        position{line: 58, col: 56, offset: 1811},
 						val:        "v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 62, offset: 1851},
+						pos:        position{line: 58, col: 62, offset: 1817},
 						val:        "\\",
 						ignoreCase: false,
 					},
@@ -649,50 +649,50 @@ var g = &grammar{
 		},
 		{
 			name: "OctalEscape",
-			pos:  position{line: 60, col: 1, offset: 1856},
+			pos:  position{line: 59, col: 1, offset: 1822},
 			expr: &choiceExpr{
-				pos: position{line: 60, col: 15, offset: 1870},
+				pos: position{line: 59, col: 15, offset: 1836},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 60, col: 15, offset: 1870},
+						pos: position{line: 59, col: 15, offset: 1836},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 60, col: 15, offset: 1870},
+								pos:  position{line: 59, col: 15, offset: 1836},
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 60, col: 26, offset: 1881},
+								pos:  position{line: 59, col: 26, offset: 1847},
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 60, col: 37, offset: 1892},
+								pos:  position{line: 59, col: 37, offset: 1858},
 								name: "OctalDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 61, col: 7, offset: 1909},
+						pos: position{line: 60, col: 7, offset: 1875},
 						run: (*parser).callonOctalEscape6,
 						expr: &seqExpr{
-							pos: position{line: 61, col: 7, offset: 1909},
+							pos: position{line: 60, col: 7, offset: 1875},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 61, col: 7, offset: 1909},
+									pos:  position{line: 60, col: 7, offset: 1875},
 									name: "OctalDigit",
 								},
 								&choiceExpr{
-									pos: position{line: 61, col: 20, offset: 1922},
+									pos: position{line: 60, col: 20, offset: 1888},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 61, col: 20, offset: 1922},
+											pos:  position{line: 60, col: 20, offset: 1888},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 61, col: 33, offset: 1935},
+											pos:  position{line: 60, col: 33, offset: 1901},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 61, col: 39, offset: 1941},
+											pos:  position{line: 60, col: 39, offset: 1907},
 											name: "EOF",
 										},
 									},
@@ -705,52 +705,52 @@ var g = &grammar{
 		},
 		{
 			name: "HexEscape",
-			pos:  position{line: 64, col: 1, offset: 2002},
+			pos:  position{line: 63, col: 1, offset: 1968},
 			expr: &choiceExpr{
-				pos: position{line: 64, col: 13, offset: 2014},
+				pos: position{line: 63, col: 13, offset: 1980},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 64, col: 13, offset: 2014},
+						pos: position{line: 63, col: 13, offset: 1980},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 64, col: 13, offset: 2014},
+								pos:        position{line: 63, col: 13, offset: 1980},
 								val:        "x",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 64, col: 17, offset: 2018},
+								pos:  position{line: 63, col: 17, offset: 1984},
 								name: "HexDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 64, col: 26, offset: 2027},
+								pos:  position{line: 63, col: 26, offset: 1993},
 								name: "HexDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 65, col: 7, offset: 2042},
+						pos: position{line: 64, col: 7, offset: 2008},
 						run: (*parser).callonHexEscape6,
 						expr: &seqExpr{
-							pos: position{line: 65, col: 7, offset: 2042},
+							pos: position{line: 64, col: 7, offset: 2008},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 65, col: 7, offset: 2042},
+									pos:        position{line: 64, col: 7, offset: 2008},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 65, col: 13, offset: 2048},
+									pos: position{line: 64, col: 13, offset: 2014},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 13, offset: 2048},
+											pos:  position{line: 64, col: 13, offset: 2014},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 26, offset: 2061},
+											pos:  position{line: 64, col: 26, offset: 2027},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 32, offset: 2067},
+											pos:  position{line: 64, col: 32, offset: 2033},
 											name: "EOF",
 										},
 									},
@@ -763,80 +763,80 @@ var g = &grammar{
 		},
 		{
 			name: "LongUnicodeEscape",
-			pos:  position{line: 68, col: 1, offset: 2134},
+			pos:  position{line: 67, col: 1, offset: 2100},
 			expr: &choiceExpr{
-				pos: position{line: 69, col: 5, offset: 2159},
+				pos: position{line: 68, col: 5, offset: 2125},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 69, col: 5, offset: 2159},
+						pos: position{line: 68, col: 5, offset: 2125},
 						run: (*parser).callonLongUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 69, col: 5, offset: 2159},
+							pos: position{line: 68, col: 5, offset: 2125},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 69, col: 5, offset: 2159},
+									pos:        position{line: 68, col: 5, offset: 2125},
 									val:        "U",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 9, offset: 2163},
+									pos:  position{line: 68, col: 9, offset: 2129},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 18, offset: 2172},
+									pos:  position{line: 68, col: 18, offset: 2138},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 27, offset: 2181},
+									pos:  position{line: 68, col: 27, offset: 2147},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 36, offset: 2190},
+									pos:  position{line: 68, col: 36, offset: 2156},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 45, offset: 2199},
+									pos:  position{line: 68, col: 45, offset: 2165},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 54, offset: 2208},
+									pos:  position{line: 68, col: 54, offset: 2174},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 63, offset: 2217},
+									pos:  position{line: 68, col: 63, offset: 2183},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 72, offset: 2226},
+									pos:  position{line: 68, col: 72, offset: 2192},
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 72, col: 7, offset: 2328},
+						pos: position{line: 71, col: 7, offset: 2294},
 						run: (*parser).callonLongUnicodeEscape13,
 						expr: &seqExpr{
-							pos: position{line: 72, col: 7, offset: 2328},
+							pos: position{line: 71, col: 7, offset: 2294},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 72, col: 7, offset: 2328},
+									pos:        position{line: 71, col: 7, offset: 2294},
 									val:        "U",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 72, col: 13, offset: 2334},
+									pos: position{line: 71, col: 13, offset: 2300},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 72, col: 13, offset: 2334},
+											pos:  position{line: 71, col: 13, offset: 2300},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 72, col: 26, offset: 2347},
+											pos:  position{line: 71, col: 26, offset: 2313},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 72, col: 32, offset: 2353},
+											pos:  position{line: 71, col: 32, offset: 2319},
 											name: "EOF",
 										},
 									},
@@ -849,64 +849,64 @@ var g = &grammar{
 		},
 		{
 			name: "ShortUnicodeEscape",
-			pos:  position{line: 75, col: 1, offset: 2416},
+			pos:  position{line: 74, col: 1, offset: 2382},
 			expr: &choiceExpr{
-				pos: position{line: 76, col: 5, offset: 2442},
+				pos: position{line: 75, col: 5, offset: 2408},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 76, col: 5, offset: 2442},
+						pos: position{line: 75, col: 5, offset: 2408},
 						run: (*parser).callonShortUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 76, col: 5, offset: 2442},
+							pos: position{line: 75, col: 5, offset: 2408},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 76, col: 5, offset: 2442},
+									pos:        position{line: 75, col: 5, offset: 2408},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 9, offset: 2446},
+									pos:  position{line: 75, col: 9, offset: 2412},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 18, offset: 2455},
+									pos:  position{line: 75, col: 18, offset: 2421},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 27, offset: 2464},
+									pos:  position{line: 75, col: 27, offset: 2430},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 36, offset: 2473},
+									pos:  position{line: 75, col: 36, offset: 2439},
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 79, col: 7, offset: 2575},
+						pos: position{line: 78, col: 7, offset: 2541},
 						run: (*parser).callonShortUnicodeEscape9,
 						expr: &seqExpr{
-							pos: position{line: 79, col: 7, offset: 2575},
+							pos: position{line: 78, col: 7, offset: 2541},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 79, col: 7, offset: 2575},
+									pos:        position{line: 78, col: 7, offset: 2541},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 79, col: 13, offset: 2581},
+									pos: position{line: 78, col: 13, offset: 2547},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 79, col: 13, offset: 2581},
+											pos:  position{line: 78, col: 13, offset: 2547},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 79, col: 26, offset: 2594},
+											pos:  position{line: 78, col: 26, offset: 2560},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 79, col: 32, offset: 2600},
+											pos:  position{line: 78, col: 32, offset: 2566},
 											name: "EOF",
 										},
 									},
@@ -919,9 +919,9 @@ var g = &grammar{
 		},
 		{
 			name: "OctalDigit",
-			pos:  position{line: 83, col: 1, offset: 2664},
+			pos:  position{line: 82, col: 1, offset: 2630},
 			expr: &charClassMatcher{
-				pos:        position{line: 83, col: 14, offset: 2677},
+				pos:        position{line: 82, col: 14, offset: 2643},
 				val:        "[0-7]",
 				ranges:     []rune{'0', '7'},
 				ignoreCase: false,
@@ -930,9 +930,9 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalDigit",
-			pos:  position{line: 84, col: 1, offset: 2683},
+			pos:  position{line: 83, col: 1, offset: 2649},
 			expr: &charClassMatcher{
-				pos:        position{line: 84, col: 16, offset: 2698},
+				pos:        position{line: 83, col: 16, offset: 2664},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -941,9 +941,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 85, col: 1, offset: 2704},
+			pos:  position{line: 84, col: 1, offset: 2670},
 			expr: &charClassMatcher{
-				pos:        position{line: 85, col: 12, offset: 2715},
+				pos:        position{line: 84, col: 12, offset: 2681},
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -952,44 +952,44 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassMatcher",
-			pos:  position{line: 87, col: 1, offset: 2726},
+			pos:  position{line: 86, col: 1, offset: 2692},
 			expr: &choiceExpr{
-				pos: position{line: 87, col: 20, offset: 2745},
+				pos: position{line: 86, col: 20, offset: 2711},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 87, col: 20, offset: 2745},
+						pos: position{line: 86, col: 20, offset: 2711},
 						run: (*parser).callonCharClassMatcher2,
 						expr: &seqExpr{
-							pos: position{line: 87, col: 20, offset: 2745},
+							pos: position{line: 86, col: 20, offset: 2711},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 87, col: 20, offset: 2745},
+									pos:        position{line: 86, col: 20, offset: 2711},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 87, col: 24, offset: 2749},
+									pos: position{line: 86, col: 24, offset: 2715},
 									expr: &choiceExpr{
-										pos: position{line: 87, col: 26, offset: 2751},
+										pos: position{line: 86, col: 26, offset: 2717},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 87, col: 26, offset: 2751},
+												pos:  position{line: 86, col: 26, offset: 2717},
 												name: "ClassCharRange",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 87, col: 43, offset: 2768},
+												pos:  position{line: 86, col: 43, offset: 2734},
 												name: "ClassChar",
 											},
 											&seqExpr{
-												pos: position{line: 87, col: 55, offset: 2780},
+												pos: position{line: 86, col: 55, offset: 2746},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 87, col: 55, offset: 2780},
+														pos:        position{line: 86, col: 55, offset: 2746},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 87, col: 60, offset: 2785},
+														pos:  position{line: 86, col: 60, offset: 2751},
 														name: "UnicodeClassEscape",
 													},
 												},
@@ -998,14 +998,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 87, col: 82, offset: 2807},
+									pos:        position{line: 86, col: 82, offset: 2773},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 87, col: 86, offset: 2811},
+									pos: position{line: 86, col: 86, offset: 2777},
 									expr: &litMatcher{
-										pos:        position{line: 87, col: 86, offset: 2811},
+										pos:        position{line: 86, col: 86, offset: 2777},
 										val:        "i",
 										ignoreCase: false,
 									},
@@ -1014,44 +1014,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 89, col: 5, offset: 2853},
+						pos: position{line: 88, col: 5, offset: 2819},
 						run: (*parser).callonCharClassMatcher15,
 						expr: &seqExpr{
-							pos: position{line: 89, col: 5, offset: 2853},
+							pos: position{line: 88, col: 5, offset: 2819},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 89, col: 5, offset: 2853},
+									pos:        position{line: 88, col: 5, offset: 2819},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 89, col: 9, offset: 2857},
+									pos: position{line: 88, col: 9, offset: 2823},
 									expr: &seqExpr{
-										pos: position{line: 89, col: 11, offset: 2859},
+										pos: position{line: 88, col: 11, offset: 2825},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 89, col: 11, offset: 2859},
+												pos: position{line: 88, col: 11, offset: 2825},
 												expr: &ruleRefExpr{
-													pos:  position{line: 89, col: 14, offset: 2862},
+													pos:  position{line: 88, col: 14, offset: 2828},
 													name: "EOL",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 89, col: 20, offset: 2868},
+												pos:  position{line: 88, col: 20, offset: 2834},
 												name: "SourceChar",
 											},
 										},
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 89, col: 36, offset: 2884},
+									pos: position{line: 88, col: 36, offset: 2850},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 89, col: 36, offset: 2884},
+											pos:  position{line: 88, col: 36, offset: 2850},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 89, col: 42, offset: 2890},
+											pos:  position{line: 88, col: 42, offset: 2856},
 											name: "EOF",
 										},
 									},
@@ -1064,21 +1064,21 @@ var g = &grammar{
 		},
 		{
 			name: "ClassCharRange",
-			pos:  position{line: 93, col: 1, offset: 2962},
+			pos:  position{line: 92, col: 1, offset: 2928},
 			expr: &seqExpr{
-				pos: position{line: 93, col: 18, offset: 2979},
+				pos: position{line: 92, col: 18, offset: 2945},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 18, offset: 2979},
+						pos:  position{line: 92, col: 18, offset: 2945},
 						name: "ClassChar",
 					},
 					&litMatcher{
-						pos:        position{line: 93, col: 28, offset: 2989},
+						pos:        position{line: 92, col: 28, offset: 2955},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 32, offset: 2993},
+						pos:  position{line: 92, col: 32, offset: 2959},
 						name: "ClassChar",
 					},
 				},
@@ -1086,51 +1086,51 @@ var g = &grammar{
 		},
 		{
 			name: "ClassChar",
-			pos:  position{line: 94, col: 1, offset: 3003},
+			pos:  position{line: 93, col: 1, offset: 2969},
 			expr: &choiceExpr{
-				pos: position{line: 94, col: 13, offset: 3015},
+				pos: position{line: 93, col: 13, offset: 2981},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 94, col: 13, offset: 3015},
+						pos: position{line: 93, col: 13, offset: 2981},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 94, col: 13, offset: 3015},
+								pos: position{line: 93, col: 13, offset: 2981},
 								expr: &choiceExpr{
-									pos: position{line: 94, col: 16, offset: 3018},
+									pos: position{line: 93, col: 16, offset: 2984},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 94, col: 16, offset: 3018},
+											pos:        position{line: 93, col: 16, offset: 2984},
 											val:        "]",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 94, col: 22, offset: 3024},
+											pos:        position{line: 93, col: 22, offset: 2990},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 94, col: 29, offset: 3031},
+											pos:  position{line: 93, col: 29, offset: 2997},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 94, col: 35, offset: 3037},
+								pos:  position{line: 93, col: 35, offset: 3003},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 94, col: 48, offset: 3050},
+						pos: position{line: 93, col: 48, offset: 3016},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 94, col: 48, offset: 3050},
+								pos:        position{line: 93, col: 48, offset: 3016},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 94, col: 53, offset: 3055},
+								pos:  position{line: 93, col: 53, offset: 3021},
 								name: "CharClassEscape",
 							},
 						},
@@ -1140,51 +1140,51 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassEscape",
-			pos:  position{line: 95, col: 1, offset: 3071},
+			pos:  position{line: 94, col: 1, offset: 3037},
 			expr: &choiceExpr{
-				pos: position{line: 95, col: 19, offset: 3089},
+				pos: position{line: 94, col: 19, offset: 3055},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 95, col: 21, offset: 3091},
+						pos: position{line: 94, col: 21, offset: 3057},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 95, col: 21, offset: 3091},
+								pos:        position{line: 94, col: 21, offset: 3057},
 								val:        "]",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 95, col: 27, offset: 3097},
+								pos:  position{line: 94, col: 27, offset: 3063},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 96, col: 7, offset: 3126},
+						pos: position{line: 95, col: 7, offset: 3092},
 						run: (*parser).callonCharClassEscape5,
 						expr: &seqExpr{
-							pos: position{line: 96, col: 7, offset: 3126},
+							pos: position{line: 95, col: 7, offset: 3092},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 96, col: 7, offset: 3126},
+									pos: position{line: 95, col: 7, offset: 3092},
 									expr: &litMatcher{
-										pos:        position{line: 96, col: 8, offset: 3127},
+										pos:        position{line: 95, col: 8, offset: 3093},
 										val:        "p",
 										ignoreCase: false,
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 96, col: 14, offset: 3133},
+									pos: position{line: 95, col: 14, offset: 3099},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 14, offset: 3133},
+											pos:  position{line: 95, col: 14, offset: 3099},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 27, offset: 3146},
+											pos:  position{line: 95, col: 27, offset: 3112},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 33, offset: 3152},
+											pos:  position{line: 95, col: 33, offset: 3118},
 											name: "EOF",
 										},
 									},
@@ -1197,49 +1197,49 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeClassEscape",
-			pos:  position{line: 100, col: 1, offset: 3218},
+			pos:  position{line: 99, col: 1, offset: 3184},
 			expr: &seqExpr{
-				pos: position{line: 100, col: 22, offset: 3239},
+				pos: position{line: 99, col: 22, offset: 3205},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 100, col: 22, offset: 3239},
+						pos:        position{line: 99, col: 22, offset: 3205},
 						val:        "p",
 						ignoreCase: false,
 					},
 					&choiceExpr{
-						pos: position{line: 101, col: 7, offset: 3252},
+						pos: position{line: 100, col: 7, offset: 3218},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 101, col: 7, offset: 3252},
+								pos:  position{line: 100, col: 7, offset: 3218},
 								name: "SingleCharUnicodeClass",
 							},
 							&actionExpr{
-								pos: position{line: 102, col: 7, offset: 3281},
+								pos: position{line: 101, col: 7, offset: 3247},
 								run: (*parser).callonUnicodeClassEscape5,
 								expr: &seqExpr{
-									pos: position{line: 102, col: 7, offset: 3281},
+									pos: position{line: 101, col: 7, offset: 3247},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 102, col: 7, offset: 3281},
+											pos: position{line: 101, col: 7, offset: 3247},
 											expr: &litMatcher{
-												pos:        position{line: 102, col: 8, offset: 3282},
+												pos:        position{line: 101, col: 8, offset: 3248},
 												val:        "{",
 												ignoreCase: false,
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 102, col: 14, offset: 3288},
+											pos: position{line: 101, col: 14, offset: 3254},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 102, col: 14, offset: 3288},
+													pos:  position{line: 101, col: 14, offset: 3254},
 													name: "SourceChar",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 102, col: 27, offset: 3301},
+													pos:  position{line: 101, col: 27, offset: 3267},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 102, col: 33, offset: 3307},
+													pos:  position{line: 101, col: 33, offset: 3273},
 													name: "EOF",
 												},
 											},
@@ -1248,26 +1248,26 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 103, col: 7, offset: 3378},
+								pos: position{line: 102, col: 7, offset: 3344},
 								run: (*parser).callonUnicodeClassEscape13,
 								expr: &seqExpr{
-									pos: position{line: 103, col: 7, offset: 3378},
+									pos: position{line: 102, col: 7, offset: 3344},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 103, col: 7, offset: 3378},
+											pos:        position{line: 102, col: 7, offset: 3344},
 											val:        "{",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 103, col: 11, offset: 3382},
+											pos:   position{line: 102, col: 11, offset: 3348},
 											label: "ident",
 											expr: &ruleRefExpr{
-												pos:  position{line: 103, col: 17, offset: 3388},
+												pos:  position{line: 102, col: 17, offset: 3354},
 												name: "IdentifierName",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 103, col: 32, offset: 3403},
+											pos:        position{line: 102, col: 32, offset: 3369},
 											val:        "}",
 											ignoreCase: false,
 										},
@@ -1275,34 +1275,34 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 109, col: 7, offset: 3567},
+								pos: position{line: 108, col: 7, offset: 3533},
 								run: (*parser).callonUnicodeClassEscape19,
 								expr: &seqExpr{
-									pos: position{line: 109, col: 7, offset: 3567},
+									pos: position{line: 108, col: 7, offset: 3533},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 109, col: 7, offset: 3567},
+											pos:        position{line: 108, col: 7, offset: 3533},
 											val:        "{",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 109, col: 11, offset: 3571},
+											pos:  position{line: 108, col: 11, offset: 3537},
 											name: "IdentifierName",
 										},
 										&choiceExpr{
-											pos: position{line: 109, col: 28, offset: 3588},
+											pos: position{line: 108, col: 28, offset: 3554},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 109, col: 28, offset: 3588},
+													pos:        position{line: 108, col: 28, offset: 3554},
 													val:        "]",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 109, col: 34, offset: 3594},
+													pos:  position{line: 108, col: 34, offset: 3560},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 109, col: 40, offset: 3600},
+													pos:  position{line: 108, col: 40, offset: 3566},
 													name: "EOF",
 												},
 											},
@@ -1317,9 +1317,9 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharUnicodeClass",
-			pos:  position{line: 114, col: 1, offset: 3680},
+			pos:  position{line: 113, col: 1, offset: 3646},
 			expr: &charClassMatcher{
-				pos:        position{line: 114, col: 26, offset: 3705},
+				pos:        position{line: 113, col: 26, offset: 3671},
 				val:        "[LMNCPZS]",
 				chars:      []rune{'L', 'M', 'N', 'C', 'P', 'Z', 'S'},
 				ignoreCase: false,
@@ -1328,39 +1328,39 @@ var g = &grammar{
 		},
 		{
 			name: "Number",
-			pos:  position{line: 117, col: 1, offset: 3717},
+			pos:  position{line: 116, col: 1, offset: 3683},
 			expr: &actionExpr{
-				pos: position{line: 117, col: 10, offset: 3726},
+				pos: position{line: 116, col: 10, offset: 3692},
 				run: (*parser).callonNumber1,
 				expr: &seqExpr{
-					pos: position{line: 117, col: 10, offset: 3726},
+					pos: position{line: 116, col: 10, offset: 3692},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 117, col: 10, offset: 3726},
+							pos: position{line: 116, col: 10, offset: 3692},
 							expr: &litMatcher{
-								pos:        position{line: 117, col: 10, offset: 3726},
+								pos:        position{line: 116, col: 10, offset: 3692},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 15, offset: 3731},
+							pos:  position{line: 116, col: 15, offset: 3697},
 							name: "Integer",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 117, col: 23, offset: 3739},
+							pos: position{line: 116, col: 23, offset: 3705},
 							expr: &seqExpr{
-								pos: position{line: 117, col: 25, offset: 3741},
+								pos: position{line: 116, col: 25, offset: 3707},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 117, col: 25, offset: 3741},
+										pos:        position{line: 116, col: 25, offset: 3707},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 117, col: 29, offset: 3745},
+										pos: position{line: 116, col: 29, offset: 3711},
 										expr: &ruleRefExpr{
-											pos:  position{line: 117, col: 29, offset: 3745},
+											pos:  position{line: 116, col: 29, offset: 3711},
 											name: "Digit",
 										},
 									},
@@ -1373,29 +1373,29 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 121, col: 1, offset: 3797},
+			pos:  position{line: 120, col: 1, offset: 3763},
 			expr: &choiceExpr{
-				pos: position{line: 121, col: 11, offset: 3807},
+				pos: position{line: 120, col: 11, offset: 3773},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 121, col: 11, offset: 3807},
+						pos:        position{line: 120, col: 11, offset: 3773},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 121, col: 17, offset: 3813},
+						pos: position{line: 120, col: 17, offset: 3779},
 						run: (*parser).callonInteger3,
 						expr: &seqExpr{
-							pos: position{line: 121, col: 17, offset: 3813},
+							pos: position{line: 120, col: 17, offset: 3779},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 121, col: 17, offset: 3813},
+									pos:  position{line: 120, col: 17, offset: 3779},
 									name: "NonZeroDigit",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 121, col: 30, offset: 3826},
+									pos: position{line: 120, col: 30, offset: 3792},
 									expr: &ruleRefExpr{
-										pos:  position{line: 121, col: 30, offset: 3826},
+										pos:  position{line: 120, col: 30, offset: 3792},
 										name: "Digit",
 									},
 								},
@@ -1407,9 +1407,9 @@ var g = &grammar{
 		},
 		{
 			name: "NonZeroDigit",
-			pos:  position{line: 125, col: 1, offset: 3890},
+			pos:  position{line: 124, col: 1, offset: 3856},
 			expr: &charClassMatcher{
-				pos:        position{line: 125, col: 16, offset: 3905},
+				pos:        position{line: 124, col: 16, offset: 3871},
 				val:        "[1-9]",
 				ranges:     []rune{'1', '9'},
 				ignoreCase: false,
@@ -1418,9 +1418,9 @@ var g = &grammar{
 		},
 		{
 			name: "Digit",
-			pos:  position{line: 126, col: 1, offset: 3911},
+			pos:  position{line: 125, col: 1, offset: 3877},
 			expr: &charClassMatcher{
-				pos:        position{line: 126, col: 9, offset: 3919},
+				pos:        position{line: 125, col: 9, offset: 3885},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1429,31 +1429,31 @@ var g = &grammar{
 		},
 		{
 			name: "LabelBlock",
-			pos:  position{line: 128, col: 1, offset: 3926},
+			pos:  position{line: 127, col: 1, offset: 3892},
 			expr: &choiceExpr{
-				pos: position{line: 128, col: 14, offset: 3939},
+				pos: position{line: 127, col: 14, offset: 3905},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 128, col: 14, offset: 3939},
+						pos: position{line: 127, col: 14, offset: 3905},
 						run: (*parser).callonLabelBlock2,
 						expr: &seqExpr{
-							pos: position{line: 128, col: 14, offset: 3939},
+							pos: position{line: 127, col: 14, offset: 3905},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 128, col: 14, offset: 3939},
+									pos:        position{line: 127, col: 14, offset: 3905},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 128, col: 18, offset: 3943},
+									pos:   position{line: 127, col: 18, offset: 3909},
 									label: "block",
 									expr: &ruleRefExpr{
-										pos:  position{line: 128, col: 24, offset: 3949},
+										pos:  position{line: 127, col: 24, offset: 3915},
 										name: "LabelMatches",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 128, col: 37, offset: 3962},
+									pos:        position{line: 127, col: 37, offset: 3928},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -1461,22 +1461,22 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 130, col: 5, offset: 3994},
+						pos: position{line: 129, col: 5, offset: 3960},
 						run: (*parser).callonLabelBlock8,
 						expr: &seqExpr{
-							pos: position{line: 130, col: 5, offset: 3994},
+							pos: position{line: 129, col: 5, offset: 3960},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 130, col: 5, offset: 3994},
+									pos:        position{line: 129, col: 5, offset: 3960},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 130, col: 9, offset: 3998},
+									pos:  position{line: 129, col: 9, offset: 3964},
 									name: "LabelMatches",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 130, col: 22, offset: 4011},
+									pos:  position{line: 129, col: 22, offset: 3977},
 									name: "EOF",
 								},
 							},
@@ -1487,12 +1487,12 @@ var g = &grammar{
 		},
 		{
 			name: "NanoSecondUnits",
-			pos:  position{line: 134, col: 1, offset: 4076},
+			pos:  position{line: 133, col: 1, offset: 4042},
 			expr: &actionExpr{
-				pos: position{line: 134, col: 19, offset: 4094},
+				pos: position{line: 133, col: 19, offset: 4060},
 				run: (*parser).callonNanoSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 134, col: 19, offset: 4094},
+					pos:        position{line: 133, col: 19, offset: 4060},
 					val:        "ns",
 					ignoreCase: false,
 				},
@@ -1500,25 +1500,25 @@ var g = &grammar{
 		},
 		{
 			name: "MicroSecondUnits",
-			pos:  position{line: 139, col: 1, offset: 4199},
+			pos:  position{line: 138, col: 1, offset: 4165},
 			expr: &actionExpr{
-				pos: position{line: 139, col: 20, offset: 4218},
+				pos: position{line: 138, col: 20, offset: 4184},
 				run: (*parser).callonMicroSecondUnits1,
 				expr: &choiceExpr{
-					pos: position{line: 139, col: 21, offset: 4219},
+					pos: position{line: 138, col: 21, offset: 4185},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 139, col: 21, offset: 4219},
+							pos:        position{line: 138, col: 21, offset: 4185},
 							val:        "us",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 28, offset: 4226},
+							pos:        position{line: 138, col: 28, offset: 4192},
 							val:        "µs",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 35, offset: 4234},
+							pos:        position{line: 138, col: 35, offset: 4200},
 							val:        "μs",
 							ignoreCase: false,
 						},
@@ -1528,12 +1528,12 @@ var g = &grammar{
 		},
 		{
 			name: "MilliSecondUnits",
-			pos:  position{line: 144, col: 1, offset: 4343},
+			pos:  position{line: 143, col: 1, offset: 4309},
 			expr: &actionExpr{
-				pos: position{line: 144, col: 20, offset: 4362},
+				pos: position{line: 143, col: 20, offset: 4328},
 				run: (*parser).callonMilliSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 144, col: 20, offset: 4362},
+					pos:        position{line: 143, col: 20, offset: 4328},
 					val:        "ms",
 					ignoreCase: false,
 				},
@@ -1541,12 +1541,12 @@ var g = &grammar{
 		},
 		{
 			name: "SecondUnits",
-			pos:  position{line: 149, col: 1, offset: 4469},
+			pos:  position{line: 148, col: 1, offset: 4435},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 15, offset: 4483},
+				pos: position{line: 148, col: 15, offset: 4449},
 				run: (*parser).callonSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 149, col: 15, offset: 4483},
+					pos:        position{line: 148, col: 15, offset: 4449},
 					val:        "s",
 					ignoreCase: false,
 				},
@@ -1554,12 +1554,12 @@ var g = &grammar{
 		},
 		{
 			name: "MinuteUnits",
-			pos:  position{line: 153, col: 1, offset: 4520},
+			pos:  position{line: 152, col: 1, offset: 4486},
 			expr: &actionExpr{
-				pos: position{line: 153, col: 15, offset: 4534},
+				pos: position{line: 152, col: 15, offset: 4500},
 				run: (*parser).callonMinuteUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 153, col: 15, offset: 4534},
+					pos:        position{line: 152, col: 15, offset: 4500},
 					val:        "m",
 					ignoreCase: false,
 				},
@@ -1567,12 +1567,12 @@ var g = &grammar{
 		},
 		{
 			name: "HourUnits",
-			pos:  position{line: 157, col: 1, offset: 4571},
+			pos:  position{line: 156, col: 1, offset: 4537},
 			expr: &actionExpr{
-				pos: position{line: 157, col: 13, offset: 4583},
+				pos: position{line: 156, col: 13, offset: 4549},
 				run: (*parser).callonHourUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 157, col: 13, offset: 4583},
+					pos:        position{line: 156, col: 13, offset: 4549},
 					val:        "h",
 					ignoreCase: false,
 				},
@@ -1580,12 +1580,12 @@ var g = &grammar{
 		},
 		{
 			name: "DayUnits",
-			pos:  position{line: 161, col: 1, offset: 4618},
+			pos:  position{line: 160, col: 1, offset: 4584},
 			expr: &actionExpr{
-				pos: position{line: 161, col: 12, offset: 4629},
+				pos: position{line: 160, col: 12, offset: 4595},
 				run: (*parser).callonDayUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 161, col: 12, offset: 4629},
+					pos:        position{line: 160, col: 12, offset: 4595},
 					val:        "d",
 					ignoreCase: false,
 				},
@@ -1593,12 +1593,12 @@ var g = &grammar{
 		},
 		{
 			name: "WeekUnits",
-			pos:  position{line: 167, col: 1, offset: 4837},
+			pos:  position{line: 166, col: 1, offset: 4803},
 			expr: &actionExpr{
-				pos: position{line: 167, col: 13, offset: 4849},
+				pos: position{line: 166, col: 13, offset: 4815},
 				run: (*parser).callonWeekUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 167, col: 13, offset: 4849},
+					pos:        position{line: 166, col: 13, offset: 4815},
 					val:        "w",
 					ignoreCase: false,
 				},
@@ -1606,12 +1606,12 @@ var g = &grammar{
 		},
 		{
 			name: "YearUnits",
-			pos:  position{line: 173, col: 1, offset: 5060},
+			pos:  position{line: 172, col: 1, offset: 5026},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 13, offset: 5072},
+				pos: position{line: 172, col: 13, offset: 5038},
 				run: (*parser).callonYearUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 173, col: 13, offset: 5072},
+					pos:        position{line: 172, col: 13, offset: 5038},
 					val:        "y",
 					ignoreCase: false,
 				},
@@ -1619,44 +1619,44 @@ var g = &grammar{
 		},
 		{
 			name: "DurationUnits",
-			pos:  position{line: 179, col: 1, offset: 5269},
+			pos:  position{line: 178, col: 1, offset: 5235},
 			expr: &choiceExpr{
-				pos: position{line: 179, col: 18, offset: 5286},
+				pos: position{line: 178, col: 18, offset: 5252},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 18, offset: 5286},
+						pos:  position{line: 178, col: 18, offset: 5252},
 						name: "NanoSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 36, offset: 5304},
+						pos:  position{line: 178, col: 36, offset: 5270},
 						name: "MicroSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 55, offset: 5323},
+						pos:  position{line: 178, col: 55, offset: 5289},
 						name: "MilliSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 74, offset: 5342},
+						pos:  position{line: 178, col: 74, offset: 5308},
 						name: "SecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 88, offset: 5356},
+						pos:  position{line: 178, col: 88, offset: 5322},
 						name: "MinuteUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 102, offset: 5370},
+						pos:  position{line: 178, col: 102, offset: 5336},
 						name: "HourUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 114, offset: 5382},
+						pos:  position{line: 178, col: 114, offset: 5348},
 						name: "DayUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 125, offset: 5393},
+						pos:  position{line: 178, col: 125, offset: 5359},
 						name: "WeekUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 137, offset: 5405},
+						pos:  position{line: 178, col: 137, offset: 5371},
 						name: "YearUnits",
 					},
 				},
@@ -1664,26 +1664,26 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 181, col: 1, offset: 5417},
+			pos:  position{line: 180, col: 1, offset: 5383},
 			expr: &actionExpr{
-				pos: position{line: 181, col: 12, offset: 5428},
+				pos: position{line: 180, col: 12, offset: 5394},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 181, col: 12, offset: 5428},
+					pos: position{line: 180, col: 12, offset: 5394},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 181, col: 12, offset: 5428},
+							pos:   position{line: 180, col: 12, offset: 5394},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 16, offset: 5432},
+								pos:  position{line: 180, col: 16, offset: 5398},
 								name: "Integer",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 181, col: 24, offset: 5440},
+							pos:   position{line: 180, col: 24, offset: 5406},
 							label: "units",
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 30, offset: 5446},
+								pos:  position{line: 180, col: 30, offset: 5412},
 								name: "DurationUnits",
 							},
 						},
@@ -1693,82 +1693,82 @@ var g = &grammar{
 		},
 		{
 			name: "Operators",
-			pos:  position{line: 187, col: 1, offset: 5595},
+			pos:  position{line: 186, col: 1, offset: 5561},
 			expr: &choiceExpr{
-				pos: position{line: 187, col: 13, offset: 5607},
+				pos: position{line: 186, col: 13, offset: 5573},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 187, col: 13, offset: 5607},
+						pos:        position{line: 186, col: 13, offset: 5573},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 19, offset: 5613},
+						pos:        position{line: 186, col: 19, offset: 5579},
 						val:        "+",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 25, offset: 5619},
+						pos:        position{line: 186, col: 25, offset: 5585},
 						val:        "*",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 31, offset: 5625},
+						pos:        position{line: 186, col: 31, offset: 5591},
 						val:        "%",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 37, offset: 5631},
+						pos:        position{line: 186, col: 37, offset: 5597},
 						val:        "/",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 43, offset: 5637},
+						pos:        position{line: 186, col: 43, offset: 5603},
 						val:        "==",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 50, offset: 5644},
+						pos:        position{line: 186, col: 50, offset: 5610},
 						val:        "!=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 57, offset: 5651},
+						pos:        position{line: 186, col: 57, offset: 5617},
 						val:        "<=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 64, offset: 5658},
+						pos:        position{line: 186, col: 64, offset: 5624},
 						val:        "<",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 70, offset: 5664},
+						pos:        position{line: 186, col: 70, offset: 5630},
 						val:        ">=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 77, offset: 5671},
+						pos:        position{line: 186, col: 77, offset: 5637},
 						val:        ">",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 83, offset: 5677},
+						pos:        position{line: 186, col: 83, offset: 5643},
 						val:        "=~",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 90, offset: 5684},
+						pos:        position{line: 186, col: 90, offset: 5650},
 						val:        "!~",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 97, offset: 5691},
+						pos:        position{line: 186, col: 97, offset: 5657},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 103, offset: 5697},
+						pos:        position{line: 186, col: 103, offset: 5663},
 						val:        "=",
 						ignoreCase: false,
 					},
@@ -1777,42 +1777,42 @@ var g = &grammar{
 		},
 		{
 			name: "LabelOperators",
-			pos:  position{line: 189, col: 1, offset: 5702},
+			pos:  position{line: 188, col: 1, offset: 5668},
 			expr: &choiceExpr{
-				pos: position{line: 189, col: 19, offset: 5720},
+				pos: position{line: 188, col: 19, offset: 5686},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 189, col: 19, offset: 5720},
+						pos: position{line: 188, col: 19, offset: 5686},
 						run: (*parser).callonLabelOperators2,
 						expr: &litMatcher{
-							pos:        position{line: 189, col: 19, offset: 5720},
+							pos:        position{line: 188, col: 19, offset: 5686},
 							val:        "!=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 191, col: 5, offset: 5756},
+						pos: position{line: 190, col: 5, offset: 5722},
 						run: (*parser).callonLabelOperators4,
 						expr: &litMatcher{
-							pos:        position{line: 191, col: 5, offset: 5756},
+							pos:        position{line: 190, col: 5, offset: 5722},
 							val:        "=~",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 193, col: 5, offset: 5794},
+						pos: position{line: 192, col: 5, offset: 5760},
 						run: (*parser).callonLabelOperators6,
 						expr: &litMatcher{
-							pos:        position{line: 193, col: 5, offset: 5794},
+							pos:        position{line: 192, col: 5, offset: 5760},
 							val:        "!~",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 195, col: 5, offset: 5834},
+						pos: position{line: 194, col: 5, offset: 5800},
 						run: (*parser).callonLabelOperators8,
 						expr: &litMatcher{
-							pos:        position{line: 195, col: 5, offset: 5834},
+							pos:        position{line: 194, col: 5, offset: 5800},
 							val:        "=",
 							ignoreCase: false,
 						},
@@ -1822,57 +1822,57 @@ var g = &grammar{
 		},
 		{
 			name: "Label",
-			pos:  position{line: 199, col: 1, offset: 5865},
+			pos:  position{line: 198, col: 1, offset: 5831},
 			expr: &ruleRefExpr{
-				pos:  position{line: 199, col: 9, offset: 5873},
+				pos:  position{line: 198, col: 9, offset: 5839},
 				name: "Identifier",
 			},
 		},
 		{
 			name: "LabelMatch",
-			pos:  position{line: 200, col: 1, offset: 5884},
+			pos:  position{line: 199, col: 1, offset: 5850},
 			expr: &actionExpr{
-				pos: position{line: 200, col: 14, offset: 5897},
+				pos: position{line: 199, col: 14, offset: 5863},
 				run: (*parser).callonLabelMatch1,
 				expr: &seqExpr{
-					pos: position{line: 200, col: 14, offset: 5897},
+					pos: position{line: 199, col: 14, offset: 5863},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 200, col: 14, offset: 5897},
+							pos:   position{line: 199, col: 14, offset: 5863},
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 20, offset: 5903},
+								pos:  position{line: 199, col: 20, offset: 5869},
 								name: "Label",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 26, offset: 5909},
+							pos:  position{line: 199, col: 26, offset: 5875},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 29, offset: 5912},
+							pos:   position{line: 199, col: 29, offset: 5878},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 32, offset: 5915},
+								pos:  position{line: 199, col: 32, offset: 5881},
 								name: "LabelOperators",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 47, offset: 5930},
+							pos:  position{line: 199, col: 47, offset: 5896},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 50, offset: 5933},
+							pos:   position{line: 199, col: 50, offset: 5899},
 							label: "match",
 							expr: &choiceExpr{
-								pos: position{line: 200, col: 58, offset: 5941},
+								pos: position{line: 199, col: 58, offset: 5907},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 200, col: 58, offset: 5941},
+										pos:  position{line: 199, col: 58, offset: 5907},
 										name: "StringLiteral",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 200, col: 74, offset: 5957},
+										pos:  position{line: 199, col: 74, offset: 5923},
 										name: "Number",
 									},
 								},
@@ -1884,32 +1884,32 @@ var g = &grammar{
 		},
 		{
 			name: "LabelMatches",
-			pos:  position{line: 203, col: 1, offset: 6047},
+			pos:  position{line: 202, col: 1, offset: 6013},
 			expr: &actionExpr{
-				pos: position{line: 203, col: 16, offset: 6062},
+				pos: position{line: 202, col: 16, offset: 6028},
 				run: (*parser).callonLabelMatches1,
 				expr: &seqExpr{
-					pos: position{line: 203, col: 16, offset: 6062},
+					pos: position{line: 202, col: 16, offset: 6028},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 203, col: 16, offset: 6062},
+							pos:   position{line: 202, col: 16, offset: 6028},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 203, col: 22, offset: 6068},
+								pos:  position{line: 202, col: 22, offset: 6034},
 								name: "LabelMatch",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 203, col: 33, offset: 6079},
+							pos:  position{line: 202, col: 33, offset: 6045},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 203, col: 36, offset: 6082},
+							pos:   position{line: 202, col: 36, offset: 6048},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 203, col: 41, offset: 6087},
+								pos: position{line: 202, col: 41, offset: 6053},
 								expr: &ruleRefExpr{
-									pos:  position{line: 203, col: 41, offset: 6087},
+									pos:  position{line: 202, col: 41, offset: 6053},
 									name: "LabelMatchesRest",
 								},
 							},
@@ -1920,27 +1920,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelMatchesRest",
-			pos:  position{line: 207, col: 1, offset: 6166},
+			pos:  position{line: 206, col: 1, offset: 6132},
 			expr: &actionExpr{
-				pos: position{line: 207, col: 21, offset: 6186},
+				pos: position{line: 206, col: 21, offset: 6152},
 				run: (*parser).callonLabelMatchesRest1,
 				expr: &seqExpr{
-					pos: position{line: 207, col: 21, offset: 6186},
+					pos: position{line: 206, col: 21, offset: 6152},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 207, col: 21, offset: 6186},
+							pos:        position{line: 206, col: 21, offset: 6152},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 207, col: 25, offset: 6190},
+							pos:  position{line: 206, col: 25, offset: 6156},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 207, col: 28, offset: 6193},
+							pos:   position{line: 206, col: 28, offset: 6159},
 							label: "match",
 							expr: &ruleRefExpr{
-								pos:  position{line: 207, col: 34, offset: 6199},
+								pos:  position{line: 206, col: 34, offset: 6165},
 								name: "LabelMatch",
 							},
 						},
@@ -1950,27 +1950,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelList",
-			pos:  position{line: 211, col: 1, offset: 6237},
+			pos:  position{line: 210, col: 1, offset: 6203},
 			expr: &choiceExpr{
-				pos: position{line: 211, col: 13, offset: 6249},
+				pos: position{line: 210, col: 13, offset: 6215},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 211, col: 13, offset: 6249},
+						pos: position{line: 210, col: 13, offset: 6215},
 						run: (*parser).callonLabelList2,
 						expr: &seqExpr{
-							pos: position{line: 211, col: 14, offset: 6250},
+							pos: position{line: 210, col: 14, offset: 6216},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 211, col: 14, offset: 6250},
+									pos:        position{line: 210, col: 14, offset: 6216},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 211, col: 18, offset: 6254},
+									pos:  position{line: 210, col: 18, offset: 6220},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 211, col: 21, offset: 6257},
+									pos:        position{line: 210, col: 21, offset: 6223},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1978,49 +1978,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 6, offset: 6289},
+						pos: position{line: 212, col: 6, offset: 6267},
 						run: (*parser).callonLabelList7,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 6, offset: 6289},
+							pos: position{line: 212, col: 6, offset: 6267},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 213, col: 6, offset: 6289},
+									pos:        position{line: 212, col: 6, offset: 6267},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 10, offset: 6293},
+									pos:  position{line: 212, col: 10, offset: 6271},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 13, offset: 6296},
+									pos:   position{line: 212, col: 13, offset: 6274},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 19, offset: 6302},
+										pos:  position{line: 212, col: 19, offset: 6280},
 										name: "Label",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 25, offset: 6308},
+									pos:  position{line: 212, col: 25, offset: 6286},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 28, offset: 6311},
+									pos:   position{line: 212, col: 28, offset: 6289},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 213, col: 33, offset: 6316},
+										pos: position{line: 212, col: 33, offset: 6294},
 										expr: &ruleRefExpr{
-											pos:  position{line: 213, col: 33, offset: 6316},
+											pos:  position{line: 212, col: 33, offset: 6294},
 											name: "LabelListRest",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 48, offset: 6331},
+									pos:  position{line: 212, col: 48, offset: 6309},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 213, col: 51, offset: 6334},
+									pos:        position{line: 212, col: 51, offset: 6312},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2032,27 +2032,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelListRest",
-			pos:  position{line: 217, col: 1, offset: 6400},
+			pos:  position{line: 216, col: 1, offset: 6378},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 18, offset: 6417},
+				pos: position{line: 216, col: 18, offset: 6395},
 				run: (*parser).callonLabelListRest1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 18, offset: 6417},
+					pos: position{line: 216, col: 18, offset: 6395},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 217, col: 18, offset: 6417},
+							pos:        position{line: 216, col: 18, offset: 6395},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 217, col: 22, offset: 6421},
+							pos:  position{line: 216, col: 22, offset: 6399},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 25, offset: 6424},
+							pos:   position{line: 216, col: 25, offset: 6402},
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 31, offset: 6430},
+								pos:  position{line: 216, col: 31, offset: 6408},
 								name: "Label",
 							},
 						},
@@ -2062,62 +2062,62 @@ var g = &grammar{
 		},
 		{
 			name: "VectorSelector",
-			pos:  position{line: 221, col: 1, offset: 6463},
+			pos:  position{line: 220, col: 1, offset: 6441},
 			expr: &actionExpr{
-				pos: position{line: 221, col: 18, offset: 6480},
+				pos: position{line: 220, col: 18, offset: 6458},
 				run: (*parser).callonVectorSelector1,
 				expr: &seqExpr{
-					pos: position{line: 221, col: 18, offset: 6480},
+					pos: position{line: 220, col: 18, offset: 6458},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 221, col: 18, offset: 6480},
+							pos:   position{line: 220, col: 18, offset: 6458},
 							label: "metric",
 							expr: &ruleRefExpr{
-								pos:  position{line: 221, col: 25, offset: 6487},
+								pos:  position{line: 220, col: 25, offset: 6465},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 221, col: 36, offset: 6498},
+							pos:  position{line: 220, col: 36, offset: 6476},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 40, offset: 6502},
+							pos:   position{line: 220, col: 40, offset: 6480},
 							label: "block",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 46, offset: 6508},
+								pos: position{line: 220, col: 46, offset: 6486},
 								expr: &ruleRefExpr{
-									pos:  position{line: 221, col: 46, offset: 6508},
+									pos:  position{line: 220, col: 46, offset: 6486},
 									name: "LabelBlock",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 221, col: 58, offset: 6520},
+							pos:  position{line: 220, col: 58, offset: 6498},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 61, offset: 6523},
+							pos:   position{line: 220, col: 61, offset: 6501},
 							label: "rng",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 65, offset: 6527},
+								pos: position{line: 220, col: 65, offset: 6505},
 								expr: &ruleRefExpr{
-									pos:  position{line: 221, col: 65, offset: 6527},
+									pos:  position{line: 220, col: 65, offset: 6505},
 									name: "Range",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 221, col: 72, offset: 6534},
+							pos:  position{line: 220, col: 72, offset: 6512},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 75, offset: 6537},
+							pos:   position{line: 220, col: 75, offset: 6515},
 							label: "offset",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 82, offset: 6544},
+								pos: position{line: 220, col: 82, offset: 6522},
 								expr: &ruleRefExpr{
-									pos:  position{line: 221, col: 82, offset: 6544},
+									pos:  position{line: 220, col: 82, offset: 6522},
 									name: "Offset",
 								},
 							},
@@ -2128,36 +2128,36 @@ var g = &grammar{
 		},
 		{
 			name: "Range",
-			pos:  position{line: 225, col: 1, offset: 6622},
+			pos:  position{line: 224, col: 1, offset: 6600},
 			expr: &actionExpr{
-				pos: position{line: 225, col: 9, offset: 6630},
+				pos: position{line: 224, col: 9, offset: 6608},
 				run: (*parser).callonRange1,
 				expr: &seqExpr{
-					pos: position{line: 225, col: 9, offset: 6630},
+					pos: position{line: 224, col: 9, offset: 6608},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 225, col: 9, offset: 6630},
+							pos:        position{line: 224, col: 9, offset: 6608},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 225, col: 13, offset: 6634},
+							pos:  position{line: 224, col: 13, offset: 6612},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 225, col: 16, offset: 6637},
+							pos:   position{line: 224, col: 16, offset: 6615},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 225, col: 20, offset: 6641},
+								pos:  position{line: 224, col: 20, offset: 6619},
 								name: "Duration",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 225, col: 29, offset: 6650},
+							pos:  position{line: 224, col: 29, offset: 6628},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 225, col: 32, offset: 6653},
+							pos:        position{line: 224, col: 32, offset: 6631},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2167,27 +2167,27 @@ var g = &grammar{
 		},
 		{
 			name: "Offset",
-			pos:  position{line: 229, col: 1, offset: 6682},
+			pos:  position{line: 228, col: 1, offset: 6660},
 			expr: &actionExpr{
-				pos: position{line: 229, col: 10, offset: 6691},
+				pos: position{line: 228, col: 10, offset: 6669},
 				run: (*parser).callonOffset1,
 				expr: &seqExpr{
-					pos: position{line: 229, col: 10, offset: 6691},
+					pos: position{line: 228, col: 10, offset: 6669},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 229, col: 10, offset: 6691},
+							pos:        position{line: 228, col: 10, offset: 6669},
 							val:        "offset",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 229, col: 20, offset: 6701},
+							pos:  position{line: 228, col: 20, offset: 6679},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 229, col: 23, offset: 6704},
+							pos:   position{line: 228, col: 23, offset: 6682},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 229, col: 27, offset: 6708},
+								pos:  position{line: 228, col: 27, offset: 6686},
 								name: "Duration",
 							},
 						},
@@ -2197,12 +2197,12 @@ var g = &grammar{
 		},
 		{
 			name: "CountValueOperator",
-			pos:  position{line: 233, col: 1, offset: 6742},
+			pos:  position{line: 232, col: 1, offset: 6720},
 			expr: &actionExpr{
-				pos: position{line: 233, col: 22, offset: 6763},
+				pos: position{line: 232, col: 22, offset: 6741},
 				run: (*parser).callonCountValueOperator1,
 				expr: &litMatcher{
-					pos:        position{line: 233, col: 22, offset: 6763},
+					pos:        position{line: 232, col: 22, offset: 6741},
 					val:        "count_values",
 					ignoreCase: true,
 				},
@@ -2210,28 +2210,28 @@ var g = &grammar{
 		},
 		{
 			name: "BinaryAggregateOperators",
-			pos:  position{line: 239, col: 1, offset: 6848},
+			pos:  position{line: 238, col: 1, offset: 6826},
 			expr: &actionExpr{
-				pos: position{line: 239, col: 29, offset: 6876},
+				pos: position{line: 238, col: 29, offset: 6854},
 				run: (*parser).callonBinaryAggregateOperators1,
 				expr: &labeledExpr{
-					pos:   position{line: 239, col: 29, offset: 6876},
+					pos:   position{line: 238, col: 29, offset: 6854},
 					label: "op",
 					expr: &choiceExpr{
-						pos: position{line: 239, col: 33, offset: 6880},
+						pos: position{line: 238, col: 33, offset: 6858},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 239, col: 33, offset: 6880},
+								pos:        position{line: 238, col: 33, offset: 6858},
 								val:        "topk",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 239, col: 43, offset: 6890},
+								pos:        position{line: 238, col: 43, offset: 6868},
 								val:        "bottomk",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 239, col: 56, offset: 6903},
+								pos:        position{line: 238, col: 56, offset: 6881},
 								val:        "quantile",
 								ignoreCase: true,
 							},
@@ -2242,48 +2242,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryAggregateOperators",
-			pos:  position{line: 245, col: 1, offset: 7005},
+			pos:  position{line: 244, col: 1, offset: 6983},
 			expr: &actionExpr{
-				pos: position{line: 245, col: 27, offset: 7031},
+				pos: position{line: 244, col: 27, offset: 7009},
 				run: (*parser).callonUnaryAggregateOperators1,
 				expr: &labeledExpr{
-					pos:   position{line: 245, col: 27, offset: 7031},
+					pos:   position{line: 244, col: 27, offset: 7009},
 					label: "op",
 					expr: &choiceExpr{
-						pos: position{line: 245, col: 31, offset: 7035},
+						pos: position{line: 244, col: 31, offset: 7013},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 245, col: 31, offset: 7035},
+								pos:        position{line: 244, col: 31, offset: 7013},
 								val:        "sum",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 40, offset: 7044},
+								pos:        position{line: 244, col: 40, offset: 7022},
 								val:        "min",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 49, offset: 7053},
+								pos:        position{line: 244, col: 49, offset: 7031},
 								val:        "max",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 58, offset: 7062},
+								pos:        position{line: 244, col: 58, offset: 7040},
 								val:        "avg",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 67, offset: 7071},
+								pos:        position{line: 244, col: 67, offset: 7049},
 								val:        "stddev",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 79, offset: 7083},
+								pos:        position{line: 244, col: 79, offset: 7061},
 								val:        "stdvar",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 91, offset: 7095},
+								pos:        position{line: 244, col: 91, offset: 7073},
 								val:        "count",
 								ignoreCase: true,
 							},
@@ -2294,20 +2294,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateOperators",
-			pos:  position{line: 251, col: 1, offset: 7194},
+			pos:  position{line: 250, col: 1, offset: 7172},
 			expr: &choiceExpr{
-				pos: position{line: 251, col: 22, offset: 7215},
+				pos: position{line: 250, col: 22, offset: 7193},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 22, offset: 7215},
+						pos:  position{line: 250, col: 22, offset: 7193},
 						name: "CountValueOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 43, offset: 7236},
+						pos:  position{line: 250, col: 43, offset: 7214},
 						name: "BinaryAggregateOperators",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 70, offset: 7263},
+						pos:  position{line: 250, col: 70, offset: 7241},
 						name: "UnaryAggregateOperators",
 					},
 				},
@@ -2315,41 +2315,41 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateBy",
-			pos:  position{line: 253, col: 1, offset: 7288},
+			pos:  position{line: 252, col: 1, offset: 7266},
 			expr: &actionExpr{
-				pos: position{line: 253, col: 15, offset: 7302},
+				pos: position{line: 252, col: 15, offset: 7280},
 				run: (*parser).callonAggregateBy1,
 				expr: &seqExpr{
-					pos: position{line: 253, col: 15, offset: 7302},
+					pos: position{line: 252, col: 15, offset: 7280},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 253, col: 15, offset: 7302},
+							pos:        position{line: 252, col: 15, offset: 7280},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 253, col: 21, offset: 7308},
+							pos:  position{line: 252, col: 21, offset: 7286},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 253, col: 24, offset: 7311},
+							pos:   position{line: 252, col: 24, offset: 7289},
 							label: "labels",
 							expr: &ruleRefExpr{
-								pos:  position{line: 253, col: 31, offset: 7318},
+								pos:  position{line: 252, col: 31, offset: 7296},
 								name: "LabelList",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 253, col: 41, offset: 7328},
+							pos:  position{line: 252, col: 41, offset: 7306},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 253, col: 44, offset: 7331},
+							pos:   position{line: 252, col: 44, offset: 7309},
 							label: "keep",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 253, col: 49, offset: 7336},
+								pos: position{line: 252, col: 49, offset: 7314},
 								expr: &litMatcher{
-									pos:        position{line: 253, col: 49, offset: 7336},
+									pos:        position{line: 252, col: 49, offset: 7314},
 									val:        "keep_common",
 									ignoreCase: true,
 								},
@@ -2361,27 +2361,27 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateWithout",
-			pos:  position{line: 260, col: 1, offset: 7449},
+			pos:  position{line: 259, col: 1, offset: 7427},
 			expr: &actionExpr{
-				pos: position{line: 260, col: 20, offset: 7468},
+				pos: position{line: 259, col: 20, offset: 7446},
 				run: (*parser).callonAggregateWithout1,
 				expr: &seqExpr{
-					pos: position{line: 260, col: 20, offset: 7468},
+					pos: position{line: 259, col: 20, offset: 7446},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 260, col: 20, offset: 7468},
+							pos:        position{line: 259, col: 20, offset: 7446},
 							val:        "without",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 260, col: 31, offset: 7479},
+							pos:  position{line: 259, col: 31, offset: 7457},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 260, col: 34, offset: 7482},
+							pos:   position{line: 259, col: 34, offset: 7460},
 							label: "labels",
 							expr: &ruleRefExpr{
-								pos:  position{line: 260, col: 41, offset: 7489},
+								pos:  position{line: 259, col: 41, offset: 7467},
 								name: "LabelList",
 							},
 						},
@@ -2391,16 +2391,16 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateGroup",
-			pos:  position{line: 267, col: 1, offset: 7601},
+			pos:  position{line: 266, col: 1, offset: 7579},
 			expr: &choiceExpr{
-				pos: position{line: 267, col: 18, offset: 7618},
+				pos: position{line: 266, col: 18, offset: 7596},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 267, col: 18, offset: 7618},
+						pos:  position{line: 266, col: 18, offset: 7596},
 						name: "AggregateBy",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 267, col: 32, offset: 7632},
+						pos:  position{line: 266, col: 32, offset: 7610},
 						name: "AggregateWithout",
 					},
 				},
@@ -2408,86 +2408,86 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateExpression",
-			pos:  position{line: 269, col: 1, offset: 7650},
+			pos:  position{line: 268, col: 1, offset: 7628},
 			expr: &choiceExpr{
-				pos: position{line: 270, col: 1, offset: 7672},
+				pos: position{line: 269, col: 1, offset: 7650},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 270, col: 1, offset: 7672},
+						pos: position{line: 269, col: 1, offset: 7650},
 						run: (*parser).callonAggregateExpression2,
 						expr: &seqExpr{
-							pos: position{line: 270, col: 1, offset: 7672},
+							pos: position{line: 269, col: 1, offset: 7650},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 270, col: 1, offset: 7672},
+									pos:   position{line: 269, col: 1, offset: 7650},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 270, col: 4, offset: 7675},
+										pos:  position{line: 269, col: 4, offset: 7653},
 										name: "CountValueOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 24, offset: 7695},
+									pos:  position{line: 269, col: 24, offset: 7673},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 270, col: 27, offset: 7698},
+									pos:        position{line: 269, col: 27, offset: 7676},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 31, offset: 7702},
+									pos:  position{line: 269, col: 31, offset: 7680},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 270, col: 34, offset: 7705},
+									pos:   position{line: 269, col: 34, offset: 7683},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 270, col: 40, offset: 7711},
+										pos:  position{line: 269, col: 40, offset: 7689},
 										name: "StringLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 54, offset: 7725},
+									pos:  position{line: 269, col: 54, offset: 7703},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 270, col: 57, offset: 7728},
+									pos:        position{line: 269, col: 57, offset: 7706},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 61, offset: 7732},
+									pos:  position{line: 269, col: 61, offset: 7710},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 270, col: 64, offset: 7735},
+									pos:   position{line: 269, col: 64, offset: 7713},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 270, col: 71, offset: 7742},
-										name: "VectorSelector",
+										pos:  position{line: 269, col: 71, offset: 7720},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 86, offset: 7757},
+									pos:  position{line: 269, col: 82, offset: 7731},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 270, col: 89, offset: 7760},
+									pos:        position{line: 269, col: 85, offset: 7734},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 93, offset: 7764},
+									pos:  position{line: 269, col: 89, offset: 7738},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 270, col: 96, offset: 7767},
+									pos:   position{line: 269, col: 92, offset: 7741},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 270, col: 102, offset: 7773},
+										pos: position{line: 269, col: 98, offset: 7747},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 102, offset: 7773},
+											pos:  position{line: 269, col: 98, offset: 7747},
 											name: "AggregateGroup",
 										},
 									},
@@ -2496,82 +2496,82 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 276, col: 1, offset: 7921},
+						pos: position{line: 275, col: 1, offset: 7890},
 						run: (*parser).callonAggregateExpression22,
 						expr: &seqExpr{
-							pos: position{line: 276, col: 1, offset: 7921},
+							pos: position{line: 275, col: 1, offset: 7890},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 276, col: 1, offset: 7921},
+									pos:   position{line: 275, col: 1, offset: 7890},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 4, offset: 7924},
+										pos:  position{line: 275, col: 4, offset: 7893},
 										name: "CountValueOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 24, offset: 7944},
+									pos:  position{line: 275, col: 24, offset: 7913},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 27, offset: 7947},
+									pos:   position{line: 275, col: 27, offset: 7916},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 276, col: 33, offset: 7953},
+										pos: position{line: 275, col: 33, offset: 7922},
 										expr: &ruleRefExpr{
-											pos:  position{line: 276, col: 33, offset: 7953},
+											pos:  position{line: 275, col: 33, offset: 7922},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 49, offset: 7969},
+									pos:  position{line: 275, col: 49, offset: 7938},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 276, col: 52, offset: 7972},
+									pos:        position{line: 275, col: 52, offset: 7941},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 56, offset: 7976},
+									pos:  position{line: 275, col: 56, offset: 7945},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 59, offset: 7979},
+									pos:   position{line: 275, col: 59, offset: 7948},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 65, offset: 7985},
+										pos:  position{line: 275, col: 65, offset: 7954},
 										name: "StringLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 79, offset: 7999},
+									pos:  position{line: 275, col: 79, offset: 7968},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 276, col: 82, offset: 8002},
+									pos:        position{line: 275, col: 82, offset: 7971},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 86, offset: 8006},
+									pos:  position{line: 275, col: 86, offset: 7975},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 89, offset: 8009},
+									pos:   position{line: 275, col: 89, offset: 7978},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 96, offset: 8016},
-										name: "VectorSelector",
+										pos:  position{line: 275, col: 96, offset: 7985},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 111, offset: 8031},
+									pos:  position{line: 275, col: 107, offset: 7996},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 276, col: 114, offset: 8034},
+									pos:        position{line: 275, col: 110, offset: 7999},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2579,81 +2579,81 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 282, col: 1, offset: 8170},
+						pos: position{line: 281, col: 1, offset: 8130},
 						run: (*parser).callonAggregateExpression42,
 						expr: &seqExpr{
-							pos: position{line: 282, col: 1, offset: 8170},
+							pos: position{line: 281, col: 1, offset: 8130},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 282, col: 1, offset: 8170},
+									pos:   position{line: 281, col: 1, offset: 8130},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 4, offset: 8173},
+										pos:  position{line: 281, col: 4, offset: 8133},
 										name: "BinaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 30, offset: 8199},
+									pos:  position{line: 281, col: 30, offset: 8159},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 282, col: 33, offset: 8202},
+									pos:        position{line: 281, col: 33, offset: 8162},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 37, offset: 8206},
+									pos:  position{line: 281, col: 37, offset: 8166},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 41, offset: 8210},
+									pos:   position{line: 281, col: 41, offset: 8170},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 47, offset: 8216},
+										pos:  position{line: 281, col: 47, offset: 8176},
 										name: "Number",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 54, offset: 8223},
+									pos:  position{line: 281, col: 54, offset: 8183},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 282, col: 57, offset: 8226},
+									pos:        position{line: 281, col: 57, offset: 8186},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 61, offset: 8230},
+									pos:  position{line: 281, col: 61, offset: 8190},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 64, offset: 8233},
+									pos:   position{line: 281, col: 64, offset: 8193},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 71, offset: 8240},
-										name: "VectorSelector",
+										pos:  position{line: 281, col: 71, offset: 8200},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 86, offset: 8255},
+									pos:  position{line: 281, col: 82, offset: 8211},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 282, col: 89, offset: 8258},
+									pos:        position{line: 281, col: 85, offset: 8214},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 93, offset: 8262},
+									pos:  position{line: 281, col: 89, offset: 8218},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 96, offset: 8265},
+									pos:   position{line: 281, col: 92, offset: 8221},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 282, col: 102, offset: 8271},
+										pos: position{line: 281, col: 98, offset: 8227},
 										expr: &ruleRefExpr{
-											pos:  position{line: 282, col: 102, offset: 8271},
+											pos:  position{line: 281, col: 98, offset: 8227},
 											name: "AggregateGroup",
 										},
 									},
@@ -2662,82 +2662,82 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 288, col: 1, offset: 8412},
+						pos: position{line: 287, col: 1, offset: 8363},
 						run: (*parser).callonAggregateExpression62,
 						expr: &seqExpr{
-							pos: position{line: 288, col: 1, offset: 8412},
+							pos: position{line: 287, col: 1, offset: 8363},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 288, col: 1, offset: 8412},
+									pos:   position{line: 287, col: 1, offset: 8363},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 288, col: 4, offset: 8415},
+										pos:  position{line: 287, col: 4, offset: 8366},
 										name: "BinaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 30, offset: 8441},
+									pos:  position{line: 287, col: 30, offset: 8392},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 288, col: 33, offset: 8444},
+									pos:   position{line: 287, col: 33, offset: 8395},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 288, col: 39, offset: 8450},
+										pos: position{line: 287, col: 39, offset: 8401},
 										expr: &ruleRefExpr{
-											pos:  position{line: 288, col: 39, offset: 8450},
+											pos:  position{line: 287, col: 39, offset: 8401},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 55, offset: 8466},
+									pos:  position{line: 287, col: 55, offset: 8417},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 288, col: 58, offset: 8469},
+									pos:        position{line: 287, col: 58, offset: 8420},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 62, offset: 8473},
+									pos:  position{line: 287, col: 62, offset: 8424},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 288, col: 66, offset: 8477},
+									pos:   position{line: 287, col: 66, offset: 8428},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 288, col: 72, offset: 8483},
+										pos:  position{line: 287, col: 72, offset: 8434},
 										name: "Number",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 79, offset: 8490},
+									pos:  position{line: 287, col: 79, offset: 8441},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 288, col: 82, offset: 8493},
+									pos:        position{line: 287, col: 82, offset: 8444},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 86, offset: 8497},
+									pos:  position{line: 287, col: 86, offset: 8448},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 288, col: 89, offset: 8500},
+									pos:   position{line: 287, col: 89, offset: 8451},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 288, col: 96, offset: 8507},
-										name: "VectorSelector",
+										pos:  position{line: 287, col: 96, offset: 8458},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 111, offset: 8522},
+									pos:  position{line: 287, col: 107, offset: 8469},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 288, col: 114, offset: 8525},
+									pos:        position{line: 287, col: 110, offset: 8472},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2745,60 +2745,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 294, col: 1, offset: 8654},
+						pos: position{line: 293, col: 1, offset: 8596},
 						run: (*parser).callonAggregateExpression82,
 						expr: &seqExpr{
-							pos: position{line: 294, col: 1, offset: 8654},
+							pos: position{line: 293, col: 1, offset: 8596},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 294, col: 1, offset: 8654},
+									pos:   position{line: 293, col: 1, offset: 8596},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 4, offset: 8657},
+										pos:  position{line: 293, col: 4, offset: 8599},
 										name: "UnaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 29, offset: 8682},
+									pos:  position{line: 293, col: 29, offset: 8624},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 294, col: 32, offset: 8685},
+									pos:        position{line: 293, col: 32, offset: 8627},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 36, offset: 8689},
+									pos:  position{line: 293, col: 36, offset: 8631},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 294, col: 39, offset: 8692},
+									pos:   position{line: 293, col: 39, offset: 8634},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 46, offset: 8699},
-										name: "VectorSelector",
+										pos:  position{line: 293, col: 46, offset: 8641},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 61, offset: 8714},
+									pos:  position{line: 293, col: 57, offset: 8652},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 294, col: 64, offset: 8717},
+									pos:        position{line: 293, col: 60, offset: 8655},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 68, offset: 8721},
+									pos:  position{line: 293, col: 64, offset: 8659},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 294, col: 71, offset: 8724},
+									pos:   position{line: 293, col: 67, offset: 8662},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 294, col: 77, offset: 8730},
+										pos: position{line: 293, col: 73, offset: 8668},
 										expr: &ruleRefExpr{
-											pos:  position{line: 294, col: 77, offset: 8730},
+											pos:  position{line: 293, col: 73, offset: 8668},
 											name: "AggregateGroup",
 										},
 									},
@@ -2807,61 +2807,61 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 298, col: 1, offset: 8823},
+						pos: position{line: 297, col: 1, offset: 8756},
 						run: (*parser).callonAggregateExpression97,
 						expr: &seqExpr{
-							pos: position{line: 298, col: 1, offset: 8823},
+							pos: position{line: 297, col: 1, offset: 8756},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 298, col: 1, offset: 8823},
+									pos:   position{line: 297, col: 1, offset: 8756},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 4, offset: 8826},
+										pos:  position{line: 297, col: 4, offset: 8759},
 										name: "UnaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 29, offset: 8851},
+									pos:  position{line: 297, col: 29, offset: 8784},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 298, col: 32, offset: 8854},
+									pos:   position{line: 297, col: 32, offset: 8787},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 298, col: 38, offset: 8860},
+										pos: position{line: 297, col: 38, offset: 8793},
 										expr: &ruleRefExpr{
-											pos:  position{line: 298, col: 38, offset: 8860},
+											pos:  position{line: 297, col: 38, offset: 8793},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 54, offset: 8876},
+									pos:  position{line: 297, col: 54, offset: 8809},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 298, col: 57, offset: 8879},
+									pos:        position{line: 297, col: 57, offset: 8812},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 61, offset: 8883},
+									pos:  position{line: 297, col: 61, offset: 8816},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 298, col: 64, offset: 8886},
+									pos:   position{line: 297, col: 64, offset: 8819},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 71, offset: 8893},
-										name: "VectorSelector",
+										pos:  position{line: 297, col: 71, offset: 8826},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 86, offset: 8908},
+									pos:  position{line: 297, col: 82, offset: 8837},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 298, col: 89, offset: 8911},
+									pos:        position{line: 297, col: 85, offset: 8840},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2872,110 +2872,370 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "__",
-			pos:  position{line: 302, col: 1, offset: 8991},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 302, col: 6, offset: 8996},
-				expr: &choiceExpr{
-					pos: position{line: 302, col: 8, offset: 8998},
-					alternatives: []interface{}{
+			name: "FunctionCall",
+			pos:  position{line: 301, col: 1, offset: 8915},
+			expr: &actionExpr{
+				pos: position{line: 301, col: 16, offset: 8930},
+				run: (*parser).callonFunctionCall1,
+				expr: &seqExpr{
+					pos: position{line: 301, col: 16, offset: 8930},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 301, col: 16, offset: 8930},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 301, col: 21, offset: 8935},
+								name: "IdentifierName",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 8, offset: 8998},
-							name: "Whitespace",
+							pos:  position{line: 301, col: 36, offset: 8950},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 301, col: 39, offset: 8953},
+							val:        "(",
+							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 21, offset: 9011},
-							name: "EOL",
+							pos:  position{line: 301, col: 43, offset: 8957},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 301, col: 46, offset: 8960},
+							label: "args",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 301, col: 51, offset: 8965},
+								expr: &ruleRefExpr{
+									pos:  position{line: 301, col: 51, offset: 8965},
+									name: "FunctionArgs",
+								},
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 27, offset: 9017},
-							name: "Comment",
+							pos:  position{line: 301, col: 65, offset: 8979},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 301, col: 68, offset: 8982},
+							val:        ")",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "_",
-			pos:  position{line: 303, col: 1, offset: 9028},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 303, col: 5, offset: 9032},
-				expr: &ruleRefExpr{
-					pos:  position{line: 303, col: 5, offset: 9032},
-					name: "Whitespace",
+			name: "FunctionArgs",
+			pos:  position{line: 305, col: 1, offset: 9031},
+			expr: &actionExpr{
+				pos: position{line: 305, col: 16, offset: 9046},
+				run: (*parser).callonFunctionArgs1,
+				expr: &seqExpr{
+					pos: position{line: 305, col: 16, offset: 9046},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 305, col: 16, offset: 9046},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 305, col: 22, offset: 9052},
+								name: "Expression",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 305, col: 33, offset: 9063},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 305, col: 36, offset: 9066},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 305, col: 41, offset: 9071},
+								expr: &ruleRefExpr{
+									pos:  position{line: 305, col: 41, offset: 9071},
+									name: "FunctionArgsRest",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "Whitespace",
-			pos:  position{line: 305, col: 1, offset: 9045},
-			expr: &charClassMatcher{
-				pos:        position{line: 305, col: 14, offset: 9058},
-				val:        "[ \\t\\r]",
-				chars:      []rune{' ', '\t', '\r'},
-				ignoreCase: false,
-				inverted:   false,
+			name: "FunctionArgsRest",
+			pos:  position{line: 309, col: 1, offset: 9137},
+			expr: &actionExpr{
+				pos: position{line: 309, col: 20, offset: 9156},
+				run: (*parser).callonFunctionArgsRest1,
+				expr: &seqExpr{
+					pos: position{line: 309, col: 20, offset: 9156},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 309, col: 20, offset: 9156},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 309, col: 24, offset: 9160},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 309, col: 27, offset: 9163},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 309, col: 31, offset: 9167},
+								name: "Expression",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 309, col: 42, offset: 9178},
+							name: "__",
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "EOL",
-			pos:  position{line: 306, col: 1, offset: 9066},
-			expr: &litMatcher{
-				pos:        position{line: 306, col: 7, offset: 9072},
-				val:        "\n",
-				ignoreCase: false,
+			name: "ParenExpression",
+			pos:  position{line: 313, col: 1, offset: 9206},
+			expr: &actionExpr{
+				pos: position{line: 313, col: 19, offset: 9224},
+				run: (*parser).callonParenExpression1,
+				expr: &seqExpr{
+					pos: position{line: 313, col: 19, offset: 9224},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 313, col: 19, offset: 9224},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 313, col: 23, offset: 9228},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 313, col: 26, offset: 9231},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 313, col: 31, offset: 9236},
+								name: "Expression",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 313, col: 42, offset: 9247},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 313, col: 45, offset: 9250},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "EOS",
-			pos:  position{line: 307, col: 1, offset: 9077},
+			name: "PrimaryExpression",
+			pos:  position{line: 317, col: 1, offset: 9280},
 			expr: &choiceExpr{
-				pos: position{line: 307, col: 7, offset: 9083},
+				pos: position{line: 317, col: 21, offset: 9300},
 				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 307, col: 7, offset: 9083},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 7, offset: 9083},
-								name: "__",
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 21, offset: 9300},
+						name: "ParenExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 39, offset: 9318},
+						name: "AggregateExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 61, offset: 9340},
+						name: "FunctionCall",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 76, offset: 9355},
+						name: "Number",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 85, offset: 9364},
+						name: "StringLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 317, col: 101, offset: 9380},
+						name: "VectorSelector",
+					},
+				},
+			},
+		},
+		{
+			name: "BoolModifier",
+			pos:  position{line: 319, col: 1, offset: 9396},
+			expr: &actionExpr{
+				pos: position{line: 319, col: 16, offset: 9411},
+				run: (*parser).callonBoolModifier1,
+				expr: &seqExpr{
+					pos: position{line: 319, col: 16, offset: 9411},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 319, col: 16, offset: 9411},
+							val:        "bool",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 319, col: 24, offset: 9419},
+							expr: &ruleRefExpr{
+								pos:  position{line: 319, col: 25, offset: 9420},
+								name: "IdentifierPart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VectorMatching",
+			pos:  position{line: 323, col: 1, offset: 9461},
+			expr: &actionExpr{
+				pos: position{line: 323, col: 18, offset: 9478},
+				run: (*parser).callonVectorMatching1,
+				expr: &seqExpr{
+					pos: position{line: 323, col: 18, offset: 9478},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 323, col: 18, offset: 9478},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 323, col: 25, offset: 9485},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 323, col: 25, offset: 9485},
+										val:        "on",
+										ignoreCase: true,
+									},
+									&litMatcher{
+										pos:        position{line: 323, col: 33, offset: 9493},
+										val:        "ignoring",
+										ignoreCase: true,
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 323, col: 47, offset: 9507},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 323, col: 50, offset: 9510},
+							label: "labels",
+							expr: &ruleRefExpr{
+								pos:  position{line: 323, col: 57, offset: 9517},
+								name: "LabelList",
 							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonOperators",
+			pos:  position{line: 327, col: 1, offset: 9608},
+			expr: &actionExpr{
+				pos: position{line: 327, col: 23, offset: 9630},
+				run: (*parser).callonComparisonOperators1,
+				expr: &labeledExpr{
+					pos:   position{line: 327, col: 23, offset: 9630},
+					label: "op",
+					expr: &choiceExpr{
+						pos: position{line: 327, col: 28, offset: 9635},
+						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 307, col: 10, offset: 9086},
-								val:        ";",
+								pos:        position{line: 327, col: 28, offset: 9635},
+								val:        "==",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 327, col: 35, offset: 9642},
+								val:        "!=",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 327, col: 42, offset: 9649},
+								val:        ">=",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 327, col: 49, offset: 9656},
+								val:        ">",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 327, col: 55, offset: 9662},
+								val:        "<=",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 327, col: 62, offset: 9669},
+								val:        "<",
 								ignoreCase: false,
 							},
 						},
 					},
-					&seqExpr{
-						pos: position{line: 307, col: 16, offset: 9092},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 16, offset: 9092},
-								name: "_",
-							},
-							&zeroOrOneExpr{
-								pos: position{line: 307, col: 18, offset: 9094},
-								expr: &ruleRefExpr{
-									pos:  position{line: 307, col: 18, offset: 9094},
-									name: "SingleLineComment",
-								},
+				},
+			},
+		},
+		{
+			name: "AdditiveOperators",
+			pos:  position{line: 331, col: 1, offset: 9738},
+			expr: &actionExpr{
+				pos: position{line: 331, col: 21, offset: 9758},
+				run: (*parser).callonAdditiveOperators1,
+				expr: &labeledExpr{
+					pos:   position{line: 331, col: 21, offset: 9758},
+					label: "op",
+					expr: &choiceExpr{
+						pos: position{line: 331, col: 26, offset: 9763},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 331, col: 26, offset: 9763},
+								val:        "+",
+								ignoreCase: false,
 							},
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 37, offset: 9113},
-								name: "EOL",
+							&litMatcher{
+								pos:        position{line: 331, col: 32, offset: 9769},
+								val:        "-",
+								ignoreCase: false,
 							},
 						},
 					},
-					&seqExpr{
-						pos: position{line: 307, col: 43, offset: 9119},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 43, offset: 9119},
-								name: "__",
+				},
+			},
+		},
+		{
+			name: "MultiplicativeOperators",
+			pos:  position{line: 335, col: 1, offset: 9838},
+			expr: &actionExpr{
+				pos: position{line: 335, col: 27, offset: 9864},
+				run: (*parser).callonMultiplicativeOperators1,
+				expr: &labeledExpr{
+					pos:   position{line: 335, col: 27, offset: 9864},
+					label: "op",
+					expr: &choiceExpr{
+						pos: position{line: 335, col: 32, offset: 9869},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 335, col: 32, offset: 9869},
+								val:        "*",
+								ignoreCase: false,
 							},
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 46, offset: 9122},
-								name: "EOF",
+							&litMatcher{
+								pos:        position{line: 335, col: 38, offset: 9875},
+								val:        "/",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 335, col: 44, offset: 9881},
+								val:        "%",
+								ignoreCase: false,
 							},
 						},
 					},
@@ -2983,28 +3243,511 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EOF",
-			pos:  position{line: 309, col: 1, offset: 9127},
-			expr: &notExpr{
-				pos: position{line: 309, col: 7, offset: 9133},
-				expr: &anyMatcher{
-					line: 309, col: 8, offset: 9134,
+			name: "PowerOperator",
+			pos:  position{line: 339, col: 1, offset: 9950},
+			expr: &actionExpr{
+				pos: position{line: 339, col: 17, offset: 9966},
+				run: (*parser).callonPowerOperator1,
+				expr: &litMatcher{
+					pos:        position{line: 339, col: 17, offset: 9966},
+					val:        "^",
+					ignoreCase: false,
 				},
 			},
 		},
-	},
-}
-
-func (c *current) onGrammar1(grammar interface{}) (interface{}, error) {
-	return grammar, nil
-}
-
-func (p *parser) callonGrammar1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onGrammar1(stack["grammar"])
-}
-
+		{
+			name: "Expression",
+			pos:  position{line: 346, col: 1, offset: 10225},
+			expr: &ruleRefExpr{
+				pos:  position{line: 346, col: 14, offset: 10238},
+				name: "ComparisonExpression",
+			},
+		},
+		{
+			name: "ComparisonExpression",
+			pos:  position{line: 348, col: 1, offset: 10260},
+			expr: &actionExpr{
+				pos: position{line: 348, col: 24, offset: 10283},
+				run: (*parser).callonComparisonExpression1,
+				expr: &seqExpr{
+					pos: position{line: 348, col: 24, offset: 10283},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 348, col: 24, offset: 10283},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 348, col: 30, offset: 10289},
+								name: "AdditiveExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 348, col: 49, offset: 10308},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 348, col: 54, offset: 10313},
+								expr: &ruleRefExpr{
+									pos:  position{line: 348, col: 54, offset: 10313},
+									name: "ComparisonRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonRest",
+			pos:  position{line: 352, col: 1, offset: 10382},
+			expr: &actionExpr{
+				pos: position{line: 352, col: 18, offset: 10399},
+				run: (*parser).callonComparisonRest1,
+				expr: &seqExpr{
+					pos: position{line: 352, col: 18, offset: 10399},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 352, col: 18, offset: 10399},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 352, col: 21, offset: 10402},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 352, col: 24, offset: 10405},
+								name: "ComparisonOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 352, col: 44, offset: 10425},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 352, col: 47, offset: 10428},
+							label: "returnBool",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 352, col: 58, offset: 10439},
+								expr: &ruleRefExpr{
+									pos:  position{line: 352, col: 58, offset: 10439},
+									name: "BoolModifier",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 352, col: 72, offset: 10453},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 352, col: 75, offset: 10456},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 352, col: 84, offset: 10465},
+								expr: &ruleRefExpr{
+									pos:  position{line: 352, col: 84, offset: 10465},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 352, col: 100, offset: 10481},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 352, col: 103, offset: 10484},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 352, col: 107, offset: 10488},
+								name: "AdditiveExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveExpression",
+			pos:  position{line: 356, col: 1, offset: 10596},
+			expr: &actionExpr{
+				pos: position{line: 356, col: 22, offset: 10617},
+				run: (*parser).callonAdditiveExpression1,
+				expr: &seqExpr{
+					pos: position{line: 356, col: 22, offset: 10617},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 356, col: 22, offset: 10617},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 356, col: 28, offset: 10623},
+								name: "MultiplicativeExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 356, col: 53, offset: 10648},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 356, col: 58, offset: 10653},
+								expr: &ruleRefExpr{
+									pos:  position{line: 356, col: 58, offset: 10653},
+									name: "AdditiveRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveRest",
+			pos:  position{line: 360, col: 1, offset: 10720},
+			expr: &actionExpr{
+				pos: position{line: 360, col: 16, offset: 10735},
+				run: (*parser).callonAdditiveRest1,
+				expr: &seqExpr{
+					pos: position{line: 360, col: 16, offset: 10735},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 360, col: 16, offset: 10735},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 360, col: 19, offset: 10738},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 360, col: 22, offset: 10741},
+								name: "AdditiveOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 360, col: 40, offset: 10759},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 360, col: 43, offset: 10762},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 360, col: 52, offset: 10771},
+								expr: &ruleRefExpr{
+									pos:  position{line: 360, col: 52, offset: 10771},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 360, col: 68, offset: 10787},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 360, col: 71, offset: 10790},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 360, col: 75, offset: 10794},
+								name: "MultiplicativeExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeExpression",
+			pos:  position{line: 364, col: 1, offset: 10901},
+			expr: &actionExpr{
+				pos: position{line: 364, col: 28, offset: 10928},
+				run: (*parser).callonMultiplicativeExpression1,
+				expr: &seqExpr{
+					pos: position{line: 364, col: 28, offset: 10928},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 364, col: 28, offset: 10928},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 364, col: 34, offset: 10934},
+								name: "PowerExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 364, col: 50, offset: 10950},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 364, col: 55, offset: 10955},
+								expr: &ruleRefExpr{
+									pos:  position{line: 364, col: 55, offset: 10955},
+									name: "MultiplicativeRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeRest",
+			pos:  position{line: 368, col: 1, offset: 11028},
+			expr: &actionExpr{
+				pos: position{line: 368, col: 22, offset: 11049},
+				run: (*parser).callonMultiplicativeRest1,
+				expr: &seqExpr{
+					pos: position{line: 368, col: 22, offset: 11049},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 368, col: 22, offset: 11049},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 368, col: 25, offset: 11052},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 368, col: 28, offset: 11055},
+								name: "MultiplicativeOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 368, col: 52, offset: 11079},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 368, col: 55, offset: 11082},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 368, col: 64, offset: 11091},
+								expr: &ruleRefExpr{
+									pos:  position{line: 368, col: 64, offset: 11091},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 368, col: 80, offset: 11107},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 368, col: 83, offset: 11110},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 368, col: 87, offset: 11114},
+								name: "PowerExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PowerExpression",
+			pos:  position{line: 372, col: 1, offset: 11212},
+			expr: &actionExpr{
+				pos: position{line: 372, col: 19, offset: 11230},
+				run: (*parser).callonPowerExpression1,
+				expr: &seqExpr{
+					pos: position{line: 372, col: 19, offset: 11230},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 372, col: 19, offset: 11230},
+							label: "lhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 372, col: 23, offset: 11234},
+								name: "PrimaryExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 41, offset: 11252},
+							label: "rest",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 372, col: 46, offset: 11257},
+								expr: &ruleRefExpr{
+									pos:  position{line: 372, col: 46, offset: 11257},
+									name: "PowerRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PowerRest",
+			pos:  position{line: 379, col: 1, offset: 11385},
+			expr: &actionExpr{
+				pos: position{line: 379, col: 13, offset: 11397},
+				run: (*parser).callonPowerRest1,
+				expr: &seqExpr{
+					pos: position{line: 379, col: 13, offset: 11397},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 379, col: 13, offset: 11397},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 379, col: 16, offset: 11400},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 379, col: 19, offset: 11403},
+								name: "PowerOperator",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 379, col: 33, offset: 11417},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 379, col: 36, offset: 11420},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 379, col: 45, offset: 11429},
+								expr: &ruleRefExpr{
+									pos:  position{line: 379, col: 45, offset: 11429},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 379, col: 61, offset: 11445},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 379, col: 64, offset: 11448},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 379, col: 68, offset: 11452},
+								name: "PowerExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "__",
+			pos:  position{line: 383, col: 1, offset: 11550},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 383, col: 6, offset: 11555},
+				expr: &choiceExpr{
+					pos: position{line: 383, col: 8, offset: 11557},
+					alternatives: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 383, col: 8, offset: 11557},
+							name: "Whitespace",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 383, col: 21, offset: 11570},
+							name: "EOL",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 383, col: 27, offset: 11576},
+							name: "Comment",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "_",
+			pos:  position{line: 384, col: 1, offset: 11587},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 384, col: 5, offset: 11591},
+				expr: &ruleRefExpr{
+					pos:  position{line: 384, col: 5, offset: 11591},
+					name: "Whitespace",
+				},
+			},
+		},
+		{
+			name: "Whitespace",
+			pos:  position{line: 386, col: 1, offset: 11604},
+			expr: &charClassMatcher{
+				pos:        position{line: 386, col: 14, offset: 11617},
+				val:        "[ \\t\\r]",
+				chars:      []rune{' ', '\t', '\r'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "EOL",
+			pos:  position{line: 387, col: 1, offset: 11625},
+			expr: &litMatcher{
+				pos:        position{line: 387, col: 7, offset: 11631},
+				val:        "\n",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "EOS",
+			pos:  position{line: 388, col: 1, offset: 11636},
+			expr: &choiceExpr{
+				pos: position{line: 388, col: 7, offset: 11642},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 388, col: 7, offset: 11642},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 388, col: 7, offset: 11642},
+								name: "__",
+							},
+							&litMatcher{
+								pos:        position{line: 388, col: 10, offset: 11645},
+								val:        ";",
+								ignoreCase: false,
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 388, col: 16, offset: 11651},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 388, col: 16, offset: 11651},
+								name: "_",
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 388, col: 18, offset: 11653},
+								expr: &ruleRefExpr{
+									pos:  position{line: 388, col: 18, offset: 11653},
+									name: "SingleLineComment",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 388, col: 37, offset: 11672},
+								name: "EOL",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 388, col: 43, offset: 11678},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 388, col: 43, offset: 11678},
+								name: "__",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 388, col: 46, offset: 11681},
+								name: "EOF",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 390, col: 1, offset: 11686},
+			expr: &notExpr{
+				pos: position{line: 390, col: 7, offset: 11692},
+				expr: &anyMatcher{
+					line: 390, col: 8, offset: 11693,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onGrammar1(grammar interface{}) (interface{}, error) {
+	return grammar, nil
+}
+
+func (p *parser) callonGrammar1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGrammar1(stack["grammar"])
+}
+
 func (c *current) onComment1() (interface{}, error) {
 	return &Comment{string(c.text)}, nil
 }
@@ -3431,7 +4174,7 @@ func (p *parser) callonLabelMatchesRest1() (interface{}, error) {
 }
 
 func (c *current) onLabelList2() (interface{}, error) {
-	return nil, nil
+	return []*Identifier{}, nil
 }
 
 func (p *parser) callonLabelList2() (interface{}, error) {
@@ -3555,7 +4298,7 @@ func (p *parser) callonAggregateWithout1() (interface{}, error) {
 func (c *current) onAggregateExpression2(op, param, vector, group interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*StringLiteral)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression2() (interface{}, error) {
@@ -3567,7 +4310,7 @@ func (p *parser) callonAggregateExpression2() (interface{}, error) {
 func (c *current) onAggregateExpression22(op, group, param, vector interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*StringLiteral)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression22() (interface{}, error) {
@@ -3579,7 +4322,7 @@ func (p *parser) callonAggregateExpression22() (interface{}, error) {
 func (c *current) onAggregateExpression42(op, param, vector, group interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*Number)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression42() (interface{}, error) {
@@ -3591,7 +4334,7 @@ func (p *parser) callonAggregateExpression42() (interface{}, error) {
 func (c *current) onAggregateExpression62(op, group, param, vector interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*Number)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression62() (interface{}, error) {
@@ -3601,7 +4344,7 @@ func (p *parser) callonAggregateExpression62() (interface{}, error) {
 }
 
 func (c *current) onAggregateExpression82(op, vector, group interface{}) (interface{}, error) {
-	return NewAggregateExpr(op.(*Operator), vector.(*Selector), group)
+	return NewAggregateExpr(op.(*Operator), vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression82() (interface{}, error) {
@@ -3611,7 +4354,7 @@ func (p *parser) callonAggregateExpression82() (interface{}, error) {
 }
 
 func (c *current) onAggregateExpression97(op, group, vector interface{}) (interface{}, error) {
-	return NewAggregateExpr(op.(*Operator), vector.(*Selector), group)
+	return NewAggregateExpr(op.(*Operator), vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression97() (interface{}, error) {
@@ -3620,6 +4363,189 @@ func (p *parser) callonAggregateExpression97() (interface{}, error) {
 	return p.cur.onAggregateExpression97(stack["op"], stack["group"], stack["vector"])
 }
 
+func (c *current) onFunctionCall1(name, args interface{}) (interface{}, error) {
+	return NewCall(name.(string), args)
+}
+
+func (p *parser) callonFunctionCall1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionCall1(stack["name"], stack["args"])
+}
+
+func (c *current) onFunctionArgs1(first, rest interface{}) (interface{}, error) {
+	return NewExprList(first.(Expr), rest)
+}
+
+func (p *parser) callonFunctionArgs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgs1(stack["first"], stack["rest"])
+}
+
+func (c *current) onFunctionArgsRest1(arg interface{}) (interface{}, error) {
+	return arg, nil
+}
+
+func (p *parser) callonFunctionArgsRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgsRest1(stack["arg"])
+}
+
+func (c *current) onParenExpression1(expr interface{}) (interface{}, error) {
+	return expr, nil
+}
+
+func (p *parser) callonParenExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParenExpression1(stack["expr"])
+}
+
+func (c *current) onBoolModifier1() (interface{}, error) {
+	return true, nil
+}
+
+func (p *parser) callonBoolModifier1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBoolModifier1()
+}
+
+func (c *current) onVectorMatching1(kind, labels interface{}) (interface{}, error) {
+	return NewVectorMatching(string(kind.([]byte)), labels.([]*Identifier))
+}
+
+func (p *parser) callonVectorMatching1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVectorMatching1(stack["kind"], stack["labels"])
+}
+
+func (c *current) onComparisonOperators1(op interface{}) (interface{}, error) {
+	return ToBinaryOperatorKind(string(op.([]byte))), nil
+}
+
+func (p *parser) callonComparisonOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonOperators1(stack["op"])
+}
+
+func (c *current) onAdditiveOperators1(op interface{}) (interface{}, error) {
+	return ToBinaryOperatorKind(string(op.([]byte))), nil
+}
+
+func (p *parser) callonAdditiveOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveOperators1(stack["op"])
+}
+
+func (c *current) onMultiplicativeOperators1(op interface{}) (interface{}, error) {
+	return ToBinaryOperatorKind(string(op.([]byte))), nil
+}
+
+func (p *parser) callonMultiplicativeOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeOperators1(stack["op"])
+}
+
+func (c *current) onPowerOperator1() (interface{}, error) {
+	return PowKind, nil
+}
+
+func (p *parser) callonPowerOperator1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerOperator1()
+}
+
+func (c *current) onComparisonExpression1(first, rest interface{}) (interface{}, error) {
+	return ChainBinaryExprs(first.(Expr), rest)
+}
+
+func (p *parser) callonComparisonExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onComparisonRest1(op, returnBool, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryExpr(op.(BinaryOperatorKind), returnBool, matching, rhs.(Expr))
+}
+
+func (p *parser) callonComparisonRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonRest1(stack["op"], stack["returnBool"], stack["matching"], stack["rhs"])
+}
+
+func (c *current) onAdditiveExpression1(first, rest interface{}) (interface{}, error) {
+	return ChainBinaryExprs(first.(Expr), rest)
+}
+
+func (p *parser) callonAdditiveExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAdditiveRest1(op, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryExpr(op.(BinaryOperatorKind), nil, matching, rhs.(Expr))
+}
+
+func (p *parser) callonAdditiveRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveRest1(stack["op"], stack["matching"], stack["rhs"])
+}
+
+func (c *current) onMultiplicativeExpression1(first, rest interface{}) (interface{}, error) {
+	return ChainBinaryExprs(first.(Expr), rest)
+}
+
+func (p *parser) callonMultiplicativeExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onMultiplicativeRest1(op, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryExpr(op.(BinaryOperatorKind), nil, matching, rhs.(Expr))
+}
+
+func (p *parser) callonMultiplicativeRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeRest1(stack["op"], stack["matching"], stack["rhs"])
+}
+
+func (c *current) onPowerExpression1(lhs, rest interface{}) (interface{}, error) {
+	if rest == nil {
+		return lhs, nil
+	}
+	return ChainBinaryExprs(lhs.(Expr), []interface{}{rest})
+}
+
+func (p *parser) callonPowerExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerExpression1(stack["lhs"], stack["rest"])
+}
+
+func (c *current) onPowerRest1(op, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryExpr(op.(BinaryOperatorKind), nil, matching, rhs.(Expr))
+}
+
+func (p *parser) callonPowerRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerRest1(stack["op"], stack["matching"], stack["rhs"])
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
@@ -3678,18 +4604,17 @@ func Entrypoint(ruleName string) Option {
 //
 // Example usage:
 //
-//     input := "input"
-//     stats := Stats{}
-//     _, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     fmt.Println(string(b))
-//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
 func Statistics(stats *Stats, choiceNoMatch string) Option {
 	return func(p *parser) Option {
 		oldStats := p.Stats
@@ -1,4 +1,11 @@
 // Package promql implements a promql parser to build flux query specifications from promql.
+//
+// Queries are instant queries evaluated at the current time, over the series written by the scrapers.
+// Instant vectors are the latest sample of each series within the last five minutes.
+//
+// Labels of series are only known after an aggregation, so binary operators between
+// vectors that are not aggregated must match their series with on().
+// Many-to-one matching, count_values, and the modulo and power operators between vectors are not supported.
 package promql
 
 import (
@@ -1,6 +1,7 @@
 package promql
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/functions/inputs"
 	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/semantic"
@@ -181,7 +183,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: MinKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "some_metric",
 				},
 				Aggregate: &Aggregate{
@@ -201,7 +203,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: CountKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "some_metric",
 				},
 				Aggregate: &Aggregate{
@@ -221,7 +223,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: AvgKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "some_metric",
 				},
 				Aggregate: &Aggregate{
@@ -241,7 +243,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: SumKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "some_metric",
 				},
 				Aggregate: &Aggregate{
@@ -264,7 +266,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: SumKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "some_metric",
 				},
 				Aggregate: &Aggregate{
@@ -284,7 +286,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: SumKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "some_metric",
 				},
 				Aggregate: &Aggregate{
@@ -307,7 +309,7 @@ func TestParsePromQL(t *testing.T) {
 						String: "version",
 					},
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name: "build_version",
 				},
 			},
@@ -319,7 +321,7 @@ func TestParsePromQL(t *testing.T) {
 				Op: &Operator{
 					Kind: SumKind,
 				},
-				Selector: &Selector{
+				Expr: &Selector{
 					Name:  "node_cpu",
 					Range: 170 * time.Hour,
 					LabelMatchers: []*LabelMatcher{
@@ -334,6 +336,129 @@ func TestParsePromQL(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "function call",
+			promql: `rate(http_requests_total[5m])`,
+			want: &Call{
+				Func: "rate",
+				Args: []Expr{
+					&Selector{
+						Name:  "http_requests_total",
+						Range: 5 * time.Minute,
+					},
+				},
+			},
+		},
+		{
+			name:   "function call with a number and an aggregate",
+			promql: `histogram_quantile(0.9, sum by (le) (rate(request_duration_seconds_bucket[5m])))`,
+			want: &Call{
+				Func: "histogram_quantile",
+				Args: []Expr{
+					&Number{Val: 0.9},
+					&AggregateExpr{
+						Op: &Operator{
+							Kind: SumKind,
+						},
+						Expr: &Call{
+							Func: "rate",
+							Args: []Expr{
+								&Selector{
+									Name:  "request_duration_seconds_bucket",
+									Range: 5 * time.Minute,
+								},
+							},
+						},
+						Aggregate: &Aggregate{
+							By:     true,
+							Labels: []*Identifier{{Name: "le"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "binary operators precedence",
+			promql: `a + b * c`,
+			want: &BinaryExpr{
+				Op:  AddKind,
+				LHS: &Selector{Name: "a"},
+				RHS: &BinaryExpr{
+					Op:  MulKind,
+					LHS: &Selector{Name: "b"},
+					RHS: &Selector{Name: "c"},
+				},
+			},
+		},
+		{
+			name:   "binary operators left associativity",
+			promql: `a - b - c`,
+			want: &BinaryExpr{
+				Op: SubKind,
+				LHS: &BinaryExpr{
+					Op:  SubKind,
+					LHS: &Selector{Name: "a"},
+					RHS: &Selector{Name: "b"},
+				},
+				RHS: &Selector{Name: "c"},
+			},
+		},
+		{
+			name:   "power right associativity",
+			promql: `2 ^ 3 ^ 2`,
+			want: &BinaryExpr{
+				Op:  PowKind,
+				LHS: &Number{Val: 2},
+				RHS: &BinaryExpr{
+					Op:  PowKind,
+					LHS: &Number{Val: 3},
+					RHS: &Number{Val: 2},
+				},
+			},
+		},
+		{
+			name:   "parentheses",
+			promql: `(a + b) / 2`,
+			want: &BinaryExpr{
+				Op: DivKind,
+				LHS: &BinaryExpr{
+					Op:  AddKind,
+					LHS: &Selector{Name: "a"},
+					RHS: &Selector{Name: "b"},
+				},
+				RHS: &Number{Val: 2},
+			},
+		},
+		{
+			name:   "comparison with bool modifier",
+			promql: `a > bool 1`,
+			want: &BinaryExpr{
+				Op:         GreaterKind,
+				LHS:        &Selector{Name: "a"},
+				RHS:        &Number{Val: 1},
+				ReturnBool: true,
+			},
+		},
+		{
+			name:   "vector matching",
+			promql: `a / on (instance, job) b + ignoring (mode) c`,
+			want: &BinaryExpr{
+				Op: AddKind,
+				LHS: &BinaryExpr{
+					Op:  DivKind,
+					LHS: &Selector{Name: "a"},
+					RHS: &Selector{Name: "b"},
+					Matching: &VectorMatching{
+						On:     true,
+						Labels: []*Identifier{{Name: "instance"}, {Name: "job"}},
+					},
+				},
+				RHS: &Selector{Name: "c"},
+				Matching: &VectorMatching{
+					Labels: []*Identifier{{Name: "mode"}},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -349,6 +474,79 @@ func TestParsePromQL(t *testing.T) {
 	}
 }
 
+// Helpers to write the expected specifications of TestBuild.
+
+func op(id string, spec flux.OperationSpec) *flux.Operation {
+	return &flux.Operation{ID: flux.OperationID(id), Spec: spec}
+}
+
+func edge(parent, child string) flux.Edge {
+	return flux.Edge{Parent: flux.OperationID(parent), Child: flux.OperationID(child)}
+}
+
+// chain returns the edges between consecutive operations.
+func chain(ids ...string) []flux.Edge {
+	edges := make([]flux.Edge, 0, len(ids)-1)
+	for i := 1; i < len(ids); i++ {
+		edges = append(edges, edge(ids[i-1], ids[i]))
+	}
+	return edges
+}
+
+func fromOp() *inputs.FromOpSpec {
+	return &inputs.FromOpSpec{Bucket: "prometheus"}
+}
+
+func rangeOp(start, stop time.Duration) *transformations.RangeOpSpec {
+	return &transformations.RangeOpSpec{
+		Start: flux.Time{Relative: start, IsRelative: true},
+		Stop:  flux.Time{Relative: stop, IsRelative: true},
+	}
+}
+
+func whereOp(body semantic.Expression) *transformations.FilterOpSpec {
+	return &transformations.FilterOpSpec{Fn: rowFunction(body)}
+}
+
+func and(left, right semantic.Expression) *semantic.LogicalExpression {
+	return &semantic.LogicalExpression{Operator: ast.AndOperator, Left: left, Right: right}
+}
+
+func equal(column, s string) *semantic.BinaryExpression {
+	return compareColumn(ast.EqualOperator, column, s)
+}
+
+func lastOp() *transformations.LastOpSpec {
+	return &transformations.LastOpSpec{SelectorConfig: execute.SelectorConfig{Column: "_value"}}
+}
+
+func groupOp(mode string, columns ...string) *transformations.GroupOpSpec {
+	if columns == nil {
+		columns = []string{}
+	}
+	return &transformations.GroupOpSpec{Mode: mode, Columns: columns}
+}
+
+func differenceOp(nonNegative bool) *transformations.DifferenceOpSpec {
+	return &transformations.DifferenceOpSpec{NonNegative: nonNegative, Columns: []string{"_value"}}
+}
+
+func sumOp() *transformations.SumOpSpec {
+	return &transformations.SumOpSpec{AggregateConfig: execute.DefaultAggregateConfig}
+}
+
+func binary(op ast.OperatorKind, left, right semantic.Expression) *semantic.BinaryExpression {
+	return &semantic.BinaryExpression{Operator: op, Left: left, Right: right}
+}
+
+func float(v float64) *semantic.FloatLiteral {
+	return &semantic.FloatLiteral{Value: v}
+}
+
+func property(key string, value semantic.Expression) *semantic.Property {
+	return &semantic.Property{Key: &semantic.Identifier{Name: key}, Value: value}
+}
+
 func TestBuild(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -366,6 +564,13 @@ func TestBuild(t *testing.T) {
 						ID:   flux.OperationID("from"),
 						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
 					},
+					{
+						ID: flux.OperationID("range"),
+						Spec: &transformations.RangeOpSpec{
+							Start: flux.Time{Relative: -time.Minute * 5, IsRelative: true},
+							Stop:  flux.Time{IsRelative: true},
+						},
+					},
 					{
 						ID: "where",
 						Spec: &transformations.FilterOpSpec{
@@ -384,7 +589,7 @@ func TestBuild(t *testing.T) {
 													Object: &semantic.IdentifierExpression{
 														Name: "r",
 													},
-													Property: "_metric",
+													Property: "_measurement",
 												},
 												Right: &semantic.StringLiteral{
 													Value: "node_cpu",
@@ -421,16 +626,41 @@ func TestBuild(t *testing.T) {
 						},
 					},
 					{
-						ID: flux.OperationID("count"), Spec: &transformations.CountOpSpec{},
+						ID: flux.OperationID("last"),
+						Spec: &transformations.LastOpSpec{
+							SelectorConfig: execute.SelectorConfig{Column: "_value"},
+						},
+					},
+					{
+						ID:   flux.OperationID("group"),
+						Spec: &transformations.GroupOpSpec{Mode: "by", Columns: []string{}},
+					},
+					{
+						ID: flux.OperationID("count"),
+						Spec: &transformations.CountOpSpec{
+							AggregateConfig: execute.AggregateConfig{Columns: []string{"_value"}},
+						},
 					},
 				},
 				Edges: []flux.Edge{
 					{
 						Parent: flux.OperationID("from"),
+						Child:  flux.OperationID("range"),
+					},
+					{
+						Parent: flux.OperationID("range"),
 						Child:  flux.OperationID("where"),
 					},
 					{
 						Parent: flux.OperationID("where"),
+						Child:  flux.OperationID("last"),
+					},
+					{
+						Parent: flux.OperationID("last"),
+						Child:  flux.OperationID("group"),
+					},
+					{
+						Parent: flux.OperationID("group"),
 						Child:  flux.OperationID("count"),
 					},
 				},
@@ -448,7 +678,8 @@ func TestBuild(t *testing.T) {
 					{
 						ID: flux.OperationID("range"),
 						Spec: &transformations.RangeOpSpec{
-							Start: flux.Time{Relative: -time.Minute * 7},
+							Start: flux.Time{Relative: -time.Minute * 7, IsRelative: true},
+							Stop:  flux.Time{Relative: -time.Minute * 5, IsRelative: true},
 						},
 					},
 					{
@@ -467,7 +698,7 @@ func TestBuild(t *testing.T) {
 												Object: &semantic.IdentifierExpression{
 													Name: "r",
 												},
-												Property: "_metric",
+												Property: "_measurement",
 											},
 											Right: &semantic.StringLiteral{
 												Value: "node_cpu",
@@ -503,81 +734,363 @@ func TestBuild(t *testing.T) {
 				},
 			},
 		},
-
 		{
 			name:   "sum over a range",
-			promql: `sum(node_cpu{_measurement="m0"}[170h])`,
+			promql: `sum(sum_over_time(node_cpu{_measurement="m0"}[170h]))`,
 			want: &flux.Spec{
 				Operations: []*flux.Operation{
-					{
-						ID:   flux.OperationID("from"),
-						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
-					},
-					{
-						ID: flux.OperationID("range"),
-						Spec: &transformations.RangeOpSpec{
-							Start: flux.Time{Relative: -170 * time.Hour},
-						},
-					},
-					{
-						ID: "where",
-						Spec: &transformations.FilterOpSpec{
-							Fn: &semantic.FunctionExpression{
-								Block: &semantic.FunctionBlock{
-									Parameters: &semantic.FunctionParameters{
-										List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
-									},
-									Body: &semantic.LogicalExpression{
-										Operator: ast.AndOperator,
-										Left: &semantic.BinaryExpression{
-											Operator: ast.EqualOperator,
-											Left: &semantic.MemberExpression{
-												Object: &semantic.IdentifierExpression{
-													Name: "r",
-												},
-												Property: "_metric",
-											},
-											Right: &semantic.StringLiteral{
-												Value: "node_cpu",
-											},
-										},
-										Right: &semantic.BinaryExpression{
-											Operator: ast.EqualOperator,
-											Left: &semantic.MemberExpression{
-												Object: &semantic.IdentifierExpression{
-													Name: "r",
-												},
-												Property: "_measurement",
-											},
-											Right: &semantic.StringLiteral{
-												Value: "m0",
-											},
-										},
+					op("from", fromOp()),
+					op("range", rangeOp(-170*time.Hour, 0)),
+					op("where", whereOp(and(equal("_measurement", "node_cpu"), equal("_measurement", "m0")))),
+					op("sum_over_time", sumOp()),
+					op("group", groupOp("by")),
+					op("sum", sumOp()),
+				},
+				Edges: chain("from", "range", "where", "sum_over_time", "group", "sum"),
+			},
+		},
+		{
+			name:    "aggregate of a range vector",
+			promql:  `sum(node_cpu[5m])`,
+			wantErr: true,
+		},
+		{
+			name:   "instant vector with offset",
+			promql: `http_requests_total offset 1h`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-time.Hour-5*time.Minute, -time.Hour)),
+					op("where", whereOp(equal("_measurement", "http_requests_total"))),
+					op("last", lastOp()),
+				},
+				Edges: chain("from", "range", "where", "last"),
+			},
+		},
+		{
+			name:   "label matching a regular expression",
+			promql: `http_requests_total{code=~"5.."}`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(and(
+						equal("_measurement", "http_requests_total"),
+						binary(ast.RegexpMatchOperator, columnRef("code"), &semantic.RegexpLiteral{Value: regexp.MustCompile(`^(?:5..)$`)}),
+					))),
+					op("last", lastOp()),
+				},
+				Edges: chain("from", "range", "where", "last"),
+			},
+		},
+		{
+			name:   "rate",
+			promql: `rate(http_requests_total[5m])`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "http_requests_total"))),
+					op("difference", differenceOp(true)),
+					op("sum", sumOp()),
+					op("map", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("_value", binary(ast.DivisionOperator, columnRef("_value"), float(300))),
+							},
+						}),
+						MergeKey: true,
+					}),
+				},
+				Edges: chain("from", "range", "where", "difference", "sum", "map"),
+			},
+		},
+		{
+			name:   "increase",
+			promql: `increase(http_requests_total[1h])`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-time.Hour, 0)),
+					op("where", whereOp(equal("_measurement", "http_requests_total"))),
+					op("difference", differenceOp(true)),
+					op("sum", sumOp()),
+				},
+				Edges: chain("from", "range", "where", "difference", "sum"),
+			},
+		},
+		{
+			name:   "irate",
+			promql: `irate(http_requests_total[5m])`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "http_requests_total"))),
+					op("derivative", &transformations.DerivativeOpSpec{
+						Unit:        flux.Duration(time.Second),
+						NonNegative: true,
+						Columns:     []string{"_value"},
+						TimeColumn:  "_time",
+					}),
+					op("last", lastOp()),
+				},
+				Edges: chain("from", "range", "where", "derivative", "last"),
+			},
+		},
+		{
+			name:    "rate of an instant vector",
+			promql:  `rate(http_requests_total)`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown function",
+			promql:  `predict_linear(http_requests_total[5m], 60)`,
+			wantErr: true,
+		},
+		{
+			name:   "sum of rates by label",
+			promql: `sum by (code) (rate(http_requests_total[5m]))`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "http_requests_total"))),
+					op("difference", differenceOp(true)),
+					op("sum", sumOp()),
+					op("map", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("_value", binary(ast.DivisionOperator, columnRef("_value"), float(300))),
+							},
+						}),
+						MergeKey: true,
+					}),
+					op("group", groupOp("by", "code")),
+					op("sum1", sumOp()),
+				},
+				Edges: chain("from", "range", "where", "difference", "sum", "map", "group", "sum1"),
+			},
+		},
+		{
+			name:   "max without label",
+			promql: `max without (instance) (node_load1)`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "node_load1"))),
+					op("last", lastOp()),
+					op("group", groupOp("except", "instance", "_start", "_stop", "_time", "_value", "_measurement", "_field")),
+					op("max", &transformations.MaxOpSpec{SelectorConfig: execute.SelectorConfig{Column: "_value"}}),
+				},
+				Edges: chain("from", "range", "where", "last", "group", "max"),
+			},
+		},
+		{
+			name:   "histogram quantile",
+			promql: `histogram_quantile(0.9, sum by (le) (rate(request_duration_seconds_bucket[5m])))`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(and(
+						and(
+							equal("_measurement", "request_duration_seconds"),
+							compareColumn(ast.NotEqualOperator, "_field", "count"),
+						),
+						compareColumn(ast.NotEqualOperator, "_field", "sum"),
+					))),
+					op("rename", &transformations.RenameOpSpec{Columns: map[string]string{"_field": "le"}}),
+					op("difference", differenceOp(true)),
+					op("sum", sumOp()),
+					op("map", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("_value", binary(ast.DivisionOperator, columnRef("_value"), float(300))),
+							},
+						}),
+						MergeKey: true,
+					}),
+					op("group", groupOp("by", "le")),
+					op("sum1", sumOp()),
+					op("group1", groupOp("except", "le", "_start", "_stop", "_time", "_value", "_measurement", "_field")),
+					op("map1", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("le", &semantic.CallExpression{
+									Callee: &semantic.IdentifierExpression{Name: "float"},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{property("v", columnRef("le"))},
 									},
-								},
+								}),
+								property("_value", columnRef("_value")),
 							},
-						},
-					},
-					{
-						ID: flux.OperationID("sum"), Spec: &transformations.SumOpSpec{},
-					},
+						}),
+						MergeKey: true,
+					}),
+					op("histogramQuantile", &transformations.HistogramQuantileOpSpec{
+						Quantile:         0.9,
+						CountColumn:      "_value",
+						UpperBoundColumn: "le",
+						ValueColumn:      "_value",
+					}),
 				},
-				Edges: []flux.Edge{
-					{
-						Parent: flux.OperationID("from"),
-						Child:  flux.OperationID("range"),
-					},
-					{
-						Parent: flux.OperationID("range"),
-						Child:  flux.OperationID("where"),
-					},
-					{
-						Parent: flux.OperationID("where"),
-						Child:  flux.OperationID("sum"),
-					},
+				Edges: chain("from", "range", "where", "rename", "difference", "sum", "map", "group", "sum1", "group1", "map1", "histogramQuantile"),
+			},
+		},
+		{
+			name:   "histogram count",
+			promql: `request_duration_seconds_count`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(&semantic.LogicalExpression{
+						Operator: ast.OrOperator,
+						Left:     equal("_measurement", "request_duration_seconds_count"),
+						Right: and(
+							equal("_measurement", "request_duration_seconds"),
+							equal("_field", "count"),
+						),
+					})),
+					op("last", lastOp()),
+				},
+				Edges: chain("from", "range", "where", "last"),
+			},
+		},
+		{
+			name:   "vector and scalar",
+			promql: `node_memory_bytes / 1024`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "node_memory_bytes"))),
+					op("last", lastOp()),
+					op("map", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("_time", columnRef("_time")),
+								property("_value", binary(ast.DivisionOperator, columnRef("_value"), float(1024))),
+							},
+						}),
+						MergeKey: true,
+					}),
 				},
+				Edges: chain("from", "range", "where", "last", "map"),
 			},
 		},
+		{
+			name:   "scalar arithmetic and comparison",
+			promql: `node_load1 > 2 * 4`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "node_load1"))),
+					op("last", lastOp()),
+					op("filter", whereOp(binary(ast.GreaterThanOperator, columnRef("_value"), float(8)))),
+				},
+				Edges: chain("from", "range", "where", "last", "filter"),
+			},
+		},
+		{
+			name:   "vectors matching on labels",
+			promql: `node_memory_free / on (instance) node_memory_total`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "node_memory_free"))),
+					op("last", lastOp()),
+					op("from1", fromOp()),
+					op("range1", rangeOp(-5*time.Minute, 0)),
+					op("where1", whereOp(equal("_measurement", "node_memory_total"))),
+					op("last1", lastOp()),
+					op("join", &transformations.JoinOpSpec{
+						TableNames: map[flux.OperationID]string{"last": "lhs", "last1": "rhs"},
+						On:         []string{"instance"},
+						Method:     "inner",
+					}),
+					op("map", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("instance", columnRef("instance")),
+								property("_value", binary(ast.DivisionOperator, columnRef("_value_lhs"), columnRef("_value_rhs"))),
+							},
+						}),
+					}),
+				},
+				Edges: append(
+					append(chain("from", "range", "where", "last"), chain("from1", "range1", "where1", "last1")...),
+					edge("last", "join"), edge("last1", "join"), edge("join", "map"),
+				),
+			},
+		},
+		{
+			name:   "comparison of aggregated vectors",
+			promql: `sum by (job) (up) < sum by (job) (scrape_samples)`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					op("from", fromOp()),
+					op("range", rangeOp(-5*time.Minute, 0)),
+					op("where", whereOp(equal("_measurement", "up"))),
+					op("last", lastOp()),
+					op("group", groupOp("by", "job")),
+					op("sum", sumOp()),
+					op("from1", fromOp()),
+					op("range1", rangeOp(-5*time.Minute, 0)),
+					op("where1", whereOp(equal("_measurement", "scrape_samples"))),
+					op("last1", lastOp()),
+					op("group1", groupOp("by", "job")),
+					op("sum1", sumOp()),
+					op("join", &transformations.JoinOpSpec{
+						TableNames: map[flux.OperationID]string{"sum": "lhs", "sum1": "rhs"},
+						On:         []string{"job"},
+						Method:     "inner",
+					}),
+					op("filter", whereOp(binary(ast.LessThanOperator, columnRef("_value_lhs"), columnRef("_value_rhs")))),
+					op("map", &transformations.MapOpSpec{
+						Fn: rowFunction(&semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								property("job", columnRef("job")),
+								property("_value", columnRef("_value_lhs")),
+							},
+						}),
+					}),
+				},
+				Edges: append(
+					append(chain("from", "range", "where", "last", "group", "sum"), chain("from1", "range1", "where1", "last1", "group1", "sum1")...),
+					edge("sum", "join"), edge("sum1", "join"), edge("join", "filter"), edge("filter", "map"),
+				),
+			},
+		},
+		{
+			name:    "vectors with unknown labels",
+			promql:  `node_memory_free / node_memory_total`,
+			wantErr: true,
+		},
+		{
+			name:    "aggregated vectors with different labels",
+			promql:  `sum by (job) (up) / sum by (instance) (up)`,
+			wantErr: true,
+		},
+		{
+			name:    "modulo between vectors",
+			promql:  `node_load1 % 2`,
+			wantErr: true,
+		},
+		{
+			name:    "bool modifier between vectors",
+			promql:  `node_load1 > bool 2`,
+			wantErr: true,
+		},
+		{
+			name:    "scalar",
+			promql:  `1 + 2`,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -587,7 +1100,7 @@ func TestBuild(t *testing.T) {
 				t.Errorf("Build() %s error = %v, wantErr %v", tt.promql, err, tt.wantErr)
 				return
 			}
-			opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(flux.Spec{}), cmpopts.IgnoreUnexported(flux.Spec{})}...)
+			opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(flux.Spec{}), cmpopts.IgnoreUnexported(flux.Spec{}), cmpopts.IgnoreUnexported(transformations.JoinOpSpec{})}...)
 			if !cmp.Equal(tt.want, got, opts...) {
 				t.Errorf("Build() = %s -want/+got\n%s", tt.promql, cmp.Diff(tt.want, got, opts...))
 			}
@@ -2,12 +2,14 @@ package promql
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/functions/inputs"
 	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/semantic"
@@ -67,6 +69,14 @@ func (s *StringLiteral) Value() interface{} {
 	return s.String
 }
 
+func (s *StringLiteral) build(b *specBuilder) (*value, error) {
+	return &value{typ: stringType}, nil
+}
+
+func (s *StringLiteral) QuerySpec() (*flux.Spec, error) {
+	return buildSpec(s)
+}
+
 type Duration struct {
 	Dur time.Duration `json:"dur,omitempty"`
 }
@@ -91,6 +101,14 @@ func (n *Number) Value() interface{} {
 	return n.Val
 }
 
+func (n *Number) build(b *specBuilder) (*value, error) {
+	return &value{typ: scalarType, scalar: n.Val}, nil
+}
+
+func (n *Number) QuerySpec() (*flux.Spec, error) {
+	return buildSpec(n)
+}
+
 func NewNumber(val string) (*Number, error) {
 	num, err := strconv.ParseFloat(val, 64)
 	if err != nil {
@@ -142,104 +160,158 @@ type Selector struct {
 }
 
 func (s *Selector) QuerySpec() (*flux.Spec, error) {
-	parent := "from"
-	ops := []*flux.Operation{
-		{
-			ID: "from", // TODO: Change this to a UUID
-			Spec: &inputs.FromOpSpec{
-				Bucket: "prometheus",
-			},
-		},
-	}
-	edges := []flux.Edge{}
+	return buildSpec(s)
+}
 
-	rng, err := NewRangeOp(s.Range, s.Offset)
-	if err != nil {
-		return nil, err
-	}
+func (s *Selector) build(b *specBuilder) (*value, error) {
+	id := b.op("from", &inputs.FromOpSpec{
+		Bucket: DefaultBucket,
+	})
 
-	if rng != nil {
-		ops = append(ops, rng)
-		edge := flux.Edge{
-			Parent: flux.OperationID(parent),
-			Child:  "range",
-		}
-		parent = "range"
-		edges = append(edges, edge)
+	// An instant vector is the latest sample of each series within the lookback delta.
+	rng := s.Range
+	if rng == 0 {
+		rng = lookbackDelta
 	}
+	id = b.op("range", NewRangeOp(rng, s.Offset), id)
 
 	where, err := NewWhereOperation(s.Name, s.LabelMatchers)
 	if err != nil {
 		return nil, err
 	}
+	id = b.op("where", where, id)
 
-	ops = append(ops, where)
-	edge := flux.Edge{
-		Parent: flux.OperationID(parent),
-		Child:  "where",
+	if isBucketSeries(s.Name) {
+		// The upper bound of the buckets of a histogram is the field.
+		id = b.op("rename", &transformations.RenameOpSpec{
+			Columns: map[string]string{fieldColumn: bucketLabel},
+		}, id)
 	}
-	parent = "where"
-	edges = append(edges, edge)
 
-	return &flux.Spec{
-		Operations: ops,
-		Edges:      edges,
-	}, nil
+	if s.Range != 0 {
+		return &value{typ: rangeVectorType, id: id, rng: s.Range}, nil
+	}
+	id = b.op("last", &transformations.LastOpSpec{
+		SelectorConfig: execute.SelectorConfig{
+			Column: execute.DefaultValueColLabel,
+		},
+	}, id)
+	return &value{typ: instantVectorType, id: id, hasTime: true}, nil
 }
 
-func NewRangeOp(rng, offset time.Duration) (*flux.Operation, error) {
-	if rng == 0 && offset == 0 {
-		return nil, nil
-	}
-	return &flux.Operation{
-		ID: "range", // TODO: Change this to a UUID
-		Spec: &transformations.RangeOpSpec{
-			Start: flux.Time{
-				Relative: -rng - offset,
-			},
+// NewRangeOp returns the range of the samples of a selector.
+func NewRangeOp(rng, offset time.Duration) *transformations.RangeOpSpec {
+	return &transformations.RangeOpSpec{
+		Start: flux.Time{
+			Relative:   -rng - offset,
+			IsRelative: true,
 		},
-	}, nil
+		Stop: flux.Time{
+			Relative:   -offset,
+			IsRelative: true,
+		},
+	}
 }
 
 var operatorLookup = map[MatchKind]ast.OperatorKind{
 	Equal:        ast.EqualOperator,
 	NotEqual:     ast.NotEqualOperator,
-	RegexMatch:   ast.EqualOperator,
-	RegexNoMatch: ast.NotEqualOperator,
+	RegexMatch:   ast.RegexpMatchOperator,
+	RegexNoMatch: ast.NotRegexpMatchOperator,
 }
 
-func NewWhereOperation(metricName string, labels []*LabelMatcher) (*flux.Operation, error) {
-	var node semantic.Expression = &semantic.BinaryExpression{
-		Operator: ast.EqualOperator,
-		Left: &semantic.MemberExpression{
-			Object: &semantic.IdentifierExpression{
-				Name: "r",
+const (
+	// metricNameLabel is the label of the metric name of a series.
+	metricNameLabel = "__name__"
+	// bucketLabel is the label of the upper bound of the buckets of a histogram.
+	bucketLabel = "le"
+	// quantileLabel is the label of the quantiles of a summary.
+	quantileLabel = "quantile"
+)
+
+// Series of histograms and summaries are suffixed with their part.
+const (
+	bucketSuffix = "_bucket"
+	countSuffix  = "_count"
+	sumSuffix    = "_sum"
+)
+
+// isBucketSeries reports whether the series of the metric are the buckets of a histogram.
+func isBucketSeries(metricName string) bool {
+	return strings.HasSuffix(metricName, bucketSuffix) && metricName != bucketSuffix
+}
+
+// NewWhereOperation returns the filter of the series of a selector.
+//
+// Series are stored the way the scrapers write them: the metric name is the measurement,
+// the labels are tags and the value is a field named after the type of the metric.
+// The count and sum of histograms and summaries are the count and sum fields of the measurement,
+// and their buckets and quantiles are the other fields.
+func NewWhereOperation(metricName string, labels []*LabelMatcher) (*transformations.FilterOpSpec, error) {
+	var node semantic.Expression
+	switch {
+	case isBucketSeries(metricName):
+		node = &semantic.LogicalExpression{
+			Operator: ast.AndOperator,
+			Left: &semantic.LogicalExpression{
+				Operator: ast.AndOperator,
+				Left:     compareColumn(ast.EqualOperator, measurementColumn, strings.TrimSuffix(metricName, bucketSuffix)),
+				Right:    compareColumn(ast.NotEqualOperator, fieldColumn, "count"),
 			},
-			Property: "_metric",
-		},
-		Right: &semantic.StringLiteral{
-			Value: metricName,
-		},
+			Right: compareColumn(ast.NotEqualOperator, fieldColumn, "sum"),
+		}
+	case strings.HasSuffix(metricName, countSuffix), strings.HasSuffix(metricName, sumSuffix):
+		// The series may also be a metric of its own.
+		i := strings.LastIndexByte(metricName, '_')
+		node = &semantic.LogicalExpression{
+			Operator: ast.OrOperator,
+			Left:     compareColumn(ast.EqualOperator, measurementColumn, metricName),
+			Right: &semantic.LogicalExpression{
+				Operator: ast.AndOperator,
+				Left:     compareColumn(ast.EqualOperator, measurementColumn, metricName[:i]),
+				Right:    compareColumn(ast.EqualOperator, fieldColumn, metricName[i+1:]),
+			},
+		}
+	default:
+		node = compareColumn(ast.EqualOperator, measurementColumn, metricName)
 	}
+
 	for _, label := range labels {
 		op, ok := operatorLookup[label.Kind]
 		if !ok {
 			return nil, fmt.Errorf("unknown label match kind %d", label.Kind)
 		}
-		ref := &semantic.MemberExpression{
-			Object: &semantic.IdentifierExpression{
-				Name: "r",
-			},
-			Property: label.Name,
+
+		column := label.Name
+		switch label.Name {
+		case metricNameLabel:
+			column = measurementColumn
+		case bucketLabel, quantileLabel:
+			column = fieldColumn
 		}
-		var value semantic.Expression
-		if label.Value.Type() == StringKind {
-			value = &semantic.StringLiteral{
-				Value: label.Value.Value().(string),
+
+		// Label values are strings; numbers are matched by their text.
+		var str string
+		switch v := label.Value.Value().(type) {
+		case string:
+			str = v
+		case float64:
+			str = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("invalid value of label %s", label.Name)
+		}
+
+		var value semantic.Expression = &semantic.StringLiteral{
+			Value: str,
+		}
+		if label.Kind == RegexMatch || label.Kind == RegexNoMatch {
+			// Regular expressions of PromQL are fully anchored.
+			re, err := regexp.Compile("^(?:" + str + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression of label %s: %v", label.Name, err)
 			}
-		} else if label.Value.Type() == NumberKind {
-			value = &semantic.FloatLiteral{
-				Value: label.Value.Value().(float64),
+			value = &semantic.RegexpLiteral{
+				Value: re,
 			}
 		}
 		node = &semantic.LogicalExpression{
@@ -247,24 +319,14 @@ func NewWhereOperation(metricName string, labels []*LabelMatcher) (*flux.Operati
 			Left:     node,
 			Right: &semantic.BinaryExpression{
 				Operator: op,
-				Left:     ref,
+				Left:     columnRef(column),
 				Right:    value,
 			},
 		}
 	}
 
-	return &flux.Operation{
-		ID: "where", // TODO: Change this to a UUID
-		Spec: &transformations.FilterOpSpec{
-			Fn: &semantic.FunctionExpression{
-				Block: &semantic.FunctionBlock{
-					Parameters: &semantic.FunctionParameters{
-						List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
-					},
-					Body: node,
-				},
-			},
-		},
+	return &transformations.FilterOpSpec{
+		Fn: rowFunction(node),
 	}, nil
 }
 
@@ -302,21 +364,39 @@ type Aggregate struct {
 	Labels  []*Identifier `json:"labels,omitempty"`
 }
 
-func (a *Aggregate) QuerySpec() (*flux.Operation, error) {
-	if a.Without {
-		return nil, fmt.Errorf("unable to merge using `without`")
+// nonLabelColumns are the columns of the series that are not labels.
+var nonLabelColumns = []string{
+	execute.DefaultStartColLabel,
+	execute.DefaultStopColLabel,
+	execute.DefaultTimeColLabel,
+	execute.DefaultValueColLabel,
+	measurementColumn,
+	fieldColumn,
+}
+
+// group groups the series of v by the labels of the aggregation.
+// The series are grouped into one when there is no aggregation.
+func (a *Aggregate) group(b *specBuilder, v *value) *value {
+	labels := identifierNames(a.labels())
+	if a != nil && a.Without {
+		id := b.op("group", &transformations.GroupOpSpec{
+			Columns: append(labels, nonLabelColumns...),
+			Mode:    "except",
+		}, v.id)
+		return &value{typ: instantVectorType, id: id}
 	}
-	keys := make([]string, len(a.Labels))
-	for i := range a.Labels {
-		keys[i] = a.Labels[i].Name
+	id := b.op("group", &transformations.GroupOpSpec{
+		Columns: labels,
+		Mode:    "by",
+	}, v.id)
+	return &value{typ: instantVectorType, id: id, labels: labels, labelsKnown: true}
+}
+
+func (a *Aggregate) labels() []*Identifier {
+	if a == nil {
+		return nil
 	}
-	return &flux.Operation{
-		ID: "merge",
-		Spec: &transformations.GroupOpSpec{
-			Columns: keys,
-			Mode:    "by",
-		},
-	}, nil
+	return a.Labels
 }
 
 type OperatorKind int
@@ -336,6 +416,27 @@ const (
 	CountKind
 )
 
+var operatorNames = map[OperatorKind]string{
+	CountValuesKind: "count_values",
+	TopKind:         "topk",
+	BottomKind:      "bottomk",
+	QuantileKind:    "quantile",
+	SumKind:         "sum",
+	MinKind:         "min",
+	MaxKind:         "max",
+	AvgKind:         "avg",
+	StdevKind:       "stddev",
+	StdVarKind:      "stdvar",
+	CountKind:       "count",
+}
+
+func (k OperatorKind) String() string {
+	if name, ok := operatorNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown operator %d", int(k))
+}
+
 func ToOperatorKind(op string) OperatorKind {
 	op = strings.ToLower(op)
 	switch op {
@@ -371,108 +472,114 @@ type Operator struct {
 	Arg  Arg          `json:"arg,omitempty"`
 }
 
-func (o *Operator) QuerySpec() (*flux.Operation, error) {
+// build aggregates the series of each group of v.
+func (o *Operator) build(b *specBuilder, v *value) (*value, error) {
+	out := &value{typ: instantVectorType, labels: v.labels, labelsKnown: v.labelsKnown}
 	switch o.Kind {
-	case CountValuesKind, BottomKind, QuantileKind, StdVarKind:
-		return nil, fmt.Errorf("unable to run %d yet", o.Kind)
 	case CountKind:
-		return &flux.Operation{
-			ID:   "count",
-			Spec: &transformations.CountOpSpec{},
-		}, nil
-	//case TopKind:
-	//	return &flux.Operation{
-	//		ID:   "top",
-	//		Spec: &transformations.TopOpSpec{}, // TODO: Top doesn't have arg yet
-	//	}, nil
+		out.id = b.op("count", &transformations.CountOpSpec{
+			AggregateConfig: execute.DefaultAggregateConfig,
+		}, v.id)
 	case SumKind:
-		return &flux.Operation{
-			ID:   "sum",
-			Spec: &transformations.SumOpSpec{},
-		}, nil
-	//case MinKind:
-	//	return &flux.Operation{
-	//		ID:   "min",
-	//		Spec: &transformations.MinOpSpec{},
-	//	}, nil
-	//case MaxKind:
-	//	return &flux.Operation{
-	//		ID:   "max",
-	//		Spec: &transformations.MaxOpSpec{},
-	//	}, nil
-	//case AvgKind:
-	//	return &flux.Operation{
-	//		ID:   "mean",
-	//		Spec: &transformations.MeanOpSpec{},
-	//	}, nil
-	//case StdevKind:
-	//	return &flux.Operation{
-	//		ID:   "stddev",
-	//		Spec: &transformations.StddevOpSpec{},
-	//	}, nil
+		out.id = b.op("sum", &transformations.SumOpSpec{
+			AggregateConfig: execute.DefaultAggregateConfig,
+		}, v.id)
+	case AvgKind:
+		out.id = b.op("mean", &transformations.MeanOpSpec{
+			AggregateConfig: execute.DefaultAggregateConfig,
+		}, v.id)
+	case StdevKind, StdVarKind:
+		out.id = b.op("stddev", &transformations.StddevOpSpec{
+			AggregateConfig: execute.DefaultAggregateConfig,
+		}, v.id)
+		if o.Kind == StdVarKind {
+			out.id = b.op("map", mapValue(&semantic.BinaryExpression{
+				Operator: ast.MultiplicationOperator,
+				Left:     columnRef(execute.DefaultValueColLabel),
+				Right:    columnRef(execute.DefaultValueColLabel),
+			}, false), out.id)
+		}
+	case MinKind:
+		// The selectors keep the row of the sample they select.
+		out.id = b.op("min", &transformations.MinOpSpec{
+			SelectorConfig: execute.SelectorConfig{
+				Column: execute.DefaultValueColLabel,
+			},
+		}, v.id)
+		out.hasTime = v.hasTime
+	case MaxKind:
+		out.id = b.op("max", &transformations.MaxOpSpec{
+			SelectorConfig: execute.SelectorConfig{
+				Column: execute.DefaultValueColLabel,
+			},
+		}, v.id)
+		out.hasTime = v.hasTime
+	case QuantileKind:
+		phi, ok := o.Arg.(*Number)
+		if !ok {
+			return nil, fmt.Errorf("quantile requires a number parameter")
+		}
+		out.id = b.op("percentile", &transformations.PercentileOpSpec{
+			Percentile:      phi.Val,
+			Method:          "exact_mean",
+			AggregateConfig: execute.DefaultAggregateConfig,
+		}, v.id)
+	case TopKind, BottomKind:
+		k, ok := o.Arg.(*Number)
+		if !ok {
+			return nil, fmt.Errorf("%s requires a number parameter", o.Kind)
+		}
+		id := b.op("sort", &transformations.SortOpSpec{
+			Columns: []string{execute.DefaultValueColLabel},
+			Desc:    o.Kind == TopKind,
+		}, v.id)
+		out.id = b.op("limit", &transformations.LimitOpSpec{
+			N: int64(k.Val),
+		}, id)
+		out.hasTime = v.hasTime
 	default:
-		return nil, fmt.Errorf("unknown Op kind %d", o.Kind)
+		return nil, fmt.Errorf("unable to run %s yet", o.Kind)
 	}
+	return out, nil
 }
 
 type AggregateExpr struct {
 	Op        *Operator  `json:"op,omitempty"`
-	Selector  *Selector  `json:"selector,omitempty"`
+	Expr      Expr       `json:"expr,omitempty"`
 	Aggregate *Aggregate `json:"aggregate,omitempty"`
 }
 
 func (a *AggregateExpr) QuerySpec() (*flux.Spec, error) {
-	spec, err := a.Selector.QuerySpec()
-	if err != nil {
-		return nil, err
-	}
-
-	if a.Aggregate != nil {
-		agg, err := a.Aggregate.QuerySpec()
-		if err != nil {
-			return nil, err
-		}
-
-		parent := flux.OperationID("from")
-		if len(spec.Edges) > 0 {
-			tail := spec.Edges[len(spec.Edges)-1]
-			parent = tail.Child
-		}
-
-		spec.Operations = append(spec.Operations, agg)
-		spec.Edges = append(spec.Edges, flux.Edge{
-			Parent: parent,
-			Child:  agg.ID,
-		})
-	}
+	return buildSpec(a)
+}
 
-	op, err := a.Op.QuerySpec()
+func (a *AggregateExpr) build(b *specBuilder) (*value, error) {
+	v, err := a.Expr.build(b)
 	if err != nil {
 		return nil, err
 	}
+	if v.typ != instantVectorType {
+		return nil, fmt.Errorf("%s expects an instant vector, got %s", a.Op.Kind, v.typ)
+	}
 
-	parent := flux.OperationID("from")
-	if len(spec.Edges) > 0 {
-		tail := spec.Edges[len(spec.Edges)-1]
-		parent = tail.Child
+	if a.Op.Kind == TopKind || a.Op.Kind == BottomKind {
+		// The series selected by topk and bottomk keep their labels.
+		group := a.Aggregate.group(b, v)
+		group.labels, group.labelsKnown, group.hasTime = v.labels, v.labelsKnown, v.hasTime
+		return a.Op.build(b, group)
 	}
-	spec.Operations = append(spec.Operations, op)
-	spec.Edges = append(spec.Edges, flux.Edge{
-		Parent: parent,
-		Child:  op.ID,
-	})
-	return spec, nil
+	return a.Op.build(b, a.Aggregate.group(b, v))
 }
 
-func NewAggregateExpr(op *Operator, selector *Selector, group interface{}) (*AggregateExpr, error) {
-	expr := &AggregateExpr{
-		Op:       op,
-		Selector: selector,
+func NewAggregateExpr(op *Operator, expr Expr, group interface{}) (*AggregateExpr, error) {
+	aggr := &AggregateExpr{
+		Op:   op,
+		Expr: expr,
 	}
 	if group != nil {
-		expr.Aggregate = group.(*Aggregate)
+		aggr.Aggregate = group.(*Aggregate)
 	}
-	return expr, nil
+	return aggr, nil
 }
 
 type Comment struct {
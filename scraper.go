@@ -2,6 +2,7 @@ package platform
 
 import (
 	"context"
+	"time"
 )
 
 // ErrScraperTargetNotFound is the error msg for a missing scraper target.
@@ -14,6 +15,9 @@ const (
 	OpGetTargetByID = "GetTargetByID"
 	OpRemoveTarget  = "RemoveTarget"
 	OpUpdateTarget  = "UpdateTarget"
+
+	OpFindTargetStatus = "FindTargetStatus"
+	OpPutTargetStatus  = "PutTargetStatus"
 )
 
 // ScraperTarget is a target to scrape
@@ -24,6 +28,41 @@ type ScraperTarget struct {
 	URL        string      `json:"url"`
 	OrgName    string      `json:"org"`
 	BucketName string      `json:"bucket"`
+	// Interval is the time between scrapes of the target.
+	// Zero scrapes the target at the interval of the scheduler.
+	Interval time.Duration `json:"interval,omitempty"`
+	// Timeout is how long a scrape of the target may take.
+	// Zero uses the timeout of the scheduler.
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// ValidateSchedule reports whether the interval and the timeout of the target are valid.
+// They may not be negative, a non-zero interval must be at least a second,
+// and the timeout may not be longer than the interval.
+func (t *ScraperTarget) ValidateSchedule() error {
+	switch {
+	case t.Interval < 0:
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target interval may not be negative",
+		}
+	case t.Timeout < 0:
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target timeout may not be negative",
+		}
+	case t.Interval > 0 && t.Interval < time.Second:
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target interval must be at least 1s",
+		}
+	case t.Interval > 0 && t.Timeout > t.Interval:
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target timeout may not be longer than its interval",
+		}
+	}
+	return nil
 }
 
 // ScraperTargetStoreService defines the crud service for ScraperTarget.
@@ -35,6 +74,29 @@ type ScraperTargetStoreService interface {
 	UpdateTarget(ctx context.Context, t *ScraperTarget) (*ScraperTarget, error)
 }
 
+// ScraperTargetStatus is the outcome of the last scrape of a target.
+type ScraperTargetStatus struct {
+	TargetID ID `json:"targetID"`
+	// LastScrape is when the last scrape started.
+	LastScrape time.Time `json:"lastScrape"`
+	// Duration is how long the last scrape took.
+	Duration time.Duration `json:"duration"`
+	// Samples is the number of samples gathered by the last scrape.
+	Samples int `json:"samples"`
+	// LastError is the error of the last scrape, empty when it succeeded.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// ScraperTargetStatusService stores the status of the scrapes of targets.
+type ScraperTargetStatusService interface {
+	// FindTargetStatus returns the status of the last scrape of the target with id.
+	// Targets that have not been scraped yet have no status.
+	FindTargetStatus(ctx context.Context, id ID) (*ScraperTargetStatus, error)
+
+	// PutTargetStatus replaces the status of the target of s.
+	PutTargetStatus(ctx context.Context, s *ScraperTargetStatus) error
+}
+
 // ScraperTargetFilter represents a set of filter that restrict the returned results.
 type ScraperTargetFilter struct {
 	ID   *ID     `json:"id"`
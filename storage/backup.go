@@ -0,0 +1,385 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	ptar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsm1"
+	"go.uber.org/zap"
+)
+
+// Backup writes a consistent, point-in-time snapshot of the engine's series
+// file, index and TSM data to tw. Entries are written beneath the default
+// series file, index and engine directory names, regardless of any paths
+// overridden in the engine's configuration.
+//
+// Writes are blocked while the snapshot is taken, but not while it is being
+// written to tw.
+func (e *Engine) Backup(ctx context.Context, tw *tar.Writer) error {
+	dir, err := ioutil.TempDir("", "influxd-backup")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	tsmPath, err := e.createSnapshot(dir)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tsmPath)
+
+	if err := ptar.Stream(tw, filepath.Join(dir, DefaultSeriesFileDirectoryName), DefaultSeriesFileDirectoryName, nil); err != nil {
+		return err
+	}
+	if err := ptar.Stream(tw, filepath.Join(dir, DefaultIndexDirectoryName), DefaultIndexDirectoryName, nil); err != nil {
+		return err
+	}
+	return ptar.Stream(tw, tsmPath, DefaultEngineDirectoryName, nil)
+}
+
+// createSnapshot copies the series file and index into dir, and hardlinks the
+// current TSM files into a temporary directory whose path is returned.
+func (e *Engine) createSnapshot(dir string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closing == nil {
+		return "", ErrEngineClosed
+	}
+
+	// Prevent the series file and index from being rewritten underneath us.
+	e.sfile.DisableCompactions()
+	defer e.sfile.EnableCompactions()
+	e.index.DisableCompactions()
+	defer e.index.EnableCompactions()
+	e.index.Wait()
+
+	tsmPath, err := e.engine.CreateSnapshot()
+	if err != nil {
+		return "", err
+	}
+
+	// The series partition indexes are rebuilt from the segments when the
+	// series file is opened, so only the segments need to be copied.
+	if err := copyDir(filepath.Join(dir, DefaultSeriesFileDirectoryName), e.sfile.Path(), func(fi os.FileInfo) bool {
+		return tsdb.IsValidSeriesSegmentFilename(fi.Name())
+	}); err != nil {
+		os.RemoveAll(tsmPath)
+		return "", err
+	}
+
+	if err := copyDir(filepath.Join(dir, DefaultIndexDirectoryName), e.index.Path(), nil); err != nil {
+		os.RemoveAll(tsmPath)
+		return "", err
+	}
+	return tsmPath, nil
+}
+
+// BackupBucket writes the TSM data of a bucket written at or after since to
+// tw, beneath the default engine directory name. Only the TSM files holding
+// values written at or after since are read, so since should be the time the
+// previous backup of the bucket was started. A zero since writes all of the
+// bucket's data.
+//
+// Deletes are not held by TSM files once they are compacted, so a backup with
+// a non-zero since also holds the deletes from the bucket made at or after
+// since, as recorded by the engine, in a DeleteLogFileName entry.
+//
+// Series are not written separately as they are recreated from the TSM keys
+// by RestoreBucket.
+func (e *Engine) BackupBucket(ctx context.Context, tw *tar.Writer, orgID, bucketID platform.ID, since time.Time) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return ErrEngineClosed
+	}
+
+	// The deletes are read first, so that a delete made while the data is
+	// exported is held by the next backup rather than missed.
+	var deletes []deleteRecord
+	if !since.IsZero() {
+		deletes = e.deletes.since(bucketID, since.UnixNano())
+	}
+
+	if err := e.engine.ExportPrefix(tw, DefaultEngineDirectoryName, bucketPrefix(orgID, bucketID), sinceTime(since)); err != nil {
+		return err
+	} else if len(deletes) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, r := range deletes {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		buf.Write(append(b, '\n'))
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     DeleteLogFileName,
+		Mode:     0666,
+		Size:     int64(buf.Len()),
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(buf.Bytes())
+	return err
+}
+
+// RestoreBucket restores the data of a bucket from dir, which must have the
+// layout of an archive written by BackupBucket with the same since. A backup
+// with a zero since replaces all of the bucket's data. Otherwise the deletes
+// and writes held by the backup are applied to the existing data, so restoring
+// an incremental bucket backup on top of an earlier backup of the bucket
+// reproduces the bucket as it was when the incremental backup was taken.
+//
+// The deletes made by a restore are recorded like any other, so that they are
+// held by later backups of the bucket.
+func (e *Engine) RestoreBucket(ctx context.Context, orgID, bucketID platform.ID, since time.Time, dir string) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return ErrEngineClosed
+	}
+
+	deletes := func() error {
+		return e.deleteBucket(orgID, bucketID)
+	}
+	if !since.IsZero() {
+		records, err := readBucketDeletes(filepath.Join(dir, DeleteLogFileName))
+		if err != nil {
+			return err
+		}
+
+		// Every predicate is parsed before any data is deleted.
+		preds := make([]influxql.Expr, len(records))
+		for i, r := range records {
+			if preds[i], err = ParseDeletePredicate(r.Predicate); err != nil {
+				return err
+			}
+		}
+
+		deletes = func() error {
+			for i, r := range records {
+				if err := e.deleteBucketRangePredicate(orgID, bucketID, r.Min, r.Max, preds[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	return e.engine.ImportPrefix(filepath.Join(dir, DefaultEngineDirectoryName), bucketPrefix(orgID, bucketID), deletes)
+}
+
+// readBucketDeletes returns the deletes held by the DeleteLogFileName entry of
+// a bucket backup extracted to path. A missing entry holds no deletes.
+func readBucketDeletes(path string) ([]deleteRecord, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	records, complete, err := readDeleteRecords(b)
+	if err != nil {
+		return nil, err
+	} else if !complete {
+		return nil, fmt.Errorf("%s is truncated", DeleteLogFileName)
+	}
+	return records, nil
+}
+
+// bucketPrefix returns the prefix of the TSM keys of a bucket.
+func bucketPrefix(orgID, bucketID platform.ID) []byte {
+	encoded := tsdb.EncodeName(orgID, bucketID)
+	return models.EscapeMeasurement(encoded[:])
+}
+
+// sinceTime returns the minimum time the values of the TSM files held by a
+// bucket backup taken since t were written at.
+func sinceTime(t time.Time) int64 {
+	if t.IsZero() {
+		return math.MinInt64
+	}
+	return t.UnixNano()
+}
+
+// Restore replaces the engine's series file, index and TSM data with the
+// contents of dir, which must have the layout of an archive written by Backup.
+// Any data in the engine's cache and WAL is discarded.
+//
+// All of the data is replaced, so the restored data is treated as written,
+// and all earlier data as deleted, when the restore is made. Later bucket
+// backups taken since an earlier time therefore hold all of a bucket's data.
+//
+// If the engine is open it is unavailable while the data is being replaced.
+func (e *Engine) Restore(ctx context.Context, dir string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	open := e.closing != nil
+	if open {
+		// Flushing the cache removes its contents and any closed WAL segments
+		// so that they are not replayed on top of the restored data.
+		if err := e.engine.WriteSnapshot(); err != nil {
+			return err
+		}
+
+		if err := e.engine.Close(); err != nil {
+			return err
+		}
+		if err := e.index.Close(); err != nil {
+			return err
+		}
+		if err := e.sfile.Close(); err != nil {
+			return err
+		}
+	}
+
+	for src, dst := range map[string]string{
+		DefaultSeriesFileDirectoryName: e.sfile.Path(),
+		DefaultIndexDirectoryName:      e.index.Path(),
+		DefaultEngineDirectoryName:     e.engine.Path(),
+	} {
+		if err := os.RemoveAll(dst); err != nil {
+			return err
+		}
+		if err := moveDir(dst, filepath.Join(dir, src)); err != nil {
+			return err
+		}
+	}
+
+	if e.wal != nil {
+		if err := os.RemoveAll(e.wal.Path()); err != nil {
+			return err
+		}
+	}
+
+	if err := e.deletes.append(deleteRecord{Min: math.MinInt64, Max: math.MaxInt64}); err != nil {
+		return err
+	} else if err := touchTSMFiles(e.engine.Path(), e.deletes.now()); err != nil {
+		return err
+	}
+
+	if !open {
+		return nil
+	}
+
+	e.logger.Info("Reopening engine after restore", zap.String("path", e.path))
+	if err := e.sfile.Open(); err != nil {
+		return err
+	}
+	if err := e.index.Open(); err != nil {
+		return err
+	}
+	if err := e.engine.Open(); err != nil {
+		return err
+	}
+	e.engine.SetCompactionsEnabled(true)
+	return nil
+}
+
+// touchTSMFiles sets the modification time of the TSM files in dir, which is
+// the time their values were written, to t.
+func touchTSMFiles(dir string, t time.Time) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*."+tsm1.TSMFileExtension))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := os.Chtimes(path, t, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveDir moves the directory src to dst, falling back to a copy when the
+// directories are on different devices. A missing src results in an empty dst.
+func moveDir(dst, src string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return os.MkdirAll(dst, 0777)
+	} else if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	if err := copyDir(dst, src, nil); err != nil {
+		return err
+	}
+	return os.RemoveAll(src)
+}
+
+// copyDir copies all regular files beneath src into dst. If filter is not nil,
+// only files for which it returns true are copied.
+func copyDir(dst, src string, filter func(os.FileInfo) bool) error {
+	if err := os.MkdirAll(dst, 0777); err != nil {
+		return err
+	}
+
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, rel), 0777)
+		} else if !fi.Mode().IsRegular() || (filter != nil && !filter(fi)) {
+			return nil
+		}
+		return copyFile(filepath.Join(dst, rel), path)
+	})
+}
+
+// copyFile copies the contents of the file at src to a new file at dst.
+func copyFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,305 @@
+package storage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	ptar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/tsdb"
+)
+
+func TestEngine_BackupRestore(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+	engine.MustOpen()
+
+	pt := models.MustNewPoint(
+		"cpu",
+		models.NewTags(map[string]string{"host": "server"}),
+		map[string]interface{}{"value": 1.0},
+		time.Unix(1, 2),
+	)
+
+	if err := engine.Write1xPoints([]models.Point{pt}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := engine.Backup(context.Background(), tw); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Data written after the backup must not survive the restore.
+	pt2 := models.MustNewPoint(
+		"mem",
+		models.NewTags(map[string]string{"host": "server"}),
+		map[string]interface{}{"value": 2.0},
+		time.Unix(1, 2),
+	)
+
+	if err := engine.Write1xPoints([]models.Point{pt2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := engine.SeriesCardinality(), int64(2); got != exp {
+		t.Fatalf("got %d series, exp %d series in index", got, exp)
+	}
+
+	dir, err := ioutil.TempDir("", "storage_engine_restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ptar.Restore(&buf, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := engine.Restore(context.Background(), dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := engine.SeriesCardinality(), int64(1); got != exp {
+		t.Fatalf("got %d series, exp %d series in index after restore", got, exp)
+	}
+
+	stats, err := engine.MeasurementStats()
+	if err != nil {
+		t.Fatal(err)
+	} else if got, exp := len(stats), 1; got != exp {
+		t.Fatalf("got %d measurements in TSM data, exp %d", got, exp)
+	}
+
+	// The restored data must be durable across a reopen and writable.
+	engine.Engine.Close()
+	engine.MustOpen()
+
+	if got, exp := engine.SeriesCardinality(), int64(1); got != exp {
+		t.Fatalf("got %d series, exp %d series in index after reopen", got, exp)
+	}
+
+	if err := engine.Write1xPoints([]models.Point{pt2}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEngine_BackupRestoreBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	engine := NewEngine(storage.NewConfig(), storage.WithNow(func() time.Time { return now }))
+	defer engine.Close()
+	engine.MustOpen()
+
+	org, _ := platform.IDFromString("3131313131313131")
+	bucket, _ := platform.IDFromString("3232323232323232")
+
+	pt := models.MustNewPoint(
+		"cpu",
+		models.NewTags(map[string]string{"host": "server"}),
+		map[string]interface{}{"value": 1.0},
+		time.Unix(1, 0),
+	)
+
+	if err := engine.Write1xPoints([]models.Point{pt}); err != nil {
+		t.Fatal(err)
+	}
+
+	var full bytes.Buffer
+	tw := tar.NewWriter(&full)
+	if err := engine.Backup(context.Background(), tw); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only data written or deleted at or after since is held by the
+	// incremental backup, whatever its timestamp.
+	now = now.Add(time.Minute)
+	since := now
+	pt2 := models.MustNewPoint(
+		"mem",
+		models.NewTags(map[string]string{"host": "server"}),
+		map[string]interface{}{"value": 2.0},
+		time.Unix(0, 0),
+	)
+
+	if err := engine.Write1xPoints([]models.Point{pt2}); err != nil {
+		t.Fatal(err)
+	}
+
+	pred, err := storage.ParseDeletePredicate(`_measurement="cpu"`)
+	if err != nil {
+		t.Fatal(err)
+	} else if err := engine.DeleteBucketRangePredicate(*org, *bucket, math.MinInt64, math.MaxInt64, pred); err != nil {
+		t.Fatal(err)
+	}
+
+	// The delete must be held by the backup even once the engine is reopened.
+	engine.Engine.Close()
+	engine.MustOpen()
+
+	var incr bytes.Buffer
+	tw = tar.NewWriter(&incr)
+	if err := engine.BackupBucket(context.Background(), tw, *org, *bucket, since); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fullDir, err := ioutil.TempDir("", "storage_engine_restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fullDir)
+
+	if err := ptar.Restore(&full, fullDir); err != nil {
+		t.Fatal(err)
+	} else if err := engine.Restore(context.Background(), fullDir); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := engine.MustMeasurementNames(), []string{"cpu"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("got measurements %v, exp %v after full restore", got, exp)
+	}
+
+	incrDir, err := ioutil.TempDir("", "storage_engine_restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(incrDir)
+
+	if err := ptar.Restore(&incr, incrDir); err != nil {
+		t.Fatal(err)
+	} else if err := engine.RestoreBucket(context.Background(), *org, *bucket, since, incrDir); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := engine.MustMeasurementNames(), []string{"mem"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("got measurements %v, exp %v after incremental restore", got, exp)
+	}
+}
+
+func TestEngine_BackupBucket_Restored(t *testing.T) {
+	now := time.Unix(0, 0)
+	engine := NewEngine(storage.NewConfig(), storage.WithNow(func() time.Time { return now }))
+	defer engine.Close()
+	engine.MustOpen()
+
+	org, _ := platform.IDFromString("3131313131313131")
+	bucket, _ := platform.IDFromString("3232323232323232")
+
+	pt := models.MustNewPoint(
+		"cpu",
+		models.NewTags(map[string]string{"host": "server"}),
+		map[string]interface{}{"value": 1.0},
+		time.Unix(1, 0),
+	)
+
+	if err := engine.Write1xPoints([]models.Point{pt}); err != nil {
+		t.Fatal(err)
+	}
+
+	var full bytes.Buffer
+	tw := tar.NewWriter(&full)
+	if err := engine.Backup(context.Background(), tw); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(time.Minute)
+	since := now
+
+	dir, err := ioutil.TempDir("", "storage_engine_restore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ptar.Restore(&full, dir); err != nil {
+		t.Fatal(err)
+	} else if err := engine.Restore(context.Background(), dir); err != nil {
+		t.Fatal(err)
+	}
+
+	// A full restore replaces all data, so a bucket backup taken since an
+	// earlier time must hold all of the restored data.
+	var incr bytes.Buffer
+	tw = tar.NewWriter(&incr)
+	if err := engine.BackupBucket(context.Background(), tw, *org, *bucket, since); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var tsmN int
+	var names []string
+	tr := tar.NewReader(&incr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if filepath.Ext(hdr.Name) == ".tsm" {
+			tsmN++
+		}
+		names = append(names, filepath.Base(hdr.Name))
+	}
+	if tsmN != 1 || names[len(names)-1] != storage.DeleteLogFileName {
+		t.Fatalf("got archive entries %v, exp a TSM file and %s", names, storage.DeleteLogFileName)
+	}
+}
+
+func TestEngine_Backup_Closed(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+
+	if err := engine.Backup(context.Background(), tar.NewWriter(ioutil.Discard)); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+// MustMeasurementNames returns the sorted names of the measurements of every series in the engine.
+func (e *Engine) MustMeasurementNames() []string {
+	cur, err := e.CreateSeriesCursor(context.Background(), storage.SeriesCursorRequest{}, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer cur.Close()
+
+	seen := make(map[string]struct{})
+	var names []string
+	for {
+		row, err := cur.Next()
+		if err != nil {
+			panic(err)
+		} else if row == nil {
+			break
+		}
+
+		name := string(row.Tags.Get([]byte(tsdb.MeasurementTagKey)))
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/tsdb"
+)
+
+// PointsDeleter deletes points from buckets.
+type PointsDeleter interface {
+	DeleteBucketRangePredicate(orgID, bucketID platform.ID, min, max int64, pred influxql.Expr) error
+}
+
+// DeleteBucketRangePredicate removes the points of a bucket with a timestamp
+// within [min, max] from every series matched by pred. A nil pred matches all
+// series in the bucket. Series left without any points are removed from the
+// index.
+func (e *Engine) DeleteBucketRangePredicate(orgID, bucketID platform.ID, min, max int64, pred influxql.Expr) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return ErrEngineClosed
+	}
+	return e.deleteBucketRangePredicate(orgID, bucketID, min, max, pred)
+}
+
+// deleteBucketRangePredicate records the delete described by its arguments
+// and removes the points. The caller must hold a lock on the engine.
+func (e *Engine) deleteBucketRangePredicate(orgID, bucketID platform.ID, min, max int64, pred influxql.Expr) error {
+	r := deleteRecord{BucketID: &bucketID, Min: min, Max: max}
+	if pred != nil {
+		// The string form of a rewritten predicate is parsed by
+		// ParseDeletePredicate into the same predicate.
+		r.Predicate = pred.String()
+	}
+	if err := e.deletes.append(r); err != nil {
+		return err
+	}
+
+	name := tsdb.EncodeName(orgID, bucketID)
+	cur, err := newSeriesCursor(SeriesCursorRequest{
+		Measurements: tsdb.NewMeasurementSliceIterator([][]byte{name[:]}),
+	}, e.index, pred)
+	if err != nil {
+		return err
+	}
+	defer cur.Close()
+
+	return e.engine.DeleteSeriesRangeWithPredicate(newSeriesIteratorAdapter(cur), func([]byte, models.Tags) (int64, int64, bool) {
+		return min, max, true
+	})
+}
+
+// ParseDeletePredicate parses a predicate of tag comparisons combined with AND
+// and OR, such as `host="a" AND region=~/^eu/`, into an expression that can be
+// passed to DeleteBucketRangePredicate. Tag values may be single or double
+// quoted, and the _measurement and _field keys match the measurement and field
+// of a point. An empty predicate returns a nil expression.
+func ParseDeletePredicate(s string) (influxql.Expr, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	expr, err := influxql.ParseExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteDeletePredicate(expr)
+}
+
+// rewriteDeletePredicate validates expr and rewrites it to refer to the tag
+// keys and values of the series it matches.
+func rewriteDeletePredicate(expr influxql.Expr) (influxql.Expr, error) {
+	switch n := expr.(type) {
+	case *influxql.ParenExpr:
+		e, err := rewriteDeletePredicate(n.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &influxql.ParenExpr{Expr: e}, nil
+
+	case *influxql.BinaryExpr:
+		switch n.Op {
+		case influxql.AND, influxql.OR:
+			lhs, err := rewriteDeletePredicate(n.LHS)
+			if err != nil {
+				return nil, err
+			}
+			rhs, err := rewriteDeletePredicate(n.RHS)
+			if err != nil {
+				return nil, err
+			}
+			return &influxql.BinaryExpr{Op: n.Op, LHS: lhs, RHS: rhs}, nil
+
+		case influxql.EQ, influxql.NEQ, influxql.EQREGEX, influxql.NEQREGEX:
+			key, ok := n.LHS.(*influxql.VarRef)
+			if !ok {
+				return nil, fmt.Errorf("invalid delete predicate %q: expected a tag key", n)
+			}
+
+			regex := n.Op == influxql.EQREGEX || n.Op == influxql.NEQREGEX
+			var value influxql.Expr
+			switch rhs := n.RHS.(type) {
+			case *influxql.VarRef:
+				// Double quoted values are parsed as identifiers.
+				value = &influxql.StringLiteral{Val: rhs.Val}
+			case *influxql.StringLiteral:
+				value = rhs
+			case *influxql.RegexLiteral:
+				value = rhs
+			}
+
+			if _, ok := value.(*influxql.RegexLiteral); value == nil || ok != regex {
+				return nil, fmt.Errorf("invalid delete predicate %q: unsupported tag value", n)
+			}
+			return &influxql.BinaryExpr{Op: n.Op, LHS: &influxql.VarRef{Val: deleteTagKey(key.Val)}, RHS: value}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid delete predicate %q: only tag comparisons are supported", expr)
+}
+
+// deleteTagKey returns the tag key a predicate key refers to.
+func deleteTagKey(key string) string {
+	switch key {
+	case "_measurement":
+		return tsdb.MeasurementTagKey
+	case "_field":
+		return tsdb.FieldKeyTagKey
+	default:
+		return key
+	}
+}
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+// DeleteLogFileName is the name of the file holding the deletes made by the
+// engine, and of the archive entry holding the deletes of a bucket backup.
+const DeleteLogFileName = "deletes.log"
+
+// A deleteRecord describes the deletion of the points of a bucket with a
+// timestamp within [Min, Max] from the series matched by Predicate.
+type deleteRecord struct {
+	// Time is the unix nanosecond time the delete was made at.
+	Time int64 `json:"time"`
+
+	// BucketID is the bucket the points were deleted from. A nil BucketID
+	// deletes the points of every bucket.
+	BucketID *platform.ID `json:"bucketID,omitempty"`
+
+	Min int64 `json:"min"`
+	Max int64 `json:"max"`
+
+	// Predicate is the delete predicate, as accepted by ParseDeletePredicate.
+	// An empty Predicate matches every series.
+	Predicate string `json:"predicate,omitempty"`
+}
+
+// covers returns true if r deletes every point deleted by o, at or after o.
+func (r deleteRecord) covers(o deleteRecord) bool {
+	if r.Time < o.Time || (r.BucketID != nil && (o.BucketID == nil || *r.BucketID != *o.BucketID)) {
+		return false
+	}
+	return r.Min <= o.Min && r.Max >= o.Max && (r.Predicate == "" || r.Predicate == o.Predicate)
+}
+
+// The deleteLog records the deletes made by the engine, so that incremental
+// bucket backups can carry the deletes of data written before they were
+// taken. TSM files cannot carry them, as a compaction removes the deleted
+// points from its files rather than recording the delete.
+//
+// Records are held as lines of JSON. Records covered by a later record are
+// dropped, so the log holds at most one retention record per bucket.
+type deleteLog struct {
+	mu      sync.Mutex
+	path    string
+	records []deleteRecord
+
+	// now returns the time deletes are made at.
+	now func() time.Time
+}
+
+// newDeleteLog returns a delete log held by the file at path.
+func newDeleteLog(path string) *deleteLog {
+	return &deleteLog{path: path, now: time.Now}
+}
+
+// open reads the records of the log. A missing file is an empty log, and a
+// last record that was not fully written is discarded.
+func (l *deleteLog) open() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, err := ioutil.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		l.records = nil
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	records, complete, err := readDeleteRecords(b)
+	if err != nil {
+		return err
+	}
+	l.records = records
+	if !complete {
+		return l.write()
+	}
+	return nil
+}
+
+// readDeleteRecords returns the records held by b, and whether its last line
+// was complete.
+func readDeleteRecords(b []byte) ([]deleteRecord, bool, error) {
+	var records []deleteRecord
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		var r deleteRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			if len(b) > 0 && b[len(b)-1] != '\n' && !scanner.Scan() {
+				return records, false, nil
+			}
+			return nil, false, err
+		}
+		records = append(records, r)
+	}
+	return records, true, scanner.Err()
+}
+
+// append records a delete made now. It must be called before the points are
+// deleted, so that a failed delete is never missing from the log.
+func (l *deleteLog) append(r deleteRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r.Time = l.now().UnixNano()
+
+	records := l.records[:0:0]
+	for _, o := range l.records {
+		if !r.covers(o) {
+			records = append(records, o)
+		}
+	}
+	dropped := len(records) < len(l.records)
+	l.records = append(records, r)
+
+	if dropped {
+		return l.write()
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// write replaces the file of the log with its records.
+func (l *deleteLog) write() error {
+	var buf bytes.Buffer
+	for _, r := range l.records {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		buf.Write(append(b, '\n'))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0777); err != nil {
+		return err
+	}
+
+	tmp := l.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// since returns the records of the deletes from a bucket made at or after
+// since, a unix nanosecond time. Deletes from every bucket are returned as
+// deletes from the bucket.
+func (l *deleteLog) since(bucketID platform.ID, since int64) []deleteRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var records []deleteRecord
+	for _, r := range l.records {
+		if r.Time < since || (r.BucketID != nil && *r.BucketID != bucketID) {
+			continue
+		}
+		r.BucketID = &bucketID
+		records = append(records, r)
+	}
+	return records
+}
@@ -0,0 +1,96 @@
+package storage_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/storage"
+)
+
+func TestEngine_DeleteBucketRangePredicate(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+	engine.MustOpen()
+
+	pts := []models.Point{
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "a", "region": "eu"}), map[string]interface{}{"value": 1.0}, time.Unix(1, 0)),
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "b", "region": "eu"}), map[string]interface{}{"value": 1.0}, time.Unix(1, 0)),
+		models.MustNewPoint("mem", models.NewTags(map[string]string{"host": "a", "region": "us"}), map[string]interface{}{"value": 1.0}, time.Unix(1, 0)),
+	}
+	if err := engine.Write1xPoints(pts); err != nil {
+		t.Fatal(err)
+	}
+
+	org, _ := platform.IDFromString("3131313131313131")
+	bucket, _ := platform.IDFromString("3232323232323232")
+
+	// Deleting from another bucket must not remove anything.
+	pred, err := storage.ParseDeletePredicate(`host="a"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.DeleteBucketRangePredicate(*org, *org, math.MinInt64, math.MaxInt64, pred); err != nil {
+		t.Fatal(err)
+	} else if got, exp := engine.SeriesCardinality(), int64(3); got != exp {
+		t.Fatalf("got %d series, exp %d series", got, exp)
+	}
+
+	pred, err = storage.ParseDeletePredicate(`host="a" AND region="eu"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.DeleteBucketRangePredicate(*org, *bucket, math.MinInt64, math.MaxInt64, pred); err != nil {
+		t.Fatal(err)
+	} else if got, exp := engine.SeriesCardinality(), int64(2); got != exp {
+		t.Fatalf("got %d series, exp %d series", got, exp)
+	}
+
+	// A nil predicate removes all series of the bucket.
+	if err := engine.DeleteBucketRangePredicate(*org, *bucket, math.MinInt64, math.MaxInt64, nil); err != nil {
+		t.Fatal(err)
+	} else if got, exp := engine.SeriesCardinality(), int64(0); got != exp {
+		t.Fatalf("got %d series, exp %d series", got, exp)
+	}
+}
+
+func TestParseDeletePredicate(t *testing.T) {
+	tests := []struct {
+		predicate string
+		exp       string
+		wantErr   bool
+	}{
+		{predicate: ``, exp: `<nil>`},
+		{predicate: `host="a"`, exp: `host = 'a'`},
+		{predicate: `host='a' AND region!="eu"`, exp: `host = 'a' AND region != 'eu'`},
+		{predicate: `(_measurement="cpu" OR _field="usage") AND host=~/^a/`, exp: `(_m = 'cpu' OR _f = 'usage') AND host =~ /^a/`},
+		{predicate: `host`, wantErr: true},
+		{predicate: `host=1`, wantErr: true},
+		{predicate: `"a"=host AND x=1`, wantErr: true},
+		{predicate: `host=~"a"`, wantErr: true},
+		{predicate: `host=/a/`, wantErr: true},
+		{predicate: `value > 1`, wantErr: true},
+		{predicate: `host="a" AND`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.predicate, func(t *testing.T) {
+			expr, err := storage.ParseDeletePredicate(tt.predicate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			} else if tt.wantErr {
+				return
+			}
+
+			got := "<nil>"
+			if expr != nil {
+				got = expr.String()
+			}
+			if got != tt.exp {
+				t.Fatalf("got %s, exp %s", got, tt.exp)
+			}
+		})
+	}
+}
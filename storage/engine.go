@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -41,6 +42,12 @@ type Engine struct {
 	wal               *tsm1.WAL
 	retentionEnforcer *retentionEnforcer
 
+	// deletes records the deletes made by the engine for bucket backups.
+	deletes *deleteLog
+
+	// invalidator is notified of the buckets whose data the engine deletes by itself.
+	invalidator BucketInvalidator
+
 	defaultMetricLabels prometheus.Labels
 
 	// Tracks all goroutines started by the Engine.
@@ -84,6 +91,15 @@ func WithNodeID(id int) Option {
 func WithRetentionEnforcer(finder BucketFinder) Option {
 	return func(e *Engine) {
 		e.retentionEnforcer = newRetentionEnforcer(e, finder)
+		e.retentionEnforcer.deletes = e.deletes
+	}
+}
+
+// WithBucketInvalidator makes the engine notify inv of the buckets whose data it deletes
+// by itself, such as when enforcing their retention periods.
+func WithBucketInvalidator(inv BucketInvalidator) Option {
+	return func(e *Engine) {
+		e.invalidator = inv
 	}
 }
 
@@ -94,6 +110,15 @@ func WithFileStoreObserver(obs tsm1.FileStoreObserver) Option {
 	}
 }
 
+// WithNow makes the engine use now as the time data is written and deleted
+// at, such as when selecting the data held by a bucket backup.
+func WithNow(now func() time.Time) Option {
+	return func(e *Engine) {
+		e.deletes.now = now
+		e.engine.WithNow(now)
+	}
+}
+
 // WithCompactionPlanner makes the engine have the provided compaction planner.
 func WithCompactionPlanner(planner tsm1.CompactionPlanner) Option {
 	return func(e *Engine) {
@@ -109,6 +134,7 @@ func NewEngine(path string, c Config, options ...Option) *Engine {
 		path:                path,
 		defaultMetricLabels: prometheus.Labels{},
 		logger:              zap.NewNop(),
+		deletes:             newDeleteLog(filepath.Join(path, DeleteLogFileName)),
 	}
 
 	// Initialize series file.
@@ -197,6 +223,10 @@ func (e *Engine) Open() error {
 	}
 	e.engine.SetCompactionsEnabled(true) // TODO(edd):is this needed?
 
+	if err := e.deletes.open(); err != nil {
+		return err
+	}
+
 	e.closing = make(chan struct{})
 
 	// TODO(edd) background tasks will be run in priority order via a scheduler.
@@ -226,6 +256,7 @@ func (e *Engine) runRetentionEnforcer() {
 	if e.retentionEnforcer != nil {
 		// Set default metric labels on retention enforcer.
 		e.retentionEnforcer.metrics = newRetentionMetrics(e.defaultMetricLabels)
+		e.retentionEnforcer.Invalidator = e.invalidator
 	}
 
 	l := e.logger.With(zap.String("component", "retention_enforcer"), logger.DurationLiteral("check_interval", interval))
@@ -373,6 +404,19 @@ func (e *Engine) DeleteBucket(orgID, bucketID platform.ID) error {
 	if e.closing == nil {
 		return ErrEngineClosed
 	}
+	return e.deleteBucket(orgID, bucketID)
+}
+
+// deleteBucket records the deletion of an entire bucket and deletes it. The
+// caller must hold a lock on the engine.
+func (e *Engine) deleteBucket(orgID, bucketID platform.ID) error {
+	if err := e.deletes.append(deleteRecord{
+		BucketID: &bucketID,
+		Min:      math.MinInt64,
+		Max:      math.MaxInt64,
+	}); err != nil {
+		return err
+	}
 
 	// TODO(edd): we need to clean up how we're encoding the prefix so that we
 	// don't have to remember to get it right everywhere we need to touch TSM data.
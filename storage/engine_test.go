@@ -1,11 +1,14 @@
 package storage_test
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxql"
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/storage"
@@ -201,16 +204,81 @@ func TestEngine_WALDisabled(t *testing.T) {
 	}
 }
 
+func TestEngine_TagKeysAndValues(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+	engine.MustOpen()
+
+	pts := []models.Point{
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "a"}), map[string]interface{}{"value": 1.0}, time.Unix(1, 0)),
+		models.MustNewPoint("mem", models.NewTags(map[string]string{"host": "b", "region": "west"}), map[string]interface{}{"free": 2.0}, time.Unix(2, 0)),
+	}
+	if err := engine.Write1xPoints(pts); err != nil {
+		t.Fatal(err)
+	}
+
+	org, _ := platform.IDFromString("3131313131313131")
+	bucket, _ := platform.IDFromString("3232323232323232")
+	only := func(m string) storage.SeriesFilter {
+		return func(name []byte, tags models.Tags) (bool, error) {
+			return string(tags.Get(tsdb.MeasurementTagKeyBytes)) == m, nil
+		}
+	}
+
+	tests := []struct {
+		name   string
+		key    string
+		cond   string
+		filter storage.SeriesFilter
+		exp    []string
+	}{
+		{name: "tag keys", exp: []string{"_f", "_m", "host", "region"}},
+		{name: "tag keys matching cond", cond: "_m = 'cpu'", exp: []string{"_f", "_m", "host"}},
+		{name: "tag keys of filtered series", filter: only("cpu"), exp: []string{"_f", "_m", "host"}},
+		{name: "tag values", key: "host", exp: []string{"a", "b"}},
+		{name: "tag values matching cond", key: "_f", cond: "host = 'a'", exp: []string{"value"}},
+		{name: "tag values of filtered series", key: "host", filter: only("mem"), exp: []string{"b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cond influxql.Expr
+			if tt.cond != "" {
+				cond = influxql.MustParseExpr(tt.cond)
+			}
+
+			var got [][]byte
+			var err error
+			if tt.key == "" {
+				got, err = engine.TagKeys(context.Background(), *org, *bucket, cond, tt.filter)
+			} else {
+				got, err = engine.TagValues(context.Background(), *org, *bucket, []byte(tt.key), cond, tt.filter)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, b := range got {
+				names = append(names, string(b))
+			}
+			if !reflect.DeepEqual(names, tt.exp) {
+				t.Errorf("got %v, expected %v", names, tt.exp)
+			}
+		})
+	}
+}
+
 type Engine struct {
 	path string
 	*storage.Engine
 }
 
 // NewEngine create a new wrapper around a storage engine.
-func NewEngine(c storage.Config) *Engine {
+func NewEngine(c storage.Config, options ...storage.Option) *Engine {
 	path, _ := ioutil.TempDir("", "storage_engine_test")
 
-	engine := storage.NewEngine(path, c)
+	engine := storage.NewEngine(path, c, options...)
 	return &Engine{
 		path:   path,
 		Engine: engine,
@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/tsdb"
+)
+
+// SeriesFilter reports whether the series with name and tags is of interest.
+type SeriesFilter func(name []byte, tags models.Tags) (bool, error)
+
+// TagKeys returns the tag keys of the series of a bucket that match cond, read from the index.
+// When filter is not nil, only the keys of a series accepted by filter are returned.
+func (e *Engine) TagKeys(ctx context.Context, orgID, bucketID platform.ID, cond influxql.Expr, filter SeriesFilter) ([][]byte, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return nil, ErrEngineClosed
+	}
+
+	name := tsdb.EncodeName(orgID, bucketID)
+	itr, err := e.index.TagKeyIterator(name[:])
+	if err != nil {
+		return nil, err
+	} else if itr == nil {
+		return nil, nil
+	}
+	defer itr.Close()
+
+	matched, err := e.seriesIDsByExpr(name[:], cond)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys [][]byte
+	for {
+		key, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if key == nil {
+			return keys, nil
+		}
+
+		sitr, err := e.index.TagKeySeriesIDIterator(name[:], key)
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := e.anySeries(ctx, sitr, matched, filter); err != nil {
+			return nil, err
+		} else if ok {
+			keys = append(keys, append([]byte(nil), key...))
+		}
+	}
+}
+
+// TagValues returns the values of key of the series of a bucket that match cond, read from the index.
+// When filter is not nil, only the values of a series accepted by filter are returned.
+func (e *Engine) TagValues(ctx context.Context, orgID, bucketID platform.ID, key []byte, cond influxql.Expr, filter SeriesFilter) ([][]byte, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return nil, ErrEngineClosed
+	}
+
+	name := tsdb.EncodeName(orgID, bucketID)
+	itr, err := e.index.TagValueIterator(name[:], key)
+	if err != nil {
+		return nil, err
+	} else if itr == nil {
+		return nil, nil
+	}
+	defer itr.Close()
+
+	matched, err := e.seriesIDsByExpr(name[:], cond)
+	if err != nil {
+		return nil, err
+	}
+
+	var vals [][]byte
+	for {
+		val, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if val == nil {
+			return vals, nil
+		}
+
+		sitr, err := e.index.TagValueSeriesIDIterator(name[:], key, val)
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := e.anySeries(ctx, sitr, matched, filter); err != nil {
+			return nil, err
+		} else if ok {
+			vals = append(vals, append([]byte(nil), val...))
+		}
+	}
+}
+
+// seriesIDsByExpr returns the IDs of the series of the measurement name that match cond,
+// or nil if cond is nil and every series matches.
+func (e *Engine) seriesIDsByExpr(name []byte, cond influxql.Expr) (*tsdb.SeriesIDSet, error) {
+	if cond == nil {
+		return nil, nil
+	}
+
+	ids := tsdb.NewSeriesIDSet()
+	itr, err := e.index.MeasurementSeriesByExprIterator(name, cond)
+	if err != nil {
+		return nil, err
+	} else if itr == nil {
+		return ids, nil
+	}
+	defer itr.Close()
+
+	for {
+		elem, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if elem.SeriesID.IsZero() {
+			return ids, nil
+		}
+		ids.AddNoLock(elem.SeriesID)
+	}
+}
+
+// anySeries reports whether itr produces a series that is in matched, unless matched is nil,
+// and is accepted by filter, unless filter is nil. anySeries closes itr.
+func (e *Engine) anySeries(ctx context.Context, itr tsdb.SeriesIDIterator, matched *tsdb.SeriesIDSet, filter SeriesFilter) (bool, error) {
+	if itr == nil {
+		return false, nil
+	}
+	defer itr.Close()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		elem, err := itr.Next()
+		if err != nil {
+			return false, err
+		} else if elem.SeriesID.IsZero() {
+			return false, nil
+		}
+
+		if matched != nil && !matched.Contains(elem.SeriesID) {
+			continue
+		}
+		if filter == nil {
+			return true, nil
+		}
+
+		key := e.sfile.SeriesKey(elem.SeriesID)
+		if len(key) == 0 {
+			continue
+		}
+		if ok, err := filter(tsdb.ParseSeriesKey(key)); err != nil || ok {
+			return ok, err
+		}
+	}
+}
@@ -8,6 +8,8 @@ package reads
 
 import (
 	"errors"
+	"math"
+	"sort"
 
 	"github.com/influxdata/platform/tsdb/cursors"
 )
@@ -209,6 +211,321 @@ func (c floatArraySumCursor) Next() *cursors.FloatArray {
 	}
 }
 
+type floatArrayMinCursor struct {
+	cursors.FloatArrayCursor
+	res *cursors.FloatArray
+}
+
+func newFloatArrayMinCursor(cur cursors.FloatArrayCursor) *floatArrayMinCursor {
+	return &floatArrayMinCursor{
+		FloatArrayCursor: cur,
+		res:              cursors.NewFloatArrayLen(1),
+	}
+}
+
+func (c *floatArrayMinCursor) Stats() cursors.CursorStats { return c.FloatArrayCursor.Stats() }
+
+func (c *floatArrayMinCursor) Next() *cursors.FloatArray {
+	a := c.FloatArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	ts, acc := a.Timestamps[0], a.Values[0]
+	for {
+		for i, v := range a.Values {
+			if v < acc {
+				ts, acc = a.Timestamps[i], v
+			}
+		}
+		a = c.FloatArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			c.res.Timestamps[0] = ts
+			c.res.Values[0] = acc
+			return c.res
+		}
+	}
+}
+
+type floatArrayMaxCursor struct {
+	cursors.FloatArrayCursor
+	res *cursors.FloatArray
+}
+
+func newFloatArrayMaxCursor(cur cursors.FloatArrayCursor) *floatArrayMaxCursor {
+	return &floatArrayMaxCursor{
+		FloatArrayCursor: cur,
+		res:              cursors.NewFloatArrayLen(1),
+	}
+}
+
+func (c *floatArrayMaxCursor) Stats() cursors.CursorStats { return c.FloatArrayCursor.Stats() }
+
+func (c *floatArrayMaxCursor) Next() *cursors.FloatArray {
+	a := c.FloatArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	ts, acc := a.Timestamps[0], a.Values[0]
+	for {
+		for i, v := range a.Values {
+			if v > acc {
+				ts, acc = a.Timestamps[i], v
+			}
+		}
+		a = c.FloatArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			c.res.Timestamps[0] = ts
+			c.res.Values[0] = acc
+			return c.res
+		}
+	}
+}
+
+type floatFloatMeanArrayCursor struct {
+	cursors.FloatArrayCursor
+}
+
+func (c *floatFloatMeanArrayCursor) Stats() cursors.CursorStats {
+	return c.FloatArrayCursor.Stats()
+}
+
+func (c *floatFloatMeanArrayCursor) Next() *cursors.FloatArray {
+	a := c.FloatArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return &cursors.FloatArray{}
+	}
+
+	ts := a.Timestamps[0]
+	var sum float64
+	var n int64
+	for {
+		for _, v := range a.Values {
+			sum += float64(v)
+		}
+		n += int64(len(a.Values))
+		a = c.FloatArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			res := cursors.NewFloatArrayLen(1)
+			res.Timestamps[0] = ts
+			res.Values[0] = sum / float64(n)
+			return res
+		}
+	}
+}
+
+// floatArrayFirstCursor produces the first point of the underlying cursor
+// without reading any further.
+type floatArrayFirstCursor struct {
+	cursors.FloatArrayCursor
+	res  *cursors.FloatArray
+	done bool
+}
+
+func newFloatArrayFirstCursor(cur cursors.FloatArrayCursor) *floatArrayFirstCursor {
+	return &floatArrayFirstCursor{
+		FloatArrayCursor: cur,
+		res:              cursors.NewFloatArrayLen(1),
+	}
+}
+
+func (c *floatArrayFirstCursor) Stats() cursors.CursorStats { return c.FloatArrayCursor.Stats() }
+
+func (c *floatArrayFirstCursor) Next() *cursors.FloatArray {
+	if c.done {
+		return &cursors.FloatArray{}
+	}
+	c.done = true
+
+	a := c.FloatArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	c.res.Timestamps[0] = a.Timestamps[0]
+	c.res.Values[0] = a.Values[0]
+	return c.res
+}
+
+// floatArrayLastCursor produces the last point of the underlying cursor.
+type floatArrayLastCursor struct {
+	cursors.FloatArrayCursor
+	res *cursors.FloatArray
+}
+
+func newFloatArrayLastCursor(cur cursors.FloatArrayCursor) *floatArrayLastCursor {
+	return &floatArrayLastCursor{
+		FloatArrayCursor: cur,
+		res:              cursors.NewFloatArrayLen(1),
+	}
+}
+
+func (c *floatArrayLastCursor) Stats() cursors.CursorStats { return c.FloatArrayCursor.Stats() }
+
+func (c *floatArrayLastCursor) Next() *cursors.FloatArray {
+	a := c.FloatArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	for {
+		n := len(a.Timestamps) - 1
+		c.res.Timestamps[0] = a.Timestamps[n]
+		c.res.Values[0] = a.Values[n]
+		a = c.FloatArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			return c.res
+		}
+	}
+}
+
+// floatWindowArrayCursor produces the points of the underlying cursor one window at a time.
+// Next returns no points once the current window is exhausted,
+// until nextWindow moves to the window of the following point.
+type floatWindowArrayCursor struct {
+	cursors.FloatArrayCursor
+	every  int64
+	offset int64
+	stop   int64
+	res    *cursors.FloatArray
+	tmp    *cursors.FloatArray
+}
+
+func newFloatWindowArrayCursor(cur cursors.FloatArrayCursor, every, offset int64) *floatWindowArrayCursor {
+	return &floatWindowArrayCursor{
+		FloatArrayCursor: cur,
+		every:            every,
+		offset:           offset,
+		stop:             math.MinInt64,
+		res:              &cursors.FloatArray{},
+		tmp:              &cursors.FloatArray{},
+	}
+}
+
+func (c *floatWindowArrayCursor) Stats() cursors.CursorStats { return c.FloatArrayCursor.Stats() }
+
+func (c *floatWindowArrayCursor) Next() *cursors.FloatArray {
+	if c.tmp.Len() == 0 {
+		a := c.FloatArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+
+	n := sort.Search(c.tmp.Len(), func(i int) bool { return c.tmp.Timestamps[i] >= c.stop })
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:n], c.tmp.Values[:n]
+	c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[n:], c.tmp.Values[n:]
+	return c.res
+}
+
+func (c *floatWindowArrayCursor) nextWindow() (int64, bool) {
+	for c.Next().Len() > 0 {
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	start := windowStart(c.tmp.Timestamps[0], c.every, c.offset)
+	c.stop = start + c.every
+	return start, true
+}
+
+func (c *floatWindowArrayCursor) window() cursors.Cursor { return floatWindowView{c} }
+
+// floatWindowView reads the current window of a floatWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type floatWindowView struct {
+	*floatWindowArrayCursor
+}
+
+func (floatWindowView) Close() {}
+
+// floatPointWindowArrayCursor produces each point of the underlying cursor as its own window,
+// for cursors that already produce at most one point per window,
+// such as a windowed aggregate.
+type floatPointWindowArrayCursor struct {
+	cursors.FloatArrayCursor
+	every  int64
+	offset int64
+	read   bool
+	res    *cursors.FloatArray
+	tmp    *cursors.FloatArray
+}
+
+func newFloatPointWindowArrayCursor(cur cursors.FloatArrayCursor, every, offset int64) *floatPointWindowArrayCursor {
+	return &floatPointWindowArrayCursor{
+		FloatArrayCursor: cur,
+		every:            every,
+		offset:           offset,
+		read:             true,
+		res:              &cursors.FloatArray{},
+		tmp:              &cursors.FloatArray{},
+	}
+}
+
+func (c *floatPointWindowArrayCursor) Stats() cursors.CursorStats { return c.FloatArrayCursor.Stats() }
+
+func (c *floatPointWindowArrayCursor) Next() *cursors.FloatArray {
+	if c.read || c.tmp.Len() == 0 {
+		c.res.Timestamps, c.res.Values = c.res.Timestamps[:0], c.res.Values[:0]
+		return c.res
+	}
+	c.read = true
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:1], c.tmp.Values[:1]
+	return c.res
+}
+
+func (c *floatPointWindowArrayCursor) nextWindow() (int64, bool) {
+	if c.tmp.Len() > 0 {
+		c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[1:], c.tmp.Values[1:]
+	}
+	if c.tmp.Len() == 0 {
+		a := c.FloatArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	c.read = false
+	return windowStart(c.tmp.Timestamps[0], c.every, c.offset), true
+}
+
+func (c *floatPointWindowArrayCursor) window() cursors.Cursor { return floatPointWindowView{c} }
+
+// floatPointWindowView reads the current point of a floatPointWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type floatPointWindowView struct {
+	*floatPointWindowArrayCursor
+}
+
+func (floatPointWindowView) Close() {}
+
+// floatWindowAggregateArrayCursor applies an aggregate to each window of a cursor,
+// producing a point for each window.
+type floatWindowAggregateArrayCursor struct {
+	windowArrayCursor
+	agg func() cursors.Cursor
+	res *cursors.FloatArray
+}
+
+func (c *floatWindowAggregateArrayCursor) Next() *cursors.FloatArray {
+	c.res.Timestamps = c.res.Timestamps[:0]
+	c.res.Values = c.res.Values[:0]
+
+	for len(c.res.Timestamps) < MaxPointsPerBlock {
+		if _, ok := c.nextWindow(); !ok {
+			break
+		}
+		a := c.agg().(cursors.FloatArrayCursor).Next()
+		if a.Len() > 0 {
+			c.res.Timestamps = append(c.res.Timestamps, a.Timestamps[0])
+			c.res.Values = append(c.res.Values, a.Values[0])
+		}
+	}
+
+	return c.res
+}
+
 type integerFloatCountArrayCursor struct {
 	cursors.FloatArrayCursor
 }
@@ -438,85 +755,402 @@ func (c integerArraySumCursor) Next() *cursors.IntegerArray {
 	}
 }
 
-type integerIntegerCountArrayCursor struct {
+type integerArrayMinCursor struct {
 	cursors.IntegerArrayCursor
+	res *cursors.IntegerArray
 }
 
-func (c *integerIntegerCountArrayCursor) Stats() cursors.CursorStats {
-	return c.IntegerArrayCursor.Stats()
+func newIntegerArrayMinCursor(cur cursors.IntegerArrayCursor) *integerArrayMinCursor {
+	return &integerArrayMinCursor{
+		IntegerArrayCursor: cur,
+		res:                cursors.NewIntegerArrayLen(1),
+	}
 }
 
-func (c *integerIntegerCountArrayCursor) Next() *cursors.IntegerArray {
+func (c *integerArrayMinCursor) Stats() cursors.CursorStats { return c.IntegerArrayCursor.Stats() }
+
+func (c *integerArrayMinCursor) Next() *cursors.IntegerArray {
 	a := c.IntegerArrayCursor.Next()
 	if len(a.Timestamps) == 0 {
-		return &cursors.IntegerArray{}
+		return a
 	}
 
-	ts := a.Timestamps[0]
-	var acc int64
+	ts, acc := a.Timestamps[0], a.Values[0]
 	for {
-		acc += int64(len(a.Timestamps))
+		for i, v := range a.Values {
+			if v < acc {
+				ts, acc = a.Timestamps[i], v
+			}
+		}
 		a = c.IntegerArrayCursor.Next()
 		if len(a.Timestamps) == 0 {
-			res := cursors.NewIntegerArrayLen(1)
-			res.Timestamps[0] = ts
-			res.Values[0] = acc
-			return res
+			c.res.Timestamps[0] = ts
+			c.res.Values[0] = acc
+			return c.res
 		}
 	}
 }
 
-type integerEmptyArrayCursor struct {
-	res cursors.IntegerArray
+type integerArrayMaxCursor struct {
+	cursors.IntegerArrayCursor
+	res *cursors.IntegerArray
 }
 
-var IntegerEmptyArrayCursor cursors.IntegerArrayCursor = &integerEmptyArrayCursor{}
-
-func (c *integerEmptyArrayCursor) Err() error                  { return nil }
-func (c *integerEmptyArrayCursor) Close()                      {}
-func (c *integerEmptyArrayCursor) Stats() cursors.CursorStats  { return cursors.CursorStats{} }
-func (c *integerEmptyArrayCursor) Next() *cursors.IntegerArray { return &c.res }
+func newIntegerArrayMaxCursor(cur cursors.IntegerArrayCursor) *integerArrayMaxCursor {
+	return &integerArrayMaxCursor{
+		IntegerArrayCursor: cur,
+		res:                cursors.NewIntegerArrayLen(1),
+	}
+}
 
-// ********************
-// Unsigned Array Cursor
+func (c *integerArrayMaxCursor) Stats() cursors.CursorStats { return c.IntegerArrayCursor.Stats() }
 
-type unsignedArrayFilterCursor struct {
-	cursors.UnsignedArrayCursor
-	cond expression
-	m    *singleValue
-	res  *cursors.UnsignedArray
-	tmp  *cursors.UnsignedArray
-}
+func (c *integerArrayMaxCursor) Next() *cursors.IntegerArray {
+	a := c.IntegerArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
 
-func newUnsignedFilterArrayCursor(cond expression) *unsignedArrayFilterCursor {
-	return &unsignedArrayFilterCursor{
-		cond: cond,
-		m:    &singleValue{},
-		res:  cursors.NewUnsignedArrayLen(MaxPointsPerBlock),
-		tmp:  &cursors.UnsignedArray{},
+	ts, acc := a.Timestamps[0], a.Values[0]
+	for {
+		for i, v := range a.Values {
+			if v > acc {
+				ts, acc = a.Timestamps[i], v
+			}
+		}
+		a = c.IntegerArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			c.res.Timestamps[0] = ts
+			c.res.Values[0] = acc
+			return c.res
+		}
 	}
 }
 
-func (c *unsignedArrayFilterCursor) reset(cur cursors.UnsignedArrayCursor) {
-	c.UnsignedArrayCursor = cur
-	c.tmp.Timestamps, c.tmp.Values = nil, nil
+type floatIntegerMeanArrayCursor struct {
+	cursors.IntegerArrayCursor
 }
 
-func (c *unsignedArrayFilterCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
-
-func (c *unsignedArrayFilterCursor) Next() *cursors.UnsignedArray {
-	pos := 0
-	c.res.Timestamps = c.res.Timestamps[:cap(c.res.Timestamps)]
-	c.res.Values = c.res.Values[:cap(c.res.Values)]
-
-	var a *cursors.UnsignedArray
+func (c *floatIntegerMeanArrayCursor) Stats() cursors.CursorStats {
+	return c.IntegerArrayCursor.Stats()
+}
 
-	if c.tmp.Len() > 0 {
-		a = c.tmp
-		c.tmp.Timestamps = nil
-		c.tmp.Values = nil
-	} else {
-		a = c.UnsignedArrayCursor.Next()
+func (c *floatIntegerMeanArrayCursor) Next() *cursors.FloatArray {
+	a := c.IntegerArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return &cursors.FloatArray{}
+	}
+
+	ts := a.Timestamps[0]
+	var sum float64
+	var n int64
+	for {
+		for _, v := range a.Values {
+			sum += float64(v)
+		}
+		n += int64(len(a.Values))
+		a = c.IntegerArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			res := cursors.NewFloatArrayLen(1)
+			res.Timestamps[0] = ts
+			res.Values[0] = sum / float64(n)
+			return res
+		}
+	}
+}
+
+// integerArrayFirstCursor produces the first point of the underlying cursor
+// without reading any further.
+type integerArrayFirstCursor struct {
+	cursors.IntegerArrayCursor
+	res  *cursors.IntegerArray
+	done bool
+}
+
+func newIntegerArrayFirstCursor(cur cursors.IntegerArrayCursor) *integerArrayFirstCursor {
+	return &integerArrayFirstCursor{
+		IntegerArrayCursor: cur,
+		res:                cursors.NewIntegerArrayLen(1),
+	}
+}
+
+func (c *integerArrayFirstCursor) Stats() cursors.CursorStats { return c.IntegerArrayCursor.Stats() }
+
+func (c *integerArrayFirstCursor) Next() *cursors.IntegerArray {
+	if c.done {
+		return &cursors.IntegerArray{}
+	}
+	c.done = true
+
+	a := c.IntegerArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	c.res.Timestamps[0] = a.Timestamps[0]
+	c.res.Values[0] = a.Values[0]
+	return c.res
+}
+
+// integerArrayLastCursor produces the last point of the underlying cursor.
+type integerArrayLastCursor struct {
+	cursors.IntegerArrayCursor
+	res *cursors.IntegerArray
+}
+
+func newIntegerArrayLastCursor(cur cursors.IntegerArrayCursor) *integerArrayLastCursor {
+	return &integerArrayLastCursor{
+		IntegerArrayCursor: cur,
+		res:                cursors.NewIntegerArrayLen(1),
+	}
+}
+
+func (c *integerArrayLastCursor) Stats() cursors.CursorStats { return c.IntegerArrayCursor.Stats() }
+
+func (c *integerArrayLastCursor) Next() *cursors.IntegerArray {
+	a := c.IntegerArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	for {
+		n := len(a.Timestamps) - 1
+		c.res.Timestamps[0] = a.Timestamps[n]
+		c.res.Values[0] = a.Values[n]
+		a = c.IntegerArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			return c.res
+		}
+	}
+}
+
+// integerWindowArrayCursor produces the points of the underlying cursor one window at a time.
+// Next returns no points once the current window is exhausted,
+// until nextWindow moves to the window of the following point.
+type integerWindowArrayCursor struct {
+	cursors.IntegerArrayCursor
+	every  int64
+	offset int64
+	stop   int64
+	res    *cursors.IntegerArray
+	tmp    *cursors.IntegerArray
+}
+
+func newIntegerWindowArrayCursor(cur cursors.IntegerArrayCursor, every, offset int64) *integerWindowArrayCursor {
+	return &integerWindowArrayCursor{
+		IntegerArrayCursor: cur,
+		every:              every,
+		offset:             offset,
+		stop:               math.MinInt64,
+		res:                &cursors.IntegerArray{},
+		tmp:                &cursors.IntegerArray{},
+	}
+}
+
+func (c *integerWindowArrayCursor) Stats() cursors.CursorStats { return c.IntegerArrayCursor.Stats() }
+
+func (c *integerWindowArrayCursor) Next() *cursors.IntegerArray {
+	if c.tmp.Len() == 0 {
+		a := c.IntegerArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+
+	n := sort.Search(c.tmp.Len(), func(i int) bool { return c.tmp.Timestamps[i] >= c.stop })
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:n], c.tmp.Values[:n]
+	c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[n:], c.tmp.Values[n:]
+	return c.res
+}
+
+func (c *integerWindowArrayCursor) nextWindow() (int64, bool) {
+	for c.Next().Len() > 0 {
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	start := windowStart(c.tmp.Timestamps[0], c.every, c.offset)
+	c.stop = start + c.every
+	return start, true
+}
+
+func (c *integerWindowArrayCursor) window() cursors.Cursor { return integerWindowView{c} }
+
+// integerWindowView reads the current window of a integerWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type integerWindowView struct {
+	*integerWindowArrayCursor
+}
+
+func (integerWindowView) Close() {}
+
+// integerPointWindowArrayCursor produces each point of the underlying cursor as its own window,
+// for cursors that already produce at most one point per window,
+// such as a windowed aggregate.
+type integerPointWindowArrayCursor struct {
+	cursors.IntegerArrayCursor
+	every  int64
+	offset int64
+	read   bool
+	res    *cursors.IntegerArray
+	tmp    *cursors.IntegerArray
+}
+
+func newIntegerPointWindowArrayCursor(cur cursors.IntegerArrayCursor, every, offset int64) *integerPointWindowArrayCursor {
+	return &integerPointWindowArrayCursor{
+		IntegerArrayCursor: cur,
+		every:              every,
+		offset:             offset,
+		read:               true,
+		res:                &cursors.IntegerArray{},
+		tmp:                &cursors.IntegerArray{},
+	}
+}
+
+func (c *integerPointWindowArrayCursor) Stats() cursors.CursorStats {
+	return c.IntegerArrayCursor.Stats()
+}
+
+func (c *integerPointWindowArrayCursor) Next() *cursors.IntegerArray {
+	if c.read || c.tmp.Len() == 0 {
+		c.res.Timestamps, c.res.Values = c.res.Timestamps[:0], c.res.Values[:0]
+		return c.res
+	}
+	c.read = true
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:1], c.tmp.Values[:1]
+	return c.res
+}
+
+func (c *integerPointWindowArrayCursor) nextWindow() (int64, bool) {
+	if c.tmp.Len() > 0 {
+		c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[1:], c.tmp.Values[1:]
+	}
+	if c.tmp.Len() == 0 {
+		a := c.IntegerArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	c.read = false
+	return windowStart(c.tmp.Timestamps[0], c.every, c.offset), true
+}
+
+func (c *integerPointWindowArrayCursor) window() cursors.Cursor { return integerPointWindowView{c} }
+
+// integerPointWindowView reads the current point of a integerPointWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type integerPointWindowView struct {
+	*integerPointWindowArrayCursor
+}
+
+func (integerPointWindowView) Close() {}
+
+// integerWindowAggregateArrayCursor applies an aggregate to each window of a cursor,
+// producing a point for each window.
+type integerWindowAggregateArrayCursor struct {
+	windowArrayCursor
+	agg func() cursors.Cursor
+	res *cursors.IntegerArray
+}
+
+func (c *integerWindowAggregateArrayCursor) Next() *cursors.IntegerArray {
+	c.res.Timestamps = c.res.Timestamps[:0]
+	c.res.Values = c.res.Values[:0]
+
+	for len(c.res.Timestamps) < MaxPointsPerBlock {
+		if _, ok := c.nextWindow(); !ok {
+			break
+		}
+		a := c.agg().(cursors.IntegerArrayCursor).Next()
+		if a.Len() > 0 {
+			c.res.Timestamps = append(c.res.Timestamps, a.Timestamps[0])
+			c.res.Values = append(c.res.Values, a.Values[0])
+		}
+	}
+
+	return c.res
+}
+
+type integerIntegerCountArrayCursor struct {
+	cursors.IntegerArrayCursor
+}
+
+func (c *integerIntegerCountArrayCursor) Stats() cursors.CursorStats {
+	return c.IntegerArrayCursor.Stats()
+}
+
+func (c *integerIntegerCountArrayCursor) Next() *cursors.IntegerArray {
+	a := c.IntegerArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return &cursors.IntegerArray{}
+	}
+
+	ts := a.Timestamps[0]
+	var acc int64
+	for {
+		acc += int64(len(a.Timestamps))
+		a = c.IntegerArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			res := cursors.NewIntegerArrayLen(1)
+			res.Timestamps[0] = ts
+			res.Values[0] = acc
+			return res
+		}
+	}
+}
+
+type integerEmptyArrayCursor struct {
+	res cursors.IntegerArray
+}
+
+var IntegerEmptyArrayCursor cursors.IntegerArrayCursor = &integerEmptyArrayCursor{}
+
+func (c *integerEmptyArrayCursor) Err() error                  { return nil }
+func (c *integerEmptyArrayCursor) Close()                      {}
+func (c *integerEmptyArrayCursor) Stats() cursors.CursorStats  { return cursors.CursorStats{} }
+func (c *integerEmptyArrayCursor) Next() *cursors.IntegerArray { return &c.res }
+
+// ********************
+// Unsigned Array Cursor
+
+type unsignedArrayFilterCursor struct {
+	cursors.UnsignedArrayCursor
+	cond expression
+	m    *singleValue
+	res  *cursors.UnsignedArray
+	tmp  *cursors.UnsignedArray
+}
+
+func newUnsignedFilterArrayCursor(cond expression) *unsignedArrayFilterCursor {
+	return &unsignedArrayFilterCursor{
+		cond: cond,
+		m:    &singleValue{},
+		res:  cursors.NewUnsignedArrayLen(MaxPointsPerBlock),
+		tmp:  &cursors.UnsignedArray{},
+	}
+}
+
+func (c *unsignedArrayFilterCursor) reset(cur cursors.UnsignedArrayCursor) {
+	c.UnsignedArrayCursor = cur
+	c.tmp.Timestamps, c.tmp.Values = nil, nil
+}
+
+func (c *unsignedArrayFilterCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c *unsignedArrayFilterCursor) Next() *cursors.UnsignedArray {
+	pos := 0
+	c.res.Timestamps = c.res.Timestamps[:cap(c.res.Timestamps)]
+	c.res.Values = c.res.Values[:cap(c.res.Values)]
+
+	var a *cursors.UnsignedArray
+
+	if c.tmp.Len() > 0 {
+		a = c.tmp
+		c.tmp.Timestamps = nil
+		c.tmp.Values = nil
+	} else {
+		a = c.UnsignedArrayCursor.Next()
 	}
 
 LOOP:
@@ -604,67 +1238,384 @@ func (c *unsignedMultiShardArrayCursor) nextArrayCursor() bool {
 		cur, _ = itr.Next(c.ctx, c.req)
 	}
 
-	var ok bool
-	if cur != nil {
-		var next cursors.UnsignedArrayCursor
-		next, ok = cur.(cursors.UnsignedArrayCursor)
-		if !ok {
-			cur.Close()
-			next = UnsignedEmptyArrayCursor
-			c.itrs = nil
-			c.err = errors.New("expected unsigned cursor")
-		} else {
-			if c.filter != nil {
-				c.filter.reset(next)
-				next = c.filter
-			}
-		}
-		c.UnsignedArrayCursor = next
-	} else {
-		c.UnsignedArrayCursor = UnsignedEmptyArrayCursor
+	var ok bool
+	if cur != nil {
+		var next cursors.UnsignedArrayCursor
+		next, ok = cur.(cursors.UnsignedArrayCursor)
+		if !ok {
+			cur.Close()
+			next = UnsignedEmptyArrayCursor
+			c.itrs = nil
+			c.err = errors.New("expected unsigned cursor")
+		} else {
+			if c.filter != nil {
+				c.filter.reset(next)
+				next = c.filter
+			}
+		}
+		c.UnsignedArrayCursor = next
+	} else {
+		c.UnsignedArrayCursor = UnsignedEmptyArrayCursor
+	}
+
+	return ok
+}
+
+type unsignedArraySumCursor struct {
+	cursors.UnsignedArrayCursor
+	ts  [1]int64
+	vs  [1]uint64
+	res *cursors.UnsignedArray
+}
+
+func newUnsignedArraySumCursor(cur cursors.UnsignedArrayCursor) *unsignedArraySumCursor {
+	return &unsignedArraySumCursor{
+		UnsignedArrayCursor: cur,
+		res:                 &cursors.UnsignedArray{},
+	}
+}
+
+func (c unsignedArraySumCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c unsignedArraySumCursor) Next() *cursors.UnsignedArray {
+	a := c.UnsignedArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	ts := a.Timestamps[0]
+	var acc uint64
+
+	for {
+		for _, v := range a.Values {
+			acc += v
+		}
+		a = c.UnsignedArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			c.ts[0] = ts
+			c.vs[0] = acc
+			c.res.Timestamps = c.ts[:]
+			c.res.Values = c.vs[:]
+			return c.res
+		}
+	}
+}
+
+type unsignedArrayMinCursor struct {
+	cursors.UnsignedArrayCursor
+	res *cursors.UnsignedArray
+}
+
+func newUnsignedArrayMinCursor(cur cursors.UnsignedArrayCursor) *unsignedArrayMinCursor {
+	return &unsignedArrayMinCursor{
+		UnsignedArrayCursor: cur,
+		res:                 cursors.NewUnsignedArrayLen(1),
+	}
+}
+
+func (c *unsignedArrayMinCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c *unsignedArrayMinCursor) Next() *cursors.UnsignedArray {
+	a := c.UnsignedArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	ts, acc := a.Timestamps[0], a.Values[0]
+	for {
+		for i, v := range a.Values {
+			if v < acc {
+				ts, acc = a.Timestamps[i], v
+			}
+		}
+		a = c.UnsignedArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			c.res.Timestamps[0] = ts
+			c.res.Values[0] = acc
+			return c.res
+		}
+	}
+}
+
+type unsignedArrayMaxCursor struct {
+	cursors.UnsignedArrayCursor
+	res *cursors.UnsignedArray
+}
+
+func newUnsignedArrayMaxCursor(cur cursors.UnsignedArrayCursor) *unsignedArrayMaxCursor {
+	return &unsignedArrayMaxCursor{
+		UnsignedArrayCursor: cur,
+		res:                 cursors.NewUnsignedArrayLen(1),
+	}
+}
+
+func (c *unsignedArrayMaxCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c *unsignedArrayMaxCursor) Next() *cursors.UnsignedArray {
+	a := c.UnsignedArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	ts, acc := a.Timestamps[0], a.Values[0]
+	for {
+		for i, v := range a.Values {
+			if v > acc {
+				ts, acc = a.Timestamps[i], v
+			}
+		}
+		a = c.UnsignedArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			c.res.Timestamps[0] = ts
+			c.res.Values[0] = acc
+			return c.res
+		}
+	}
+}
+
+type floatUnsignedMeanArrayCursor struct {
+	cursors.UnsignedArrayCursor
+}
+
+func (c *floatUnsignedMeanArrayCursor) Stats() cursors.CursorStats {
+	return c.UnsignedArrayCursor.Stats()
+}
+
+func (c *floatUnsignedMeanArrayCursor) Next() *cursors.FloatArray {
+	a := c.UnsignedArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return &cursors.FloatArray{}
+	}
+
+	ts := a.Timestamps[0]
+	var sum float64
+	var n int64
+	for {
+		for _, v := range a.Values {
+			sum += float64(v)
+		}
+		n += int64(len(a.Values))
+		a = c.UnsignedArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			res := cursors.NewFloatArrayLen(1)
+			res.Timestamps[0] = ts
+			res.Values[0] = sum / float64(n)
+			return res
+		}
+	}
+}
+
+// unsignedArrayFirstCursor produces the first point of the underlying cursor
+// without reading any further.
+type unsignedArrayFirstCursor struct {
+	cursors.UnsignedArrayCursor
+	res  *cursors.UnsignedArray
+	done bool
+}
+
+func newUnsignedArrayFirstCursor(cur cursors.UnsignedArrayCursor) *unsignedArrayFirstCursor {
+	return &unsignedArrayFirstCursor{
+		UnsignedArrayCursor: cur,
+		res:                 cursors.NewUnsignedArrayLen(1),
+	}
+}
+
+func (c *unsignedArrayFirstCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c *unsignedArrayFirstCursor) Next() *cursors.UnsignedArray {
+	if c.done {
+		return &cursors.UnsignedArray{}
+	}
+	c.done = true
+
+	a := c.UnsignedArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	c.res.Timestamps[0] = a.Timestamps[0]
+	c.res.Values[0] = a.Values[0]
+	return c.res
+}
+
+// unsignedArrayLastCursor produces the last point of the underlying cursor.
+type unsignedArrayLastCursor struct {
+	cursors.UnsignedArrayCursor
+	res *cursors.UnsignedArray
+}
+
+func newUnsignedArrayLastCursor(cur cursors.UnsignedArrayCursor) *unsignedArrayLastCursor {
+	return &unsignedArrayLastCursor{
+		UnsignedArrayCursor: cur,
+		res:                 cursors.NewUnsignedArrayLen(1),
+	}
+}
+
+func (c *unsignedArrayLastCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c *unsignedArrayLastCursor) Next() *cursors.UnsignedArray {
+	a := c.UnsignedArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	for {
+		n := len(a.Timestamps) - 1
+		c.res.Timestamps[0] = a.Timestamps[n]
+		c.res.Values[0] = a.Values[n]
+		a = c.UnsignedArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			return c.res
+		}
+	}
+}
+
+// unsignedWindowArrayCursor produces the points of the underlying cursor one window at a time.
+// Next returns no points once the current window is exhausted,
+// until nextWindow moves to the window of the following point.
+type unsignedWindowArrayCursor struct {
+	cursors.UnsignedArrayCursor
+	every  int64
+	offset int64
+	stop   int64
+	res    *cursors.UnsignedArray
+	tmp    *cursors.UnsignedArray
+}
+
+func newUnsignedWindowArrayCursor(cur cursors.UnsignedArrayCursor, every, offset int64) *unsignedWindowArrayCursor {
+	return &unsignedWindowArrayCursor{
+		UnsignedArrayCursor: cur,
+		every:               every,
+		offset:              offset,
+		stop:                math.MinInt64,
+		res:                 &cursors.UnsignedArray{},
+		tmp:                 &cursors.UnsignedArray{},
+	}
+}
+
+func (c *unsignedWindowArrayCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+
+func (c *unsignedWindowArrayCursor) Next() *cursors.UnsignedArray {
+	if c.tmp.Len() == 0 {
+		a := c.UnsignedArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+
+	n := sort.Search(c.tmp.Len(), func(i int) bool { return c.tmp.Timestamps[i] >= c.stop })
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:n], c.tmp.Values[:n]
+	c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[n:], c.tmp.Values[n:]
+	return c.res
+}
+
+func (c *unsignedWindowArrayCursor) nextWindow() (int64, bool) {
+	for c.Next().Len() > 0 {
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
 	}
 
-	return ok
+	start := windowStart(c.tmp.Timestamps[0], c.every, c.offset)
+	c.stop = start + c.every
+	return start, true
 }
 
-type unsignedArraySumCursor struct {
+func (c *unsignedWindowArrayCursor) window() cursors.Cursor { return unsignedWindowView{c} }
+
+// unsignedWindowView reads the current window of a unsignedWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type unsignedWindowView struct {
+	*unsignedWindowArrayCursor
+}
+
+func (unsignedWindowView) Close() {}
+
+// unsignedPointWindowArrayCursor produces each point of the underlying cursor as its own window,
+// for cursors that already produce at most one point per window,
+// such as a windowed aggregate.
+type unsignedPointWindowArrayCursor struct {
 	cursors.UnsignedArrayCursor
-	ts  [1]int64
-	vs  [1]uint64
-	res *cursors.UnsignedArray
+	every  int64
+	offset int64
+	read   bool
+	res    *cursors.UnsignedArray
+	tmp    *cursors.UnsignedArray
 }
 
-func newUnsignedArraySumCursor(cur cursors.UnsignedArrayCursor) *unsignedArraySumCursor {
-	return &unsignedArraySumCursor{
+func newUnsignedPointWindowArrayCursor(cur cursors.UnsignedArrayCursor, every, offset int64) *unsignedPointWindowArrayCursor {
+	return &unsignedPointWindowArrayCursor{
 		UnsignedArrayCursor: cur,
+		every:               every,
+		offset:              offset,
+		read:                true,
 		res:                 &cursors.UnsignedArray{},
+		tmp:                 &cursors.UnsignedArray{},
 	}
 }
 
-func (c unsignedArraySumCursor) Stats() cursors.CursorStats { return c.UnsignedArrayCursor.Stats() }
+func (c *unsignedPointWindowArrayCursor) Stats() cursors.CursorStats {
+	return c.UnsignedArrayCursor.Stats()
+}
 
-func (c unsignedArraySumCursor) Next() *cursors.UnsignedArray {
-	a := c.UnsignedArrayCursor.Next()
-	if len(a.Timestamps) == 0 {
-		return a
+func (c *unsignedPointWindowArrayCursor) Next() *cursors.UnsignedArray {
+	if c.read || c.tmp.Len() == 0 {
+		c.res.Timestamps, c.res.Values = c.res.Timestamps[:0], c.res.Values[:0]
+		return c.res
 	}
+	c.read = true
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:1], c.tmp.Values[:1]
+	return c.res
+}
 
-	ts := a.Timestamps[0]
-	var acc uint64
+func (c *unsignedPointWindowArrayCursor) nextWindow() (int64, bool) {
+	if c.tmp.Len() > 0 {
+		c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[1:], c.tmp.Values[1:]
+	}
+	if c.tmp.Len() == 0 {
+		a := c.UnsignedArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
 
-	for {
-		for _, v := range a.Values {
-			acc += v
+	c.read = false
+	return windowStart(c.tmp.Timestamps[0], c.every, c.offset), true
+}
+
+func (c *unsignedPointWindowArrayCursor) window() cursors.Cursor { return unsignedPointWindowView{c} }
+
+// unsignedPointWindowView reads the current point of a unsignedPointWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type unsignedPointWindowView struct {
+	*unsignedPointWindowArrayCursor
+}
+
+func (unsignedPointWindowView) Close() {}
+
+// unsignedWindowAggregateArrayCursor applies an aggregate to each window of a cursor,
+// producing a point for each window.
+type unsignedWindowAggregateArrayCursor struct {
+	windowArrayCursor
+	agg func() cursors.Cursor
+	res *cursors.UnsignedArray
+}
+
+func (c *unsignedWindowAggregateArrayCursor) Next() *cursors.UnsignedArray {
+	c.res.Timestamps = c.res.Timestamps[:0]
+	c.res.Values = c.res.Values[:0]
+
+	for len(c.res.Timestamps) < MaxPointsPerBlock {
+		if _, ok := c.nextWindow(); !ok {
+			break
 		}
-		a = c.UnsignedArrayCursor.Next()
-		if len(a.Timestamps) == 0 {
-			c.ts[0] = ts
-			c.vs[0] = acc
-			c.res.Timestamps = c.ts[:]
-			c.res.Values = c.vs[:]
-			return c.res
+		a := c.agg().(cursors.UnsignedArrayCursor).Next()
+		if a.Len() > 0 {
+			c.res.Timestamps = append(c.res.Timestamps, a.Timestamps[0])
+			c.res.Values = append(c.res.Values, a.Values[0])
 		}
 	}
+
+	return c.res
 }
 
 type integerUnsignedCountArrayCursor struct {
@@ -856,6 +1807,219 @@ func (c *stringMultiShardArrayCursor) nextArrayCursor() bool {
 	return ok
 }
 
+// stringArrayFirstCursor produces the first point of the underlying cursor
+// without reading any further.
+type stringArrayFirstCursor struct {
+	cursors.StringArrayCursor
+	res  *cursors.StringArray
+	done bool
+}
+
+func newStringArrayFirstCursor(cur cursors.StringArrayCursor) *stringArrayFirstCursor {
+	return &stringArrayFirstCursor{
+		StringArrayCursor: cur,
+		res:               cursors.NewStringArrayLen(1),
+	}
+}
+
+func (c *stringArrayFirstCursor) Stats() cursors.CursorStats { return c.StringArrayCursor.Stats() }
+
+func (c *stringArrayFirstCursor) Next() *cursors.StringArray {
+	if c.done {
+		return &cursors.StringArray{}
+	}
+	c.done = true
+
+	a := c.StringArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	c.res.Timestamps[0] = a.Timestamps[0]
+	c.res.Values[0] = a.Values[0]
+	return c.res
+}
+
+// stringArrayLastCursor produces the last point of the underlying cursor.
+type stringArrayLastCursor struct {
+	cursors.StringArrayCursor
+	res *cursors.StringArray
+}
+
+func newStringArrayLastCursor(cur cursors.StringArrayCursor) *stringArrayLastCursor {
+	return &stringArrayLastCursor{
+		StringArrayCursor: cur,
+		res:               cursors.NewStringArrayLen(1),
+	}
+}
+
+func (c *stringArrayLastCursor) Stats() cursors.CursorStats { return c.StringArrayCursor.Stats() }
+
+func (c *stringArrayLastCursor) Next() *cursors.StringArray {
+	a := c.StringArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	for {
+		n := len(a.Timestamps) - 1
+		c.res.Timestamps[0] = a.Timestamps[n]
+		c.res.Values[0] = a.Values[n]
+		a = c.StringArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			return c.res
+		}
+	}
+}
+
+// stringWindowArrayCursor produces the points of the underlying cursor one window at a time.
+// Next returns no points once the current window is exhausted,
+// until nextWindow moves to the window of the following point.
+type stringWindowArrayCursor struct {
+	cursors.StringArrayCursor
+	every  int64
+	offset int64
+	stop   int64
+	res    *cursors.StringArray
+	tmp    *cursors.StringArray
+}
+
+func newStringWindowArrayCursor(cur cursors.StringArrayCursor, every, offset int64) *stringWindowArrayCursor {
+	return &stringWindowArrayCursor{
+		StringArrayCursor: cur,
+		every:             every,
+		offset:            offset,
+		stop:              math.MinInt64,
+		res:               &cursors.StringArray{},
+		tmp:               &cursors.StringArray{},
+	}
+}
+
+func (c *stringWindowArrayCursor) Stats() cursors.CursorStats { return c.StringArrayCursor.Stats() }
+
+func (c *stringWindowArrayCursor) Next() *cursors.StringArray {
+	if c.tmp.Len() == 0 {
+		a := c.StringArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+
+	n := sort.Search(c.tmp.Len(), func(i int) bool { return c.tmp.Timestamps[i] >= c.stop })
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:n], c.tmp.Values[:n]
+	c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[n:], c.tmp.Values[n:]
+	return c.res
+}
+
+func (c *stringWindowArrayCursor) nextWindow() (int64, bool) {
+	for c.Next().Len() > 0 {
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	start := windowStart(c.tmp.Timestamps[0], c.every, c.offset)
+	c.stop = start + c.every
+	return start, true
+}
+
+func (c *stringWindowArrayCursor) window() cursors.Cursor { return stringWindowView{c} }
+
+// stringWindowView reads the current window of a stringWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type stringWindowView struct {
+	*stringWindowArrayCursor
+}
+
+func (stringWindowView) Close() {}
+
+// stringPointWindowArrayCursor produces each point of the underlying cursor as its own window,
+// for cursors that already produce at most one point per window,
+// such as a windowed aggregate.
+type stringPointWindowArrayCursor struct {
+	cursors.StringArrayCursor
+	every  int64
+	offset int64
+	read   bool
+	res    *cursors.StringArray
+	tmp    *cursors.StringArray
+}
+
+func newStringPointWindowArrayCursor(cur cursors.StringArrayCursor, every, offset int64) *stringPointWindowArrayCursor {
+	return &stringPointWindowArrayCursor{
+		StringArrayCursor: cur,
+		every:             every,
+		offset:            offset,
+		read:              true,
+		res:               &cursors.StringArray{},
+		tmp:               &cursors.StringArray{},
+	}
+}
+
+func (c *stringPointWindowArrayCursor) Stats() cursors.CursorStats {
+	return c.StringArrayCursor.Stats()
+}
+
+func (c *stringPointWindowArrayCursor) Next() *cursors.StringArray {
+	if c.read || c.tmp.Len() == 0 {
+		c.res.Timestamps, c.res.Values = c.res.Timestamps[:0], c.res.Values[:0]
+		return c.res
+	}
+	c.read = true
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:1], c.tmp.Values[:1]
+	return c.res
+}
+
+func (c *stringPointWindowArrayCursor) nextWindow() (int64, bool) {
+	if c.tmp.Len() > 0 {
+		c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[1:], c.tmp.Values[1:]
+	}
+	if c.tmp.Len() == 0 {
+		a := c.StringArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	c.read = false
+	return windowStart(c.tmp.Timestamps[0], c.every, c.offset), true
+}
+
+func (c *stringPointWindowArrayCursor) window() cursors.Cursor { return stringPointWindowView{c} }
+
+// stringPointWindowView reads the current point of a stringPointWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type stringPointWindowView struct {
+	*stringPointWindowArrayCursor
+}
+
+func (stringPointWindowView) Close() {}
+
+// stringWindowAggregateArrayCursor applies an aggregate to each window of a cursor,
+// producing a point for each window.
+type stringWindowAggregateArrayCursor struct {
+	windowArrayCursor
+	agg func() cursors.Cursor
+	res *cursors.StringArray
+}
+
+func (c *stringWindowAggregateArrayCursor) Next() *cursors.StringArray {
+	c.res.Timestamps = c.res.Timestamps[:0]
+	c.res.Values = c.res.Values[:0]
+
+	for len(c.res.Timestamps) < MaxPointsPerBlock {
+		if _, ok := c.nextWindow(); !ok {
+			break
+		}
+		a := c.agg().(cursors.StringArrayCursor).Next()
+		if a.Len() > 0 {
+			c.res.Timestamps = append(c.res.Timestamps, a.Timestamps[0])
+			c.res.Values = append(c.res.Values, a.Values[0])
+		}
+	}
+
+	return c.res
+}
+
 type integerStringCountArrayCursor struct {
 	cursors.StringArrayCursor
 }
@@ -1045,6 +2209,219 @@ func (c *booleanMultiShardArrayCursor) nextArrayCursor() bool {
 	return ok
 }
 
+// booleanArrayFirstCursor produces the first point of the underlying cursor
+// without reading any further.
+type booleanArrayFirstCursor struct {
+	cursors.BooleanArrayCursor
+	res  *cursors.BooleanArray
+	done bool
+}
+
+func newBooleanArrayFirstCursor(cur cursors.BooleanArrayCursor) *booleanArrayFirstCursor {
+	return &booleanArrayFirstCursor{
+		BooleanArrayCursor: cur,
+		res:                cursors.NewBooleanArrayLen(1),
+	}
+}
+
+func (c *booleanArrayFirstCursor) Stats() cursors.CursorStats { return c.BooleanArrayCursor.Stats() }
+
+func (c *booleanArrayFirstCursor) Next() *cursors.BooleanArray {
+	if c.done {
+		return &cursors.BooleanArray{}
+	}
+	c.done = true
+
+	a := c.BooleanArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	c.res.Timestamps[0] = a.Timestamps[0]
+	c.res.Values[0] = a.Values[0]
+	return c.res
+}
+
+// booleanArrayLastCursor produces the last point of the underlying cursor.
+type booleanArrayLastCursor struct {
+	cursors.BooleanArrayCursor
+	res *cursors.BooleanArray
+}
+
+func newBooleanArrayLastCursor(cur cursors.BooleanArrayCursor) *booleanArrayLastCursor {
+	return &booleanArrayLastCursor{
+		BooleanArrayCursor: cur,
+		res:                cursors.NewBooleanArrayLen(1),
+	}
+}
+
+func (c *booleanArrayLastCursor) Stats() cursors.CursorStats { return c.BooleanArrayCursor.Stats() }
+
+func (c *booleanArrayLastCursor) Next() *cursors.BooleanArray {
+	a := c.BooleanArrayCursor.Next()
+	if len(a.Timestamps) == 0 {
+		return a
+	}
+
+	for {
+		n := len(a.Timestamps) - 1
+		c.res.Timestamps[0] = a.Timestamps[n]
+		c.res.Values[0] = a.Values[n]
+		a = c.BooleanArrayCursor.Next()
+		if len(a.Timestamps) == 0 {
+			return c.res
+		}
+	}
+}
+
+// booleanWindowArrayCursor produces the points of the underlying cursor one window at a time.
+// Next returns no points once the current window is exhausted,
+// until nextWindow moves to the window of the following point.
+type booleanWindowArrayCursor struct {
+	cursors.BooleanArrayCursor
+	every  int64
+	offset int64
+	stop   int64
+	res    *cursors.BooleanArray
+	tmp    *cursors.BooleanArray
+}
+
+func newBooleanWindowArrayCursor(cur cursors.BooleanArrayCursor, every, offset int64) *booleanWindowArrayCursor {
+	return &booleanWindowArrayCursor{
+		BooleanArrayCursor: cur,
+		every:              every,
+		offset:             offset,
+		stop:               math.MinInt64,
+		res:                &cursors.BooleanArray{},
+		tmp:                &cursors.BooleanArray{},
+	}
+}
+
+func (c *booleanWindowArrayCursor) Stats() cursors.CursorStats { return c.BooleanArrayCursor.Stats() }
+
+func (c *booleanWindowArrayCursor) Next() *cursors.BooleanArray {
+	if c.tmp.Len() == 0 {
+		a := c.BooleanArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+
+	n := sort.Search(c.tmp.Len(), func(i int) bool { return c.tmp.Timestamps[i] >= c.stop })
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:n], c.tmp.Values[:n]
+	c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[n:], c.tmp.Values[n:]
+	return c.res
+}
+
+func (c *booleanWindowArrayCursor) nextWindow() (int64, bool) {
+	for c.Next().Len() > 0 {
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	start := windowStart(c.tmp.Timestamps[0], c.every, c.offset)
+	c.stop = start + c.every
+	return start, true
+}
+
+func (c *booleanWindowArrayCursor) window() cursors.Cursor { return booleanWindowView{c} }
+
+// booleanWindowView reads the current window of a booleanWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type booleanWindowView struct {
+	*booleanWindowArrayCursor
+}
+
+func (booleanWindowView) Close() {}
+
+// booleanPointWindowArrayCursor produces each point of the underlying cursor as its own window,
+// for cursors that already produce at most one point per window,
+// such as a windowed aggregate.
+type booleanPointWindowArrayCursor struct {
+	cursors.BooleanArrayCursor
+	every  int64
+	offset int64
+	read   bool
+	res    *cursors.BooleanArray
+	tmp    *cursors.BooleanArray
+}
+
+func newBooleanPointWindowArrayCursor(cur cursors.BooleanArrayCursor, every, offset int64) *booleanPointWindowArrayCursor {
+	return &booleanPointWindowArrayCursor{
+		BooleanArrayCursor: cur,
+		every:              every,
+		offset:             offset,
+		read:               true,
+		res:                &cursors.BooleanArray{},
+		tmp:                &cursors.BooleanArray{},
+	}
+}
+
+func (c *booleanPointWindowArrayCursor) Stats() cursors.CursorStats {
+	return c.BooleanArrayCursor.Stats()
+}
+
+func (c *booleanPointWindowArrayCursor) Next() *cursors.BooleanArray {
+	if c.read || c.tmp.Len() == 0 {
+		c.res.Timestamps, c.res.Values = c.res.Timestamps[:0], c.res.Values[:0]
+		return c.res
+	}
+	c.read = true
+	c.res.Timestamps, c.res.Values = c.tmp.Timestamps[:1], c.tmp.Values[:1]
+	return c.res
+}
+
+func (c *booleanPointWindowArrayCursor) nextWindow() (int64, bool) {
+	if c.tmp.Len() > 0 {
+		c.tmp.Timestamps, c.tmp.Values = c.tmp.Timestamps[1:], c.tmp.Values[1:]
+	}
+	if c.tmp.Len() == 0 {
+		a := c.BooleanArrayCursor.Next()
+		c.tmp.Timestamps, c.tmp.Values = a.Timestamps, a.Values
+	}
+	if c.tmp.Len() == 0 {
+		return 0, false
+	}
+
+	c.read = false
+	return windowStart(c.tmp.Timestamps[0], c.every, c.offset), true
+}
+
+func (c *booleanPointWindowArrayCursor) window() cursors.Cursor { return booleanPointWindowView{c} }
+
+// booleanPointWindowView reads the current point of a booleanPointWindowArrayCursor,
+// leaving the underlying cursor open when it is closed.
+type booleanPointWindowView struct {
+	*booleanPointWindowArrayCursor
+}
+
+func (booleanPointWindowView) Close() {}
+
+// booleanWindowAggregateArrayCursor applies an aggregate to each window of a cursor,
+// producing a point for each window.
+type booleanWindowAggregateArrayCursor struct {
+	windowArrayCursor
+	agg func() cursors.Cursor
+	res *cursors.BooleanArray
+}
+
+func (c *booleanWindowAggregateArrayCursor) Next() *cursors.BooleanArray {
+	c.res.Timestamps = c.res.Timestamps[:0]
+	c.res.Values = c.res.Values[:0]
+
+	for len(c.res.Timestamps) < MaxPointsPerBlock {
+		if _, ok := c.nextWindow(); !ok {
+			break
+		}
+		a := c.agg().(cursors.BooleanArrayCursor).Next()
+		if a.Len() > 0 {
+			c.res.Timestamps = append(c.res.Timestamps, a.Timestamps[0])
+			c.res.Values = append(c.res.Values, a.Values[0])
+		}
+	}
+
+	return c.res
+}
+
 type integerBooleanCountArrayCursor struct {
 	cursors.BooleanArrayCursor
 }
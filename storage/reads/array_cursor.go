@@ -3,7 +3,9 @@ package reads
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/influxdata/flux/values"
 	"github.com/influxdata/platform/storage/reads/datatypes"
 	"github.com/influxdata/platform/tsdb/cursors"
 )
@@ -16,19 +18,64 @@ func (v *singleValue) Value(key string) (interface{}, bool) {
 	return v.v, true
 }
 
-func newAggregateArrayCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) cursors.Cursor {
+// newAggregateArrayCursor returns a cursor producing agg of the points of cursor.
+// An error is returned if agg is invalid or does not support the type of cursor.
+func newAggregateArrayCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) (cursors.Cursor, error) {
 	if cursor == nil {
-		return nil
+		return nil, nil
+	}
+
+	if agg.WindowEvery > 0 {
+		return newWindowAggregateArrayCursor(ctx, agg, cursor)
 	}
 
+	var cur cursors.Cursor
 	switch agg.Type {
 	case datatypes.AggregateTypeSum:
-		return newSumArrayCursor(cursor)
+		cur = newSumArrayCursor(cursor)
 	case datatypes.AggregateTypeCount:
-		return newCountArrayCursor(cursor)
+		cur = newCountArrayCursor(cursor)
+	case datatypes.AggregateTypeMin:
+		cur = newMinArrayCursor(cursor)
+	case datatypes.AggregateTypeMax:
+		cur = newMaxArrayCursor(cursor)
+	case datatypes.AggregateTypeMean:
+		cur = newMeanArrayCursor(cursor)
+	case datatypes.AggregateTypeFirst, datatypes.AggregateTypeLast:
+		// The cursors are read in the order that produces the point first,
+		// see cursorAscending.
+		cur = newFirstArrayCursor(cursor)
 	default:
-		// TODO(sgc): should be validated higher up
-		panic("invalid aggregate")
+		return nil, fmt.Errorf("invalid aggregate: %v", agg.Type)
+	}
+
+	if cur == nil {
+		return nil, unsupportedAggregateError(agg.Type, cursor)
+	}
+	return cur, nil
+}
+
+// unsupportedAggregateError returns the error for aggregating the points of cur with typ,
+// which does not support their type.
+func unsupportedAggregateError(typ datatypes.Aggregate_AggregateType, cur cursors.Cursor) error {
+	return fmt.Errorf("unsupported aggregate %s for %s values", strings.ToLower(typ.String()), cursorValueType(cur))
+}
+
+// cursorValueType returns the name of the type of the values produced by cur.
+func cursorValueType(cur cursors.Cursor) string {
+	switch cur.(type) {
+	case cursors.FloatArrayCursor:
+		return "float"
+	case cursors.IntegerArrayCursor:
+		return "integer"
+	case cursors.UnsignedArrayCursor:
+		return "unsigned"
+	case cursors.StringArrayCursor:
+		return "string"
+	case cursors.BooleanArrayCursor:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", cur)
 	}
 }
 
@@ -41,11 +88,199 @@ func newSumArrayCursor(cur cursors.Cursor) cursors.Cursor {
 	case cursors.UnsignedArrayCursor:
 		return newUnsignedArraySumCursor(cur)
 	default:
-		// TODO(sgc): propagate an error instead?
 		return nil
 	}
 }
 
+func newMinArrayCursor(cur cursors.Cursor) cursors.Cursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return newFloatArrayMinCursor(cur)
+	case cursors.IntegerArrayCursor:
+		return newIntegerArrayMinCursor(cur)
+	case cursors.UnsignedArrayCursor:
+		return newUnsignedArrayMinCursor(cur)
+	default:
+		return nil
+	}
+}
+
+func newMaxArrayCursor(cur cursors.Cursor) cursors.Cursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return newFloatArrayMaxCursor(cur)
+	case cursors.IntegerArrayCursor:
+		return newIntegerArrayMaxCursor(cur)
+	case cursors.UnsignedArrayCursor:
+		return newUnsignedArrayMaxCursor(cur)
+	default:
+		return nil
+	}
+}
+
+func newMeanArrayCursor(cur cursors.Cursor) cursors.Cursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return &floatFloatMeanArrayCursor{FloatArrayCursor: cur}
+	case cursors.IntegerArrayCursor:
+		return &floatIntegerMeanArrayCursor{IntegerArrayCursor: cur}
+	case cursors.UnsignedArrayCursor:
+		return &floatUnsignedMeanArrayCursor{UnsignedArrayCursor: cur}
+	default:
+		return nil
+	}
+}
+
+func newFirstArrayCursor(cur cursors.Cursor) cursors.Cursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return newFloatArrayFirstCursor(cur)
+	case cursors.IntegerArrayCursor:
+		return newIntegerArrayFirstCursor(cur)
+	case cursors.UnsignedArrayCursor:
+		return newUnsignedArrayFirstCursor(cur)
+	case cursors.StringArrayCursor:
+		return newStringArrayFirstCursor(cur)
+	case cursors.BooleanArrayCursor:
+		return newBooleanArrayFirstCursor(cur)
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+func newLastArrayCursor(cur cursors.Cursor) cursors.Cursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return newFloatArrayLastCursor(cur)
+	case cursors.IntegerArrayCursor:
+		return newIntegerArrayLastCursor(cur)
+	case cursors.UnsignedArrayCursor:
+		return newUnsignedArrayLastCursor(cur)
+	case cursors.StringArrayCursor:
+		return newStringArrayLastCursor(cur)
+	case cursors.BooleanArrayCursor:
+		return newBooleanArrayLastCursor(cur)
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+// windowArrayCursor produces the points of a cursor one window at a time.
+type windowArrayCursor interface {
+	cursors.Cursor
+
+	// nextWindow moves to the window of the next point and returns its start,
+	// or returns false when there are no more points.
+	nextWindow() (int64, bool)
+
+	// window returns a cursor over the points of the current window.
+	window() cursors.Cursor
+}
+
+func newWindowArrayCursor(cur cursors.Cursor, every, offset int64) windowArrayCursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return newFloatWindowArrayCursor(cur, every, offset)
+	case cursors.IntegerArrayCursor:
+		return newIntegerWindowArrayCursor(cur, every, offset)
+	case cursors.UnsignedArrayCursor:
+		return newUnsignedWindowArrayCursor(cur, every, offset)
+	case cursors.StringArrayCursor:
+		return newStringWindowArrayCursor(cur, every, offset)
+	case cursors.BooleanArrayCursor:
+		return newBooleanWindowArrayCursor(cur, every, offset)
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+// newPointWindowArrayCursor produces each point of cur as its own window,
+// where cur already produces at most one point per window.
+func newPointWindowArrayCursor(cur cursors.Cursor, every, offset int64) windowArrayCursor {
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		return newFloatPointWindowArrayCursor(cur, every, offset)
+	case cursors.IntegerArrayCursor:
+		return newIntegerPointWindowArrayCursor(cur, every, offset)
+	case cursors.UnsignedArrayCursor:
+		return newUnsignedPointWindowArrayCursor(cur, every, offset)
+	case cursors.StringArrayCursor:
+		return newStringPointWindowArrayCursor(cur, every, offset)
+	case cursors.BooleanArrayCursor:
+		return newBooleanPointWindowArrayCursor(cur, every, offset)
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+// newWindowAggregateArrayCursor applies agg to each window of cursor,
+// as described by agg.WindowEvery and agg.WindowOffset.
+func newWindowAggregateArrayCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) (cursors.Cursor, error) {
+	w := newWindowArrayCursor(cursor, agg.WindowEvery, agg.WindowOffset)
+	newAggErr := func() (cursors.Cursor, error) {
+		if agg.Type == datatypes.AggregateTypeLast {
+			// windows are always read in ascending order
+			return newLastArrayCursor(w.window()), nil
+		}
+		return newAggregateArrayCursor(ctx, &datatypes.Aggregate{Type: agg.Type}, w.window())
+	}
+
+	// the type of the aggregate determines the type of the points produced,
+	// and is the same for every window once it is known to be supported
+	first, err := newAggErr()
+	if err != nil {
+		return nil, err
+	}
+	newAgg := func() cursors.Cursor {
+		cur, _ := newAggErr()
+		return cur
+	}
+
+	switch first.(type) {
+	case cursors.FloatArrayCursor:
+		return &floatWindowAggregateArrayCursor{windowArrayCursor: w, agg: newAgg, res: &cursors.FloatArray{}}, nil
+	case cursors.IntegerArrayCursor:
+		return &integerWindowAggregateArrayCursor{windowArrayCursor: w, agg: newAgg, res: &cursors.IntegerArray{}}, nil
+	case cursors.UnsignedArrayCursor:
+		return &unsignedWindowAggregateArrayCursor{windowArrayCursor: w, agg: newAgg, res: &cursors.UnsignedArray{}}, nil
+	case cursors.StringArrayCursor:
+		return &stringWindowAggregateArrayCursor{windowArrayCursor: w, agg: newAgg, res: &cursors.StringArray{}}, nil
+	case cursors.BooleanArrayCursor:
+		return &booleanWindowAggregateArrayCursor{windowArrayCursor: w, agg: newAgg, res: &cursors.BooleanArray{}}, nil
+	default:
+		return nil, unsupportedAggregateError(agg.Type, cursor)
+	}
+}
+
+// windowStart returns the start of the window of duration every, shifted by offset,
+// that contains t. The windows are the same as those of the Flux window function.
+func windowStart(t, every, offset int64) int64 {
+	stop := int64(values.Time(t).Truncate(values.Duration(every))) + offset
+	if t >= stop {
+		stop += every
+	}
+	return stop - every
+}
+
+// cursorAscending returns the order in which the cursors for req are read.
+// The first and last aggregates need only one point, so their cursors are read
+// in the order that produces that point first. Windows are always read in
+// ascending order.
+func cursorAscending(req *datatypes.ReadRequest) bool {
+	if req.Aggregate != nil {
+		if req.Aggregate.WindowEvery > 0 {
+			return true
+		}
+		switch req.Aggregate.Type {
+		case datatypes.AggregateTypeFirst:
+			return true
+		case datatypes.AggregateTypeLast:
+			return false
+		}
+	}
+	return !req.Descending
+}
+
 func newCountArrayCursor(cur cursors.Cursor) cursors.Cursor {
 	switch cur := cur.(type) {
 	case cursors.FloatArrayCursor:
@@ -158,6 +393,6 @@ func (m *multiShardArrayCursors) createCursor(row SeriesRow) cursors.Cursor {
 	}
 }
 
-func (m *multiShardArrayCursors) newAggregateCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) cursors.Cursor {
+func (m *multiShardArrayCursors) newAggregateCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) (cursors.Cursor, error) {
 	return newAggregateArrayCursor(ctx, agg, cursor)
 }
@@ -0,0 +1,333 @@
+package reads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform/storage/reads/datatypes"
+	"github.com/influxdata/platform/tsdb/cursors"
+)
+
+// floatBlocksCursor produces each of its arrays in turn.
+type floatBlocksCursor struct {
+	blocks []*cursors.FloatArray
+	reads  int
+}
+
+func (c *floatBlocksCursor) Close()                     {}
+func (c *floatBlocksCursor) Err() error                 { return nil }
+func (c *floatBlocksCursor) Stats() cursors.CursorStats { return cursors.CursorStats{} }
+
+func (c *floatBlocksCursor) Next() *cursors.FloatArray {
+	c.reads++
+	if len(c.blocks) == 0 {
+		return &cursors.FloatArray{}
+	}
+	a := c.blocks[0]
+	c.blocks = c.blocks[1:]
+	return a
+}
+
+// integerBlocksCursor produces each of its arrays in turn.
+type integerBlocksCursor struct {
+	blocks []*cursors.IntegerArray
+}
+
+func (c *integerBlocksCursor) Close()                     {}
+func (c *integerBlocksCursor) Err() error                 { return nil }
+func (c *integerBlocksCursor) Stats() cursors.CursorStats { return cursors.CursorStats{} }
+
+func (c *integerBlocksCursor) Next() *cursors.IntegerArray {
+	if len(c.blocks) == 0 {
+		return &cursors.IntegerArray{}
+	}
+	a := c.blocks[0]
+	c.blocks = c.blocks[1:]
+	return a
+}
+
+// stringBlocksCursor produces each of its arrays in turn.
+type stringBlocksCursor struct {
+	blocks []*cursors.StringArray
+}
+
+func (c *stringBlocksCursor) Close()                     {}
+func (c *stringBlocksCursor) Err() error                 { return nil }
+func (c *stringBlocksCursor) Stats() cursors.CursorStats { return cursors.CursorStats{} }
+
+func (c *stringBlocksCursor) Next() *cursors.StringArray {
+	if len(c.blocks) == 0 {
+		return &cursors.StringArray{}
+	}
+	a := c.blocks[0]
+	c.blocks = c.blocks[1:]
+	return a
+}
+
+type point struct {
+	T int64
+	V interface{}
+}
+
+func readPoints(cur cursors.Cursor) []point {
+	var ps []point
+	switch cur := cur.(type) {
+	case cursors.FloatArrayCursor:
+		for a := cur.Next(); a.Len() > 0; a = cur.Next() {
+			for i := range a.Timestamps {
+				ps = append(ps, point{T: a.Timestamps[i], V: a.Values[i]})
+			}
+		}
+	case cursors.IntegerArrayCursor:
+		for a := cur.Next(); a.Len() > 0; a = cur.Next() {
+			for i := range a.Timestamps {
+				ps = append(ps, point{T: a.Timestamps[i], V: a.Values[i]})
+			}
+		}
+	}
+	return ps
+}
+
+func TestNewAggregateArrayCursor(t *testing.T) {
+	floats := func() cursors.Cursor {
+		return &floatBlocksCursor{blocks: []*cursors.FloatArray{
+			{Timestamps: []int64{1, 2, 3}, Values: []float64{4, 1, 7}},
+			{Timestamps: []int64{4, 5}, Values: []float64{7, 1}},
+		}}
+	}
+	integers := func() cursors.Cursor {
+		return &integerBlocksCursor{blocks: []*cursors.IntegerArray{
+			{Timestamps: []int64{1, 2}, Values: []int64{3, 6}},
+			{Timestamps: []int64{3}, Values: []int64{-1}},
+		}}
+	}
+
+	tests := []struct {
+		name string
+		agg  datatypes.Aggregate_AggregateType
+		cur  func() cursors.Cursor
+		exp  []point
+	}{
+		{name: "min float", agg: datatypes.AggregateTypeMin, cur: floats, exp: []point{{T: 2, V: 1.0}}},
+		{name: "max float", agg: datatypes.AggregateTypeMax, cur: floats, exp: []point{{T: 3, V: 7.0}}},
+		{name: "first float", agg: datatypes.AggregateTypeFirst, cur: floats, exp: []point{{T: 1, V: 4.0}}},
+		{name: "mean float", agg: datatypes.AggregateTypeMean, cur: floats, exp: []point{{T: 1, V: 4.0}}},
+		{name: "min integer", agg: datatypes.AggregateTypeMin, cur: integers, exp: []point{{T: 3, V: int64(-1)}}},
+		{name: "max integer", agg: datatypes.AggregateTypeMax, cur: integers, exp: []point{{T: 2, V: int64(6)}}},
+		{name: "mean integer", agg: datatypes.AggregateTypeMean, cur: integers, exp: []point{{T: 1, V: 8.0 / 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur, err := newAggregateArrayCursor(context.Background(), &datatypes.Aggregate{Type: tt.agg}, tt.cur())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := readPoints(cur); !cmp.Equal(got, tt.exp) {
+				t.Errorf("unexpected points -got/+exp\n%s", cmp.Diff(got, tt.exp))
+			}
+		})
+	}
+}
+
+func TestNewAggregateArrayCursor_Unsupported(t *testing.T) {
+	strs := func() cursors.Cursor {
+		return &stringBlocksCursor{blocks: []*cursors.StringArray{
+			{Timestamps: []int64{1, 2}, Values: []string{"b", "a"}},
+		}}
+	}
+
+	tests := []struct {
+		name string
+		agg  *datatypes.Aggregate
+		exp  string
+	}{
+		{
+			name: "min string",
+			agg:  &datatypes.Aggregate{Type: datatypes.AggregateTypeMin},
+			exp:  "unsupported aggregate min for string values",
+		},
+		{
+			name: "windowed max string",
+			agg:  &datatypes.Aggregate{Type: datatypes.AggregateTypeMax, WindowEvery: 10},
+			exp:  "unsupported aggregate max for string values",
+		},
+		{
+			name: "invalid type",
+			agg:  &datatypes.Aggregate{Type: datatypes.Aggregate_AggregateType(-1)},
+			exp:  "invalid aggregate: -1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur, err := newAggregateArrayCursor(context.Background(), tt.agg, strs())
+			if cur != nil {
+				t.Errorf("expected nil cursor, got %T", cur)
+			}
+			if err == nil || err.Error() != tt.exp {
+				t.Errorf("unexpected error -got/+exp\n%v\n%s", err, tt.exp)
+			}
+		})
+	}
+}
+
+func TestNewAggregateArrayCursor_FirstReadsOneBlock(t *testing.T) {
+	src := &floatBlocksCursor{blocks: []*cursors.FloatArray{
+		{Timestamps: []int64{1, 2}, Values: []float64{4, 1}},
+		{Timestamps: []int64{3}, Values: []float64{7}},
+	}}
+
+	cur, err := newAggregateArrayCursor(context.Background(), &datatypes.Aggregate{Type: datatypes.AggregateTypeFirst}, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	readPoints(cur)
+
+	if src.reads != 1 {
+		t.Errorf("first read %d blocks, want 1", src.reads)
+	}
+}
+
+func TestNewAggregateArrayCursor_Window(t *testing.T) {
+	floats := func() cursors.Cursor {
+		return &floatBlocksCursor{blocks: []*cursors.FloatArray{
+			{Timestamps: []int64{0, 5, 9, 10}, Values: []float64{4, 1, 7, 2}},
+			{Timestamps: []int64{12, 31}, Values: []float64{6, 3}},
+			{Timestamps: []int64{35, 38}, Values: []float64{5, 8}},
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		agg    datatypes.Aggregate_AggregateType
+		offset int64
+		exp    []point
+	}{
+		{
+			name: "max",
+			agg:  datatypes.AggregateTypeMax,
+			exp:  []point{{T: 9, V: 7.0}, {T: 12, V: 6.0}, {T: 38, V: 8.0}},
+		},
+		{
+			name: "first",
+			agg:  datatypes.AggregateTypeFirst,
+			exp:  []point{{T: 0, V: 4.0}, {T: 10, V: 2.0}, {T: 31, V: 3.0}},
+		},
+		{
+			name: "last",
+			agg:  datatypes.AggregateTypeLast,
+			exp:  []point{{T: 9, V: 7.0}, {T: 12, V: 6.0}, {T: 38, V: 8.0}},
+		},
+		{
+			name: "mean",
+			agg:  datatypes.AggregateTypeMean,
+			exp:  []point{{T: 0, V: 4.0}, {T: 10, V: 4.0}, {T: 31, V: 16.0 / 3}},
+		},
+		{
+			name:   "min with offset",
+			agg:    datatypes.AggregateTypeMin,
+			offset: 5,
+			exp:    []point{{T: 0, V: 4.0}, {T: 5, V: 1.0}, {T: 31, V: 3.0}, {T: 35, V: 5.0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agg := &datatypes.Aggregate{Type: tt.agg, WindowEvery: 10, WindowOffset: tt.offset}
+			cur, err := newAggregateArrayCursor(context.Background(), agg, floats())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := readPoints(cur); !cmp.Equal(got, tt.exp) {
+				t.Errorf("unexpected points -got/+exp\n%s", cmp.Diff(got, tt.exp))
+			}
+		})
+	}
+}
+
+func TestNewPointWindowArrayCursor(t *testing.T) {
+	src := &floatBlocksCursor{blocks: []*cursors.FloatArray{
+		{Timestamps: []int64{9, 12}, Values: []float64{7, 6}},
+		{Timestamps: []int64{38}, Values: []float64{8}},
+	}}
+
+	type window struct {
+		Start  int64
+		Points []point
+	}
+	var got []window
+	cur := newPointWindowArrayCursor(src, 10, 0)
+	for start, ok := cur.nextWindow(); ok; start, ok = cur.nextWindow() {
+		got = append(got, window{Start: start, Points: readPoints(cur.window())})
+	}
+
+	exp := []window{
+		{Start: 0, Points: []point{{T: 9, V: 7.0}}},
+		{Start: 10, Points: []point{{T: 12, V: 6.0}}},
+		{Start: 30, Points: []point{{T: 38, V: 8.0}}},
+	}
+	if !cmp.Equal(got, exp) {
+		t.Errorf("unexpected windows -got/+exp\n%s", cmp.Diff(got, exp))
+	}
+}
+
+func TestWindowStart(t *testing.T) {
+	tests := []struct {
+		t, every, offset int64
+		exp              int64
+	}{
+		{t: 0, every: 10, exp: 0},
+		{t: 9, every: 10, exp: 0},
+		{t: 10, every: 10, exp: 10},
+		{t: 4, every: 10, offset: 5, exp: -5},
+		{t: 5, every: 10, offset: 5, exp: 5},
+		{t: -1, every: 10, exp: -10},
+	}
+
+	for _, tt := range tests {
+		if got := windowStart(tt.t, tt.every, tt.offset); got != tt.exp {
+			t.Errorf("windowStart(%d, %d, %d) = %d, want %d", tt.t, tt.every, tt.offset, got, tt.exp)
+		}
+	}
+}
+
+func TestCursorAscending(t *testing.T) {
+	tests := []struct {
+		name string
+		req  datatypes.ReadRequest
+		exp  bool
+	}{
+		{name: "ascending", req: datatypes.ReadRequest{}, exp: true},
+		{name: "descending", req: datatypes.ReadRequest{Descending: true}, exp: false},
+		{
+			name: "first of descending",
+			req:  datatypes.ReadRequest{Descending: true, Aggregate: &datatypes.Aggregate{Type: datatypes.AggregateTypeFirst}},
+			exp:  true,
+		},
+		{
+			name: "last",
+			req:  datatypes.ReadRequest{Aggregate: &datatypes.Aggregate{Type: datatypes.AggregateTypeLast}},
+			exp:  false,
+		},
+		{
+			name: "windowed last",
+			req:  datatypes.ReadRequest{Aggregate: &datatypes.Aggregate{Type: datatypes.AggregateTypeLast, WindowEvery: 10}},
+			exp:  true,
+		},
+		{
+			name: "max of descending",
+			req:  datatypes.ReadRequest{Descending: true, Aggregate: &datatypes.Aggregate{Type: datatypes.AggregateTypeMax}},
+			exp:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cursorAscending(&tt.req); got != tt.exp {
+				t.Errorf("cursorAscending() = %v, want %v", got, tt.exp)
+			}
+		})
+	}
+}
@@ -59,7 +59,7 @@ func (x ReadRequest_Group) String() string {
 	return proto.EnumName(ReadRequest_Group_name, int32(x))
 }
 func (ReadRequest_Group) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{0, 0}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{0, 0}
 }
 
 type ReadRequest_HintFlags int32
@@ -89,7 +89,7 @@ func (x ReadRequest_HintFlags) String() string {
 	return proto.EnumName(ReadRequest_HintFlags_name, int32(x))
 }
 func (ReadRequest_HintFlags) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{0, 1}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{0, 1}
 }
 
 type Aggregate_AggregateType int32
@@ -98,24 +98,39 @@ const (
 	AggregateTypeNone  Aggregate_AggregateType = 0
 	AggregateTypeSum   Aggregate_AggregateType = 1
 	AggregateTypeCount Aggregate_AggregateType = 2
+	AggregateTypeMin   Aggregate_AggregateType = 3
+	AggregateTypeMax   Aggregate_AggregateType = 4
+	AggregateTypeFirst Aggregate_AggregateType = 5
+	AggregateTypeLast  Aggregate_AggregateType = 6
+	AggregateTypeMean  Aggregate_AggregateType = 7
 )
 
 var Aggregate_AggregateType_name = map[int32]string{
 	0: "NONE",
 	1: "SUM",
 	2: "COUNT",
+	3: "MIN",
+	4: "MAX",
+	5: "FIRST",
+	6: "LAST",
+	7: "MEAN",
 }
 var Aggregate_AggregateType_value = map[string]int32{
 	"NONE":  0,
 	"SUM":   1,
 	"COUNT": 2,
+	"MIN":   3,
+	"MAX":   4,
+	"FIRST": 5,
+	"LAST":  6,
+	"MEAN":  7,
 }
 
 func (x Aggregate_AggregateType) String() string {
 	return proto.EnumName(Aggregate_AggregateType_name, int32(x))
 }
 func (Aggregate_AggregateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{1, 0}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{1, 0}
 }
 
 type ReadResponse_FrameType int32
@@ -138,7 +153,7 @@ func (x ReadResponse_FrameType) String() string {
 	return proto.EnumName(ReadResponse_FrameType_name, int32(x))
 }
 func (ReadResponse_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 0}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 0}
 }
 
 type ReadResponse_DataType int32
@@ -170,7 +185,7 @@ func (x ReadResponse_DataType) String() string {
 	return proto.EnumName(ReadResponse_DataType_name, int32(x))
 }
 func (ReadResponse_DataType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 1}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 1}
 }
 
 // Request message for Storage.Read.
@@ -208,7 +223,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{0}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{0}
 }
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,16 +253,21 @@ func (m *ReadRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_ReadRequest proto.InternalMessageInfo
 
 type Aggregate struct {
-	Type                 Aggregate_AggregateType `protobuf:"varint,1,opt,name=type,proto3,enum=influxdata.platform.storage.Aggregate_AggregateType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Type Aggregate_AggregateType `protobuf:"varint,1,opt,name=type,proto3,enum=influxdata.platform.storage.Aggregate_AggregateType" json:"type,omitempty"`
+	// WindowEvery, when non-zero, applies the aggregate to consecutive windows of this duration,
+	// in nanoseconds, instead of the whole time range.
+	WindowEvery int64 `protobuf:"varint,2,opt,name=window_every,json=windowEvery,proto3" json:"window_every,omitempty"`
+	// WindowOffset shifts the window boundaries from the Unix epoch, in nanoseconds.
+	WindowOffset         int64    `protobuf:"varint,3,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{1}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{1}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,7 +307,7 @@ func (m *Tag) Reset()         { *m = Tag{} }
 func (m *Tag) String() string { return proto.CompactTextString(m) }
 func (*Tag) ProtoMessage()    {}
 func (*Tag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{2}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{2}
 }
 func (m *Tag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +347,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3}
 }
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +394,7 @@ func (m *ReadResponse_Frame) Reset()         { *m = ReadResponse_Frame{} }
 func (m *ReadResponse_Frame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_Frame) ProtoMessage()    {}
 func (*ReadResponse_Frame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 0}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 0}
 }
 func (m *ReadResponse_Frame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +697,7 @@ func (m *ReadResponse_GroupFrame) Reset()         { *m = ReadResponse_GroupFrame
 func (m *ReadResponse_GroupFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_GroupFrame) ProtoMessage()    {}
 func (*ReadResponse_GroupFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 1}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 1}
 }
 func (m *ReadResponse_GroupFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +737,7 @@ func (m *ReadResponse_SeriesFrame) Reset()         { *m = ReadResponse_SeriesFra
 func (m *ReadResponse_SeriesFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_SeriesFrame) ProtoMessage()    {}
 func (*ReadResponse_SeriesFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 2}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 2}
 }
 func (m *ReadResponse_SeriesFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +777,7 @@ func (m *ReadResponse_FloatPointsFrame) Reset()         { *m = ReadResponse_Floa
 func (m *ReadResponse_FloatPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_FloatPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_FloatPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 3}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 3}
 }
 func (m *ReadResponse_FloatPointsFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +817,7 @@ func (m *ReadResponse_IntegerPointsFrame) Reset()         { *m = ReadResponse_In
 func (m *ReadResponse_IntegerPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_IntegerPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_IntegerPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 4}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 4}
 }
 func (m *ReadResponse_IntegerPointsFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +857,7 @@ func (m *ReadResponse_UnsignedPointsFrame) Reset()         { *m = ReadResponse_U
 func (m *ReadResponse_UnsignedPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_UnsignedPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_UnsignedPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 5}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 5}
 }
 func (m *ReadResponse_UnsignedPointsFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -877,7 +897,7 @@ func (m *ReadResponse_BooleanPointsFrame) Reset()         { *m = ReadResponse_Bo
 func (m *ReadResponse_BooleanPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_BooleanPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_BooleanPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 6}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 6}
 }
 func (m *ReadResponse_BooleanPointsFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +937,7 @@ func (m *ReadResponse_StringPointsFrame) Reset()         { *m = ReadResponse_Str
 func (m *ReadResponse_StringPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_StringPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_StringPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{3, 7}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{3, 7}
 }
 func (m *ReadResponse_StringPointsFrame) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,7 +976,7 @@ func (m *CapabilitiesResponse) Reset()         { *m = CapabilitiesResponse{} }
 func (m *CapabilitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*CapabilitiesResponse) ProtoMessage()    {}
 func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{4}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{4}
 }
 func (m *CapabilitiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1014,7 @@ func (m *HintsResponse) Reset()         { *m = HintsResponse{} }
 func (m *HintsResponse) String() string { return proto.CompactTextString(m) }
 func (*HintsResponse) ProtoMessage()    {}
 func (*HintsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{5}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{5}
 }
 func (m *HintsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1057,7 @@ func (m *TimestampRange) Reset()         { *m = TimestampRange{} }
 func (m *TimestampRange) String() string { return proto.CompactTextString(m) }
 func (*TimestampRange) ProtoMessage()    {}
 func (*TimestampRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_common_01b6ac29b3fb8162, []int{6}
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{6}
 }
 func (m *TimestampRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,6 +1086,133 @@ func (m *TimestampRange) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TimestampRange proto.InternalMessageInfo
 
+// TagKeysRequest is the request for Store.TagKeys.
+type TagKeysRequest struct {
+	TagsSource           *types.Any     `protobuf:"bytes,1,opt,name=tags_source,json=tagsSource" json:"tags_source,omitempty"`
+	Range                TimestampRange `protobuf:"bytes,2,opt,name=range" json:"range"`
+	Predicate            *Predicate     `protobuf:"bytes,3,opt,name=predicate" json:"predicate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TagKeysRequest) Reset()         { *m = TagKeysRequest{} }
+func (m *TagKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*TagKeysRequest) ProtoMessage()    {}
+func (*TagKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{7}
+}
+func (m *TagKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TagKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TagKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TagKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagKeysRequest.Merge(dst, src)
+}
+func (m *TagKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TagKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagKeysRequest proto.InternalMessageInfo
+
+// TagValuesRequest is the request for Store.TagValues.
+type TagValuesRequest struct {
+	TagsSource           *types.Any     `protobuf:"bytes,1,opt,name=tags_source,json=tagsSource" json:"tags_source,omitempty"`
+	Range                TimestampRange `protobuf:"bytes,2,opt,name=range" json:"range"`
+	Predicate            *Predicate     `protobuf:"bytes,3,opt,name=predicate" json:"predicate,omitempty"`
+	TagKey               string         `protobuf:"bytes,4,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TagValuesRequest) Reset()         { *m = TagValuesRequest{} }
+func (m *TagValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*TagValuesRequest) ProtoMessage()    {}
+func (*TagValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{8}
+}
+func (m *TagValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TagValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TagValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TagValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagValuesRequest.Merge(dst, src)
+}
+func (m *TagValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TagValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagValuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagValuesRequest proto.InternalMessageInfo
+
+// MeasurementNamesRequest is the request for Store.MeasurementNames.
+type MeasurementNamesRequest struct {
+	TagsSource           *types.Any     `protobuf:"bytes,1,opt,name=tags_source,json=tagsSource" json:"tags_source,omitempty"`
+	Range                TimestampRange `protobuf:"bytes,2,opt,name=range" json:"range"`
+	Predicate            *Predicate     `protobuf:"bytes,3,opt,name=predicate" json:"predicate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MeasurementNamesRequest) Reset()         { *m = MeasurementNamesRequest{} }
+func (m *MeasurementNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*MeasurementNamesRequest) ProtoMessage()    {}
+func (*MeasurementNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_common_e7775d11b52e646a, []int{9}
+}
+func (m *MeasurementNamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MeasurementNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MeasurementNamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MeasurementNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeasurementNamesRequest.Merge(dst, src)
+}
+func (m *MeasurementNamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MeasurementNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeasurementNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeasurementNamesRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReadRequest)(nil), "influxdata.platform.storage.ReadRequest")
 	proto.RegisterMapType((map[string]string)(nil), "influxdata.platform.storage.ReadRequest.TraceEntry")
@@ -1084,6 +1231,9 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "influxdata.platform.storage.CapabilitiesResponse.CapsEntry")
 	proto.RegisterType((*HintsResponse)(nil), "influxdata.platform.storage.HintsResponse")
 	proto.RegisterType((*TimestampRange)(nil), "influxdata.platform.storage.TimestampRange")
+	proto.RegisterType((*TagKeysRequest)(nil), "influxdata.platform.storage.TagKeysRequest")
+	proto.RegisterType((*TagValuesRequest)(nil), "influxdata.platform.storage.TagValuesRequest")
+	proto.RegisterType((*MeasurementNamesRequest)(nil), "influxdata.platform.storage.MeasurementNamesRequest")
 	proto.RegisterEnum("influxdata.platform.storage.ReadRequest_Group", ReadRequest_Group_name, ReadRequest_Group_value)
 	proto.RegisterEnum("influxdata.platform.storage.ReadRequest_HintFlags", ReadRequest_HintFlags_name, ReadRequest_HintFlags_value)
 	proto.RegisterEnum("influxdata.platform.storage.Aggregate_AggregateType", Aggregate_AggregateType_name, Aggregate_AggregateType_value)
@@ -1405,6 +1555,16 @@ func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorageCommon(dAtA, i, uint64(m.Type))
 	}
+	if m.WindowEvery != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.WindowEvery))
+	}
+	if m.WindowOffset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.WindowOffset))
+	}
 	return i, nil
 }
 
@@ -1949,6 +2109,150 @@ func (m *TimestampRange) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TagKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TagsSource != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.TagsSource.Size()))
+		n13, err := m.TagsSource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintStorageCommon(dAtA, i, uint64(m.Range.Size()))
+	n14, err := m.Range.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	if m.Predicate != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.Predicate.Size()))
+		n15, err := m.Predicate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *TagValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TagsSource != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.TagsSource.Size()))
+		n16, err := m.TagsSource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintStorageCommon(dAtA, i, uint64(m.Range.Size()))
+	n17, err := m.Range.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if m.Predicate != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.Predicate.Size()))
+		n18, err := m.Predicate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.TagKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(len(m.TagKey)))
+		i += copy(dAtA[i:], m.TagKey)
+	}
+	return i, nil
+}
+
+func (m *MeasurementNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeasurementNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TagsSource != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.TagsSource.Size()))
+		n19, err := m.TagsSource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintStorageCommon(dAtA, i, uint64(m.Range.Size()))
+	n20, err := m.Range.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	if m.Predicate != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStorageCommon(dAtA, i, uint64(m.Predicate.Size()))
+		n21, err := m.Predicate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	return i, nil
+}
+
 func encodeVarintStorageCommon(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -2016,6 +2320,12 @@ func (m *Aggregate) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovStorageCommon(uint64(m.Type))
 	}
+	if m.WindowEvery != 0 {
+		n += 1 + sovStorageCommon(uint64(m.WindowEvery))
+	}
+	if m.WindowOffset != 0 {
+		n += 1 + sovStorageCommon(uint64(m.WindowOffset))
+	}
 	return n
 }
 
@@ -2253,6 +2563,58 @@ func (m *TimestampRange) Size() (n int) {
 	return n
 }
 
+func (m *TagKeysRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TagsSource != nil {
+		l = m.TagsSource.Size()
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	l = m.Range.Size()
+	n += 1 + l + sovStorageCommon(uint64(l))
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	return n
+}
+
+func (m *TagValuesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TagsSource != nil {
+		l = m.TagsSource.Size()
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	l = m.Range.Size()
+	n += 1 + l + sovStorageCommon(uint64(l))
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	l = len(m.TagKey)
+	if l > 0 {
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	return n
+}
+
+func (m *MeasurementNamesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TagsSource != nil {
+		l = m.TagsSource.Size()
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	l = m.Range.Size()
+	n += 1 + l + sovStorageCommon(uint64(l))
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorageCommon(uint64(l))
+	}
+	return n
+}
+
 func sovStorageCommon(x uint64) (n int) {
 	for {
 		n++
@@ -2746,6 +3108,44 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEvery", wireType)
+			}
+			m.WindowEvery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEvery |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowOffset", wireType)
+			}
+			m.WindowOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorageCommon(dAtA[iNdEx:])
@@ -4479,6 +4879,473 @@ func (m *TimestampRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TagKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TagKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TagKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TagsSource == nil {
+				m.TagsSource = &types.Any{}
+			}
+			if err := m.TagsSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TagValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TagValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TagValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TagsSource == nil {
+				m.TagsSource = &types.Any{}
+			}
+			if err := m.TagsSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeasurementNamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MeasurementNamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MeasurementNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TagsSource == nil {
+				m.TagsSource = &types.Any{}
+			}
+			if err := m.TagsSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorageCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStorageCommon(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4585,106 +5452,118 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("storage_common.proto", fileDescriptor_storage_common_01b6ac29b3fb8162)
-}
-
-var fileDescriptor_storage_common_01b6ac29b3fb8162 = []byte{
-	// 1539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x5f, 0x8f, 0x2a, 0x49,
-	0x15, 0xa7, 0xf9, 0xcf, 0xe1, 0xcf, 0xf4, 0xad, 0xc5, 0x09, 0xdb, 0xd7, 0x85, 0x5e, 0x62, 0x36,
-	0xa8, 0x2b, 0xa3, 0xec, 0x6e, 0xbc, 0xb9, 0xea, 0x03, 0xcc, 0x70, 0x07, 0x9c, 0x19, 0x98, 0x14,
-	0x8c, 0x71, 0x4d, 0x0c, 0xa9, 0x81, 0xa2, 0xb7, 0xb3, 0x4d, 0x77, 0xdb, 0xdd, 0x6c, 0x86, 0xc4,
-	0x77, 0x37, 0x3c, 0x5d, 0x5f, 0x4d, 0x48, 0x4c, 0x7c, 0xf4, 0xdd, 0xcf, 0x70, 0xdf, 0xf4, 0x13,
-	0x10, 0xc5, 0x0f, 0x61, 0xe2, 0x93, 0xa9, 0xaa, 0x6e, 0x68, 0x66, 0xae, 0x13, 0x78, 0xab, 0x3a,
-	0x7f, 0x7e, 0xbf, 0x53, 0xa7, 0xcf, 0x39, 0x55, 0x0d, 0x45, 0xd7, 0xb3, 0x1c, 0xa2, 0xd1, 0xd1,
-	0xd8, 0x9a, 0xcd, 0x2c, 0xb3, 0x6e, 0x3b, 0x96, 0x67, 0xa1, 0x97, 0xba, 0x39, 0x35, 0xe6, 0x0f,
-	0x13, 0xe2, 0x91, 0xba, 0x6d, 0x10, 0x6f, 0x6a, 0x39, 0xb3, 0xba, 0x6f, 0xa9, 0x14, 0x35, 0x4b,
-	0xb3, 0xb8, 0xdd, 0x19, 0x5b, 0x09, 0x17, 0xe5, 0xa5, 0x66, 0x59, 0x9a, 0x41, 0xcf, 0xf8, 0xee,
-	0x7e, 0x3e, 0x3d, 0xa3, 0x33, 0xdb, 0x5b, 0xf8, 0xca, 0x0f, 0x1f, 0x2b, 0x89, 0x19, 0xa8, 0x4e,
-	0x6c, 0x87, 0x4e, 0xf4, 0x31, 0xf1, 0xa8, 0x10, 0x54, 0xff, 0x93, 0x86, 0x2c, 0xa6, 0x64, 0x82,
-	0xe9, 0xef, 0xe6, 0xd4, 0xf5, 0x90, 0x01, 0x27, 0x9e, 0x3e, 0xa3, 0xae, 0x47, 0x66, 0xf6, 0xc8,
-	0x21, 0xa6, 0x46, 0x4b, 0x51, 0x55, 0xaa, 0x65, 0x1b, 0x3f, 0xac, 0x3f, 0x13, 0x65, 0x7d, 0x18,
-	0xf8, 0x60, 0xe6, 0xd2, 0x3a, 0x7d, 0xb7, 0xae, 0x44, 0x36, 0xeb, 0x4a, 0x61, 0x5f, 0x8e, 0x0b,
-	0xde, 0xde, 0x1e, 0x95, 0x01, 0x26, 0xd4, 0x1d, 0x53, 0x73, 0xa2, 0x9b, 0x5a, 0x29, 0xa6, 0x4a,
-	0xb5, 0x34, 0x0e, 0x49, 0xd0, 0xa7, 0x00, 0x9a, 0x63, 0xcd, 0xed, 0xd1, 0xd7, 0x74, 0xe1, 0x96,
-	0xe2, 0x6a, 0xac, 0x96, 0x69, 0xe5, 0x37, 0xeb, 0x4a, 0xe6, 0x92, 0x49, 0xaf, 0xe8, 0xc2, 0xc5,
-	0x19, 0x2d, 0x58, 0xa2, 0x0b, 0xc8, 0x6c, 0x8f, 0x57, 0x4a, 0xf0, 0xa8, 0x3f, 0x79, 0x36, 0xea,
-	0xdb, 0xc0, 0x1a, 0xef, 0x1c, 0x51, 0x03, 0x72, 0x2e, 0x75, 0x74, 0xea, 0x8e, 0x0c, 0x7d, 0xa6,
-	0x7b, 0xa5, 0xa4, 0x2a, 0xd5, 0x62, 0xad, 0x93, 0xcd, 0xba, 0x92, 0x1d, 0x70, 0xf9, 0x35, 0x13,
-	0xe3, 0xac, 0xbb, 0xdb, 0xa0, 0x2f, 0x20, 0xef, 0xfb, 0x58, 0xd3, 0xa9, 0x4b, 0xbd, 0x52, 0x8a,
-	0x3b, 0xc9, 0x9b, 0x75, 0x25, 0x27, 0x9c, 0xfa, 0x5c, 0x8e, 0x7d, 0x68, 0xb1, 0x63, 0x54, 0xb6,
-	0xa5, 0x9b, 0x5e, 0x40, 0x95, 0xde, 0x51, 0xdd, 0x72, 0xb9, 0x4f, 0x65, 0xef, 0x36, 0xec, 0x90,
-	0x44, 0xd3, 0x1c, 0xaa, 0xb1, 0x43, 0x66, 0x0e, 0x38, 0x64, 0x33, 0xb0, 0xc6, 0x3b, 0x47, 0x34,
-	0x84, 0x84, 0xe7, 0x90, 0x31, 0x2d, 0x81, 0x1a, 0xab, 0x65, 0x1b, 0x9f, 0x3d, 0x8b, 0x10, 0xaa,
-	0x8f, 0xfa, 0x90, 0x79, 0xb5, 0x4d, 0xcf, 0x59, 0xb4, 0x32, 0x9b, 0x75, 0x25, 0xc1, 0xf7, 0x58,
-	0x80, 0xa1, 0x0b, 0x48, 0xf0, 0xaf, 0x51, 0xca, 0xaa, 0x52, 0xad, 0xd0, 0xa8, 0x1f, 0x8c, 0xca,
-	0x3f, 0x27, 0x16, 0xce, 0xe8, 0x53, 0x48, 0x7c, 0xc5, 0xce, 0x5b, 0xca, 0xa9, 0x52, 0x2d, 0xd5,
-	0x3a, 0x65, 0x34, 0x1d, 0x26, 0xf8, 0xef, 0xba, 0x92, 0x61, 0x8b, 0x37, 0x06, 0xd1, 0x5c, 0x2c,
-	0x8c, 0x50, 0x1b, 0xb2, 0x0e, 0x25, 0x93, 0x91, 0x6b, 0xcd, 0x9d, 0x31, 0x2d, 0xe5, 0x79, 0x46,
-	0x8a, 0x75, 0xd1, 0x02, 0xf5, 0xa0, 0x05, 0xea, 0x4d, 0x73, 0xd1, 0x2a, 0x6c, 0xd6, 0x15, 0x60,
-	0xb4, 0x03, 0x6e, 0x8b, 0xc1, 0xd9, 0xae, 0x95, 0x57, 0x00, 0xbb, 0xa3, 0x21, 0x19, 0x62, 0x5f,
-	0xd3, 0x45, 0x49, 0x52, 0xa5, 0x5a, 0x06, 0xb3, 0x25, 0x2a, 0x42, 0xe2, 0x1b, 0x62, 0xcc, 0x45,
-	0x37, 0x64, 0xb0, 0xd8, 0xbc, 0x8e, 0xbe, 0x92, 0xaa, 0x7f, 0x90, 0x20, 0xc1, 0xe3, 0x47, 0x1f,
-	0x01, 0x5c, 0xe2, 0xfe, 0xdd, 0xed, 0xa8, 0xd7, 0xef, 0xb5, 0xe5, 0x88, 0x92, 0x5f, 0xae, 0x54,
-	0x51, 0xa9, 0x3d, 0xcb, 0xa4, 0xe8, 0x25, 0x64, 0x84, 0xba, 0x79, 0x7d, 0x2d, 0x4b, 0x4a, 0x6e,
-	0xb9, 0x52, 0xd3, 0x5c, 0xdb, 0x34, 0x0c, 0xf4, 0x21, 0xa4, 0x85, 0xb2, 0xf5, 0xa5, 0x1c, 0x55,
-	0xb2, 0xcb, 0x95, 0x9a, 0xe2, 0xba, 0xd6, 0x02, 0x7d, 0x0c, 0x39, 0xa1, 0x6a, 0xff, 0xfa, 0xbc,
-	0x7d, 0x3b, 0x94, 0x63, 0xca, 0xc9, 0x72, 0xa5, 0x66, 0xb9, 0xba, 0xfd, 0x30, 0xa6, 0xb6, 0xa7,
-	0xc4, 0xbf, 0xfd, 0x4b, 0x39, 0x52, 0xfd, 0xab, 0x04, 0xbb, 0xfc, 0x30, 0xba, 0x4e, 0xb7, 0x37,
-	0x0c, 0x82, 0xe1, 0x74, 0x4c, 0xcb, 0x63, 0xf9, 0x1e, 0x14, 0x7c, 0xe5, 0xe8, 0xb6, 0xdf, 0xed,
-	0x0d, 0x07, 0xb2, 0xa4, 0xc8, 0xcb, 0x95, 0x9a, 0x13, 0x16, 0xa2, 0xfa, 0xc2, 0x56, 0x83, 0x36,
-	0xee, 0xb6, 0x07, 0x72, 0x34, 0x6c, 0x25, 0x2a, 0x1b, 0x9d, 0x41, 0x91, 0x5b, 0x0d, 0xce, 0x3b,
-	0xed, 0x9b, 0x26, 0x3b, 0xdd, 0x68, 0xd8, 0xbd, 0x69, 0xcb, 0x71, 0xe5, 0x3b, 0xcb, 0x95, 0xfa,
-	0x82, 0xd9, 0x0e, 0xc6, 0x5f, 0xd1, 0x19, 0x69, 0x1a, 0x06, 0x9b, 0x07, 0x7e, 0xb4, 0x7f, 0x97,
-	0x20, 0xb3, 0xad, 0x4d, 0xd4, 0x81, 0xb8, 0xb7, 0xb0, 0x29, 0x4f, 0x79, 0xa1, 0xf1, 0xf9, 0x61,
-	0x15, 0xbd, 0x5b, 0x0d, 0x17, 0x36, 0xc5, 0x1c, 0xa1, 0xfa, 0x00, 0xf9, 0x3d, 0x31, 0xaa, 0x40,
-	0xdc, 0xcf, 0x01, 0x8f, 0x67, 0x4f, 0xc9, 0x93, 0xf1, 0x11, 0xc4, 0x06, 0x77, 0x37, 0xb2, 0xa4,
-	0x14, 0x97, 0x2b, 0x55, 0xde, 0xd3, 0x0f, 0xe6, 0x33, 0xf4, 0x31, 0x24, 0xce, 0xfb, 0x77, 0xbd,
-	0xa1, 0x1c, 0x55, 0x4e, 0x97, 0x2b, 0x15, 0xed, 0x19, 0x9c, 0x5b, 0x73, 0x33, 0xc8, 0xff, 0x8f,
-	0x20, 0x36, 0x24, 0x5a, 0xb8, 0x78, 0x72, 0xef, 0x29, 0x9e, 0x9c, 0x5f, 0x3c, 0xd5, 0x3f, 0x16,
-	0x20, 0x27, 0x9a, 0xc0, 0xb5, 0x2d, 0xd3, 0xa5, 0xe8, 0x06, 0x92, 0x53, 0x87, 0xcc, 0xa8, 0x5b,
-	0x92, 0x78, 0x57, 0x9e, 0x1d, 0xd0, 0x3f, 0xc2, 0xb5, 0xfe, 0x86, 0xf9, 0xb5, 0xe2, 0x6c, 0xec,
-	0x62, 0x1f, 0x44, 0xf9, 0x36, 0x09, 0x09, 0x2e, 0x47, 0x7d, 0x48, 0x8a, 0xb9, 0xc3, 0x83, 0xca,
-	0x36, 0xbe, 0x38, 0x1c, 0x58, 0x7c, 0x63, 0x0e, 0xd3, 0x89, 0x60, 0x1f, 0x06, 0xd9, 0x90, 0x9b,
-	0x1a, 0x16, 0xf1, 0x46, 0x62, 0x32, 0xf9, 0x57, 0xc4, 0xeb, 0x23, 0xe2, 0x65, 0xde, 0xa2, 0xca,
-	0x44, 0xe8, 0x7c, 0xe8, 0x85, 0xa4, 0x9d, 0x08, 0xce, 0x4e, 0x77, 0x5b, 0xf4, 0x00, 0x05, 0xdd,
-	0xf4, 0xa8, 0x46, 0x9d, 0x80, 0x33, 0xc6, 0x39, 0x7f, 0x7e, 0x38, 0x67, 0x57, 0xf8, 0x87, 0x59,
-	0x5f, 0x6c, 0xd6, 0x95, 0xfc, 0x9e, 0xbc, 0x13, 0xc1, 0x79, 0x3d, 0x2c, 0x40, 0xbf, 0x87, 0x93,
-	0xb9, 0xe9, 0xea, 0x9a, 0x49, 0x27, 0x01, 0x75, 0x9c, 0x53, 0xff, 0xe2, 0x70, 0xea, 0x3b, 0x1f,
-	0x20, 0xcc, 0x8d, 0xd8, 0xfd, 0xb8, 0xaf, 0xe8, 0x44, 0x70, 0x61, 0xbe, 0x27, 0x61, 0xe7, 0xbe,
-	0xb7, 0x2c, 0x83, 0x12, 0x33, 0x20, 0x4f, 0x1c, 0x7b, 0xee, 0x96, 0xf0, 0x7f, 0x72, 0xee, 0x3d,
-	0x39, 0x3b, 0xf7, 0x7d, 0x58, 0x80, 0x3c, 0xc8, 0xbb, 0x9e, 0xa3, 0x9b, 0x5a, 0x40, 0x9c, 0xe4,
-	0xc4, 0x3f, 0x3b, 0xa2, 0x76, 0xb8, 0x7b, 0x98, 0x57, 0x5c, 0x88, 0x21, 0x71, 0x27, 0x82, 0x73,
-	0x6e, 0x68, 0x8f, 0xae, 0x83, 0x2b, 0x24, 0xc5, 0xd9, 0x3e, 0x3f, 0x9c, 0x8d, 0xcf, 0xc3, 0xa0,
-	0x50, 0x05, 0x48, 0x2b, 0x09, 0x71, 0xe6, 0xa9, 0x3c, 0x00, 0xec, 0xd4, 0xe8, 0x13, 0x48, 0x7b,
-	0x44, 0x13, 0x6f, 0x0a, 0xd6, 0x69, 0xb9, 0x56, 0x76, 0xb3, 0xae, 0xa4, 0x86, 0x44, 0xe3, 0x2f,
-	0x8a, 0x94, 0x27, 0x16, 0xa8, 0x05, 0xc8, 0x26, 0x8e, 0xa7, 0x7b, 0xba, 0x65, 0x32, 0xeb, 0xd1,
-	0x37, 0xc4, 0x60, 0xb5, 0xce, 0x3c, 0x8a, 0x9b, 0x75, 0x45, 0xbe, 0x0d, 0xb4, 0x57, 0x74, 0xf1,
-	0x2b, 0x62, 0xb8, 0x58, 0xb6, 0x1f, 0x49, 0x94, 0x3f, 0x49, 0x90, 0x0d, 0xf5, 0x10, 0x7a, 0x0d,
-	0x71, 0x8f, 0x68, 0x41, 0x87, 0xab, 0xcf, 0x3f, 0xaa, 0x88, 0xe6, 0xb7, 0x34, 0xf7, 0x41, 0x7d,
-	0xc8, 0x30, 0xc3, 0x11, 0x1f, 0x94, 0x51, 0x3e, 0x28, 0x1b, 0x87, 0xe7, 0xe7, 0x82, 0x78, 0x84,
-	0x8f, 0xc9, 0xf4, 0xc4, 0x5f, 0x29, 0xbf, 0x04, 0xf9, 0x71, 0x23, 0xb2, 0x27, 0xd9, 0xf6, 0x91,
-	0x26, 0xc2, 0x94, 0x71, 0x48, 0x82, 0x4e, 0x21, 0xc9, 0xc7, 0x97, 0x48, 0x84, 0x84, 0xfd, 0x9d,
-	0x72, 0x0d, 0xe8, 0x69, 0x83, 0x1d, 0x89, 0x16, 0xdb, 0xa2, 0xdd, 0xc0, 0x07, 0xef, 0xe9, 0x99,
-	0x23, 0xe1, 0xe2, 0xe1, 0xe0, 0x9e, 0x76, 0xc1, 0x91, 0x68, 0xe9, 0x2d, 0xda, 0x15, 0xbc, 0x78,
-	0x52, 0xda, 0x47, 0x82, 0x65, 0x02, 0xb0, 0xea, 0x00, 0x32, 0x1c, 0xc0, 0xbf, 0xaa, 0x92, 0xfe,
-	0x45, 0x1b, 0x51, 0x3e, 0x58, 0xae, 0xd4, 0x93, 0xad, 0xca, 0xbf, 0x6b, 0x2b, 0x90, 0xdc, 0xde,
-	0xd7, 0xfb, 0x06, 0x22, 0x16, 0xff, 0x26, 0xfa, 0x9b, 0x04, 0xe9, 0xe0, 0x7b, 0xa3, 0xef, 0x42,
-	0xe2, 0xcd, 0x75, 0xbf, 0x39, 0x94, 0x23, 0xca, 0x8b, 0xe5, 0x4a, 0xcd, 0x07, 0x0a, 0xfe, 0xe9,
-	0x91, 0x0a, 0xa9, 0x6e, 0x6f, 0xd8, 0xbe, 0x6c, 0xe3, 0x00, 0x32, 0xd0, 0xfb, 0x9f, 0x13, 0x55,
-	0x21, 0x7d, 0xd7, 0x1b, 0x74, 0x2f, 0x7b, 0xed, 0x0b, 0x39, 0x2a, 0xee, 0xc8, 0xc0, 0x24, 0xf8,
-	0x46, 0x0c, 0xa5, 0xd5, 0xef, 0x5f, 0xb7, 0x9b, 0x3d, 0x39, 0xb6, 0x8f, 0xe2, 0xe7, 0x1d, 0x95,
-	0x21, 0x39, 0x18, 0xe2, 0x6e, 0xef, 0x52, 0x8e, 0x2b, 0x68, 0xb9, 0x52, 0x0b, 0x81, 0x81, 0x48,
-	0xa5, 0x1f, 0xf8, 0x9f, 0x25, 0x28, 0x9e, 0x13, 0x9b, 0xdc, 0xeb, 0x86, 0xee, 0xe9, 0xd4, 0xdd,
-	0xde, 0x8d, 0x7d, 0x88, 0x8f, 0x89, 0x1d, 0xf4, 0xcd, 0xf3, 0x43, 0xe8, 0x7d, 0x00, 0x4c, 0xe8,
-	0xf2, 0xc7, 0x1d, 0xe6, 0x40, 0xca, 0x4f, 0x21, 0xb3, 0x15, 0x1d, 0xf5, 0xde, 0x3b, 0x81, 0x3c,
-	0x7f, 0x8d, 0x06, 0xc8, 0xd5, 0x57, 0xf0, 0xe8, 0x37, 0x87, 0x39, 0xbb, 0x1e, 0x71, 0x3c, 0x0e,
-	0x18, 0xc3, 0x62, 0xc3, 0x48, 0xa8, 0x39, 0xe1, 0x80, 0x31, 0xcc, 0x96, 0x8d, 0xb7, 0x51, 0x48,
-	0x0d, 0x44, 0xd0, 0xe8, 0xb7, 0x10, 0x67, 0xed, 0x8a, 0x6a, 0x87, 0x3e, 0x9a, 0x95, 0xef, 0x1f,
-	0xdc, 0xfb, 0x3f, 0x96, 0xd0, 0x97, 0x90, 0x0b, 0xa7, 0x05, 0x9d, 0x3e, 0x79, 0x21, 0xb7, 0xd9,
-	0x1f, 0xa4, 0xf2, 0x93, 0xa3, 0x33, 0x8b, 0xae, 0x40, 0x3c, 0xcf, 0xff, 0x2f, 0xe6, 0x0f, 0x9e,
-	0xc5, 0xdc, 0x4b, 0x66, 0xab, 0xf2, 0xee, 0x5f, 0xe5, 0xc8, 0xbb, 0x4d, 0x59, 0xfa, 0xc7, 0xa6,
-	0x2c, 0xfd, 0x73, 0x53, 0x96, 0xde, 0xfe, 0xbb, 0x1c, 0xf9, 0x0d, 0x9f, 0x7b, 0x6c, 0xec, 0xb9,
-	0xf7, 0x49, 0x0e, 0xfe, 0xd9, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x62, 0x34, 0xad, 0x1c, 0x4b,
-	0x0f, 0x00, 0x00,
+	proto.RegisterFile("storage_common.proto", fileDescriptor_storage_common_e7775d11b52e646a)
+}
+
+var fileDescriptor_storage_common_e7775d11b52e646a = []byte{
+	// 1742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0xfb, 0xdb, 0xcf, 0x1f, 0xe9, 0xa9, 0x0d, 0xc1, 0xdb, 0xc3, 0xc6, 0xbd, 0x06, 0xad,
+	0x02, 0x2c, 0x0e, 0x64, 0x77, 0xc5, 0x68, 0x80, 0x83, 0x9d, 0x71, 0x62, 0x33, 0xfe, 0x88, 0xca,
+	0x9d, 0x65, 0x17, 0x09, 0x59, 0x95, 0xb8, 0xd2, 0xdb, 0x5a, 0xbb, 0xdb, 0x74, 0x97, 0x67, 0x62,
+	0x89, 0x3b, 0x2b, 0x4b, 0x48, 0xcb, 0x15, 0x64, 0x09, 0x89, 0x23, 0x77, 0xfe, 0x86, 0x39, 0xf2,
+	0x17, 0x58, 0x60, 0x24, 0xae, 0x1c, 0x91, 0x38, 0xa1, 0xaa, 0xea, 0xb6, 0xdb, 0x93, 0x90, 0xb5,
+	0xf7, 0x38, 0xb7, 0xaa, 0xf7, 0xf1, 0x7b, 0xef, 0x55, 0xd5, 0xfb, 0xe8, 0x86, 0x7d, 0x8f, 0x39,
+	0x2e, 0x31, 0x69, 0xff, 0xda, 0x19, 0x8d, 0x1c, 0xbb, 0x32, 0x76, 0x1d, 0xe6, 0xa0, 0xc7, 0x96,
+	0x7d, 0x33, 0x9c, 0xdc, 0x0e, 0x08, 0x23, 0x95, 0xf1, 0x90, 0xb0, 0x1b, 0xc7, 0x1d, 0x55, 0x7c,
+	0x49, 0x6d, 0xdf, 0x74, 0x4c, 0x47, 0xc8, 0x1d, 0xf3, 0x95, 0x54, 0xd1, 0x1e, 0x9b, 0x8e, 0x63,
+	0x0e, 0xe9, 0xb1, 0xd8, 0x5d, 0x4d, 0x6e, 0x8e, 0xe9, 0x68, 0xcc, 0xa6, 0x3e, 0xf3, 0xed, 0xd7,
+	0x99, 0xc4, 0x0e, 0x58, 0x7b, 0x63, 0x97, 0x0e, 0xac, 0x6b, 0xc2, 0xa8, 0x24, 0x94, 0xff, 0x93,
+	0x86, 0x2c, 0xa6, 0x64, 0x80, 0xe9, 0xaf, 0x27, 0xd4, 0x63, 0x68, 0x08, 0x7b, 0xcc, 0x1a, 0x51,
+	0x8f, 0x91, 0xd1, 0xb8, 0xef, 0x12, 0xdb, 0xa4, 0xc5, 0xa8, 0xae, 0x1c, 0x65, 0x4f, 0xbe, 0x5f,
+	0x79, 0xc0, 0xcb, 0x8a, 0x11, 0xe8, 0x60, 0xae, 0x52, 0x3b, 0x78, 0xb5, 0x28, 0x45, 0x96, 0x8b,
+	0x52, 0x61, 0x93, 0x8e, 0x0b, 0x6c, 0x63, 0x8f, 0x0e, 0x01, 0x06, 0xd4, 0xbb, 0xa6, 0xf6, 0xc0,
+	0xb2, 0xcd, 0x62, 0x4c, 0x57, 0x8e, 0xd2, 0x38, 0x44, 0x41, 0xef, 0x03, 0x98, 0xae, 0x33, 0x19,
+	0xf7, 0x3f, 0xa7, 0x53, 0xaf, 0x18, 0xd7, 0x63, 0x47, 0x99, 0x5a, 0x7e, 0xb9, 0x28, 0x65, 0xce,
+	0x39, 0xf5, 0x39, 0x9d, 0x7a, 0x38, 0x63, 0x06, 0x4b, 0xf4, 0x0c, 0x32, 0xab, 0xf0, 0x8a, 0x09,
+	0xe1, 0xf5, 0x7b, 0x0f, 0x7a, 0x7d, 0x11, 0x48, 0xe3, 0xb5, 0x22, 0x3a, 0x81, 0x9c, 0x47, 0x5d,
+	0x8b, 0x7a, 0xfd, 0xa1, 0x35, 0xb2, 0x58, 0x31, 0xa9, 0x2b, 0x47, 0xb1, 0xda, 0xde, 0x72, 0x51,
+	0xca, 0xf6, 0x04, 0xbd, 0xc5, 0xc9, 0x38, 0xeb, 0xad, 0x37, 0xe8, 0x23, 0xc8, 0xfb, 0x3a, 0xce,
+	0xcd, 0x8d, 0x47, 0x59, 0x31, 0x25, 0x94, 0xd4, 0xe5, 0xa2, 0x94, 0x93, 0x4a, 0x5d, 0x41, 0xc7,
+	0x39, 0x2f, 0xb4, 0xe3, 0xa6, 0xc6, 0x8e, 0x65, 0xb3, 0xc0, 0x54, 0x7a, 0x6d, 0xea, 0x42, 0xd0,
+	0x7d, 0x53, 0xe3, 0xf5, 0x86, 0x07, 0x49, 0x4c, 0xd3, 0xa5, 0x26, 0x0f, 0x32, 0xb3, 0x45, 0x90,
+	0xd5, 0x40, 0x1a, 0xaf, 0x15, 0x91, 0x01, 0x09, 0xe6, 0x92, 0x6b, 0x5a, 0x04, 0x3d, 0x76, 0x94,
+	0x3d, 0xf9, 0xe0, 0x41, 0x84, 0xd0, 0xfb, 0xa8, 0x18, 0x5c, 0xab, 0x6e, 0x33, 0x77, 0x5a, 0xcb,
+	0x2c, 0x17, 0xa5, 0x84, 0xd8, 0x63, 0x09, 0x86, 0x9e, 0x41, 0x42, 0xdc, 0x46, 0x31, 0xab, 0x2b,
+	0x47, 0x85, 0x93, 0xca, 0xd6, 0xa8, 0xe2, 0x3a, 0xb1, 0x54, 0x46, 0xef, 0x43, 0xe2, 0x33, 0x1e,
+	0x6f, 0x31, 0xa7, 0x2b, 0x47, 0xa9, 0xda, 0x01, 0x37, 0xd3, 0xe0, 0x84, 0xff, 0x2e, 0x4a, 0x19,
+	0xbe, 0x38, 0x1b, 0x12, 0xd3, 0xc3, 0x52, 0x08, 0xd5, 0x21, 0xeb, 0x52, 0x32, 0xe8, 0x7b, 0xce,
+	0xc4, 0xbd, 0xa6, 0xc5, 0xbc, 0x38, 0x91, 0xfd, 0x8a, 0x4c, 0x81, 0x4a, 0x90, 0x02, 0x95, 0xaa,
+	0x3d, 0xad, 0x15, 0x96, 0x8b, 0x12, 0x70, 0xb3, 0x3d, 0x21, 0x8b, 0xc1, 0x5d, 0xad, 0xb5, 0x27,
+	0x00, 0xeb, 0xd0, 0x90, 0x0a, 0xb1, 0xcf, 0xe9, 0xb4, 0xa8, 0xe8, 0xca, 0x51, 0x06, 0xf3, 0x25,
+	0xda, 0x87, 0xc4, 0x0b, 0x32, 0x9c, 0xc8, 0x6c, 0xc8, 0x60, 0xb9, 0x79, 0x1a, 0x7d, 0xa2, 0x94,
+	0x7f, 0xab, 0x40, 0x42, 0xf8, 0x8f, 0xde, 0x01, 0x38, 0xc7, 0xdd, 0xcb, 0x8b, 0x7e, 0xa7, 0xdb,
+	0xa9, 0xab, 0x11, 0x2d, 0x3f, 0x9b, 0xeb, 0xf2, 0xa5, 0x76, 0x1c, 0x9b, 0xa2, 0xc7, 0x90, 0x91,
+	0xec, 0x6a, 0xab, 0xa5, 0x2a, 0x5a, 0x6e, 0x36, 0xd7, 0xd3, 0x82, 0x5b, 0x1d, 0x0e, 0xd1, 0xdb,
+	0x90, 0x96, 0xcc, 0xda, 0xa7, 0x6a, 0x54, 0xcb, 0xce, 0xe6, 0x7a, 0x4a, 0xf0, 0x6a, 0x53, 0xf4,
+	0x2e, 0xe4, 0x24, 0xab, 0xfe, 0xc9, 0x69, 0xfd, 0xc2, 0x50, 0x63, 0xda, 0xde, 0x6c, 0xae, 0x67,
+	0x05, 0xbb, 0x7e, 0x7b, 0x4d, 0xc7, 0x4c, 0x8b, 0x7f, 0xf1, 0xe7, 0xc3, 0x48, 0xf9, 0x2f, 0x0a,
+	0xac, 0xcf, 0x87, 0x9b, 0x6b, 0x34, 0x3b, 0x46, 0xe0, 0x8c, 0x30, 0xc7, 0xb9, 0xc2, 0x97, 0xef,
+	0x40, 0xc1, 0x67, 0xf6, 0x2f, 0xba, 0xcd, 0x8e, 0xd1, 0x53, 0x15, 0x4d, 0x9d, 0xcd, 0xf5, 0x9c,
+	0x94, 0x90, 0xaf, 0x2f, 0x2c, 0xd5, 0xab, 0xe3, 0x66, 0xbd, 0xa7, 0x46, 0xc3, 0x52, 0xf2, 0x65,
+	0xa3, 0x63, 0xd8, 0x17, 0x52, 0xbd, 0xd3, 0x46, 0xbd, 0x5d, 0xe5, 0xd1, 0xf5, 0x8d, 0x66, 0xbb,
+	0xae, 0xc6, 0xb5, 0x6f, 0xcc, 0xe6, 0xfa, 0x23, 0x2e, 0xdb, 0xbb, 0xfe, 0x8c, 0x8e, 0x48, 0x75,
+	0x38, 0xe4, 0xf5, 0xc0, 0xf7, 0x76, 0x11, 0x83, 0xcc, 0xea, 0x6d, 0xa2, 0x06, 0xc4, 0xd9, 0x74,
+	0x4c, 0xc5, 0x91, 0x17, 0x4e, 0x3e, 0xdc, 0xee, 0x45, 0xaf, 0x57, 0xc6, 0x74, 0x4c, 0xb1, 0x40,
+	0xe0, 0x49, 0xf5, 0xd2, 0xb2, 0x07, 0xce, 0xcb, 0x3e, 0x7d, 0x41, 0xdd, 0x69, 0x31, 0xba, 0x4e,
+	0xaa, 0x5f, 0x08, 0x7a, 0x9d, 0x93, 0x71, 0xf6, 0xe5, 0x7a, 0xc3, 0xf3, 0xd7, 0xd7, 0xf1, 0xf3,
+	0x37, 0xb6, 0xce, 0x5f, 0xa9, 0x14, 0xe4, 0xef, 0xcb, 0xd0, 0xae, 0xfc, 0xc7, 0x28, 0xe4, 0x37,
+	0x5c, 0x40, 0x25, 0x88, 0xfb, 0xe7, 0x2d, 0x62, 0xdf, 0x60, 0x8a, 0x83, 0x7f, 0x07, 0x62, 0xbd,
+	0xcb, 0xb6, 0xaa, 0x68, 0xfb, 0xb3, 0xb9, 0xae, 0x6e, 0xf0, 0x7b, 0x93, 0x11, 0x7a, 0x17, 0x12,
+	0xa7, 0xdd, 0xcb, 0x8e, 0xa1, 0x46, 0xb5, 0x83, 0xd9, 0x5c, 0x47, 0x1b, 0x02, 0xa7, 0xce, 0xc4,
+	0x66, 0x1c, 0xa1, 0xdd, 0xec, 0xa8, 0xb1, 0x7b, 0x10, 0xda, 0x96, 0x2d, 0xd8, 0xd5, 0x4f, 0xd4,
+	0xf8, 0x7d, 0x6c, 0x72, 0xcb, 0x0d, 0x9c, 0x35, 0x71, 0xcf, 0x50, 0x13, 0xf7, 0x18, 0x38, 0xb3,
+	0x5c, 0x8f, 0xf1, 0x18, 0x5a, 0xd5, 0x9e, 0xa1, 0x26, 0xef, 0x89, 0xa1, 0x45, 0xa4, 0x40, 0xbb,
+	0x5e, 0xed, 0xa8, 0xa9, 0x7b, 0x04, 0xda, 0x94, 0xd8, 0xfe, 0x05, 0xff, 0x00, 0x62, 0x06, 0x31,
+	0xc3, 0xb9, 0x94, 0xbb, 0x27, 0x97, 0x72, 0x7e, 0x2e, 0x95, 0x7f, 0x5f, 0x80, 0x9c, 0xac, 0x09,
+	0xde, 0xd8, 0xb1, 0x3d, 0x8a, 0xda, 0x90, 0xbc, 0x71, 0xc9, 0x88, 0x7a, 0x45, 0x45, 0x14, 0xa9,
+	0xe3, 0x2d, 0xca, 0x89, 0x54, 0xad, 0x9c, 0x71, 0xbd, 0x5a, 0x9c, 0x77, 0x21, 0xec, 0x83, 0x68,
+	0x5f, 0x24, 0x21, 0x21, 0xe8, 0xa8, 0x0b, 0x49, 0x59, 0x86, 0x85, 0x53, 0xd9, 0x93, 0x8f, 0xb6,
+	0x07, 0x96, 0x4f, 0x5e, 0xc0, 0x34, 0x22, 0xd8, 0x87, 0x41, 0x63, 0xc8, 0xdd, 0x0c, 0x1d, 0xc2,
+	0xfa, 0xb2, 0x50, 0xfb, 0x1d, 0xf3, 0xe9, 0x0e, 0xfe, 0x72, 0x6d, 0x99, 0x74, 0xd2, 0x75, 0xf1,
+	0x5c, 0x43, 0xd4, 0x46, 0x04, 0x67, 0x6f, 0xd6, 0x5b, 0x74, 0x0b, 0x05, 0xcb, 0x66, 0xd4, 0xa4,
+	0x6e, 0x60, 0x33, 0x26, 0x6c, 0xfe, 0x74, 0x7b, 0x9b, 0x4d, 0xa9, 0x1f, 0xb6, 0xfa, 0x68, 0xb9,
+	0x28, 0xe5, 0x37, 0xe8, 0x8d, 0x08, 0xce, 0x5b, 0x61, 0x02, 0xfa, 0x0d, 0xec, 0x4d, 0x6c, 0xcf,
+	0x32, 0x6d, 0x3a, 0x08, 0x4c, 0xc7, 0x85, 0xe9, 0x9f, 0x6d, 0x6f, 0xfa, 0xd2, 0x07, 0x08, 0xdb,
+	0x46, 0x7c, 0x5c, 0xd8, 0x64, 0x34, 0x22, 0xb8, 0x30, 0xd9, 0xa0, 0xf0, 0xb8, 0xaf, 0x1c, 0x67,
+	0x48, 0x89, 0x1d, 0x18, 0x4f, 0xec, 0x1a, 0x77, 0x4d, 0xea, 0xdf, 0x89, 0x7b, 0x83, 0xce, 0xe3,
+	0xbe, 0x0a, 0x13, 0x10, 0x83, 0xbc, 0xc7, 0x5c, 0xcb, 0x36, 0x03, 0xc3, 0x49, 0x61, 0xf8, 0x27,
+	0x3b, 0xbc, 0x1d, 0xa1, 0x1e, 0xb6, 0x2b, 0xe7, 0x83, 0x10, 0xb9, 0x11, 0xc1, 0x39, 0x2f, 0xb4,
+	0x47, 0xad, 0xa0, 0xa3, 0xa6, 0x84, 0xb5, 0x0f, 0xb7, 0xb7, 0x26, 0xda, 0x43, 0xf0, 0x50, 0x25,
+	0x48, 0x2d, 0x09, 0x71, 0xae, 0xa9, 0xdd, 0x02, 0xac, 0xd9, 0xe8, 0x3d, 0x48, 0x33, 0x62, 0xca,
+	0x11, 0x8b, 0x67, 0x5a, 0xae, 0x96, 0x5d, 0x2e, 0x4a, 0x29, 0x83, 0x98, 0x62, 0xc0, 0x4a, 0x31,
+	0xb9, 0x40, 0x35, 0x40, 0x63, 0xe2, 0x32, 0x8b, 0x59, 0x8e, 0xcd, 0xa5, 0xfb, 0x2f, 0xc8, 0x90,
+	0xbf, 0x75, 0xae, 0xb1, 0xbf, 0x5c, 0x94, 0xd4, 0x8b, 0x80, 0xfb, 0x9c, 0x4e, 0x3f, 0x26, 0x43,
+	0x0f, 0xab, 0xe3, 0xd7, 0x28, 0xda, 0x1f, 0x14, 0xc8, 0x86, 0x72, 0x08, 0x3d, 0x85, 0x38, 0x23,
+	0x66, 0x90, 0xe1, 0xfa, 0xc3, 0x33, 0x26, 0x31, 0xfd, 0x94, 0x16, 0x3a, 0xa8, 0x0b, 0x19, 0x2e,
+	0xd8, 0x17, 0x7d, 0x23, 0x2a, 0xfa, 0xc6, 0xc9, 0xf6, 0xe7, 0xf3, 0x8c, 0x30, 0x22, 0xba, 0x46,
+	0x7a, 0xe0, 0xaf, 0xb4, 0x9f, 0x83, 0xfa, 0x7a, 0x22, 0xf2, 0x09, 0x75, 0x35, 0xb3, 0x4a, 0x37,
+	0x55, 0x1c, 0xa2, 0xa0, 0x03, 0x48, 0x8a, 0xf2, 0x25, 0x0f, 0x42, 0xc1, 0xfe, 0x4e, 0x6b, 0x01,
+	0xba, 0x9b, 0x60, 0x3b, 0xa2, 0xc5, 0x56, 0x68, 0x6d, 0x78, 0xeb, 0x9e, 0x9c, 0xd9, 0x11, 0x2e,
+	0x1e, 0x76, 0xee, 0x6e, 0x16, 0xec, 0x88, 0x96, 0x5e, 0xa1, 0x3d, 0x87, 0x47, 0x77, 0x9e, 0xf6,
+	0x8e, 0x60, 0x99, 0x00, 0xac, 0xdc, 0x83, 0x8c, 0x00, 0xf0, 0xbb, 0x69, 0xd2, 0x9f, 0x3b, 0x22,
+	0xda, 0x5b, 0xb3, 0xb9, 0xbe, 0xb7, 0x62, 0xf9, 0xa3, 0x47, 0x09, 0x92, 0xab, 0xf1, 0x65, 0x53,
+	0x40, 0xfa, 0xe2, 0x77, 0xa2, 0xbf, 0x2a, 0x90, 0x0e, 0xee, 0x1b, 0x7d, 0x0b, 0x12, 0x67, 0xad,
+	0x6e, 0xd5, 0x50, 0x23, 0xda, 0xa3, 0xd9, 0x5c, 0xcf, 0x07, 0x0c, 0x71, 0xf5, 0x48, 0x87, 0x54,
+	0xb3, 0x63, 0xd4, 0xcf, 0xeb, 0x38, 0x80, 0x0c, 0xf8, 0xfe, 0x75, 0xa2, 0x32, 0xa4, 0x2f, 0x3b,
+	0xbd, 0xe6, 0x79, 0xa7, 0xfe, 0x4c, 0x8d, 0xca, 0x2e, 0x1b, 0x88, 0x04, 0x77, 0xc4, 0x51, 0x6a,
+	0xdd, 0x6e, 0x8b, 0x37, 0xc9, 0xd8, 0x26, 0x8a, 0x7f, 0xee, 0xe8, 0x10, 0x92, 0x3d, 0x03, 0x37,
+	0x3b, 0xe7, 0x6a, 0x5c, 0x43, 0xb3, 0xb9, 0x5e, 0x08, 0x04, 0xe4, 0x51, 0xfa, 0x8e, 0xff, 0x49,
+	0x81, 0xfd, 0x53, 0x32, 0x26, 0x57, 0xd6, 0xd0, 0x62, 0x16, 0xf5, 0x56, 0xbd, 0xb1, 0x0b, 0xf1,
+	0x6b, 0x32, 0x0e, 0xf2, 0xe6, 0xe1, 0x22, 0x74, 0x1f, 0x00, 0x27, 0x7a, 0x62, 0xd6, 0xc5, 0x02,
+	0x48, 0xfb, 0x31, 0x64, 0x56, 0xa4, 0x9d, 0xc6, 0xdf, 0x3d, 0xc8, 0x8b, 0xe1, 0x3c, 0x40, 0x2e,
+	0x3f, 0x81, 0xd7, 0xbe, 0xfa, 0xb8, 0xb2, 0xc7, 0x88, 0xcb, 0x04, 0x60, 0x0c, 0xcb, 0x0d, 0x37,
+	0x42, 0xed, 0x81, 0x1c, 0xcf, 0x30, 0x5f, 0x96, 0xff, 0xa5, 0x40, 0x21, 0xa8, 0x3a, 0xfe, 0xe7,
+	0x68, 0x1d, 0xb2, 0x3c, 0xd7, 0x83, 0xe9, 0x5e, 0xf9, 0xaa, 0xe9, 0xde, 0x20, 0xa6, 0x17, 0x4c,
+	0xf7, 0x6c, 0xb5, 0x46, 0xe7, 0x90, 0xf8, 0xda, 0xdf, 0xb2, 0xb2, 0xe4, 0x48, 0xfd, 0xcd, 0x4f,
+	0xcc, 0xd8, 0xd7, 0xfc, 0xc4, 0x2c, 0xff, 0x2e, 0x0a, 0xaa, 0x41, 0xcc, 0x8f, 0xc5, 0x93, 0x7f,
+	0xa3, 0x43, 0x45, 0xdf, 0x86, 0x94, 0xdf, 0x5c, 0xc4, 0x98, 0x90, 0xa9, 0xc1, 0x72, 0x51, 0x4a,
+	0xca, 0x5b, 0xc6, 0x49, 0xd9, 0x5a, 0xca, 0xff, 0x56, 0xe0, 0x9b, 0x6d, 0x4a, 0xbc, 0x89, 0x4b,
+	0x47, 0xd4, 0x66, 0x1d, 0x32, 0x7a, 0xc3, 0x8f, 0xe5, 0xe4, 0xcb, 0x28, 0xa4, 0x7a, 0x52, 0x00,
+	0xfd, 0x0a, 0xe2, 0xbc, 0x33, 0xa1, 0xa3, 0x6d, 0x3f, 0x97, 0xb5, 0xef, 0x6e, 0xdd, 0xe6, 0x7e,
+	0xa8, 0xa0, 0x4f, 0x21, 0x17, 0xae, 0x00, 0xe8, 0xe0, 0xce, 0xd9, 0xd5, 0xf9, 0xbf, 0x23, 0xed,
+	0x47, 0x3b, 0x17, 0x11, 0xf4, 0x1c, 0xe4, 0x87, 0xf9, 0xff, 0xc5, 0xfc, 0xde, 0x83, 0x98, 0x1b,
+	0x75, 0xa3, 0x56, 0x7a, 0xf5, 0x8f, 0xc3, 0xc8, 0xab, 0xe5, 0xa1, 0xf2, 0xb7, 0xe5, 0xa1, 0xf2,
+	0xf7, 0xe5, 0xa1, 0xf2, 0xe5, 0x3f, 0x0f, 0x23, 0xbf, 0x14, 0x2d, 0x9e, 0x77, 0x78, 0xef, 0x2a,
+	0x29, 0xc0, 0x3f, 0xf8, 0xdf, 0x00, 0x4d, 0xe6, 0xb3, 0x0f, 0x45, 0x13, 0x00, 0x00,
 }
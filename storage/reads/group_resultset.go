@@ -58,7 +58,7 @@ func NewGroupResultSet(ctx context.Context, req *datatypes.ReadRequest, newCurso
 		o(g)
 	}
 
-	g.mb = newMultiShardArrayCursors(ctx, req.TimestampRange.Start, req.TimestampRange.End, !req.Descending, req.PointsLimit)
+	g.mb = newMultiShardArrayCursors(ctx, req.TimestampRange.Start, req.TimestampRange.End, cursorAscending(req), req.PointsLimit)
 
 	for i, k := range req.GroupKeys {
 		g.keys[i] = []byte(k)
@@ -297,9 +297,10 @@ type groupNoneCursor struct {
 	cur  SeriesCursor
 	row  SeriesRow
 	keys [][]byte
+	err  error
 }
 
-func (c *groupNoneCursor) Err() error                 { return nil }
+func (c *groupNoneCursor) Err() error                 { return c.err }
 func (c *groupNoneCursor) Tags() models.Tags          { return c.row.Tags }
 func (c *groupNoneCursor) Keys() [][]byte             { return c.keys }
 func (c *groupNoneCursor) PartitionKeyVals() [][]byte { return nil }
@@ -307,6 +308,10 @@ func (c *groupNoneCursor) Close()                     { c.cur.Close() }
 func (c *groupNoneCursor) Stats() cursors.CursorStats { return c.row.Query.Stats() }
 
 func (c *groupNoneCursor) Next() bool {
+	if c.err != nil {
+		return false
+	}
+
 	row := c.cur.Next()
 	if row == nil {
 		return false
@@ -318,9 +323,9 @@ func (c *groupNoneCursor) Next() bool {
 }
 
 func (c *groupNoneCursor) Cursor() cursors.Cursor {
-	cur := c.mb.createCursor(c.row)
-	if c.agg != nil {
-		cur = c.mb.newAggregateCursor(c.ctx, c.agg, cur)
+	cur, err := newGroupCursor(c.ctx, c.mb, c.agg, c.row)
+	if err != nil {
+		c.err = err
 	}
 	return cur
 }
@@ -333,6 +338,7 @@ type groupByCursor struct {
 	rows []*SeriesRow
 	keys [][]byte
 	vals [][]byte
+	err  error
 }
 
 func (c *groupByCursor) reset(rows []*SeriesRow) {
@@ -340,14 +346,14 @@ func (c *groupByCursor) reset(rows []*SeriesRow) {
 	c.rows = rows
 }
 
-func (c *groupByCursor) Err() error                 { return nil }
+func (c *groupByCursor) Err() error                 { return c.err }
 func (c *groupByCursor) Keys() [][]byte             { return c.keys }
 func (c *groupByCursor) PartitionKeyVals() [][]byte { return c.vals }
 func (c *groupByCursor) Tags() models.Tags          { return c.rows[c.i-1].Tags }
 func (c *groupByCursor) Close()                     {}
 
 func (c *groupByCursor) Next() bool {
-	if c.i < len(c.rows) {
+	if c.err == nil && c.i < len(c.rows) {
 		c.i++
 		return true
 	}
@@ -355,13 +361,29 @@ func (c *groupByCursor) Next() bool {
 }
 
 func (c *groupByCursor) Cursor() cursors.Cursor {
-	cur := c.mb.createCursor(*c.rows[c.i-1])
-	if c.agg != nil {
-		cur = c.mb.newAggregateCursor(c.ctx, c.agg, cur)
+	cur, err := newGroupCursor(c.ctx, c.mb, c.agg, *c.rows[c.i-1])
+	if err != nil {
+		c.err = err
 	}
 	return cur
 }
 
+// newGroupCursor returns the cursor of row, aggregated by agg if it is not nil.
+// The cursor is nil if row has no points.
+func newGroupCursor(ctx context.Context, mb multiShardCursors, agg *datatypes.Aggregate, row SeriesRow) (cursors.Cursor, error) {
+	cur := mb.createCursor(row)
+	if agg == nil || cur == nil {
+		return cur, nil
+	}
+
+	aggCur, err := mb.newAggregateCursor(ctx, agg, cur)
+	if err != nil {
+		cur.Close()
+		return nil, err
+	}
+	return aggCur, nil
+}
+
 func (c *groupByCursor) Stats() cursors.CursorStats {
 	var stats cursors.CursorStats
 	for _, row := range c.rows {
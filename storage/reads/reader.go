@@ -3,6 +3,7 @@ package reads
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -49,6 +50,78 @@ func (r *storeReader) Read(ctx context.Context, rs fstorage.ReadSpec, start, sto
 	}, nil
 }
 
+func (r *storeReader) TagKeys(ctx context.Context, rs fstorage.ReadSpec, start, stop execute.Time) ([]string, error) {
+	src, predicate, err := r.metadataRequest(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := r.s.TagKeys(ctx, &datatypes.TagKeysRequest{
+		TagsSource: src,
+		Range:      datatypes.TimestampRange{Start: int64(start), End: int64(stop)},
+		Predicate:  predicate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cursors.StringIteratorToSlice(itr), nil
+}
+
+func (r *storeReader) TagValues(ctx context.Context, rs fstorage.ReadSpec, tagKey string, start, stop execute.Time) ([]string, error) {
+	src, predicate, err := r.metadataRequest(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := r.s.TagValues(ctx, &datatypes.TagValuesRequest{
+		TagsSource: src,
+		Range:      datatypes.TimestampRange{Start: int64(start), End: int64(stop)},
+		Predicate:  predicate,
+		TagKey:     tagKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cursors.StringIteratorToSlice(itr), nil
+}
+
+func (r *storeReader) MeasurementNames(ctx context.Context, rs fstorage.ReadSpec, start, stop execute.Time) ([]string, error) {
+	src, predicate, err := r.metadataRequest(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := r.s.MeasurementNames(ctx, &datatypes.MeasurementNamesRequest{
+		TagsSource: src,
+		Range:      datatypes.TimestampRange{Start: int64(start), End: int64(stop)},
+		Predicate:  predicate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cursors.StringIteratorToSlice(itr), nil
+}
+
+// metadataRequest returns the source and predicate of the metadata requests for rs.
+func (r *storeReader) metadataRequest(rs fstorage.ReadSpec) (*types.Any, *datatypes.Predicate, error) {
+	src, err := r.s.GetSource(rs)
+	if err != nil {
+		return nil, nil, err
+	}
+	any, err := types.MarshalAny(src)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var predicate *datatypes.Predicate
+	if rs.Predicate != nil {
+		if predicate, err = toStoragePredicate(rs.Predicate); err != nil {
+			return nil, nil, err
+		}
+	}
+	return any, predicate, nil
+}
+
 func (r *storeReader) Close() {}
 
 type tableIterator struct {
@@ -95,6 +168,17 @@ func (bi *tableIterator) Do(f func(flux.Table) error) error {
 		req.Aggregate = &datatypes.Aggregate{Type: agg}
 	}
 
+	if bi.readSpec.WindowEvery > 0 {
+		if req.Aggregate == nil {
+			return errors.New("windowed read requires an aggregate")
+		}
+		if req.Group != datatypes.GroupAll {
+			return errors.New("windowed read cannot be grouped")
+		}
+		req.Aggregate.WindowEvery = bi.readSpec.WindowEvery
+		req.Aggregate.WindowOffset = bi.readSpec.WindowOffset
+	}
+
 	switch {
 	case req.Group != datatypes.GroupAll:
 		rs, err := bi.s.GroupRead(bi.ctx, &req)
@@ -124,6 +208,9 @@ func (bi *tableIterator) Do(f func(flux.Table) error) error {
 		if req.Hints.NoPoints() {
 			return bi.handleReadNoPoints(f, rs)
 		}
+		if bi.readSpec.WindowEvery > 0 {
+			return bi.handleWindowRead(f, rs)
+		}
 		return bi.handleRead(f, rs)
 	}
 }
@@ -198,6 +285,105 @@ READ:
 	return rs.Err()
 }
 
+// handleWindowRead produces a table for each window of each series.
+// The cursors of rs produce the aggregated point of each window,
+// so each point is a table of its own.
+func (bi *tableIterator) handleWindowRead(f func(flux.Table) error, rs ResultSet) error {
+	// these resources must be closed if not nil on return
+	var (
+		cur   windowArrayCursor
+		table storageTable
+	)
+
+	defer func() {
+		if table != nil {
+			table.Close()
+		}
+		if cur != nil {
+			cur.Close()
+		}
+		rs.Close()
+	}()
+
+READ:
+	for rs.Next() {
+		c := rs.Cursor()
+		if c == nil {
+			// no data for series key + field combination
+			continue
+		}
+		cur = newPointWindowArrayCursor(c, bi.readSpec.WindowEvery, bi.readSpec.WindowOffset)
+
+		for start, ok := cur.nextWindow(); ok; start, ok = cur.nextWindow() {
+			bounds := bi.windowBounds(start)
+			key := groupKeyForSeries(rs.Tags(), &bi.readSpec, bounds)
+			done := make(chan struct{})
+			switch typedCur := cur.window().(type) {
+			case cursors.IntegerArrayCursor:
+				cols, defs := determineTableColsForSeries(rs.Tags(), flux.TInt)
+				table = newIntegerTable(done, typedCur, bounds, key, cols, rs.Tags(), defs)
+			case cursors.FloatArrayCursor:
+				cols, defs := determineTableColsForSeries(rs.Tags(), flux.TFloat)
+				table = newFloatTable(done, typedCur, bounds, key, cols, rs.Tags(), defs)
+			case cursors.UnsignedArrayCursor:
+				cols, defs := determineTableColsForSeries(rs.Tags(), flux.TUInt)
+				table = newUnsignedTable(done, typedCur, bounds, key, cols, rs.Tags(), defs)
+			case cursors.BooleanArrayCursor:
+				cols, defs := determineTableColsForSeries(rs.Tags(), flux.TBool)
+				table = newBooleanTable(done, typedCur, bounds, key, cols, rs.Tags(), defs)
+			case cursors.StringArrayCursor:
+				cols, defs := determineTableColsForSeries(rs.Tags(), flux.TString)
+				table = newStringTable(done, typedCur, bounds, key, cols, rs.Tags(), defs)
+			default:
+				panic(fmt.Sprintf("unreachable: %T", typedCur))
+			}
+
+			if !table.Empty() {
+				if err := f(table); err != nil {
+					table.Close()
+					table = nil
+					return err
+				}
+				select {
+				case <-done:
+				case <-bi.ctx.Done():
+					table.Cancel()
+					break READ
+				}
+			}
+
+			// the tables share cur, which is closed once all windows are read
+			table.Close()
+			table = nil
+		}
+
+		cs := cur.Stats()
+		bi.stats = bi.stats.Add(flux.Statistics{
+			ScannedValues: cs.ScannedValues,
+			ScannedBytes:  cs.ScannedBytes,
+		})
+		cur.Close()
+		cur = nil
+	}
+	return rs.Err()
+}
+
+// windowBounds returns the bounds of the window that starts at start,
+// limited to the bounds of the read.
+func (bi *tableIterator) windowBounds(start int64) execute.Bounds {
+	bounds := execute.Bounds{
+		Start: execute.Time(start),
+		Stop:  execute.Time(start + bi.readSpec.WindowEvery),
+	}
+	if bounds.Start < bi.bounds.Start {
+		bounds.Start = bi.bounds.Start
+	}
+	if bounds.Stop > bi.bounds.Stop {
+		bounds.Stop = bi.bounds.Stop
+	}
+	return bounds
+}
+
 func (bi *tableIterator) handleReadNoPoints(f func(flux.Table) error, rs ResultSet) error {
 	// these resources must be closed if not nil on return
 	var table storageTable
@@ -272,6 +458,9 @@ READ:
 		}
 
 		if cur == nil {
+			if err := gc.Err(); err != nil {
+				return err
+			}
 			gc.Close()
 			gc = rs.Next()
 			continue
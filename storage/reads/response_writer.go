@@ -77,6 +77,10 @@ func (w *ResponseWriter) WriteResultSet(rs ResultSet) error {
 		}
 	}
 
+	if err := rs.Err(); err != nil {
+		return err
+	}
+
 	stats := rs.Stats()
 	w.stream.SetTrailer(metadata.Pairs(
 		"scanned-bytes", fmt.Sprint(stats.ScannedBytes),
@@ -105,6 +109,10 @@ func (w *ResponseWriter) WriteGroupResultSet(rs GroupResultSet) error {
 			}
 			stats.Add(gc.Stats())
 		}
+		if err := gc.Err(); err != nil {
+			gc.Close()
+			return err
+		}
 		gc.Close()
 		gc = rs.Next()
 	}
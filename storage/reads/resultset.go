@@ -10,7 +10,7 @@ import (
 
 type multiShardCursors interface {
 	createCursor(row SeriesRow) cursors.Cursor
-	newAggregateCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) cursors.Cursor
+	newAggregateCursor(ctx context.Context, agg *datatypes.Aggregate, cursor cursors.Cursor) (cursors.Cursor, error)
 }
 
 type resultSet struct {
@@ -19,6 +19,7 @@ type resultSet struct {
 	cur SeriesCursor
 	row SeriesRow
 	mb  multiShardCursors
+	err error
 }
 
 func NewResultSet(ctx context.Context, req *datatypes.ReadRequest, cur SeriesCursor) ResultSet {
@@ -26,11 +27,11 @@ func NewResultSet(ctx context.Context, req *datatypes.ReadRequest, cur SeriesCur
 		ctx: ctx,
 		agg: req.Aggregate,
 		cur: cur,
-		mb:  newMultiShardArrayCursors(ctx, req.TimestampRange.Start, req.TimestampRange.End, !req.Descending, req.PointsLimit),
+		mb:  newMultiShardArrayCursors(ctx, req.TimestampRange.Start, req.TimestampRange.End, cursorAscending(req), req.PointsLimit),
 	}
 }
 
-func (r *resultSet) Err() error { return nil }
+func (r *resultSet) Err() error { return r.err }
 
 // Close closes the result set. Close is idempotent.
 func (r *resultSet) Close() {
@@ -43,7 +44,7 @@ func (r *resultSet) Close() {
 
 // Next returns true if there are more results available.
 func (r *resultSet) Next() bool {
-	if r == nil {
+	if r == nil || r.err != nil {
 		return false
 	}
 
@@ -57,10 +58,18 @@ func (r *resultSet) Next() bool {
 	return true
 }
 
+// Cursor returns the cursor of the current series, or nil if the series has
+// no points or its points cannot be aggregated, in which case Err is set.
 func (r *resultSet) Cursor() cursors.Cursor {
 	cur := r.mb.createCursor(r.row)
-	if r.agg != nil {
-		cur = r.mb.newAggregateCursor(r.ctx, r.agg, cur)
+	if r.agg != nil && cur != nil {
+		aggCur, err := r.mb.newAggregateCursor(r.ctx, r.agg, cur)
+		if err != nil {
+			cur.Close()
+			r.err = err
+			return nil
+		}
+		cur = aggCur
 	}
 	return cur
 }
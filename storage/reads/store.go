@@ -78,4 +78,13 @@ type Store interface {
 	Read(ctx context.Context, req *datatypes.ReadRequest) (ResultSet, error)
 	GroupRead(ctx context.Context, req *datatypes.ReadRequest) (GroupResultSet, error)
 	GetSource(rs fstorage.ReadSpec) (proto.Message, error)
+
+	// TagKeys returns the sorted, distinct tag keys of the series matching req.
+	TagKeys(ctx context.Context, req *datatypes.TagKeysRequest) (cursors.StringIterator, error)
+
+	// TagValues returns the sorted, distinct values of req.TagKey of the series matching req.
+	TagValues(ctx context.Context, req *datatypes.TagValuesRequest) (cursors.StringIterator, error)
+
+	// MeasurementNames returns the sorted, distinct measurement names of the series matching req.
+	MeasurementNames(ctx context.Context, req *datatypes.MeasurementNamesRequest) (cursors.StringIterator, error)
 }
@@ -242,6 +242,7 @@ func (t *floatGroupTable) advanceCursor() bool {
 			return true
 		}
 	}
+	t.err = t.gc.Err()
 	return false
 }
 
@@ -480,6 +481,7 @@ func (t *integerGroupTable) advanceCursor() bool {
 			return true
 		}
 	}
+	t.err = t.gc.Err()
 	return false
 }
 
@@ -718,6 +720,7 @@ func (t *unsignedGroupTable) advanceCursor() bool {
 			return true
 		}
 	}
+	t.err = t.gc.Err()
 	return false
 }
 
@@ -956,6 +959,7 @@ func (t *stringGroupTable) advanceCursor() bool {
 			return true
 		}
 	}
+	t.err = t.gc.Err()
 	return false
 }
 
@@ -1194,6 +1198,7 @@ func (t *booleanGroupTable) advanceCursor() bool {
 			return true
 		}
 	}
+	t.err = t.gc.Err()
 	return false
 }
 
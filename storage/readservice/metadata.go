@@ -0,0 +1,177 @@
+package readservice
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/storage/reads"
+	"github.com/influxdata/platform/storage/reads/datatypes"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/cursors"
+)
+
+func (s *store) TagKeys(ctx context.Context, req *datatypes.TagKeysRequest) (cursors.StringIterator, error) {
+	src, cond, filter, err := s.metadataQuery(ctx, req.TagsSource, req.Range, req.Predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.engine.TagKeys(ctx, platform.ID(src.OrganizationID), platform.ID(src.BucketID), cond, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		names[normalizeTagKey(key)] = struct{}{}
+	}
+	return cursors.NewStringSliceIterator(sortedStrings(names)), nil
+}
+
+func (s *store) TagValues(ctx context.Context, req *datatypes.TagValuesRequest) (cursors.StringIterator, error) {
+	if req.TagKey == "" {
+		return nil, errors.New("missing tag key")
+	}
+
+	src, cond, filter, err := s.metadataQuery(ctx, req.TagsSource, req.Range, req.Predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := s.engine.TagValues(ctx, platform.ID(src.OrganizationID), platform.ID(src.BucketID), indexTagKey(req.TagKey), cond, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	a := make([]string, 0, len(vals))
+	for _, v := range vals {
+		a = append(a, string(v))
+	}
+	return cursors.NewStringSliceIterator(a), nil
+}
+
+func (s *store) MeasurementNames(ctx context.Context, req *datatypes.MeasurementNamesRequest) (cursors.StringIterator, error) {
+	return s.TagValues(ctx, &datatypes.TagValuesRequest{
+		TagsSource: req.TagsSource,
+		Range:      req.Range,
+		Predicate:  req.Predicate,
+		TagKey:     measurementKey,
+	})
+}
+
+// metadataQuery returns the bucket identified by source, the condition of predicate
+// and, unless the range is unbounded, a filter accepting the series with points within tr.
+func (s *store) metadataQuery(ctx context.Context, source *types.Any, tr datatypes.TimestampRange, predicate *datatypes.Predicate) (*readSource, influxql.Expr, storage.SeriesFilter, error) {
+	if source == nil {
+		return nil, nil, nil, errors.New("missing tags source")
+	}
+
+	var src readSource
+	if err := types.UnmarshalAny(source, &src); err != nil {
+		return nil, nil, nil, err
+	}
+
+	var cond influxql.Expr
+	if root := predicate.GetRoot(); root != nil {
+		var err error
+		if cond, err = reads.NodeToExpr(root, nil); err != nil {
+			return nil, nil, nil, err
+		}
+		if reads.HasFieldValueKey(cond) {
+			return nil, nil, nil, errors.New("predicate cannot reference the _value column")
+		}
+	}
+
+	start, end := tr.Start, tr.End
+	if start <= 0 {
+		start = models.MinNanoTime
+	}
+	if end <= 0 {
+		end = models.MaxNanoTime
+	}
+
+	// The index holds every series, so only a bounded range requires reading from the engine.
+	if start == models.MinNanoTime && end == models.MaxNanoTime {
+		return &src, cond, nil, nil
+	}
+
+	qry, err := s.engine.CreateCursorIterator(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	filter := func(name []byte, tags models.Tags) (bool, error) {
+		return hasPoints(ctx, qry, name, tags, start, end)
+	}
+	return &src, cond, filter, nil
+}
+
+// hasPoints reports whether the series with name and tags has any points between start and end.
+func hasPoints(ctx context.Context, qry tsdb.CursorIterator, name []byte, tags models.Tags, start, end int64) (bool, error) {
+	cur, err := qry.Next(ctx, &cursors.CursorRequest{
+		Name:      name,
+		Tags:      tags,
+		Field:     string(tags.Get(tsdb.FieldKeyTagKeyBytes)),
+		Ascending: true,
+		StartTime: start,
+		EndTime:   end,
+	})
+	if err != nil || cur == nil {
+		return false, err
+	}
+	defer cur.Close()
+
+	switch c := cur.(type) {
+	case cursors.IntegerArrayCursor:
+		return c.Next().Len() > 0, c.Err()
+	case cursors.FloatArrayCursor:
+		return c.Next().Len() > 0, c.Err()
+	case cursors.UnsignedArrayCursor:
+		return c.Next().Len() > 0, c.Err()
+	case cursors.StringArrayCursor:
+		return c.Next().Len() > 0, c.Err()
+	case cursors.BooleanArrayCursor:
+		return c.Next().Len() > 0, c.Err()
+	default:
+		return false, nil
+	}
+}
+
+// normalizeTagKey returns the name of key, a tag key as stored in the index,
+// like normalizeTags does for the tags of a series.
+func normalizeTagKey(key []byte) string {
+	switch string(key) {
+	case tsdb.FieldKeyTagKey:
+		return fieldKey
+	case tsdb.MeasurementTagKey:
+		return measurementKey
+	default:
+		return string(key)
+	}
+}
+
+// indexTagKey returns the tag key as stored in the index of the tag named key.
+func indexTagKey(key string) []byte {
+	switch key {
+	case fieldKey:
+		return tsdb.FieldKeyTagKeyBytes
+	case measurementKey:
+		return tsdb.MeasurementTagKeyBytes
+	default:
+		return []byte(key)
+	}
+}
+
+func sortedStrings(m map[string]struct{}) []string {
+	a := make([]string, 0, len(m))
+	for s := range m {
+		a = append(a, s)
+	}
+	sort.Strings(a)
+	return a
+}
@@ -24,9 +24,11 @@ func NewProxyQueryService(queryController *pcontrol.Controller) query.ProxyQuery
 
 // AddControllerConfigDependencies sets up the dependencies on cc
 // such that "from" and "to" flux functions will work correctly.
+// The "to" function writes with pointsWriter, which is usually the engine itself.
 func AddControllerConfigDependencies(
 	cc *control.Config,
 	engine *storage.Engine,
+	pointsWriter storage.PointsWriter,
 	bucketSvc platform.BucketService,
 	orgSvc platform.OrganizationService,
 ) error {
@@ -48,6 +50,6 @@ func AddControllerConfigDependencies(
 	return outputs.InjectToDependencies(cc.ExecutorDependencies, outputs.ToDependencies{
 		BucketLookup:       bucketLookupSvc,
 		OrganizationLookup: orgLookupSvc,
-		PointsWriter:       engine,
+		PointsWriter:       pointsWriter,
 	})
 }
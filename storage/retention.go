@@ -33,6 +33,11 @@ type BucketFinder interface {
 	FindBuckets(context.Context, platform.BucketFilter, ...platform.FindOptions) ([]*platform.Bucket, int, error)
 }
 
+// A BucketInvalidator discards state derived from the data of buckets, such as cached query results.
+type BucketInvalidator interface {
+	Invalidate(bucketIDs ...platform.ID)
+}
+
 // ErrServiceClosed is returned when the service is unavailable.
 var ErrServiceClosed = errors.New("service is currently closed")
 
@@ -46,6 +51,12 @@ type retentionEnforcer struct {
 	// organisations.
 	BucketService BucketFinder
 
+	// Invalidator, if set, is notified of the buckets whose expired data is deleted.
+	Invalidator BucketInvalidator
+
+	// deletes, if set, records the expired data of each bucket before it is deleted.
+	deletes *deleteLog
+
 	logger *zap.Logger
 
 	metrics *retentionMetrics
@@ -104,6 +115,22 @@ func (s *retentionEnforcer) expireData(rpByBucketID map[platform.ID]time.Duratio
 	_, logEnd := logger.NewOperation(s.logger, "Data deletion", "data_deletion")
 	defer logEnd()
 
+	if s.deletes != nil {
+		for bucketID, retentionPeriod := range rpByBucketID {
+			if retentionPeriod == 0 {
+				continue
+			}
+			bucketID := bucketID
+			if err := s.deletes.append(deleteRecord{
+				BucketID: &bucketID,
+				Min:      math.MinInt64,
+				Max:      now.Add(-retentionPeriod).UnixNano(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), engineAPITimeout)
 	defer cancel()
 	cur, err := s.Engine.CreateSeriesCursor(ctx, SeriesCursorRequest{}, nil)
@@ -119,6 +146,8 @@ func (s *retentionEnforcer) expireData(rpByBucketID map[platform.ID]time.Duratio
 	var seriesDeleted uint64 // Number of series where a delete is attempted.
 	var seriesSkipped uint64 // Number of series that were skipped from delete.
 
+	expiredBuckets := make(map[platform.ID]struct{}) // Buckets where a delete is attempted.
+
 	fn := func(name []byte, tags models.Tags) (int64, int64, bool) {
 		if len(name) != platform.IDLength {
 			mu.Lock()
@@ -145,6 +174,9 @@ func (s *retentionEnforcer) expireData(rpByBucketID map[platform.ID]time.Duratio
 			return 0, 0, false
 		}
 
+		mu.Lock()
+		expiredBuckets[bucketID] = struct{}{}
+		mu.Unlock()
 		atomic.AddUint64(&seriesDeleted, 1)
 		to := now.Add(-retentionPeriod).UnixNano()
 		return math.MinInt64, to, true
@@ -168,7 +200,17 @@ func (s *retentionEnforcer) expireData(rpByBucketID map[platform.ID]time.Duratio
 		s.metrics.Series.With(labels).Add(float64(atomic.LoadUint64(&seriesSkipped)))
 	}()
 
-	return s.Engine.DeleteSeriesRangeWithPredicate(newSeriesIteratorAdapter(cur), fn)
+	err = s.Engine.DeleteSeriesRangeWithPredicate(newSeriesIteratorAdapter(cur), fn)
+
+	// Some data may have been deleted even if the delete failed.
+	if s.Invalidator != nil && len(expiredBuckets) > 0 {
+		ids := make([]platform.ID, 0, len(expiredBuckets))
+		for id := range expiredBuckets {
+			ids = append(ids, id)
+		}
+		s.Invalidator.Invalidate(ids...)
+	}
+	return err
 }
 
 // getRetentionPeriodPerBucket returns a map of (bucket ID -> retention period)
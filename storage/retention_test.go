@@ -35,6 +35,7 @@ func TestService_expireData(t *testing.T) {
 	rpByBucketID := map[platform.ID]time.Duration{}
 	expMatchedFrequencies := map[string]int{}  // To be used for verifying test results.
 	expRejectedFrequencies := map[string]int{} // To be used for verifying test results.
+	expInvalidated := map[platform.ID]bool{}   // To be used for verifying test results.
 	for i := 0; i < 15; i++ {
 		repeat := rand.Intn(10) + 1 // [1, 10]
 		name := genMeasurementName()
@@ -51,6 +52,7 @@ func TestService_expireData(t *testing.T) {
 		if i%3 == 0 {
 			rpByBucketID[bucketID] = 3 * time.Hour
 			expMatchedFrequencies[string(name)] = repeat
+			expInvalidated[bucketID] = true
 		} else if i%3 == 1 {
 			expRejectedFrequencies[string(name)] = repeat
 		} else if i%3 == 2 {
@@ -89,11 +91,25 @@ func TestService_expireData(t *testing.T) {
 		return nil
 	}
 
+	gotInvalidated := map[platform.ID]bool{}
+	service.Invalidator = TestBucketInvalidator(func(bucketIDs ...platform.ID) {
+		for _, id := range bucketIDs {
+			gotInvalidated[id] = true
+		}
+	})
+
 	t.Run("multiple bucket", func(t *testing.T) {
 		if err := service.expireData(rpByBucketID, now); err != nil {
 			t.Error(err)
 		}
 
+		// Verify that the buckets with deleted data were invalidated.
+		t.Run("invalidated", func(t *testing.T) {
+			if !reflect.DeepEqual(gotInvalidated, expInvalidated) {
+				t.Fatalf("got\n%#v\nexpected\n%#v", gotInvalidated, expInvalidated)
+			}
+		})
+
 		// Verify that the correct series were marked to be deleted.
 		t.Run("matched", func(t *testing.T) {
 			if !reflect.DeepEqual(gotMatchedFrequencies, expMatchedFrequencies) {
@@ -156,6 +172,10 @@ func (e *TestEngine) DeleteSeriesRangeWithPredicate(itr tsdb.SeriesIterator, fn
 	return e.DeleteSeriesRangeWithPredicateFn(itr, fn)
 }
 
+type TestBucketInvalidator func(bucketIDs ...platform.ID)
+
+func (f TestBucketInvalidator) Invalidate(bucketIDs ...platform.ID) { f(bucketIDs...) }
+
 type TestBucketFinder struct {
 	FindBucketsFn func(context.Context, platform.BucketFilter, ...platform.FindOptions) ([]*platform.Bucket, int, error)
 }
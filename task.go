@@ -2,6 +2,9 @@ package platform
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 )
 
 const (
@@ -32,11 +35,100 @@ type Run struct {
 	StartedAt    string `json:"startedAt,omitempty"`
 	FinishedAt   string `json:"finishedAt,omitempty"`
 	RequestedAt  string `json:"requestedAt,omitempty"`
-	Log          Log    `json:"log"`
+
+	// Log is the log of the run as text, one entry per line as formatted by LogText.
+	// It is kept for clients written before the structured entries of Logs were added.
+	Log string `json:"log"`
+
+	// Logs are the entries of the log of the run.
+	Logs []Log `json:"logs,omitempty"`
+}
+
+// Log is a single entry in the log of a run.
+type Log struct {
+	// RunID is the ID of the run the entry belongs to.
+	RunID ID `json:"runID,omitempty"`
+
+	// Time is when the entry was written, RFC3339Nano.
+	Time string `json:"time"`
+
+	Message string `json:"message"`
+
+	// Stats is set on the entry recording the statistics of the run's query.
+	Stats *RunStats `json:"stats,omitempty"`
+
+	// Error is set on an entry recording an error that failed the run, or an attempt of the run.
+	Error *RunError `json:"error,omitempty"`
+}
+
+// String returns the entry formatted as a single line of text.
+func (l Log) String() string {
+	return fmt.Sprintf("%s: %s", l.Time, l.Message)
+}
+
+// LogText returns logs formatted as text, one entry per line.
+func LogText(logs []Log) string {
+	lines := make([]string, len(logs))
+	for i, l := range logs {
+		lines[i] = l.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RunStats are statistics about the execution of the query of a run.
+type RunStats struct {
+	// RowsWritten is the number of rows written by each call to to() in the query, by the name of the result holding its output.
+	RowsWritten map[string]int64 `json:"rowsWritten,omitempty"`
+
+	// BytesRead is the number of uncompressed bytes scanned by the query.
+	BytesRead int64 `json:"bytesRead"`
+
+	// ValuesRead is the number of values scanned by the query.
+	ValuesRead int64 `json:"valuesRead"`
+
+	TotalDuration   time.Duration `json:"totalDuration"`
+	CompileDuration time.Duration `json:"compileDuration"`
+	QueueDuration   time.Duration `json:"queueDuration"`
+	PlanDuration    time.Duration `json:"planDuration"`
+	RequeueDuration time.Duration `json:"requeueDuration"`
+	ExecuteDuration time.Duration `json:"executeDuration"`
+
+	// Concurrency is the number of goroutines allocated to process the query.
+	Concurrency int `json:"concurrency"`
+
+	// MaxAllocated is the maximum number of bytes the query allocated.
+	MaxAllocated int64 `json:"maxAllocated"`
+}
+
+// RunError is the error that failed a run, with the position in the task's script that caused it, if known.
+type RunError struct {
+	Message string `json:"message"`
+
+	// Start and End delimit the part of the script that caused the error.
+	Start *ScriptPosition `json:"start,omitempty"`
+	End   *ScriptPosition `json:"end,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *RunError) Error() string {
+	if e.Start == nil {
+		return e.Message
+	}
+	if e.End == nil {
+		return fmt.Sprintf("error @%s: %s", e.Start, e.Message)
+	}
+	return fmt.Sprintf("error @%s-%s: %s", e.Start, e.End, e.Message)
 }
 
-// Log represents a link to a log resource
-type Log string
+// ScriptPosition is a position in a Flux script.
+type ScriptPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (p ScriptPosition) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
 
 // TaskService represents a service for managing one-off and recurring tasks.
 type TaskService interface {
@@ -56,7 +148,7 @@ type TaskService interface {
 	// DeleteTask removes a task by ID and purges all associated data and scheduled runs.
 	DeleteTask(ctx context.Context, id ID) error
 
-	// FindLogs returns logs for a run.
+	// FindLogs returns the log entries of a run, or of every run of a task.
 	FindLogs(ctx context.Context, filter LogFilter) ([]*Log, int, error)
 
 	// FindRuns returns a list of runs that match a filter and the total count of returned runs.
@@ -74,6 +166,10 @@ type TaskService interface {
 	// ForceRun forces a run to occur with unix timestamp scheduledFor, to be executed as soon as possible.
 	// The value of scheduledFor may or may not align with the task's schedule.
 	ForceRun(ctx context.Context, taskID ID, scheduledFor int64) (*Run, error)
+
+	// BackfillRuns requests runs for every time on the task's schedule between the unix timestamps start and stop, inclusive,
+	// to be executed as soon as possible.
+	BackfillRuns(ctx context.Context, taskID ID, start, stop int64) error
 }
 
 // TaskUpdate represents updates to a task
@@ -241,8 +241,13 @@ func (s *Store) UpdateTask(ctx context.Context, req backend.UpdateTaskRequest) (
 			return err
 		}
 		res.OldStatus = backend.TaskStatus(stm.Status)
+		if req.Script != "" {
+			stm.UpdateCatchUp(op)
+		}
 		if req.Status != "" {
 			stm.Status = string(req.Status)
+		}
+		if req.Script != "" || req.Status != "" {
 			stmBytes, err = stm.Marshal()
 			if err != nil {
 				return err
@@ -601,6 +606,7 @@ func (s *Store) DeleteTask(ctx context.Context, id platform.ID) (deleted bool, e
 
 func (s *Store) CreateNextRun(ctx context.Context, taskID platform.ID, now int64) (backend.RunCreation, error) {
 	var rc backend.RunCreation
+	var notDue error
 
 	encodedID, err := taskID.Encode()
 	if err != nil {
@@ -623,7 +629,10 @@ func (s *Store) CreateNextRun(ctx context.Context, taskID platform.ID, now int64
 		rc, err = stm.CreateNextRun(now, func() (platform.ID, error) {
 			return s.idGen.ID(), nil
 		})
-		if err != nil {
+		if _, ok := err.(backend.RunNotYetDueError); ok {
+			// Missed runs may have been skipped, so stm is persisted along with the error.
+			notDue = err
+		} else if err != nil {
 			return err
 		}
 		rc.Created.TaskID = taskID
@@ -636,6 +645,9 @@ func (s *Store) CreateNextRun(ctx context.Context, taskID platform.ID, now int64
 	}); err != nil {
 		return backend.RunCreation{}, err
 	}
+	if notDue != nil {
+		return backend.RunCreation{}, notDue
+	}
 
 	return rc, nil
 }
@@ -4,14 +4,23 @@ package executor
 
 import (
 	"context"
+	"net"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions/outputs"
 	"github.com/influxdata/platform/task/backend"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
@@ -130,7 +139,7 @@ func (p *syncRunPromise) doQuery(wg *sync.WaitGroup) {
 
 	spec, err := flux.Compile(p.ctx, p.t.Script, time.Unix(p.qr.Now, 0))
 	if err != nil {
-		p.finish(nil, err)
+		p.finish(nil, scriptError(p.t.Script, err))
 		return
 	}
 
@@ -149,16 +158,23 @@ func (p *syncRunPromise) doQuery(wg *sync.WaitGroup) {
 	defer it.Release()
 
 	// Drain the result iterator.
+	written := toResults(spec)
+	rows := make(map[string]int64, len(written))
 	for it.More() {
 		// Consume the full iterator so that we don't leak outstanding iterators.
 		res := it.Next()
-		if err := exhaustResultIterators(res); err != nil {
+		n, err := exhaustResultIterators(res)
+		if err != nil {
 			p.logger.Info("Error exhausting result iterator", zap.Error(err), zap.String("name", res.Name()))
 		}
+		if written[res.Name()] {
+			rows[res.Name()] += n
+		}
 	}
 
 	// Is it okay to assume it.Err will be set if the query context is canceled?
-	p.finish(&runResult{err: it.Err()}, nil)
+	err = it.Err()
+	p.finish(&runResult{err: err, retryable: isRetryable(err), stats: runStats(it.Statistics(), rows)}, nil)
 }
 
 func (p *syncRunPromise) cancelOnContextDone(wg *sync.WaitGroup) {
@@ -198,7 +214,7 @@ func (e *asyncQueryServiceExecutor) Execute(ctx context.Context, run backend.Que
 
 	spec, err := flux.Compile(ctx, t.Script, time.Unix(run.Now, 0))
 	if err != nil {
-		return nil, err
+		return nil, scriptError(t.Script, err)
 	}
 
 	req := &query.Request{
@@ -212,7 +228,7 @@ func (e *asyncQueryServiceExecutor) Execute(ctx context.Context, run backend.Que
 		return nil, err
 	}
 
-	return newAsyncRunPromise(run, q, e), nil
+	return newAsyncRunPromise(run, q, toResults(spec), e), nil
 }
 
 func (e *asyncQueryServiceExecutor) Wait() {
@@ -224,6 +240,9 @@ type asyncRunPromise struct {
 	qr backend.QueuedRun
 	q  flux.Query
 
+	// outputs are the names of the results of q holding the tables written by to().
+	outputs map[string]bool
+
 	logger *zap.Logger
 	logEnd func()
 
@@ -235,14 +254,15 @@ type asyncRunPromise struct {
 
 var _ backend.RunPromise = (*asyncRunPromise)(nil)
 
-func newAsyncRunPromise(qr backend.QueuedRun, q flux.Query, e *asyncQueryServiceExecutor) *asyncRunPromise {
+func newAsyncRunPromise(qr backend.QueuedRun, q flux.Query, outputs map[string]bool, e *asyncQueryServiceExecutor) *asyncRunPromise {
 	opLogger := e.logger.With(zap.Stringer("task_id", qr.TaskID), zap.Stringer("run_id", qr.RunID))
 	log, logEnd := logger.NewOperation(opLogger, "Executing task", "execute")
 
 	p := &asyncRunPromise{
-		qr:    qr,
-		q:     q,
-		ready: make(chan struct{}),
+		qr:      qr,
+		q:       q,
+		outputs: outputs,
+		ready:   make(chan struct{}),
 
 		logger: log,
 		logEnd: logEnd,
@@ -287,28 +307,46 @@ func (p *asyncRunPromise) followQuery(wg *sync.WaitGroup) {
 	case results, ok := <-p.q.Ready():
 		if !ok {
 			// Something went wrong with the flux. Set the error in the run result.
-			rr := &runResult{err: p.q.Err()}
+			err := p.q.Err()
+			rr := &runResult{err: err, retryable: isRetryable(err)}
 			p.finish(rr, nil)
 			return
 		}
 
 		// Exhaust the results so we don't leave unfinished iterators around.
-		var wg sync.WaitGroup
+		var (
+			wg     sync.WaitGroup
+			rowsMu sync.Mutex
+			rows   = make(map[string]int64, len(p.outputs))
+		)
 		wg.Add(len(results))
 		for _, res := range results {
 			r := res
 			go func() {
 				defer wg.Done()
-				if err := exhaustResultIterators(r); err != nil {
+				n, err := exhaustResultIterators(r)
+				if err != nil {
 					p.logger.Info("Error exhausting result iterator", zap.Error(err), zap.String("name", r.Name()))
 				}
+				if !p.outputs[r.Name()] {
+					return
+				}
+				rowsMu.Lock()
+				rows[r.Name()] += n
+				rowsMu.Unlock()
 			}()
 		}
 		wg.Wait()
 
+		// The query's statistics are complete once it is done.
+		p.q.Done()
+		stats := p.q.Statistics()
+		for _, res := range results {
+			stats = stats.Add(res.Statistics())
+		}
+
 		// Otherwise, query was successful.
-		// TODO(mr): collect query statistics, once RunResult interface supports them?
-		p.finish(new(runResult), nil)
+		p.finish(&runResult{stats: runStats(stats, rows)}, nil)
 	}
 }
 
@@ -332,18 +370,151 @@ func (p *asyncRunPromise) finish(res *runResult, err error) {
 type runResult struct {
 	err       error
 	retryable bool
+	stats     platform.RunStats
 }
 
 var _ backend.RunResult = (*runResult)(nil)
 
-func (rr *runResult) Err() error        { return rr.err }
-func (rr *runResult) IsRetryable() bool { return rr.retryable }
+func (rr *runResult) Err() error                    { return rr.err }
+func (rr *runResult) IsRetryable() bool             { return rr.retryable }
+func (rr *runResult) Statistics() platform.RunStats { return rr.stats }
+
+// isRetryable returns true if a run failing with err may succeed when retried.
+// Only transient failures are retryable, such as an unavailable service, an
+// exceeded quota, a timeout or a network error. Errors in the script or the
+// data it refers to, such as an unknown bucket, fail every retry.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
 
-// exhaustResultIterators drains all the iterators from a flux query Result.
-func exhaustResultIterators(res flux.Result) error {
-	return res.Tables().Do(func(tbl flux.Table) error {
-		return tbl.Do(func(flux.ColReader) error {
+	err = errors.Cause(err)
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	if nerr, ok := err.(net.Error); ok {
+		return nerr.Timeout() || nerr.Temporary()
+	}
+
+	switch platform.ErrorCode(err) {
+	case platform.EUnavailable, platform.ETooManyRequests:
+		return true
+	}
+	return false
+}
+
+// exhaustResultIterators drains all the iterators from a flux query Result,
+// and returns the number of rows in the Result.
+func exhaustResultIterators(res flux.Result) (int64, error) {
+	var rows int64
+	err := res.Tables().Do(func(tbl flux.Table) error {
+		return tbl.Do(func(cr flux.ColReader) error {
+			rows += int64(cr.Len())
 			return nil
 		})
 	})
+	return rows, err
+}
+
+// toResults returns the names of the results of spec that hold the tables written by to().
+func toResults(spec *flux.Spec) map[string]bool {
+	ops := make(map[flux.OperationID]*flux.Operation, len(spec.Operations))
+	for _, op := range spec.Operations {
+		ops[op.ID] = op
+	}
+
+	parents := make(map[flux.OperationID][]flux.OperationID, len(spec.Edges))
+	hasChildren := make(map[flux.OperationID]bool, len(spec.Edges))
+	for _, e := range spec.Edges {
+		parents[e.Child] = append(parents[e.Child], e.Parent)
+		hasChildren[e.Parent] = true
+	}
+
+	names := make(map[string]bool)
+	for _, op := range spec.Operations {
+		switch {
+		case op.Spec.Kind() == transformations.YieldKind:
+			// A yield passes on the tables of its parent under its own name.
+			for _, id := range parents[op.ID] {
+				if ops[id].Spec.Kind() == outputs.ToKind {
+					names[op.Spec.(*transformations.YieldOpSpec).Name] = true
+				}
+			}
+		case op.Spec.Kind() == outputs.ToKind && !hasChildren[op.ID]:
+			// The planner adds a yield of the default name after each operation without children.
+			names[plan.DefaultYieldName] = true
+		}
+	}
+	return names
+}
+
+// runStats returns the statistics of a run, from the statistics of its query and the number of rows written by each of its to() calls.
+func runStats(s flux.Statistics, rows map[string]int64) platform.RunStats {
+	return platform.RunStats{
+		RowsWritten:     rows,
+		BytesRead:       int64(s.ScannedBytes),
+		ValuesRead:      int64(s.ScannedValues),
+		TotalDuration:   s.TotalDuration,
+		CompileDuration: s.CompileDuration,
+		QueueDuration:   s.QueueDuration,
+		PlanDuration:    s.PlanDuration,
+		RequeueDuration: s.RequeueDuration,
+		ExecuteDuration: s.ExecuteDuration,
+		Concurrency:     s.Concurrency,
+		MaxAllocated:    s.MaxAllocated,
+	}
+}
+
+// scriptError returns err, the error from compiling script, as a *platform.RunError.
+// If err was reported for a node of the script's syntax tree, the node's position is included.
+func scriptError(script string, err error) *platform.RunError {
+	pkg := parser.ParseSource(script)
+	if ast.Check(pkg) > 0 {
+		var re *platform.RunError
+		ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+			if errs := n.Errs(); re == nil && len(errs) > 0 {
+				re = newRunError(errs[0].Msg, n.Location())
+			}
+		}), pkg)
+		if re != nil {
+			return re
+		}
+	}
+
+	// Type errors wrap their cause with the location of the node they were reported for,
+	// most specific innermost.
+	cause := errors.Cause(err)
+	if cause == err {
+		return &platform.RunError{Message: err.Error()}
+	}
+	wrapper := err
+	for {
+		c, ok := wrapper.(interface{ Cause() error })
+		if !ok || c.Cause() == cause {
+			break
+		}
+		wrapper = c.Cause()
+	}
+	prefix := strings.TrimSuffix(wrapper.Error(), ": "+cause.Error())
+
+	var re *platform.RunError
+	ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+		if loc := n.Location(); re == nil && loc.IsValid() && strings.HasSuffix(prefix, " "+loc.String()) {
+			re = newRunError(strings.TrimSuffix(prefix, " "+loc.String())+": "+cause.Error(), loc)
+		}
+	}), pkg)
+	if re == nil {
+		return &platform.RunError{Message: err.Error()}
+	}
+	return re
+}
+
+// newRunError returns a *platform.RunError with msg, positioned at loc.
+func newRunError(msg string, loc ast.SourceLocation) *platform.RunError {
+	re := &platform.RunError{Message: msg}
+	if loc.IsValid() {
+		re.Start = &platform.ScriptPosition{Line: loc.Start.Line, Column: loc.Start.Column}
+		re.End = &platform.ScriptPosition{Line: loc.End.Line, Column: loc.End.Column}
+	}
+	return re
 }
@@ -264,6 +264,8 @@ func TestExecutor(t *testing.T) {
 		testExecutorQueryFailure(t, fn)
 		testExecutorPromiseCancel(t, fn)
 		testExecutorServiceError(t, fn)
+		testExecutorCompileError(t, fn)
+		testExecutorRowsWritten(t, fn)
 		testExecutorWait(t, fn)
 	}
 }
@@ -320,6 +322,10 @@ func testExecutorQuerySuccess(t *testing.T, fn createSysFn) {
 		if got := res.Err(); got != nil {
 			t.Fatal(got)
 		}
+		// The script writes with toHTTP, not to, so no rows are counted as written.
+		if got := res.Statistics().RowsWritten; len(got) != 0 {
+			t.Fatalf("expected no rows written, got %v", got)
+		}
 
 		res2, err := rp.Wait()
 		if err != nil {
@@ -334,31 +340,47 @@ func testExecutorQuerySuccess(t *testing.T, fn createSysFn) {
 func testExecutorQueryFailure(t *testing.T, fn createSysFn) {
 	var orgID = platformtesting.MustIDBase16("aaaaaaaaaaaaaaaa")
 	var userID = platformtesting.MustIDBase16("baaaaaaaaaaaaaab")
-	sys := fn()
-	t.Run(sys.name+"/QueryFail", func(t *testing.T) {
-		t.Parallel()
-		script := fmt.Sprintf(fmtTestScript, t.Name())
-		tid, err := sys.st.CreateTask(context.Background(), backend.CreateTaskRequest{Org: orgID, User: userID, Script: script})
-		if err != nil {
-			t.Fatal(err)
-		}
-		qr := backend.QueuedRun{TaskID: tid, RunID: platform.ID(1), Now: 123}
-		rp, err := sys.ex.Execute(context.Background(), qr)
-		if err != nil {
-			t.Fatal(err)
-		}
 
-		expErr := errors.New("forced error")
-		sys.svc.WaitForQueryLive(t, script)
-		sys.svc.FailQuery(script, expErr)
-		res, err := rp.Wait()
-		if err != nil {
-			t.Fatal(err)
-		}
-		if got := res.Err(); got != expErr {
-			t.Fatalf("expected error %v; got %v", expErr, got)
-		}
-	})
+	for _, tc := range []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{name: "Unavailable", err: &platform.Error{Code: platform.EUnavailable, Msg: "storage unavailable"}, retryable: true},
+		{name: "TooManyRequests", err: &platform.Error{Code: platform.ETooManyRequests, Msg: "quota exceeded"}, retryable: true},
+		{name: "DeadlineExceeded", err: context.DeadlineExceeded, retryable: true},
+		{name: "UnknownBucket", err: &platform.Error{Code: platform.ENotFound, Msg: "bucket not found"}},
+		{name: "CompileError", err: errors.New(`error calling function "from": missing required keyword argument "bucketID"`)},
+	} {
+		sys := fn()
+		tc := tc
+		t.Run(sys.name+"/QueryFail/"+tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := fmt.Sprintf(fmtTestScript, t.Name())
+			tid, err := sys.st.CreateTask(context.Background(), backend.CreateTaskRequest{Org: orgID, User: userID, Script: script})
+			if err != nil {
+				t.Fatal(err)
+			}
+			qr := backend.QueuedRun{TaskID: tid, RunID: platform.ID(1), Now: 123}
+			rp, err := sys.ex.Execute(context.Background(), qr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sys.svc.WaitForQueryLive(t, script)
+			sys.svc.FailQuery(script, tc.err)
+			res, err := rp.Wait()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Err(); got != tc.err {
+				t.Fatalf("expected error %v; got %v", tc.err, got)
+			}
+			if got := res.IsRetryable(); got != tc.retryable {
+				t.Fatalf("expected retryable %v; got %v", tc.retryable, got)
+			}
+		})
+	}
 }
 
 func testExecutorPromiseCancel(t *testing.T, fn createSysFn) {
@@ -427,6 +449,122 @@ func testExecutorServiceError(t *testing.T, fn createSysFn) {
 	})
 }
 
+// scriptStore is a backend.Store that returns tasks with its script in place of the stored one,
+// so that the executor can be given a script the store would not accept.
+type scriptStore struct {
+	backend.Store
+	script string
+}
+
+func (s scriptStore) FindTaskByID(ctx context.Context, id platform.ID) (*backend.StoreTask, error) {
+	t, err := s.Store.FindTaskByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t.Script = s.script
+	return t, nil
+}
+
+func testExecutorCompileError(t *testing.T, fn createSysFn) {
+	var orgID = platformtesting.MustIDBase16("aaaaaaaaaaaaaaaa")
+	var userID = platformtesting.MustIDBase16("baaaaaaaaaaaaaab")
+	sys := fn()
+	for _, tc := range []struct {
+		name   string
+		script string
+		exp    *platform.RunError
+	}{
+		{
+			name:   "TypeError",
+			script: "option task = {name: \"x\", every: 1m}\nfrom(bucket: \"one\") |> nope()",
+			exp: &platform.RunError{
+				Message: `type error: undefined identifier "nope"`,
+				Start:   &platform.ScriptPosition{Line: 2, Column: 24},
+				End:     &platform.ScriptPosition{Line: 2, Column: 28},
+			},
+		},
+		{
+			name:   "SyntaxError",
+			script: "option task = {name: \"x\", every: 1m}\nfrom(bucket: \"one\")\n@",
+			exp: &platform.RunError{
+				Message: "invalid statement: @",
+				Start:   &platform.ScriptPosition{Line: 3, Column: 1},
+				End:     &platform.ScriptPosition{Line: 3, Column: 2},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(sys.name+"/CompileError/"+tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := fmt.Sprintf(fmtTestScript, t.Name())
+			tid, err := sys.st.CreateTask(context.Background(), backend.CreateTaskRequest{Org: orgID, User: userID, Script: script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			st := scriptStore{Store: sys.st, script: tc.script}
+			var ex backend.Executor
+			if sys.name == "AsyncExecutor" {
+				ex = executor.NewAsyncQueryServiceExecutor(zap.NewNop(), sys.svc, st)
+			} else {
+				ex = executor.NewQueryServiceExecutor(zap.NewNop(), query.QueryServiceBridge{AsyncQueryService: sys.svc}, st)
+			}
+
+			qr := backend.QueuedRun{TaskID: tid, RunID: platform.ID(1), Now: 123}
+			rp, err := ex.Execute(context.Background(), qr)
+			if err == nil {
+				// Synchronous query service compiles the script after returning the promise.
+				_, err = rp.Wait()
+			}
+
+			re, ok := err.(*platform.RunError)
+			if !ok {
+				t.Fatalf("expected *platform.RunError, got %T: %v", err, err)
+			}
+			if !reflect.DeepEqual(re, tc.exp) {
+				t.Fatalf("expected error %v, got %v", tc.exp, re)
+			}
+		})
+	}
+}
+
+func testExecutorRowsWritten(t *testing.T, fn createSysFn) {
+	var orgID = platformtesting.MustIDBase16("aaaaaaaaaaaaaaaa")
+	var userID = platformtesting.MustIDBase16("baaaaaaaaaaaaaab")
+	sys := fn()
+	t.Run(sys.name+"/RowsWritten", func(t *testing.T) {
+		t.Parallel()
+
+		script := fmt.Sprintf(`option task = {
+			name: %q,
+			every: 1m,
+		}
+		from(bucket: "one") |> range(start: -1m) |> to(bucket: "two", orgID: "aaaaaaaaaaaaaaaa") |> yield(name: "res")`, t.Name())
+		tid, err := sys.st.CreateTask(context.Background(), backend.CreateTaskRequest{Org: orgID, User: userID, Script: script})
+		if err != nil {
+			t.Fatal(err)
+		}
+		qr := backend.QueuedRun{TaskID: tid, RunID: platform.ID(1), Now: 123}
+		rp, err := sys.ex.Execute(context.Background(), qr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sys.svc.WaitForQueryLive(t, script)
+		sys.svc.SucceedQuery(script)
+		res, err := rp.Wait()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Err(); got != nil {
+			t.Fatal(got)
+		}
+		if exp, got := map[string]int64{"res": 1}, res.Statistics().RowsWritten; !reflect.DeepEqual(exp, got) {
+			t.Fatalf("expected rows written %v, got %v", exp, got)
+		}
+	})
+}
+
 func testExecutorWait(t *testing.T, createSys createSysFn) {
 	// This is a longer delay than I'd prefer,
 	// but it needs to be large-ish for slow machines running with the race detector.
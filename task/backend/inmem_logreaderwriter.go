@@ -3,7 +3,6 @@ package backend
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sync"
 	"time"
 
@@ -58,20 +57,18 @@ func (r *runReaderWriter) UpdateRunState(ctx context.Context, rlb RunLogBase, wh
 	return nil
 }
 
-func (r *runReaderWriter) AddRunLog(ctx context.Context, rlb RunLogBase, when time.Time, log string) error {
+func (r *runReaderWriter) AddRunLog(ctx context.Context, rlb RunLogBase, when time.Time, log platform.Log) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	log = fmt.Sprintf("%s: %s", when.Format(time.RFC3339Nano), log)
+	log.RunID = rlb.RunID
+	log.Time = when.Format(time.RFC3339Nano)
 	ridStr := rlb.RunID.String()
 	existingRun, ok := r.byRunID[ridStr]
 	if !ok {
 		return ErrRunNotFound
 	}
-	sep := ""
-	if existingRun.Log != "" {
-		sep = "\n"
-	}
-	existingRun.Log = platform.Log(string(existingRun.Log) + sep + log)
+	existingRun.Logs = append(existingRun.Logs, log)
+	existingRun.Log = platform.LogText(existingRun.Logs)
 	return nil
 }
 
@@ -107,6 +104,7 @@ func (r *runReaderWriter) ListRuns(ctx context.Context, runFilter platform.RunFi
 
 		// Copy the element, to avoid a data race if the original Run is modified in UpdateRunState or AddRunLog.
 		r := *r
+		r.Logs = append([]platform.Log(nil), r.Logs...)
 		runs = append(runs, &r)
 
 		if runFilter.Limit > 0 && len(runs) >= runFilter.Limit {
@@ -126,6 +124,7 @@ func (r *runReaderWriter) FindRunByID(ctx context.Context, orgID, runID platform
 	}
 
 	rtnRun := *run
+	rtnRun.Logs = append([]platform.Log(nil), run.Logs...)
 	return &rtnRun, nil
 }
 
@@ -142,12 +141,12 @@ func (r *runReaderWriter) ListLogs(ctx context.Context, logFilter platform.LogFi
 		if !ok {
 			return nil, ErrRunNotFound
 		}
-		return []platform.Log{run.Log}, nil
+		return append([]platform.Log{}, run.Logs...), nil
 	}
 
 	logs := []platform.Log{}
 	for _, run := range r.byTaskID[logFilter.Task.String()] {
-		logs = append(logs, run.Log)
+		logs = append(logs, run.Logs...)
 	}
 	return logs, nil
 }
@@ -107,11 +107,14 @@ func (s *inmem) UpdateTask(_ context.Context, req UpdateTaskRequest) (UpdateTask
 	}
 	res.OldStatus = TaskStatus(stm.Status)
 
+	if req.Script != "" {
+		stm.UpdateCatchUp(op)
+	}
 	if req.Status != "" {
 		// Changing the status.
 		stm.Status = string(req.Status)
-		s.meta[req.ID] = stm
 	}
+	s.meta[req.ID] = stm
 	res.NewMeta = stm
 
 	return res, nil
@@ -266,7 +269,11 @@ func (s *inmem) CreateNextRun(ctx context.Context, taskID platform.ID, now int64
 		return s.idgen.ID(), nil
 	}
 	rc, err := stm.CreateNextRun(now, makeID)
-	if err != nil {
+	if _, ok := err.(RunNotYetDueError); ok {
+		// Missed runs may have been skipped, so stm is persisted along with the error.
+		s.meta[taskID] = stm
+		return RunCreation{}, err
+	} else if err != nil {
 		return RunCreation{}, err
 	}
 	rc.Created.TaskID = taskID
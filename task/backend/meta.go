@@ -20,6 +20,8 @@ func NewStoreTaskMeta(req CreateTaskRequest, o options.Options) StoreTaskMeta {
 		LatestCompleted: req.ScheduleAfter,
 		EffectiveCron:   o.EffectiveCronString(),
 		Offset:          int32(o.Offset / time.Second),
+		CatchUp:         o.CatchUp,
+		CatchUpRate:     int32(o.CatchUpRate),
 	}
 
 	if stm.Status == "" {
@@ -40,6 +42,13 @@ func NewStoreTaskMeta(req CreateTaskRequest, o options.Options) StoreTaskMeta {
 	return stm
 }
 
+// UpdateCatchUp sets the catch-up policy of stm to the one in the options of
+// an updated script.
+func (stm *StoreTaskMeta) UpdateCatchUp(o options.Options) {
+	stm.CatchUp = o.CatchUp
+	stm.CatchUpRate = int32(o.CatchUpRate)
+}
+
 // FinishRun removes the run matching runID from m's CurrentlyRunning slice,
 // and if that run's Now value is greater than m's LatestCompleted value,
 // updates the value of LatestCompleted to the run's Now value.
@@ -82,6 +91,15 @@ func (stm *StoreTaskMeta) FinishRun(runID platform.ID) bool {
 // that is later than any in-progress run and stm's LatestCompleted timestamp.
 // If the run's now would be later than the passed-in now, CreateNextRun returns a RunNotYetDueError.
 //
+// When the backlog of due runs exceeds one interval of the schedule, i.e. more than two runs are due,
+// the missed runs are handled according to stm.CatchUp. A task only one run late runs every due run.
+// With the "latest" policy, the run is created for the most recent due time, skipping the earlier ones.
+// With the "skip" policy, no run is created for any of the due times: stm's LatestCompleted value is advanced
+// to the most recent due time, and a RunNotYetDueError is returned for the next scheduled run.
+// In that case stm has been modified, and must be persisted despite the error.
+//
+// A task without a schedule, i.e. one only triggered by other tasks, only has runs created from its manual run queue.
+//
 // makeID is a function provided by the caller to create an ID, in case we can create a run.
 // Because a StoreTaskMeta doesn't know the ID of the task it belongs to, it never sets RunCreation.Created.TaskID.
 func (stm *StoreTaskMeta) CreateNextRun(now int64, makeID func() (platform.ID, error)) (RunCreation, error) {
@@ -89,6 +107,13 @@ func (stm *StoreTaskMeta) CreateNextRun(now int64, makeID func() (platform.ID, e
 		return RunCreation{}, errors.New("cannot create next run when max concurrency already reached")
 	}
 
+	if stm.EffectiveCron == "" {
+		if len(stm.ManualRuns) > 0 {
+			return stm.createNextRunFromQueue(now, math.MaxInt64, nil, makeID)
+		}
+		return RunCreation{}, RunNotYetDueError{DueAt: math.MaxInt64}
+	}
+
 	// Not calling stm.DueAt here because we reuse sch.
 	// We can definitely optimize (minimize) cron parsing at a later point in time.
 	sch, err := cron.Parse(stm.EffectiveCron)
@@ -113,6 +138,25 @@ func (stm *StoreTaskMeta) CreateNextRun(now int64, makeID func() (platform.ID, e
 		return RunCreation{}, RunNotYetDueError{DueAt: dueAt}
 	}
 
+	// Only a backlog of more than two due runs is caught up.
+	catchUp := stm.CatchUp == options.CatchUpLatest || stm.CatchUp == options.CatchUpSkip
+	if until := now - int64(stm.Offset); catchUp && sch.Next(sch.Next(nextScheduled)).Unix() <= until {
+		last := latestDue(sch, nextScheduled, until)
+		switch stm.CatchUp {
+		case options.CatchUpLatest:
+			nextScheduled = last
+			nextScheduledUnix = nextScheduled.Unix()
+		case options.CatchUpSkip:
+			// Consider every missed run complete, and wait for the next scheduled one.
+			stm.LatestCompleted = last.Unix()
+			dueAt := sch.Next(last).Unix() + int64(stm.Offset)
+			if len(stm.ManualRuns) > 0 {
+				return stm.createNextRunFromQueue(now, dueAt, sch, makeID)
+			}
+			return RunCreation{}, RunNotYetDueError{DueAt: dueAt}
+		}
+	}
+
 	id, err := makeID()
 	if err != nil {
 		return RunCreation{}, err
@@ -134,8 +178,25 @@ func (stm *StoreTaskMeta) CreateNextRun(now int64, makeID func() (platform.ID, e
 	}, nil
 }
 
+// latestDue returns the latest time of sch no later than the Unix timestamp until,
+// starting from next, which must be no later than until.
+func latestDue(sch cron.Schedule, next time.Time, until int64) time.Time {
+	if cds, ok := sch.(cron.ConstantDelaySchedule); ok {
+		// Avoid iterating over every missed run of a frequent schedule.
+		n := (until - next.Unix()) / int64(cds.Delay/time.Second)
+		return next.Add(time.Duration(n) * cds.Delay)
+	}
+
+	for n := sch.Next(next); n.Unix() <= until; n = sch.Next(n) {
+		next = n
+	}
+	return next
+}
+
 // createNextRunFromQueue creates the next run from a queue.
 // This should only be called when the queue is not empty.
+// A request for a single time is run at exactly that time, even if it isn't aligned with sch.
+// sch is nil for a task without a schedule, in which case a request for a time range is run once, at the end of the range.
 func (stm *StoreTaskMeta) createNextRunFromQueue(now, nextDue int64, sch cron.Schedule, makeID func() (platform.ID, error)) (RunCreation, error) {
 	if len(stm.ManualRuns) == 0 {
 		return RunCreation{}, errors.New("cannot create run from empty queue")
@@ -153,7 +214,13 @@ func (stm *StoreTaskMeta) createNextRunFromQueue(now, nextDue int64, sch cron.Sc
 		}
 	}
 
-	runNow := sch.Next(time.Unix(latest, 0)).Unix()
+	var runNow int64
+	switch {
+	case q.Start == q.End, sch == nil:
+		runNow = q.End
+	default:
+		runNow = sch.Next(time.Unix(latest, 0)).Unix()
+	}
 
 	// Already validated that we have room to create another run, in CreateNextRun.
 	id := platform.ID(q.RunID)
@@ -194,7 +261,13 @@ func (stm *StoreTaskMeta) createNextRunFromQueue(now, nextDue int64, sch cron.Sc
 
 // NextDueRun returns the Unix timestamp of when the next call to CreateNextRun will be ready.
 // The returned timestamp reflects the task's delay, so it does not necessarily exactly match the schedule time.
+//
+// A task without a schedule is never due, so math.MaxInt64 is returned.
 func (stm *StoreTaskMeta) NextDueRun() (int64, error) {
+	if stm.EffectiveCron == "" {
+		return math.MaxInt64, nil
+	}
+
 	sch, err := cron.Parse(stm.EffectiveCron)
 	if err != nil {
 		return 0, err
@@ -265,6 +338,8 @@ func (stm StoreTaskMeta) Equal(other StoreTaskMeta) bool {
 		stm.Status != other.Status ||
 		stm.EffectiveCron != other.EffectiveCron ||
 		stm.Offset != other.Offset ||
+		stm.CatchUp != other.CatchUp ||
+		stm.CatchUpRate != other.CatchUpRate ||
 		len(stm.CurrentlyRunning) != len(other.CurrentlyRunning) ||
 		len(stm.ManualRuns) != len(other.ManualRuns) {
 		return false
@@ -35,17 +35,23 @@ type StoreTaskMeta struct {
 	// effective_cron is the effective cron string as reported by the task's options.
 	EffectiveCron string `protobuf:"bytes,5,opt,name=effective_cron,json=effectiveCron,proto3" json:"effective_cron,omitempty"`
 	// Task's configured delay, in seconds.
-	Offset               int32                     `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
-	ManualRuns           []*StoreTaskMetaManualRun `protobuf:"bytes,16,rep,name=manual_runs,json=manualRuns" json:"manual_runs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Offset     int32                     `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	ManualRuns []*StoreTaskMetaManualRun `protobuf:"bytes,16,rep,name=manual_runs,json=manualRuns" json:"manual_runs,omitempty"`
+	// catch_up is the task's policy for runs missed while it was not scheduled, as reported by the task's options.
+	// It is one of "all", "skip" or "latest". An empty value is treated as "all".
+	CatchUp string `protobuf:"bytes,17,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"`
+	// catch_up_rate is the maximum number of missed runs started per second while catching up.
+	// Zero means there is no limit.
+	CatchUpRate          int32    `protobuf:"varint,18,opt,name=catch_up_rate,json=catchUpRate,proto3" json:"catch_up_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StoreTaskMeta) Reset()         { *m = StoreTaskMeta{} }
 func (m *StoreTaskMeta) String() string { return proto.CompactTextString(m) }
 func (*StoreTaskMeta) ProtoMessage()    {}
 func (*StoreTaskMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_d42b29c328506298, []int{0}
+	return fileDescriptor_meta_904e07bcbbb2390f, []int{0}
 }
 func (m *StoreTaskMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +129,20 @@ func (m *StoreTaskMeta) GetManualRuns() []*StoreTaskMetaManualRun {
 	return nil
 }
 
+func (m *StoreTaskMeta) GetCatchUp() string {
+	if m != nil {
+		return m.CatchUp
+	}
+	return ""
+}
+
+func (m *StoreTaskMeta) GetCatchUpRate() int32 {
+	if m != nil {
+		return m.CatchUpRate
+	}
+	return 0
+}
+
 type StoreTaskMetaRun struct {
 	// now is the unix timestamp of the "now" value for the run.
 	Now   int64  `protobuf:"varint,1,opt,name=now,proto3" json:"now,omitempty"`
@@ -143,7 +163,7 @@ func (m *StoreTaskMetaRun) Reset()         { *m = StoreTaskMetaRun{} }
 func (m *StoreTaskMetaRun) String() string { return proto.CompactTextString(m) }
 func (*StoreTaskMetaRun) ProtoMessage()    {}
 func (*StoreTaskMetaRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_d42b29c328506298, []int{1}
+	return fileDescriptor_meta_904e07bcbbb2390f, []int{1}
 }
 func (m *StoreTaskMetaRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +255,7 @@ func (m *StoreTaskMetaManualRun) Reset()         { *m = StoreTaskMetaManualRun{}
 func (m *StoreTaskMetaManualRun) String() string { return proto.CompactTextString(m) }
 func (*StoreTaskMetaManualRun) ProtoMessage()    {}
 func (*StoreTaskMetaManualRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_d42b29c328506298, []int{2}
+	return fileDescriptor_meta_904e07bcbbb2390f, []int{2}
 }
 func (m *StoreTaskMetaManualRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,6 +392,21 @@ func (m *StoreTaskMeta) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.CatchUp) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.CatchUp)))
+		i += copy(dAtA[i:], m.CatchUp)
+	}
+	if m.CatchUpRate != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.CatchUpRate))
+	}
 	return i, nil
 }
 
@@ -507,6 +542,13 @@ func (m *StoreTaskMeta) Size() (n int) {
 			n += 2 + l + sovMeta(uint64(l))
 		}
 	}
+	l = len(m.CatchUp)
+	if l > 0 {
+		n += 2 + l + sovMeta(uint64(l))
+	}
+	if m.CatchUpRate != 0 {
+		n += 2 + sovMeta(uint64(m.CatchUpRate))
+	}
 	return n
 }
 
@@ -774,6 +816,54 @@ func (m *StoreTaskMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CatchUp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpRate", wireType)
+			}
+			m.CatchUpRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpRate |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -1209,38 +1299,40 @@ var (
 	ErrIntOverflowMeta   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("meta.proto", fileDescriptor_meta_d42b29c328506298) }
+func init() { proto.RegisterFile("meta.proto", fileDescriptor_meta_904e07bcbbb2390f) }
 
-var fileDescriptor_meta_d42b29c328506298 = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_meta_904e07bcbbb2390f = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xd9, 0x6e, 0x36, 0x90, 0x09, 0x69, 0x17, 0xab, 0xaa, 0x16, 0x90, 0xd2, 0x25, 0x02,
-	0x11, 0x2e, 0x8b, 0x04, 0x12, 0x27, 0x2e, 0x34, 0x70, 0xe8, 0xa1, 0x17, 0x97, 0x13, 0x12, 0x5a,
-	0xb9, 0x5e, 0x6f, 0x14, 0x65, 0xd7, 0x2e, 0xf6, 0x18, 0x92, 0xb7, 0xe0, 0x51, 0xb8, 0xf2, 0x06,
-	0x1c, 0x79, 0x02, 0x84, 0xc2, 0x7b, 0x20, 0x64, 0x3b, 0x0d, 0x50, 0x72, 0x40, 0xbd, 0xcd, 0x7c,
-	0x5a, 0x8f, 0xff, 0xff, 0xdf, 0x31, 0x40, 0x2b, 0x90, 0x15, 0xe7, 0x5a, 0xa1, 0x22, 0xf7, 0xb9,
-	0x6a, 0x8b, 0x99, 0xac, 0x1b, 0xbb, 0xa8, 0x98, 0xa3, 0x0d, 0xc3, 0x5a, 0xe9, 0xb6, 0x40, 0x66,
-	0xe6, 0xc5, 0x19, 0xe3, 0x73, 0x21, 0xab, 0x3b, 0xfb, 0x53, 0x35, 0x55, 0xfe, 0xc0, 0x63, 0x57,
-	0x85, 0xb3, 0xa3, 0x9f, 0x3b, 0x30, 0x38, 0x45, 0xa5, 0xc5, 0x6b, 0x66, 0xe6, 0x27, 0x02, 0x19,
-	0x79, 0x08, 0x7b, 0x2d, 0x5b, 0x94, 0x5c, 0x49, 0x6e, 0xb5, 0x16, 0x92, 0x2f, 0xb3, 0x28, 0x8f,
-	0xc6, 0x09, 0xdd, 0x6d, 0xd9, 0x62, 0xf2, 0x9b, 0x92, 0x47, 0x90, 0x36, 0x0c, 0x85, 0xc1, 0x92,
-	0xab, 0xf6, 0xbc, 0x11, 0x28, 0xaa, 0x6c, 0x27, 0x8f, 0xc6, 0x31, 0xdd, 0x0b, 0x7c, 0x72, 0x81,
-	0xc9, 0x01, 0x74, 0x0d, 0x32, 0xb4, 0x26, 0x8b, 0xf3, 0x68, 0xdc, 0xa3, 0xeb, 0x8e, 0x70, 0xb8,
-	0x15, 0xc6, 0x61, 0xb3, 0x2c, 0xb5, 0x95, 0x72, 0x26, 0xa7, 0x59, 0x27, 0x8f, 0xc7, 0xfd, 0x27,
-	0xcf, 0x8a, 0xff, 0x71, 0x55, 0xfc, 0xa5, 0x9d, 0x5a, 0x49, 0xd3, 0xcd, 0x40, 0x1a, 0xe6, 0x91,
-	0x07, 0xb0, 0x2b, 0xea, 0x5a, 0x70, 0x9c, 0xbd, 0x17, 0x25, 0xd7, 0x4a, 0x66, 0x89, 0x17, 0x31,
-	0xd8, 0xd0, 0x89, 0x56, 0xd2, 0x69, 0x54, 0x75, 0x6d, 0x04, 0x66, 0x5d, 0x6f, 0x77, 0xdd, 0x91,
-	0xb7, 0xd0, 0x6f, 0x99, 0xb4, 0xac, 0x71, 0x02, 0x4d, 0x96, 0x7a, 0x75, 0xcf, 0xaf, 0xa0, 0xee,
-	0xc4, 0x4f, 0x71, 0x1a, 0xa1, 0xbd, 0x28, 0xcd, 0xe8, 0x73, 0x04, 0xe9, 0x65, 0x13, 0x24, 0x85,
-	0x58, 0xaa, 0x0f, 0x3e, 0xf7, 0x98, 0xba, 0xd2, 0x11, 0xd4, 0x4b, 0x9f, 0xef, 0x80, 0xba, 0x92,
-	0xe4, 0xd0, 0xd5, 0x56, 0x96, 0xb3, 0xca, 0x67, 0xda, 0x39, 0xea, 0xad, 0xbe, 0x1d, 0x26, 0xd4,
-	0xca, 0xe3, 0x97, 0x34, 0xd1, 0x56, 0x1e, 0x57, 0xe4, 0x10, 0xfa, 0x9a, 0xc9, 0xa9, 0x28, 0x0d,
-	0x32, 0x8d, 0x59, 0xc7, 0x4f, 0x03, 0x8f, 0x4e, 0x1d, 0x21, 0x77, 0xa1, 0x17, 0x3e, 0x10, 0xb2,
-	0xf2, 0xa1, 0xc4, 0xf4, 0x86, 0x07, 0xaf, 0x64, 0x45, 0xee, 0xc1, 0x4d, 0x2d, 0xde, 0x59, 0x61,
-	0x50, 0x54, 0x25, 0x0b, 0xa9, 0xc4, 0xb4, 0xbf, 0x61, 0x2f, 0x70, 0xf4, 0x29, 0x82, 0x83, 0xed,
-	0x16, 0xc9, 0x3e, 0x24, 0xe1, 0xd6, 0xe0, 0x21, 0x34, 0xce, 0x85, 0xbb, 0x2a, 0x6c, 0x89, 0x2b,
-	0xb7, 0x2e, 0x51, 0xbc, 0x7d, 0x89, 0x2e, 0x0b, 0xea, 0xfc, 0x23, 0xe8, 0x8f, 0x4c, 0x92, 0xed,
-	0x99, 0x1c, 0xdd, 0xfe, 0xb2, 0x1a, 0x46, 0x5f, 0x57, 0xc3, 0xe8, 0xfb, 0x6a, 0x18, 0x7d, 0xfc,
-	0x31, 0xbc, 0xf6, 0xe6, 0xfa, 0xfa, 0x67, 0x9d, 0x75, 0xfd, 0x8b, 0x78, 0xfa, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0x93, 0x87, 0xe2, 0xd0, 0x5b, 0x03, 0x00, 0x00,
+	0x10, 0x86, 0x59, 0x36, 0x9b, 0x36, 0x13, 0xd2, 0xa6, 0x56, 0x55, 0x6d, 0x41, 0x4a, 0x43, 0x04,
+	0x22, 0x5c, 0x16, 0x09, 0x24, 0x4e, 0x5c, 0x68, 0xe0, 0xd0, 0x43, 0x2f, 0x2e, 0x5c, 0x90, 0xd0,
+	0xca, 0xf5, 0x7a, 0x43, 0x94, 0x5d, 0x3b, 0xd8, 0x63, 0x48, 0xde, 0x82, 0x47, 0xe1, 0xca, 0x81,
+	0x3b, 0x47, 0x9e, 0x00, 0xa1, 0xf0, 0x22, 0xc8, 0x76, 0x12, 0xa0, 0xe4, 0x80, 0xb8, 0xcd, 0xfc,
+	0x5e, 0x8f, 0xbf, 0xf9, 0x67, 0x16, 0xa0, 0x16, 0xc8, 0xb2, 0x99, 0x56, 0xa8, 0xc8, 0x1d, 0xae,
+	0xea, 0x6c, 0x22, 0xcb, 0xca, 0xce, 0x0b, 0xe6, 0xd4, 0x8a, 0x61, 0xa9, 0x74, 0x9d, 0x21, 0x33,
+	0xd3, 0xec, 0x92, 0xf1, 0xa9, 0x90, 0xc5, 0xcd, 0xc3, 0xb1, 0x1a, 0x2b, 0x7f, 0xe1, 0x81, 0x8b,
+	0xc2, 0xdd, 0xc1, 0xe7, 0x18, 0x3a, 0x17, 0xa8, 0xb4, 0x78, 0xc1, 0xcc, 0xf4, 0x5c, 0x20, 0x23,
+	0xf7, 0x60, 0xbf, 0x66, 0xf3, 0x9c, 0x2b, 0xc9, 0xad, 0xd6, 0x42, 0xf2, 0x45, 0x1a, 0xf5, 0xa3,
+	0x61, 0x42, 0xf7, 0x6a, 0x36, 0x1f, 0xfd, 0x52, 0xc9, 0x7d, 0xe8, 0x56, 0x0c, 0x85, 0xc1, 0x9c,
+	0xab, 0x7a, 0x56, 0x09, 0x14, 0x45, 0x7a, 0xbd, 0x1f, 0x0d, 0x63, 0xba, 0x1f, 0xf4, 0xd1, 0x5a,
+	0x26, 0x47, 0xd0, 0x34, 0xc8, 0xd0, 0x9a, 0x34, 0xee, 0x47, 0xc3, 0x16, 0x5d, 0x65, 0x84, 0xc3,
+	0x41, 0x28, 0x87, 0xd5, 0x22, 0xd7, 0x56, 0xca, 0x89, 0x1c, 0xa7, 0x8d, 0x7e, 0x3c, 0x6c, 0x3f,
+	0x7c, 0x9c, 0xfd, 0x4b, 0x57, 0xd9, 0x1f, 0xec, 0xd4, 0x4a, 0xda, 0xdd, 0x14, 0xa4, 0xa1, 0x1e,
+	0xb9, 0x0b, 0x7b, 0xa2, 0x2c, 0x05, 0xc7, 0xc9, 0x3b, 0x91, 0x73, 0xad, 0x64, 0x9a, 0x78, 0x88,
+	0xce, 0x46, 0x1d, 0x69, 0x25, 0x1d, 0xa3, 0x2a, 0x4b, 0x23, 0x30, 0x6d, 0xfa, 0x76, 0x57, 0x19,
+	0x79, 0x0d, 0xed, 0x9a, 0x49, 0xcb, 0x2a, 0x07, 0x68, 0xd2, 0xae, 0xa7, 0x7b, 0xf2, 0x1f, 0x74,
+	0xe7, 0xbe, 0x8a, 0x63, 0x84, 0x7a, 0x1d, 0x1a, 0x72, 0x0c, 0xbb, 0x9c, 0x21, 0x7f, 0x93, 0xdb,
+	0x59, 0x7a, 0xe0, 0xb9, 0x76, 0x7c, 0xfe, 0x72, 0x46, 0x06, 0xd0, 0x59, 0x1f, 0xe5, 0x9a, 0xa1,
+	0x48, 0x89, 0x07, 0x6b, 0xaf, 0xce, 0x29, 0x43, 0x31, 0xf8, 0x14, 0x41, 0xf7, 0xaa, 0x07, 0xa4,
+	0x0b, 0xb1, 0x54, 0xef, 0xfd, 0xd8, 0x62, 0xea, 0x42, 0xa7, 0xa0, 0x5e, 0xf8, 0xf1, 0x74, 0xa8,
+	0x0b, 0x49, 0x1f, 0x9a, 0xda, 0xca, 0x7c, 0x52, 0xf8, 0x91, 0x34, 0x4e, 0x5b, 0xcb, 0x6f, 0x27,
+	0x09, 0xb5, 0xf2, 0xec, 0x19, 0x4d, 0xb4, 0x95, 0x67, 0x05, 0x39, 0x81, 0xb6, 0x66, 0x72, 0x2c,
+	0x72, 0x83, 0x4c, 0x63, 0xda, 0xf0, 0xd5, 0xc0, 0x4b, 0x17, 0x4e, 0x21, 0xb7, 0xa0, 0x15, 0x3e,
+	0x10, 0xb2, 0xf0, 0x9e, 0xc6, 0x74, 0xd7, 0x0b, 0xcf, 0x65, 0x41, 0x6e, 0xc3, 0x0d, 0x2d, 0xde,
+	0x5a, 0x61, 0x50, 0x14, 0x39, 0x0b, 0xa6, 0xc6, 0xb4, 0xbd, 0xd1, 0x9e, 0xe2, 0xe0, 0x63, 0x04,
+	0x47, 0xdb, 0x1d, 0x22, 0x87, 0x90, 0x84, 0x57, 0x43, 0x0f, 0x21, 0x71, 0x5d, 0xb8, 0xa7, 0xc2,
+	0x92, 0xb9, 0x70, 0xeb, 0x0e, 0xc6, 0xdb, 0x77, 0xf0, 0x2a, 0x50, 0xe3, 0x2f, 0xa0, 0xdf, 0x3c,
+	0x49, 0xb6, 0x7b, 0x72, 0x7a, 0xfc, 0x65, 0xd9, 0x8b, 0xbe, 0x2e, 0x7b, 0xd1, 0xf7, 0x65, 0x2f,
+	0xfa, 0xf0, 0xa3, 0x77, 0xed, 0xd5, 0xce, 0x6a, 0xd6, 0x97, 0x4d, 0xff, 0x43, 0x3d, 0xfa, 0x39,
+	0x00, 0x9b, 0x38, 0xec, 0xba, 0x9a, 0x03, 0x00, 0x00,
 }
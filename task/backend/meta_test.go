@@ -2,6 +2,7 @@ package backend_test
 
 import (
 	"errors"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/snowflake"
 	"github.com/influxdata/platform/task/backend"
+	"github.com/influxdata/platform/task/options"
 )
 
 var idGen = snowflake.NewIDGenerator()
@@ -213,6 +215,135 @@ func TestMeta_CreateNextRun_Delay(t *testing.T) {
 	}
 }
 
+func TestMeta_CreateNextRun_CatchUp(t *testing.T) {
+	for _, sch := range []string{"* * * * *", "@every 1m"} {
+		t.Run(sch, func(t *testing.T) {
+			stm := backend.StoreTaskMeta{
+				MaxConcurrency:  2,
+				Status:          "enabled",
+				EffectiveCron:   sch,
+				Offset:          5,
+				LatestCompleted: 60,
+				CatchUp:         options.CatchUpLatest,
+			}
+
+			// The runs for 120, 180 and 240 are due at 304; only the latest is created.
+			rc, err := stm.CreateNextRun(304, makeID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rc.Created.Now != 240 {
+				t.Fatalf("expected created run to have time 240, got %d", rc.Created.Now)
+			}
+			if rc.NextDue != 305 {
+				t.Fatalf("unexpected next run time: %d", rc.NextDue)
+			}
+
+			// A single due run is created as usual.
+			rc, err = stm.CreateNextRun(305, makeID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rc.Created.Now != 300 {
+				t.Fatalf("expected created run to have time 300, got %d", rc.Created.Now)
+			}
+
+			stm = backend.StoreTaskMeta{
+				MaxConcurrency:  2,
+				Status:          "enabled",
+				EffectiveCron:   sch,
+				Offset:          5,
+				LatestCompleted: 60,
+				CatchUp:         options.CatchUpSkip,
+			}
+
+			// The runs for 120, 180 and 240 are skipped.
+			_, err = stm.CreateNextRun(299, makeID)
+			if e, ok := err.(backend.RunNotYetDueError); !ok {
+				t.Fatalf("expected RunNotYetDueError, got %v (%T)", err, err)
+			} else if e.DueAt != 305 {
+				t.Fatalf("expected run due at 305, got %d", e.DueAt)
+			}
+			if stm.LatestCompleted != 240 {
+				t.Fatalf("expected skipped runs to be completed through 240, got %d", stm.LatestCompleted)
+			}
+
+			rc, err = stm.CreateNextRun(305, makeID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rc.Created.Now != 300 {
+				t.Fatalf("expected created run to have time 300, got %d", rc.Created.Now)
+			}
+		})
+	}
+}
+
+func TestMeta_CreateNextRun_CatchUp_OneRunLate(t *testing.T) {
+	for _, catchUp := range []string{options.CatchUpLatest, options.CatchUpSkip} {
+		t.Run(catchUp, func(t *testing.T) {
+			stm := backend.StoreTaskMeta{
+				MaxConcurrency:  2,
+				Status:          "enabled",
+				EffectiveCron:   "@every 1m",
+				Offset:          5,
+				LatestCompleted: 60,
+				CatchUp:         catchUp,
+			}
+
+			// The runs for 120 and 180 are due at 185, which is not enough of a
+			// backlog to catch up, so both are created in order.
+			for _, exp := range []int64{120, 180} {
+				rc, err := stm.CreateNextRun(185, makeID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if rc.Created.Now != exp {
+					t.Fatalf("expected created run to have time %d, got %d", exp, rc.Created.Now)
+				}
+			}
+			if stm.LatestCompleted != 60 {
+				t.Fatalf("expected no runs to be skipped, got latest completed %d", stm.LatestCompleted)
+			}
+		})
+	}
+}
+
+func TestMeta_CreateNextRun_NoSchedule(t *testing.T) {
+	stm := backend.StoreTaskMeta{
+		MaxConcurrency:  2,
+		Status:          "enabled",
+		LatestCompleted: 60,
+	}
+
+	// A task without a schedule is never due on its own.
+	if due, err := stm.NextDueRun(); err != nil {
+		t.Fatal(err)
+	} else if due != math.MaxInt64 {
+		t.Fatalf("expected task without schedule to never be due, got %d", due)
+	}
+	if _, err := stm.CreateNextRun(1000, makeID); err == nil {
+		t.Fatal("expected error creating run for task without schedule")
+	} else if _, ok := err.(backend.RunNotYetDueError); !ok {
+		t.Fatalf("expected RunNotYetDueError, got %v (%T)", err, err)
+	}
+
+	// A requested run is created at exactly the requested time.
+	if err := stm.ManuallyRunTimeRange(123, 123, 1000, makeID); err != nil {
+		t.Fatal(err)
+	}
+	rc, err := stm.CreateNextRun(1000, makeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc.Created.Now != 123 {
+		t.Fatalf("expected created run to have time 123, got %d", rc.Created.Now)
+	}
+	if rc.HasQueue {
+		t.Fatal("expected queue to be drained")
+	}
+}
+
 func TestMeta_ManuallyRunTimeRange(t *testing.T) {
 	now := time.Now().Unix()
 	stm := backend.StoreTaskMeta{
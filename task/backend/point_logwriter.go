@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/influxdata/platform"
@@ -11,6 +12,8 @@ import (
 
 const (
 	lineField         = "line"
+	statsField        = "stats"
+	errorField        = "error"
 	runIDField        = "runID"
 	scheduledForField = "scheduledFor"
 	requestedAtField  = "requestedAt"
@@ -64,13 +67,28 @@ func (p *PointLogWriter) UpdateRunState(ctx context.Context, rlb RunLogBase, whe
 	return p.pointsWriter.WritePoints(exploded)
 }
 
-func (p *PointLogWriter) AddRunLog(ctx context.Context, rlb RunLogBase, when time.Time, log string) error {
+func (p *PointLogWriter) AddRunLog(ctx context.Context, rlb RunLogBase, when time.Time, log platform.Log) error {
 	tags := models.Tags{
 		models.NewTag([]byte(taskIDTag), []byte(rlb.Task.ID.String())),
 	}
 	fields := map[string]interface{}{
 		runIDField: rlb.RunID.String(),
-		lineField:  log,
+		lineField:  log.Message,
+	}
+	// The structured parts of the entry are stored as JSON.
+	if log.Stats != nil {
+		b, err := json.Marshal(log.Stats)
+		if err != nil {
+			return err
+		}
+		fields[statsField] = string(b)
+	}
+	if log.Error != nil {
+		b, err := json.Marshal(log.Error)
+		if err != nil {
+			return err
+		}
+		fields[errorField] = string(b)
 	}
 	pt, err := models.NewPoint("logs", tags, fields, when)
 	if err != nil {
@@ -2,10 +2,10 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -75,10 +75,9 @@ func (qlr *QueryLogReader) ListLogs(ctx context.Context, logFilter platform.LogF
 		return nil, err
 	}
 
-	runs := re.Runs()
-	logs := make([]platform.Log, len(runs))
-	for i, r := range runs {
-		logs[i] = r.Log
+	logs := []platform.Log{}
+	for _, r := range re.Runs() {
+		logs = append(logs, r.Logs...)
 	}
 	return logs, nil
 }
@@ -294,7 +293,7 @@ func (re *runExtractor) extractRecord(cr flux.ColReader) error {
 		}
 
 		if ex, ok := re.runs[r.ID]; ok {
-			r.Log = ex.Log
+			r.Log, r.Logs = ex.Log, ex.Logs
 		}
 
 		re.runs[r.ID] = r
@@ -304,35 +303,58 @@ func (re *runExtractor) extractRecord(cr flux.ColReader) error {
 }
 
 func (re *runExtractor) extractLog(cr flux.ColReader) error {
-	entries := make(map[platform.ID][]string)
+	type entry struct {
+		when time.Time
+		log  platform.Log
+	}
+	entries := make(map[platform.ID][]entry)
 	for i := 0; i < cr.Len(); i++ {
-		var runID platform.ID
-		var when, line string
+		var e entry
 		for j, col := range cr.Cols() {
 			switch col.Label {
-			case "runID":
+			case runIDField:
 				id, err := platform.IDFromString(cr.Strings(j)[i])
 				if err != nil {
 					return err
 				}
-				runID = *id
+				e.log.RunID = *id
 			case "_time":
-				when = cr.Times(j)[i].Time().Format(time.RFC3339Nano)
-			case "line":
-				line = cr.Strings(j)[i]
+				e.when = cr.Times(j)[i].Time()
+			case lineField:
+				e.log.Message = cr.Strings(j)[i]
+			case statsField:
+				if v := cr.Strings(j)[i]; v != "" {
+					e.log.Stats = new(platform.RunStats)
+					if err := json.Unmarshal([]byte(v), e.log.Stats); err != nil {
+						return err
+					}
+				}
+			case errorField:
+				if v := cr.Strings(j)[i]; v != "" {
+					e.log.Error = new(platform.RunError)
+					if err := json.Unmarshal([]byte(v), e.log.Error); err != nil {
+						return err
+					}
+				}
 			}
 		}
 
-		if !runID.Valid() {
+		if !e.log.RunID.Valid() {
 			return errors.New("extractLog: did not find valid run ID in table")
 		}
 
-		entries[runID] = append(entries[runID], when+": "+line)
+		e.log.Time = e.when.Format(time.RFC3339Nano)
+		entries[e.log.RunID] = append(entries[e.log.RunID], e)
 	}
 
-	for id, lines := range entries {
+	for id, es := range entries {
+		sort.SliceStable(es, func(i, j int) bool { return es[i].when.Before(es[j].when) })
+
 		run := re.runs[id]
-		run.Log = platform.Log(strings.Join(lines, "\n"))
+		for _, e := range es {
+			run.Logs = append(run.Logs, e.log)
+		}
+		run.Log = platform.LogText(run.Logs)
 		re.runs[id] = run
 	}
 
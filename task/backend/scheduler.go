@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/task/options"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -39,6 +40,10 @@ type DesiredState interface {
 	// FinishRun indicates that the given run is no longer intended to be executed.
 	// This may be called after a successful or failed execution, or upon cancellation.
 	FinishRun(ctx context.Context, taskID, runID platform.ID) error
+
+	// ManuallyRunTimeRange enqueues a request to run the task with the given ID for all schedules no earlier than start and no later than end (Unix timestamps).
+	// The scheduler uses it to trigger runs of tasks that depend on a task whose run succeeded.
+	ManuallyRunTimeRange(ctx context.Context, taskID platform.ID, start, end, requestedAt int64) (*StoreTaskMetaManualRun, error)
 }
 
 // Executor handles execution of a run.
@@ -90,9 +95,11 @@ type RunResult interface {
 	Err() error
 
 	// IsRetryable returns true if the error was non-terminal and the run is eligible for retry.
+	// Retryable runs are executed again, up to the number of attempts given by the task's retry option.
 	IsRetryable() bool
 
-	// TODO(mr): add more detail here like number of points written, execution time, etc.
+	// Statistics returns statistics about the execution of the run's query, such as the number of rows written.
+	Statistics() platform.RunStats
 }
 
 // Scheduler accepts tasks and handles their scheduling.
@@ -160,6 +167,17 @@ func WithLogger(logger *zap.Logger) TickSchedulerOption {
 	}
 }
 
+// WithRetryBackoff sets the delay before the first retry of a failed run,
+// and the maximum delay between retries.
+// The delay doubles with each attempt of a run, up to max.
+// If not set, the scheduler will wait one second before the first retry, and at most five minutes between retries.
+func WithRetryBackoff(initial, max time.Duration) TickSchedulerOption {
+	return func(s *TickScheduler) {
+		s.retryBackoff = initial
+		s.maxRetryBackoff = max
+	}
+}
+
 // NewScheduler returns a new scheduler with the given desired state and the given now UTC timestamp.
 func NewScheduler(desiredState DesiredState, executor Executor, lw LogWriter, now int64, opts ...TickSchedulerOption) *TickScheduler {
 	o := &TickScheduler{
@@ -171,6 +189,9 @@ func NewScheduler(desiredState DesiredState, executor Executor, lw LogWriter, no
 		logger:         zap.NewNop(),
 		wg:             &sync.WaitGroup{},
 		metrics:        newSchedulerMetrics(),
+
+		retryBackoff:    time.Second,
+		maxRetryBackoff: 5 * time.Minute,
 	}
 
 	for _, opt := range opts {
@@ -190,6 +211,9 @@ type TickScheduler struct {
 
 	metrics *schedulerMetrics
 
+	retryBackoff    time.Duration // Delay before the first retry of a run.
+	maxRetryBackoff time.Duration // Maximum delay between retries of a run.
+
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     *sync.WaitGroup
@@ -365,6 +389,40 @@ func (s *TickScheduler) ReleaseTask(taskID platform.ID) error {
 	return nil
 }
 
+// triggerDependents requests a run, with the given now value, of every claimed task
+// whose after option names the given task, in the same organization.
+func (s *TickScheduler) triggerDependents(upstream *StoreTask, now int64) {
+	s.schedulerMu.Lock()
+	if s.ctx.Err() != nil {
+		// Stopped.
+		s.schedulerMu.Unlock()
+		return
+	}
+	var dependents []*taskScheduler
+	for _, ts := range s.taskSchedulers {
+		if ts.task.Org == upstream.Org && ts.DependsOn(upstream.Name) {
+			dependents = append(dependents, ts)
+		}
+	}
+	ctx := s.ctx
+	s.schedulerMu.Unlock()
+
+	for _, ts := range dependents {
+		_, err := s.desiredState.ManuallyRunTimeRange(ctx, ts.task.ID, now, now, time.Now().Unix())
+		if _, ok := err.(RequestStillQueuedError); ok {
+			// Already triggered for this time.
+			continue
+		} else if err != nil {
+			ts.logger.Info("Failed to trigger run of dependent task", zap.String("upstream_task_id", upstream.ID.String()), zap.Int64("now", now), zap.Error(err))
+			continue
+		}
+
+		ts.logger.Info("Triggered run of dependent task", zap.String("upstream_task_id", upstream.ID.String()), zap.Int64("now", now))
+		ts.SetHasQueue()
+		ts.Work()
+	}
+}
+
 func (s *TickScheduler) PrometheusCollectors() []prometheus.Collector {
 	return s.metrics.PrometheusCollectors()
 }
@@ -382,6 +440,21 @@ type taskScheduler struct {
 	// Task we are scheduling for.
 	task *StoreTask
 
+	// Maximum number of attempts of a run, as set by the task's retry option.
+	maxAttempts int
+
+	// Backoff between attempts of a run.
+	retryBackoff, maxRetryBackoff time.Duration
+
+	// Maximum number of missed runs started per second, or zero for no limit.
+	catchUpRate int64
+
+	// Names of the tasks whose successful runs trigger a run of this task, as set by the task's after option.
+	after []string
+
+	// Called with the now value of each successful run of the task.
+	onSuccess func(task *StoreTask, now int64)
+
 	// CancelFunc for context passed to runners, to enable Cancel method.
 	cancel context.CancelFunc
 	wg     *sync.WaitGroup
@@ -399,6 +472,10 @@ type taskScheduler struct {
 	nextDue       int64        // Unix timestamp of next due.
 	nextDueSource int64        // Run time that produced nextDue.
 	hasQueue      bool         // Whether there is a queue of manual runs.
+
+	catchUpMu     sync.Mutex // Protects following fields.
+	catchUpSecond int64      // Unix timestamp of the second in which catchUpCount missed runs were started.
+	catchUpCount  int64      // Number of missed runs started during catchUpSecond.
 }
 
 func newTaskScheduler(
@@ -414,19 +491,35 @@ func newTaskScheduler(
 		return nil, err
 	}
 
+	// A task without valid options is never retried, and never triggered by other tasks.
+	maxAttempts := 1
+	var after []string
+	if o, err := options.FromScript(task.Script); err == nil {
+		if o.Retry > 1 {
+			maxAttempts = int(o.Retry)
+		}
+		after = o.After
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	ts := &taskScheduler{
-		now:           &s.now,
-		task:          task,
-		cancel:        cancel,
-		wg:            wg,
-		runners:       make([]*runner, meta.MaxConcurrency),
-		running:       make(map[platform.ID]runCtx, meta.MaxConcurrency),
-		logger:        s.logger.With(zap.String("task_id", task.ID.String())),
-		metrics:       s.metrics,
-		nextDue:       firstDue,
-		nextDueSource: math.MinInt64,
-		hasQueue:      len(meta.ManualRuns) > 0,
+		now:             &s.now,
+		task:            task,
+		maxAttempts:     maxAttempts,
+		retryBackoff:    s.retryBackoff,
+		maxRetryBackoff: s.maxRetryBackoff,
+		catchUpRate:     int64(meta.CatchUpRate),
+		after:           after,
+		onSuccess:       s.triggerDependents,
+		cancel:          cancel,
+		wg:              wg,
+		runners:         make([]*runner, meta.MaxConcurrency),
+		running:         make(map[platform.ID]runCtx, meta.MaxConcurrency),
+		logger:          s.logger.With(zap.String("task_id", task.ID.String())),
+		metrics:         s.metrics,
+		nextDue:         firstDue,
+		nextDueSource:   math.MinInt64,
+		hasQueue:        len(meta.ManualRuns) > 0,
 	}
 
 	for i := range ts.runners {
@@ -473,6 +566,50 @@ func (ts *taskScheduler) Cancel() {
 	ts.cancel()
 }
 
+// CatchUpLimited returns true if the task has already started as many missed runs
+// as its catch-up rate allows during the second now.
+func (ts *taskScheduler) CatchUpLimited(now int64) bool {
+	if ts.catchUpRate <= 0 {
+		return false
+	}
+
+	ts.catchUpMu.Lock()
+	defer ts.catchUpMu.Unlock()
+	return ts.catchUpSecond == now && ts.catchUpCount >= ts.catchUpRate
+}
+
+// StartCatchUp records that a missed run was started during the second now.
+func (ts *taskScheduler) StartCatchUp(now int64) {
+	ts.catchUpMu.Lock()
+	defer ts.catchUpMu.Unlock()
+	if ts.catchUpSecond != now {
+		ts.catchUpSecond, ts.catchUpCount = now, 0
+	}
+	ts.catchUpCount++
+}
+
+// DependsOn returns true if a successful run of the task with the given name triggers a run of this task.
+func (ts *taskScheduler) DependsOn(name string) bool {
+	for _, a := range ts.after {
+		if a == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryBackoff returns how long to wait before retrying a run whose given attempt failed.
+func (ts *taskScheduler) RetryBackoff(attempt int) time.Duration {
+	d := ts.retryBackoff
+	for i := 1; i < attempt && d < ts.maxRetryBackoff; i++ {
+		d *= 2
+	}
+	if d > ts.maxRetryBackoff {
+		d = ts.maxRetryBackoff
+	}
+	return d
+}
+
 // NextDue returns the next due timestamp, and whether there is a queue.
 func (ts *taskScheduler) NextDue() (int64, bool) {
 	ts.nextDueMu.RLock()
@@ -491,6 +628,13 @@ func (ts *taskScheduler) SetNextDue(nextDue int64, hasQueue bool, source int64)
 	ts.hasQueue = hasQueue
 }
 
+// SetHasQueue records that the task has a queue of manual runs.
+func (ts *taskScheduler) SetHasQueue() {
+	ts.nextDueMu.Lock()
+	defer ts.nextDueMu.Unlock()
+	ts.hasQueue = true
+}
+
 // A runner is one eligible "concurrency slot" for a given task.
 type runner struct {
 	state *uint32
@@ -594,10 +738,20 @@ func (r *runner) startFromWorking(now int64) {
 		atomic.StoreUint32(r.state, runnerIdle)
 		return
 	}
+	if r.ts.CatchUpLimited(now) {
+		// Started enough missed runs for this second. The next tick will pick up from here.
+		atomic.StoreUint32(r.state, runnerIdle)
+		return
+	}
 	ctx, cancel := context.WithCancel(r.ctx)
 	rc, err := r.desiredState.CreateNextRun(ctx, r.task.ID, now)
 	if err != nil {
-		r.logger.Info("Failed to create run", zap.Error(err))
+		if e, ok := err.(RunNotYetDueError); ok {
+			// Missed runs were skipped according to the task's catch-up policy.
+			r.ts.SetNextDue(e.DueAt, false, now)
+		} else {
+			r.logger.Info("Failed to create run", zap.Error(err))
+		}
 		atomic.StoreUint32(r.state, runnerIdle)
 		cancel() // cancel to prevent context leak
 		return
@@ -607,6 +761,10 @@ func (r *runner) startFromWorking(now int64) {
 	r.ts.running[qr.RunID] = runCtx{Context: ctx, CancelFunc: cancel}
 	r.ts.runningMu.Unlock()
 	r.ts.SetNextDue(rc.NextDue, rc.HasQueue, qr.Now)
+	if rc.NextDue <= now {
+		// Another run is already due, so this one was missed.
+		r.ts.StartCatchUp(now)
+	}
 
 	// Create a new child logger for the individual run.
 	// We can't do r.logger = r.logger.With(zap.String("run_id", qr.RunID.String()) because zap doesn't deduplicate fields,
@@ -633,88 +791,184 @@ func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, runLogger *za
 	sp, spCtx := opentracing.StartSpanFromContext(ctx, "task.run.execution")
 	defer sp.Finish()
 
-	rp, err := r.executor.Execute(spCtx, qr)
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			r.addRunLog(qr, platform.Log{Message: fmt.Sprintf("Started attempt %d of %d", attempt, r.ts.maxAttempts)})
+		}
 
-	if err != nil {
-		// TODO(mr): retry? and log error.
+		rp, err := r.executor.Execute(spCtx, qr)
+
+		if err != nil {
+			// TODO(mr): retry?
+			runLogger.Info("Failed to begin execution", zap.Error(err))
+			r.addRunLog(qr, platform.Log{Message: fmt.Sprintf("Failed to begin execution: %v", err), Error: runError(err)})
+			atomic.StoreUint32(r.state, runnerIdle)
+			r.updateRunState(qr, RunFail, runLogger)
+			return
+		}
+
+		res, err := r.wait(ctx, rp)
+		if err != nil {
+			r.clearRunning(qr.RunID)
+			if err == ErrRunCanceled {
+				r.finishCanceled(qr, runLogger)
+				return
+			}
+
+			runLogger.Info("Failed to wait for execution result", zap.Error(err))
+			r.addRunLog(qr, platform.Log{Message: fmt.Sprintf("Failed to wait for execution result: %v", err), Error: runError(err)})
+			// TODO(mr): retry?
+			r.updateRunState(qr, RunFail, runLogger)
+			atomic.StoreUint32(r.state, runnerIdle)
+			return
+		}
+
+		if rerr := res.Err(); rerr != nil {
+			if !res.IsRetryable() || attempt >= r.ts.maxAttempts {
+				runLogger.Info("Execution failed", zap.Int("attempt", attempt), zap.Error(rerr))
+				r.addRunLog(qr, platform.Log{Message: fmt.Sprintf("Attempt %d of %d failed: %v", attempt, r.ts.maxAttempts, rerr), Error: runError(rerr)})
+				r.addStatsLog(qr, res)
+				r.clearRunning(qr.RunID)
+				if err := r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID); err != nil {
+					runLogger.Info("Failed to finish run", zap.Error(err))
+				}
+				r.updateRunState(qr, RunFail, runLogger)
+
+				// A failed run is not going to be attempted again, so move on to the next execution.
+				r.startFromWorking(atomic.LoadInt64(r.ts.now))
+				return
+			}
+
+			backoff := r.ts.RetryBackoff(attempt)
+			runLogger.Info("Execution failed; retrying", zap.Int("attempt", attempt), zap.Duration("backoff", backoff), zap.Error(rerr))
+			r.addRunLog(qr, platform.Log{Message: fmt.Sprintf("Attempt %d of %d failed: %v; retrying in %s", attempt, r.ts.maxAttempts, rerr, backoff), Error: runError(rerr)})
+			if !r.sleep(ctx, backoff) {
+				r.clearRunning(qr.RunID)
+				r.finishCanceled(qr, runLogger)
+				return
+			}
+			continue
+		}
+
+		r.addStatsLog(qr, res)
+		break
+	}
+
+	r.clearRunning(qr.RunID)
+	if err := r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID); err != nil {
+		runLogger.Info("Failed to finish run", zap.Error(err))
+		// TODO(mr): retry?
+		// Need to think about what it means if there was an error finishing a run.
 		atomic.StoreUint32(r.state, runnerIdle)
 		r.updateRunState(qr, RunFail, runLogger)
 		return
 	}
+	r.updateRunState(qr, RunSuccess, runLogger)
+	runLogger.Info("Execution succeeded")
+
+	// Not waited on by the scheduler, because Stop holds the scheduler lock while waiting for runs to finish.
+	go r.ts.onSuccess(r.task, qr.Now)
+
+	// Check again if there is a new run available, without returning to idle state.
+	r.startFromWorking(atomic.LoadInt64(r.ts.now))
+}
 
+// wait blocks until rp completes, canceling rp if either ctx or the runner's context is canceled first.
+func (r *runner) wait(ctx context.Context, rp RunPromise) (RunResult, error) {
 	ready := make(chan struct{})
+	defer close(ready)
+
 	go func() {
-		// If the runner's context is canceled, cancel the RunPromise.
 		select {
+		// Canceled run.
 		case <-ctx.Done():
-			r.clearRunning(qr.RunID)
 			rp.Cancel()
 		// Canceled context.
 		case <-r.ctx.Done():
-			r.clearRunning(qr.RunID)
 			rp.Cancel()
 		// Wait finished.
 		case <-ready:
-			r.clearRunning(qr.RunID)
 		}
 	}()
 
-	// TODO(mr): handle res.IsRetryable().
-	_, err = rp.Wait()
-	close(ready)
-	if err != nil {
-		if err == ErrRunCanceled {
-			_ = r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID)
-			r.updateRunState(qr, RunCanceled, runLogger)
+	return rp.Wait()
+}
 
-			// Move on to the next execution, for a canceled run.
-			r.startFromWorking(atomic.LoadInt64(r.ts.now))
-			return
-		}
+// sleep blocks for d, returning false if either ctx or the runner's context is canceled first.
+func (r *runner) sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
 
-		runLogger.Info("Failed to wait for execution result", zap.Error(err))
-		// TODO(mr): retry?
-		r.updateRunState(qr, RunFail, runLogger)
-		atomic.StoreUint32(r.state, runnerIdle)
-		return
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-r.ctx.Done():
+		return false
 	}
+}
 
-	if err := r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID); err != nil {
-		runLogger.Info("Failed to finish run", zap.Error(err))
-		// TODO(mr): retry?
-		// Need to think about what it means if there was an error finishing a run.
-		atomic.StoreUint32(r.state, runnerIdle)
-		r.updateRunState(qr, RunFail, runLogger)
-		return
-	}
-	r.updateRunState(qr, RunSuccess, runLogger)
-	runLogger.Info("Execution succeeded")
+// finishCanceled records the cancellation of a run, and moves on to the next execution.
+func (r *runner) finishCanceled(qr QueuedRun, runLogger *zap.Logger) {
+	_ = r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID)
+	r.updateRunState(qr, RunCanceled, runLogger)
 
-	// Check again if there is a new run available, without returning to idle state.
+	// Move on to the next execution, for a canceled run.
 	r.startFromWorking(atomic.LoadInt64(r.ts.now))
 }
 
-func (r *runner) updateRunState(qr QueuedRun, s RunStatus, runLogger *zap.Logger) {
-	rlb := RunLogBase{
+// addRunLog adds a log entry to the run.
+func (r *runner) addRunLog(qr QueuedRun, log platform.Log) {
+	r.logWriter.AddRunLog(r.ctx, r.runLogBase(qr), time.Now(), log)
+}
+
+// addStatsLog adds a log entry to the run, with the statistics of the run's query.
+func (r *runner) addStatsLog(qr QueuedRun, res RunResult) {
+	stats := res.Statistics()
+	var rows int64
+	for _, n := range stats.RowsWritten {
+		rows += n
+	}
+	r.addRunLog(qr, platform.Log{
+		Message: fmt.Sprintf("Query finished: %d rows written, %d bytes read", rows, stats.BytesRead),
+		Stats:   &stats,
+	})
+}
+
+// runError returns err as a *platform.RunError, for the log of a run.
+func runError(err error) *platform.RunError {
+	if e, ok := err.(*platform.RunError); ok {
+		return e
+	}
+	return &platform.RunError{Message: err.Error()}
+}
+
+func (r *runner) runLogBase(qr QueuedRun) RunLogBase {
+	return RunLogBase{
 		Task:            r.task,
 		RunID:           qr.RunID,
 		RunScheduledFor: qr.Now,
 		RequestedAt:     qr.RequestedAt,
 	}
+}
+
+func (r *runner) updateRunState(qr QueuedRun, s RunStatus, runLogger *zap.Logger) {
+	rlb := r.runLogBase(qr)
 
 	switch s {
 	case RunStarted:
 		r.ts.metrics.StartRun(r.task.ID.String())
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), fmt.Sprintf("Started task from script: %q", r.task.Script))
+		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), platform.Log{Message: fmt.Sprintf("Started task from script: %q", r.task.Script)})
 	case RunSuccess:
 		r.ts.metrics.FinishRun(r.task.ID.String(), true)
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), "Completed successfully")
+		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), platform.Log{Message: "Completed successfully"})
 	case RunFail:
 		r.ts.metrics.FinishRun(r.task.ID.String(), false)
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), "Failed")
+		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), platform.Log{Message: "Failed"})
 	case RunCanceled:
 		r.ts.metrics.FinishRun(r.task.ID.String(), false)
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), "Canceled")
+		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), platform.Log{Message: "Canceled"})
 	default: // We are deliberately not handling RunQueued yet.
 		// There is not really a notion of being queued in this runner architecture.
 		runLogger.Warn("Unhandled run state", zap.Stringer("state", s))
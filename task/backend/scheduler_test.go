@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -467,13 +468,35 @@ func TestScheduler_RunLog(t *testing.T) {
 	}
 
 	// Finish with success.
-	promises[0].Finish(mock.NewRunResult(nil, false), nil)
+	stats := platform.RunStats{RowsWritten: map[string]int64{"_result": 2}, BytesRead: 64}
+	promises[0].Finish(mock.NewRunResult(nil, false).WithStatistics(stats), nil)
 	if _, err := e.PollForNumberRunning(task.ID, 0); err != nil {
 		t.Fatal(err)
 	}
 
 	pollForRunStatus(t, rl, task.ID, 1, 0, backend.RunSuccess.String())
 
+	// The statistics of the query are logged with the run.
+	logs, err := rl.ListLogs(context.Background(), platform.LogFilter{Run: &runs[0].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statsLog *platform.Log
+	for i := range logs {
+		if logs[i].Stats != nil {
+			statsLog = &logs[i]
+		}
+	}
+	if statsLog == nil {
+		t.Fatalf("expected a log entry with statistics, got %v", logs)
+	}
+	if !reflect.DeepEqual(*statsLog.Stats, stats) {
+		t.Fatalf("expected statistics %#v, got %#v", stats, *statsLog.Stats)
+	}
+	if exp := "Query finished: 2 rows written, 64 bytes read"; statsLog.Message != exp {
+		t.Fatalf("expected message %q, got %q", exp, statsLog.Message)
+	}
+
 	// Create a new run, but fail this time.
 	s.Tick(7)
 	promises, err = e.PollForNumberRunning(task.ID, 1)
@@ -509,6 +532,213 @@ func TestScheduler_RunLog(t *testing.T) {
 	pollForRunStatus(t, rl, task.ID, 3, 2, backend.RunCanceled.String())
 }
 
+func TestScheduler_Retry(t *testing.T) {
+	d := mock.NewDesiredState()
+	e := mock.NewExecutor()
+	rl := backend.NewInMemRunReaderWriter()
+	s := backend.NewScheduler(d, e, rl, 5, backend.WithLogger(zaptest.NewLogger(t)), backend.WithRetryBackoff(time.Millisecond, 5*time.Millisecond))
+	s.Start(context.Background())
+	defer s.Stop()
+
+	task := &backend.StoreTask{
+		ID:     platform.ID(1),
+		Script: "option task = {\n  name: \"name\",\n  every: 1s,\n  retry: 3,\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)",
+	}
+	meta := &backend.StoreTaskMeta{
+		MaxConcurrency:  1,
+		EffectiveCron:   "@every 1s",
+		LatestCompleted: 5,
+	}
+
+	d.SetTaskMeta(task.ID, *meta)
+	if err := s.ClaimTask(task, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// A retryable failure is attempted again with the same run.
+	s.Tick(6)
+	rp := pollForNewPromise(t, e, task.ID, nil)
+	rp.Finish(mock.NewRunResult(errors.New("transient"), true), nil)
+	rp = pollForNewPromise(t, e, task.ID, rp)
+	if got := rp.Run().RunID; got != d.CreatedFor(task.ID)[0].RunID {
+		t.Fatalf("expected retry of run %s, got run %s", d.CreatedFor(task.ID)[0].RunID, got)
+	}
+	rp.Finish(mock.NewRunResult(nil, false), nil)
+	pollForRunStatus(t, rl, task.ID, 1, 0, backend.RunSuccess.String())
+	if _, err := e.PollForNumberRunning(task.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// A run that keeps failing stops after the task's retry option of 3 attempts.
+	s.Tick(7)
+	var prev *mock.RunPromise
+	for i := 0; i < 3; i++ {
+		prev = pollForNewPromise(t, e, task.ID, prev)
+		prev.Finish(mock.NewRunResult(errors.New("transient"), true), nil)
+	}
+	pollForRunStatus(t, rl, task.ID, 2, 1, backend.RunFail.String())
+	if _, err := e.PollForNumberRunning(task.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := rl.ListRuns(context.Background(), platform.RunFilter{Task: &task.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs, err := rl.ListLogs(context.Background(), platform.LogFilter{Run: &runs[1].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var failed int
+	for _, l := range logs {
+		if strings.Contains(l.Message, "failed: transient") && l.Error != nil && l.Error.Message == "transient" {
+			failed++
+		}
+	}
+	if failed != 3 {
+		t.Fatalf("expected a log entry for each of 3 failed attempts, got %d in: %v", failed, logs)
+	}
+
+	// A failure that is not retryable fails the run immediately.
+	s.Tick(8)
+	rp = pollForNewPromise(t, e, task.ID, nil)
+	rp.Finish(mock.NewRunResult(errors.New("permanent"), false), nil)
+	pollForRunStatus(t, rl, task.ID, 3, 2, backend.RunFail.String())
+	if _, err := e.PollForNumberRunning(task.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// pollForNewPromise waits for the single running promise of the task to be a promise other than prev.
+func pollForNewPromise(t *testing.T, e *mock.Executor, taskID platform.ID, prev *mock.RunPromise) *mock.RunPromise {
+	t.Helper()
+
+	const maxAttempts = 50
+	for i := 0; i < maxAttempts; i++ {
+		if i != 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if rps := e.RunningFor(taskID); len(rps) == 1 && rps[0] != prev {
+			return rps[0]
+		}
+	}
+
+	t.Fatalf("did not see a new running promise for task %s", taskID)
+	return nil
+}
+
+func TestScheduler_CatchUpRate(t *testing.T) {
+	d := mock.NewDesiredState()
+	e := mock.NewExecutor()
+	s := backend.NewScheduler(d, e, backend.NopLogWriter{}, 5, backend.WithLogger(zaptest.NewLogger(t)))
+	s.Start(context.Background())
+	defer s.Stop()
+
+	task := &backend.StoreTask{
+		ID: platform.ID(1),
+	}
+	meta := &backend.StoreTaskMeta{
+		MaxConcurrency:  99,
+		EffectiveCron:   "@every 1s",
+		LatestCompleted: 5,
+		CatchUpRate:     2,
+	}
+
+	d.SetTaskMeta(task.ID, *meta)
+	if err := s.ClaimTask(task, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// The runs for 6 through 10 are due, but only two missed runs may start per second.
+	s.Tick(10)
+	if _, err := d.PollForNumberCreated(task.ID, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Tick(11)
+	if _, err := d.PollForNumberCreated(task.ID, 4); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScheduler_Dependencies(t *testing.T) {
+	d := mock.NewDesiredState()
+	e := mock.NewExecutor()
+	s := backend.NewScheduler(d, e, backend.NopLogWriter{}, 5, backend.WithLogger(zaptest.NewLogger(t)))
+	s.Start(context.Background())
+	defer s.Stop()
+
+	upstream := &backend.StoreTask{
+		ID:     platform.ID(1),
+		Org:    platform.ID(1),
+		Name:   "raw",
+		Script: "option task = {\n  name: \"raw\",\n  every: 1s,\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)",
+	}
+	dependent := &backend.StoreTask{
+		ID:     platform.ID(2),
+		Org:    platform.ID(1),
+		Name:   "1m",
+		Script: "option task = {\n  name: \"1m\",\n  after: [\"raw\"],\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)",
+	}
+	otherOrg := &backend.StoreTask{
+		ID:     platform.ID(3),
+		Org:    platform.ID(2),
+		Name:   "1m",
+		Script: dependent.Script,
+	}
+
+	for _, task := range []*backend.StoreTask{upstream, dependent, otherOrg} {
+		meta := &backend.StoreTaskMeta{MaxConcurrency: 1, LatestCompleted: 5}
+		if task == upstream {
+			meta.EffectiveCron = "@every 1s"
+		}
+		d.SetTaskMeta(task.ID, *meta)
+		if err := s.ClaimTask(task, meta); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A successful upstream run triggers a run of the dependent task, with the same now.
+	s.Tick(6)
+	rp := pollForNewPromise(t, e, upstream.ID, nil)
+	rp.Finish(mock.NewRunResult(nil, false), nil)
+
+	qrs, err := d.PollForNumberCreated(dependent.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qrs[0].Now != 6 {
+		t.Fatalf("expected dependent run with now 6, got %d", qrs[0].Now)
+	}
+	if qrs := d.CreatedFor(otherOrg.ID); len(qrs) != 0 {
+		t.Fatalf("expected no run of task in other organization, got %v", qrs)
+	}
+	pollForNewPromise(t, e, dependent.ID, nil).Finish(mock.NewRunResult(nil, false), nil)
+	if _, err := d.PollForNumberCreated(dependent.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// A failed upstream run doesn't trigger the dependent task.
+	s.Tick(7)
+	rp = pollForNewPromise(t, e, upstream.ID, rp)
+	rp.Finish(mock.NewRunResult(errors.New("permanent"), false), nil)
+	if _, err := e.PollForNumberRunning(upstream.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Tick(8)
+	rp = pollForNewPromise(t, e, upstream.ID, rp)
+	rp.Finish(mock.NewRunResult(nil, false), nil)
+	qrs, err = d.PollForNumberCreated(dependent.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qrs[0].Now != 8 {
+		t.Fatalf("expected dependent run with now 8, got %d", qrs[0].Now)
+	}
+}
+
 func TestScheduler_Metrics(t *testing.T) {
 	d := mock.NewDesiredState()
 	e := mock.NewExecutor()
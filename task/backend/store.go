@@ -258,8 +258,9 @@ type LogWriter interface {
 	// UpdateRunState sets the run state and the respective time.
 	UpdateRunState(ctx context.Context, base RunLogBase, when time.Time, state RunStatus) error
 
-	// AddRunLog adds a log line to the run.
-	AddRunLog(ctx context.Context, base RunLogBase, when time.Time, log string) error
+	// AddRunLog adds a log entry to the run.
+	// The entry's RunID and Time are set from base and when.
+	AddRunLog(ctx context.Context, base RunLogBase, when time.Time, log platform.Log) error
 }
 
 // NopLogWriter is a LogWriter that doesn't do anything when its methods are called.
@@ -270,7 +271,7 @@ func (NopLogWriter) UpdateRunState(context.Context, RunLogBase, time.Time, RunSt
 	return nil
 }
 
-func (NopLogWriter) AddRunLog(context.Context, RunLogBase, time.Time, string) error {
+func (NopLogWriter) AddRunLog(context.Context, RunLogBase, time.Time, platform.Log) error {
 	return nil
 }
 
@@ -283,7 +284,8 @@ type LogReader interface {
 	// orgID is necessary to look in the correct system bucket.
 	FindRunByID(ctx context.Context, orgID, runID platform.ID) (*platform.Run, error)
 
-	// ListLogs lists logs for a task or a specified run of a task.
+	// ListLogs lists the log entries of a specified run of a task, or of every run of a task.
+	// Entries are ordered by run, and then by time.
 	ListLogs(ctx context.Context, logFilter platform.LogFilter) ([]platform.Log, error)
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -136,22 +137,37 @@ func runLogTest(t *testing.T, crf CreateRunStoreFunc, drf DestroyRunStoreFunc) {
 		t.Fatal(err)
 	}
 
-	if err := writer.AddRunLog(ctx, rlb, sa.Add(time.Second), "first"); err != nil {
+	stats := &platform.RunStats{
+		RowsWritten: map[string]int64{"_result": 3},
+		BytesRead:   128,
+		ValuesRead:  6,
+	}
+	runErr := &platform.RunError{
+		Message: "undefined identifier \"x\"",
+		Start:   &platform.ScriptPosition{Line: 1, Column: 5},
+		End:     &platform.ScriptPosition{Line: 1, Column: 6},
+	}
+
+	if err := writer.AddRunLog(ctx, rlb, sa.Add(time.Second), platform.Log{Message: "first"}); err != nil {
 		t.Fatal(err)
 	}
-	if err := writer.AddRunLog(ctx, rlb, sa.Add(2*time.Second), "second"); err != nil {
+	if err := writer.AddRunLog(ctx, rlb, sa.Add(2*time.Second), platform.Log{Message: "second", Stats: stats}); err != nil {
 		t.Fatal(err)
 	}
-	if err := writer.AddRunLog(ctx, rlb, sa.Add(3*time.Second), "third"); err != nil {
+	if err := writer.AddRunLog(ctx, rlb, sa.Add(3*time.Second), platform.Log{Message: "third", Error: runErr}); err != nil {
 		t.Fatal(err)
 	}
 
-	run.Log = platform.Log(fmt.Sprintf(
-		"%s: first\n%s: second\n%s: third",
-		sa.Add(time.Second).Format(time.RFC3339Nano),
-		sa.Add(2*time.Second).Format(time.RFC3339Nano),
-		sa.Add(3*time.Second).Format(time.RFC3339Nano),
-	))
+	run.Logs = []platform.Log{
+		{RunID: run.ID, Time: sa.Add(time.Second).Format(time.RFC3339Nano), Message: "first"},
+		{RunID: run.ID, Time: sa.Add(2 * time.Second).Format(time.RFC3339Nano), Message: "second", Stats: stats},
+		{RunID: run.ID, Time: sa.Add(3 * time.Second).Format(time.RFC3339Nano), Message: "third", Error: runErr},
+	}
+	run.Log = strings.Join([]string{
+		sa.Add(time.Second).Format(time.RFC3339Nano) + ": first",
+		sa.Add(2*time.Second).Format(time.RFC3339Nano) + ": second",
+		sa.Add(3*time.Second).Format(time.RFC3339Nano) + ": third",
+	}, "\n")
 	returnedRun, err := reader.FindRunByID(ctx, task.Org, run.ID)
 	if err != nil {
 		t.Fatal(err)
@@ -320,7 +336,7 @@ func findRunByIDTest(t *testing.T, crf CreateRunStoreFunc, drf DestroyRunStoreFu
 		t.Fatalf("expected:\n%#v, got: \n%#v", run, *returnedRun)
 	}
 
-	returnedRun.Log = "cows"
+	returnedRun.Logs = []platform.Log{{Message: "cows"}}
 
 	rr2, err := reader.FindRunByID(ctx, task.Org, run.ID)
 	if err != nil {
@@ -372,7 +388,7 @@ func listLogsTest(t *testing.T, crf CreateRunStoreFunc, drf DestroyRunStoreFunc)
 			t.Fatal(err)
 		}
 
-		writer.AddRunLog(ctx, rlb, sf.Add(2*time.Millisecond), fmt.Sprintf("log%d", i))
+		writer.AddRunLog(ctx, rlb, sf.Add(2*time.Millisecond), platform.Log{Message: fmt.Sprintf("log%d", i)})
 	}
 
 	const targetRun = 4
@@ -385,8 +401,8 @@ func listLogsTest(t *testing.T, crf CreateRunStoreFunc, drf DestroyRunStoreFunc)
 	}
 
 	fmtTimelog := now.Add(time.Duration(targetRun-nRuns)*time.Second + 2*time.Millisecond).Format(time.RFC3339Nano)
-	if fmtTimelog+": log4" != string(logs[0]) {
-		t.Fatalf("expected: %q, got: %q", fmtTimelog+": log4", string(logs[0]))
+	if logs[0].Time != fmtTimelog || logs[0].Message != "log4" || logs[0].RunID != runs[targetRun].ID {
+		t.Fatalf("expected: %q, got: %q", fmtTimelog+": log4", logs[0].String())
 	}
 
 	logs, err = reader.ListLogs(ctx, platform.LogFilter{Task: &task.ID, Org: &task.Org})
@@ -219,6 +219,66 @@ from(bucket:"y") |> range(start:-1h)`
 		}
 	})
 
+	t.Run("catch-up policy", func(t *testing.T) {
+		s := create(t)
+		defer destroy(t, s)
+
+		id, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: 1, User: 2, Script: script})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		const scriptCatchUp = `option task = {
+		name: "a task",
+		cron: "* * * * *",
+		catchUp: "latest",
+		catchUpRate: 3,
+	}
+
+from(bucket:"x") |> range(start:-1h)`
+
+		res, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: id, Script: scriptCatchUp})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.NewMeta.CatchUp != "latest" || res.NewMeta.CatchUpRate != 3 {
+			t.Fatalf("expected updated catch-up policy latest at rate 3, got %q at rate %d", res.NewMeta.CatchUp, res.NewMeta.CatchUpRate)
+		}
+
+		// The policy must have been persisted.
+		meta, err := s.FindTaskMetaByID(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.CatchUp != "latest" || meta.CatchUpRate != 3 {
+			t.Fatalf("expected stored catch-up policy latest at rate 3, got %q at rate %d", meta.CatchUp, meta.CatchUpRate)
+		}
+
+		// Updating the status alone keeps the policy.
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: id, Status: backend.TaskInactive}); err != nil {
+			t.Fatal(err)
+		}
+		meta, err = s.FindTaskMetaByID(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.CatchUp != "latest" || meta.CatchUpRate != 3 {
+			t.Fatalf("expected catch-up policy to be kept, got %q at rate %d", meta.CatchUp, meta.CatchUpRate)
+		}
+
+		// Removing the options restores the defaults.
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: id, Script: script}); err != nil {
+			t.Fatal(err)
+		}
+		meta, err = s.FindTaskMetaByID(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.CatchUp != "" || meta.CatchUpRate != 0 {
+			t.Fatalf("expected default catch-up policy, got %q at rate %d", meta.CatchUp, meta.CatchUpRate)
+		}
+	})
+
 	for _, args := range []struct {
 		caseName string
 		req      backend.UpdateTaskRequest
@@ -829,6 +889,49 @@ from(bucket:"test") |> range(start:-1h)`
 		}
 	})
 
+	t.Run("skipping missed runs", func(t *testing.T) {
+		const script = `option task = {
+			name: "a task",
+			cron: "* * * * *",
+			offset: 5s,
+			catchUp: "skip",
+		}
+
+	from(bucket:"test") |> range(start:-1h)`
+		taskID, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: 3, User: 4, Script: script, ScheduleAfter: 30})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The runs for 60, 120 and 180 were missed.
+		_, err = s.CreateNextRun(context.Background(), taskID, 200)
+		if e, ok := err.(backend.RunNotYetDueError); !ok {
+			t.Fatalf("expected RunNotYetDueError, got %v (%T)", err, err)
+		} else if e.DueAt != 245 {
+			t.Fatalf("expected run due at 245, got %d", e.DueAt)
+		}
+
+		// Skipping the missed runs must have been persisted.
+		meta, err := s.FindTaskMetaByID(context.Background(), taskID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.LatestCompleted != 180 {
+			t.Fatalf("expected latest completed to be 180, got %d", meta.LatestCompleted)
+		}
+		if meta.CatchUp != "skip" {
+			t.Fatalf("expected catch-up policy skip, got %q", meta.CatchUp)
+		}
+
+		rc, err := s.CreateNextRun(context.Background(), taskID, 245)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rc.Created.Now != 240 {
+			t.Fatalf("unexpected time for created run: %d", rc.Created.Now)
+		}
+	})
+
 	t.Run("with a queue", func(t *testing.T) {
 		const script = `option task = {
 			name: "a task",
@@ -215,7 +215,11 @@ func (d *DesiredState) CreateNextRun(_ context.Context, taskID platform.ID, now
 	}
 
 	rc, err := meta.CreateNextRun(now, makeID)
-	if err != nil {
+	if _, ok := err.(backend.RunNotYetDueError); ok {
+		// Missed runs may have been skipped.
+		d.meta[tid] = meta
+		return backend.RunCreation{}, err
+	} else if err != nil {
 		return backend.RunCreation{}, err
 	}
 	d.meta[tid] = meta
@@ -243,6 +247,27 @@ func (d *DesiredState) FinishRun(_ context.Context, taskID, runID platform.ID) e
 	return nil
 }
 
+func (d *DesiredState) ManuallyRunTimeRange(_ context.Context, taskID platform.ID, start, end, requestedAt int64) (*backend.StoreTaskMetaManualRun, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tid := taskID.String()
+	m, ok := d.meta[tid]
+	if !ok {
+		return nil, errors.New("task not found")
+	}
+
+	makeID := func() (platform.ID, error) {
+		d.runIDs[tid]++
+		return platform.ID(d.runIDs[tid]), nil
+	}
+	if err := m.ManuallyRunTimeRange(start, end, requestedAt, makeID); err != nil {
+		return nil, err
+	}
+	d.meta[tid] = m
+	return m.ManualRuns[len(m.ManualRuns)-1], nil
+}
+
 func (d *DesiredState) CreatedFor(taskID platform.ID) []backend.QueuedRun {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -310,7 +335,10 @@ func (e *Executor) Execute(ctx context.Context, run backend.QueuedRun) (backend.
 		defer e.wg.Done()
 		res, _ := rp.Wait()
 		e.mu.Lock()
-		delete(e.running, id)
+		// A retried run may already have replaced this promise.
+		if e.running[id] == rp {
+			delete(e.running, id)
+		}
 		e.finished[id] = res
 		e.mu.Unlock()
 	}()
@@ -424,6 +452,7 @@ func (p *RunPromise) Finish(r backend.RunResult, err error) {
 type RunResult struct {
 	err         error
 	isRetryable bool
+	stats       platform.RunStats
 }
 
 var _ backend.RunResult = (*RunResult)(nil)
@@ -439,3 +468,13 @@ func (rr *RunResult) Err() error {
 func (rr *RunResult) IsRetryable() bool {
 	return rr.isRetryable
 }
+
+// WithStatistics sets the statistics returned by rr, and returns rr.
+func (rr *RunResult) WithStatistics(stats platform.RunStats) *RunResult {
+	rr.stats = stats
+	return rr
+}
+
+func (rr *RunResult) Statistics() platform.RunStats {
+	return rr.stats
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
 	cron "gopkg.in/robfig/cron.v2"
 )
 
@@ -28,6 +29,19 @@ func EnableScriptCacheForTest() {
 const maxConcurrency = 100
 const maxRetry = 10
 
+// Catch-up policies for runs that were missed while a task was not scheduled,
+// for example because the server was down.
+const (
+	// CatchUpAll executes every missed run, oldest first. It is the default policy.
+	CatchUpAll = "all"
+
+	// CatchUpSkip skips every missed run, resuming with the next scheduled run.
+	CatchUpSkip = "skip"
+
+	// CatchUpLatest skips the missed runs except for the most recent one, which is executed.
+	CatchUpLatest = "latest"
+)
+
 // Options are the task-related options that can be specified in a Flux script.
 type Options struct {
 	// Name is a non optional name designator for each task.
@@ -45,6 +59,19 @@ type Options struct {
 	Concurrency int64
 
 	Retry int64
+
+	// CatchUp is the policy for runs that were missed while the task was not scheduled.
+	// It is one of CatchUpAll, CatchUpSkip or CatchUpLatest. The empty string is treated as CatchUpAll.
+	CatchUp string
+
+	// CatchUpRate is the maximum number of missed runs started per second while catching up.
+	// Zero means there is no limit.
+	CatchUpRate int64
+
+	// After is the names of the tasks, in the same organization, whose successful runs trigger a run of this task,
+	// with the same now value as the triggering run.
+	// A task with After set does not need to specify either Cron or Every.
+	After []string
 }
 
 // FromScript extracts Options from a Flux script.
@@ -88,8 +115,9 @@ func FromScript(script string) (Options, error) {
 	if cronOK && everyOK {
 		return opt, errors.New("cannot use both cron and every in task options")
 	}
-	if !cronOK && !everyOK {
-		return opt, errors.New("cron or every is required")
+	afterVal, afterOK := optObject.Get("after")
+	if !cronOK && !everyOK && !afterOK {
+		return opt, errors.New("cron, every or after is required")
 	}
 
 	if cronOK {
@@ -127,6 +155,38 @@ func FromScript(script string) (Options, error) {
 		opt.Retry = retryVal.Int()
 	}
 
+	if catchUpVal, ok := optObject.Get("catchUp"); ok {
+		if err := checkNature(catchUpVal.PolyType().Nature(), semantic.String); err != nil {
+			return opt, err
+		}
+		opt.CatchUp = catchUpVal.Str()
+	}
+
+	if catchUpRateVal, ok := optObject.Get("catchUpRate"); ok {
+		if err := checkNature(catchUpRateVal.PolyType().Nature(), semantic.Int); err != nil {
+			return opt, err
+		}
+		opt.CatchUpRate = catchUpRateVal.Int()
+	}
+
+	if afterOK {
+		if err := checkNature(afterVal.PolyType().Nature(), semantic.Array); err != nil {
+			return opt, err
+		}
+		var err error
+		afterVal.Array().Range(func(i int, v values.Value) {
+			if err != nil {
+				return
+			}
+			if err = checkNature(v.PolyType().Nature(), semantic.String); err == nil {
+				opt.After = append(opt.After, v.Str())
+			}
+		})
+		if err != nil {
+			return opt, err
+		}
+	}
+
 	if err := opt.Validate(); err != nil {
 		return opt, err
 	}
@@ -149,9 +209,10 @@ func (o *Options) Validate() error {
 
 	cronPresent := o.Cron != ""
 	everyPresent := o.Every != 0
-	if cronPresent == everyPresent {
-		// They're both present or both missing.
+	if cronPresent && everyPresent {
 		errs = append(errs, "must specify exactly one of either cron or every")
+	} else if !cronPresent && !everyPresent && len(o.After) == 0 {
+		errs = append(errs, "must specify exactly one of either cron or every, unless after is specified")
 	} else if cronPresent {
 		_, err := cron.Parse(o.Cron)
 		if err != nil {
@@ -182,6 +243,24 @@ func (o *Options) Validate() error {
 		errs = append(errs, fmt.Sprintf("retry exceeded max of %d", maxRetry))
 	}
 
+	switch o.CatchUp {
+	case "", CatchUpAll, CatchUpSkip, CatchUpLatest:
+	default:
+		errs = append(errs, fmt.Sprintf("catchUp must be one of %q, %q or %q", CatchUpAll, CatchUpSkip, CatchUpLatest))
+	}
+
+	if o.CatchUpRate < 0 {
+		errs = append(errs, "catchUpRate must not be negative")
+	}
+
+	for _, a := range o.After {
+		if a == "" {
+			errs = append(errs, "after must not contain an empty task name")
+		} else if a == o.Name {
+			errs = append(errs, "after must not contain the task's own name")
+		}
+	}
+
 	if len(errs) == 0 {
 		return nil
 	}
@@ -3,6 +3,7 @@ package options_test
 import (
 	"fmt"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,6 +32,19 @@ func scriptGenerator(opt options.Options, body string) string {
 	if opt.Retry != 0 {
 		taskData = fmt.Sprintf("%s  retry: %d,\n", taskData, opt.Retry)
 	}
+	if opt.CatchUp != "" {
+		taskData = fmt.Sprintf("%s  catchUp: %q,\n", taskData, opt.CatchUp)
+	}
+	if opt.CatchUpRate != 0 {
+		taskData = fmt.Sprintf("%s  catchUpRate: %d,\n", taskData, opt.CatchUpRate)
+	}
+	if len(opt.After) > 0 {
+		names := make([]string, len(opt.After))
+		for i, a := range opt.After {
+			names[i] = fmt.Sprintf("%q", a)
+		}
+		taskData = fmt.Sprintf("%s  after: [%s],\n", taskData, strings.Join(names, ", "))
+	}
 	if body == "" {
 		body = `from(bucket: "test")
     |> range(start:-1h)`
@@ -58,6 +72,14 @@ func TestFromScript(t *testing.T) {
 		{script: "option task = {\n  name: \"name\",\n  concurrency: 1,\n  every: 1,\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
 		{script: scriptGenerator(options.Options{Name: "name", Retry: 20, Every: time.Hour}, ""), shouldErr: true},
 		{script: "option task = {\n  name: \"name\",\n  retry: 0,\n  every: 1m0s,\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", Every: time.Hour, CatchUp: options.CatchUpLatest, CatchUpRate: 5}, ""), exp: options.Options{Name: "name", Every: time.Hour, Concurrency: 1, Retry: 1, CatchUp: options.CatchUpLatest, CatchUpRate: 5}},
+		{script: scriptGenerator(options.Options{Name: "name", Every: time.Hour, CatchUp: "some"}, ""), shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", Every: time.Hour, CatchUpRate: -1}, ""), shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", After: []string{"a", "b"}}, ""), exp: options.Options{Name: "name", Concurrency: 1, Retry: 1, After: []string{"a", "b"}}},
+		{script: scriptGenerator(options.Options{Name: "name", Every: time.Hour, After: []string{"a"}}, ""), exp: options.Options{Name: "name", Every: time.Hour, Concurrency: 1, Retry: 1, After: []string{"a"}}},
+		{script: scriptGenerator(options.Options{Name: "name", After: []string{"name"}}, ""), shouldErr: true},
+		{script: "option task = {\n  name: \"name\",\n  after: [1, 2],\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
+		{script: "option task = {\n  name: \"name\",\n  after: \"a\",\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
 		{script: scriptGenerator(options.Options{Name: "name"}, ""), shouldErr: true},
 		{script: scriptGenerator(options.Options{}, ""), shouldErr: true},
 	} {
@@ -96,6 +118,25 @@ func TestValidate(t *testing.T) {
 		t.Error("expected error for options without cron or every")
 	}
 
+	*bad = good
+	bad.Cron = ""
+	bad.After = []string{"y"}
+	if err := bad.Validate(); err != nil {
+		t.Errorf("expected no error for options with after but without cron or every, got %v", err)
+	}
+
+	*bad = good
+	bad.After = []string{""}
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for options with empty name in after")
+	}
+
+	*bad = good
+	bad.After = []string{"x"}
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for options with own name in after")
+	}
+
 	*bad = good
 	bad.Every = time.Minute
 	if err := bad.Validate(); err == nil {
@@ -144,6 +185,18 @@ func TestValidate(t *testing.T) {
 	if err := bad.Validate(); err == nil {
 		t.Error("expected error for retry too large")
 	}
+
+	*bad = good
+	bad.CatchUp = "some"
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for unknown catch-up policy")
+	}
+
+	*bad = good
+	bad.CatchUpRate = -1
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for negative catch-up rate")
+	}
 }
 
 func TestEffectiveCronString(t *testing.T) {
@@ -3,6 +3,8 @@ package task
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/influxdata/platform"
@@ -75,6 +77,10 @@ func (p pAdapter) CreateTask(ctx context.Context, t *platform.Task) error {
 		return err
 	}
 
+	if err := p.checkDependencyCycle(ctx, t.Organization, 0, opts); err != nil {
+		return err
+	}
+
 	// TODO(mr): decide whether we allow user to configure scheduleAfter. https://github.com/influxdata/platform/issues/595
 	scheduleAfter := time.Now().Unix()
 
@@ -109,6 +115,20 @@ func (p pAdapter) UpdateTask(ctx context.Context, id platform.ID, upd platform.T
 	req := backend.UpdateTaskRequest{ID: id}
 	if upd.Flux != nil {
 		req.Script = *upd.Flux
+
+		opts, err := options.FromScript(req.Script)
+		if err != nil {
+			return nil, err
+		}
+		t, err := p.s.FindTaskByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if t != nil {
+			if err := p.checkDependencyCycle(ctx, t.Org, id, opts); err != nil {
+				return nil, err
+			}
+		}
 	}
 	if upd.Status != nil {
 		req.Status = backend.TaskStatus(*upd.Status)
@@ -220,10 +240,81 @@ func (p pAdapter) ForceRun(ctx context.Context, taskID platform.ID, scheduledFor
 	}, nil
 }
 
+func (p pAdapter) BackfillRuns(ctx context.Context, taskID platform.ID, start, stop int64) error {
+	_, err := p.s.ManuallyRunTimeRange(ctx, taskID, start, stop, time.Now().Unix())
+	return err
+}
+
 func (p pAdapter) CancelRun(ctx context.Context, taskID, runID platform.ID) error {
 	return p.rc.CancelRun(ctx, taskID, runID)
 }
 
+// checkDependencyCycle returns an error if the after option in opts, for the task with the given ID in the given organization,
+// would make the task depend on itself, directly or through the other tasks of the organization.
+// The ID is zero for a task that is being created.
+func (p pAdapter) checkDependencyCycle(ctx context.Context, org, id platform.ID, opts options.Options) error {
+	if len(opts.After) == 0 {
+		// Nothing triggers the task, so it can't be part of a cycle.
+		return nil
+	}
+
+	// Map of task name to the names of the tasks it runs after.
+	after := map[string][]string{opts.Name: opts.After}
+	params := backend.TaskSearchParams{Org: org}
+	for {
+		ts, err := p.s.ListTasks(ctx, params)
+		if err != nil {
+			return err
+		}
+		if len(ts) == 0 {
+			break
+		}
+
+		for _, t := range ts {
+			if t.Task.ID == id {
+				// Use the options being validated instead of the task's current ones.
+				continue
+			}
+			o, err := options.FromScript(t.Task.Script)
+			if err != nil {
+				continue
+			}
+			after[o.Name] = append(after[o.Name], o.After...)
+		}
+		params.After = ts[len(ts)-1].Task.ID
+	}
+
+	visited := make(map[string]bool)
+	var path []string
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		path = append(path, name)
+		for _, a := range after[name] {
+			if a == opts.Name {
+				path = append(path, a)
+				return true
+			}
+			if visited[a] {
+				continue
+			}
+			visited[a] = true
+			if visit(a) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+
+	if visit(opts.Name) {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("task dependency cycle: %s", strings.Join(path, " -> ")),
+		}
+	}
+	return nil
+}
+
 func toPlatformTask(t backend.StoreTask, m *backend.StoreTaskMeta) (*platform.Task, error) {
 	opts, err := options.FromScript(t.Script)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -76,6 +77,11 @@ func TestTaskService(t *testing.T, fn BackendComponentFactory) {
 			t.Parallel()
 			testMetaUpdate(t, sys)
 		})
+
+		t.Run("Task Dependencies", func(t *testing.T) {
+			t.Parallel()
+			testTaskDependencies(t, sys)
+		})
 	})
 }
 
@@ -537,6 +543,46 @@ func testTaskRuns(t *testing.T, sys *System) {
 		}
 	})
 
+	t.Run("BackfillRuns", func(t *testing.T) {
+		t.Parallel()
+
+		task := &platform.Task{Organization: orgID, Owner: platform.User{ID: userID}, Flux: fmt.Sprintf(scriptFmt, 0)}
+		if err := sys.ts.CreateTask(sys.Ctx, task); err != nil {
+			t.Fatal(err)
+		}
+
+		beforeBackfill := time.Now().Unix()
+		const start, stop = 60, 600
+		if err := sys.ts.BackfillRuns(sys.Ctx, task.ID, start, stop); err != nil {
+			t.Fatal(err)
+		}
+		afterBackfill := time.Now().Unix()
+
+		m, err := sys.S.FindTaskMetaByID(sys.Ctx, task.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(m.ManualRuns) != 1 {
+			t.Fatalf("expected 1 manual run range created, got: %#v", m.ManualRuns)
+		}
+
+		if mr := m.ManualRuns[0]; mr.Start != start || mr.End != stop {
+			t.Fatalf("expected manual run range [%d, %d], got [%d, %d]", start, stop, mr.Start, mr.End)
+		}
+
+		if requestedAt := m.ManualRuns[0].RequestedAt; requestedAt < beforeBackfill || requestedAt > afterBackfill {
+			t.Fatalf("expected run RequestedAt to be in [%d, %d]; got %d", beforeBackfill, afterBackfill, requestedAt)
+		}
+
+		exp := backend.RequestStillQueuedError{Start: start, End: stop}
+
+		// Backfilling the same range before it's executed should be rejected.
+		if err := sys.ts.BackfillRuns(sys.Ctx, task.ID, start, stop); err != exp {
+			t.Fatalf("subsequent backfill should have been rejected with %v; got %v", exp, err)
+		}
+	})
+
 	t.Run("FindLogs", func(t *testing.T) {
 		t.Parallel()
 
@@ -582,7 +628,7 @@ func testTaskRuns(t *testing.T, sys *System) {
 
 		// Add a log for the first run.
 		log1Time := time.Now().UTC()
-		if err := sys.LW.AddRunLog(sys.Ctx, rlb1, log1Time, "entry 1"); err != nil {
+		if err := sys.LW.AddRunLog(sys.Ctx, rlb1, log1Time, platform.Log{Message: "entry 1"}); err != nil {
 			t.Fatal(err)
 		}
 
@@ -596,7 +642,7 @@ func testTaskRuns(t *testing.T, sys *System) {
 			t.Fatal(err)
 		}
 
-		expLine1 := platform.Log(log1Time.Format(time.RFC3339Nano) + ": entry 1")
+		expLine1 := platform.Log{RunID: rc1.Created.RunID, Time: log1Time.Format(time.RFC3339Nano), Message: "entry 1"}
 		exp := []platform.Log{expLine1}
 		if diff := cmp.Diff(logs, exp); diff != "" {
 			t.Fatalf("unexpected log: -got/+want: %s", diff)
@@ -604,7 +650,7 @@ func testTaskRuns(t *testing.T, sys *System) {
 
 		// Add a log for the second run.
 		log2Time := time.Now().UTC()
-		if err := sys.LW.AddRunLog(sys.Ctx, rlb2, log2Time, "entry 2"); err != nil {
+		if err := sys.LW.AddRunLog(sys.Ctx, rlb2, log2Time, platform.Log{Message: "entry 2"}); err != nil {
 			t.Fatal(err)
 		}
 
@@ -617,11 +663,23 @@ func testTaskRuns(t *testing.T, sys *System) {
 			t.Fatal(err)
 		}
 
-		expLine2 := platform.Log(log2Time.Format(time.RFC3339Nano) + ": entry 2")
+		expLine2 := platform.Log{RunID: rc2.Created.RunID, Time: log2Time.Format(time.RFC3339Nano), Message: "entry 2"}
 		exp = []platform.Log{expLine1, expLine2}
 		if diff := cmp.Diff(logs, exp); diff != "" {
 			t.Fatalf("unexpected log: -got/+want: %s", diff)
 		}
+
+		// Ensure the task service returns the same entries.
+		tsLogs, _, err := sys.ts.FindLogs(sys.Ctx, platform.LogFilter{
+			Org:  &orgID,
+			Task: &task.ID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tsLogs, []*platform.Log{&expLine1, &expLine2}); diff != "" {
+			t.Fatalf("unexpected task service log: -got/+want: %s", diff)
+		}
 	})
 }
 
@@ -788,6 +846,60 @@ func testTaskConcurrency(t *testing.T, sys *System) {
 	extraWg.Wait()
 }
 
+func testTaskDependencies(t *testing.T, sys *System) {
+	orgID, userID, _ := creds(t, sys)
+
+	// Names are unique to this test, in case the organization is shared with other tests.
+	prefix := idGen.ID().String()
+	script := func(name string, after ...string) string {
+		if len(after) == 0 {
+			return fmt.Sprintf(dependentScriptFmt, prefix+name, "")
+		}
+		names := make([]string, len(after))
+		for i, a := range after {
+			names[i] = fmt.Sprintf("%q", prefix+a)
+		}
+		return fmt.Sprintf(dependentScriptFmt, prefix+name, fmt.Sprintf("after: [%s],", strings.Join(names, ", ")))
+	}
+
+	var ids []platform.ID
+	defer func() {
+		for _, id := range ids {
+			if err := sys.ts.DeleteTask(sys.Ctx, id); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+
+	// a <- b <- c.
+	for _, flux := range []string{script("a"), script("b", "a"), script("c", "b")} {
+		task := &platform.Task{Organization: orgID, Owner: platform.User{ID: userID}, Flux: flux}
+		if err := sys.ts.CreateTask(sys.Ctx, task); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, task.ID)
+	}
+
+	// A new task that closes a cycle is rejected.
+	task := &platform.Task{Organization: orgID, Owner: platform.User{ID: userID}, Flux: script("a", "c")}
+	if err := sys.ts.CreateTask(sys.Ctx, task); err == nil {
+		ids = append(ids, task.ID)
+		t.Fatal("expected error creating task with dependency cycle")
+	}
+
+	// An update that closes a cycle is rejected.
+	flux := script("a", "c")
+	if _, err := sys.ts.UpdateTask(sys.Ctx, ids[0], platform.TaskUpdate{Flux: &flux}); err == nil {
+		t.Fatal("expected error updating task with dependency cycle")
+	}
+
+	// An update that doesn't close a cycle is accepted.
+	flux = script("c", "a")
+	if _, err := sys.ts.UpdateTask(sys.Ctx, ids[2], platform.TaskUpdate{Flux: &flux}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func creds(t *testing.T, s *System) (orgID, userID platform.ID, token string) {
 	t.Helper()
 
@@ -810,4 +922,11 @@ const scriptFmt = `option task = {
 }
 from(bucket:"b") |> toHTTP(url:"http://example.com")`
 
+const dependentScriptFmt = `option task = {
+	name: %q,
+	cron: "* * * * *",
+	%s
+}
+from(bucket:"b") |> toHTTP(url:"http://example.com")`
+
 var idGen = snowflake.NewIDGenerator()
@@ -37,7 +37,7 @@ func NewValidator(ts platform.TaskService, bs platform.BucketService) platform.T
 }
 
 func (ts *taskServiceValidator) CreateTask(ctx context.Context, t *platform.Task) error {
-	p, err := platform.NewPermissionAtID(t.Organization, platform.WriteAction, platform.TasksResource)
+	p, err := platform.NewOrgPermission(t.Organization, platform.WriteAction, platform.TasksResource)
 	if err != nil {
 		return err
 	}
@@ -53,7 +53,68 @@ func (ts *taskServiceValidator) CreateTask(ctx context.Context, t *platform.Task
 	return ts.TaskService.CreateTask(ctx, t)
 }
 
-// TODO(lh): add permission checking for the all the platform.TaskService functions.
+func (ts *taskServiceValidator) UpdateTask(ctx context.Context, id platform.ID, upd platform.TaskUpdate) (*platform.Task, error) {
+	if err := ts.validateTaskPermission(ctx, id, platform.WriteAction); err != nil {
+		return nil, err
+	}
+
+	if upd.Flux != nil {
+		if err := validateBucket(ctx, *upd.Flux, ts.preAuth); err != nil {
+			return nil, err
+		}
+	}
+
+	return ts.TaskService.UpdateTask(ctx, id, upd)
+}
+
+func (ts *taskServiceValidator) DeleteTask(ctx context.Context, id platform.ID) error {
+	if err := ts.validateTaskPermission(ctx, id, platform.DeleteAction); err != nil {
+		return err
+	}
+
+	return ts.TaskService.DeleteTask(ctx, id)
+}
+
+func (ts *taskServiceValidator) RetryRun(ctx context.Context, taskID, runID platform.ID) (*platform.Run, error) {
+	if err := ts.validateTaskPermission(ctx, taskID, platform.ExecuteAction); err != nil {
+		return nil, err
+	}
+
+	return ts.TaskService.RetryRun(ctx, taskID, runID)
+}
+
+func (ts *taskServiceValidator) ForceRun(ctx context.Context, taskID platform.ID, scheduledFor int64) (*platform.Run, error) {
+	if err := ts.validateTaskPermission(ctx, taskID, platform.ExecuteAction); err != nil {
+		return nil, err
+	}
+
+	return ts.TaskService.ForceRun(ctx, taskID, scheduledFor)
+}
+
+func (ts *taskServiceValidator) BackfillRuns(ctx context.Context, taskID platform.ID, start, stop int64) error {
+	if err := ts.validateTaskPermission(ctx, taskID, platform.ExecuteAction); err != nil {
+		return err
+	}
+
+	return ts.TaskService.BackfillRuns(ctx, taskID, start, stop)
+}
+
+// TODO(lh): add permission checking for the remaining platform.TaskService functions.
+
+// validateTaskPermission ensures the authorizer in ctx may perform action on the task with the given ID.
+func (ts *taskServiceValidator) validateTaskPermission(ctx context.Context, id platform.ID, action platform.Action) error {
+	t, err := ts.TaskService.FindTaskByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p, err := platform.NewOrgPermissionAtID(t.Organization, t.ID, action, platform.TasksResource)
+	if err != nil {
+		return err
+	}
+
+	return validatePermission(ctx, *p)
+}
 
 func validatePermission(ctx context.Context, perm platform.Permission) error {
 	auth, err := platcontext.GetAuthorizer(ctx)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/platform"
@@ -73,6 +74,14 @@ func AuthorizationService(
 			name: "DeleteAuthorization",
 			fn:   DeleteAuthorization,
 		},
+		{
+			name: "RotateAuthorization",
+			fn:   RotateAuthorization,
+		},
+		{
+			name: "SetAuthorizationLastUsed",
+			fn:   SetAuthorizationLastUsed,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -147,7 +156,6 @@ func CreateAuthorization(
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "supersecret",
 						Permissions: allUsersPermission(),
 						Description: "already existing auth",
 					},
@@ -155,7 +163,6 @@ func CreateAuthorization(
 						ID:          MustIDBase16(authTwoID),
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
-						Token:       "rand",
 						Status:      platform.Active,
 						Permissions: createUsersPermission(),
 						Description: "new auth",
@@ -209,14 +216,12 @@ func CreateAuthorization(
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "supersecret",
 						Permissions: allUsersPermission(),
 					},
 					{
 						ID:          MustIDBase16(authTwoID),
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
-						Token:       "rand",
 						Status:      platform.Active,
 						Permissions: createUsersPermission(),
 					},
@@ -270,7 +275,6 @@ func CreateAuthorization(
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "supersecret",
 						Permissions: allUsersPermission(),
 						Description: "already existing auth",
 					},
@@ -325,7 +329,6 @@ func CreateAuthorization(
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "supersecret",
 						Permissions: allUsersPermission(),
 						Description: "already existing auth",
 					},
@@ -346,6 +349,9 @@ func CreateAuthorization(
 			}
 
 			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+			if err == nil && tt.args.authorization.Token == "" {
+				t.Errorf("expected the token of the created authorization to be set")
+			}
 
 			defer s.DeleteAuthorization(ctx, tt.args.authorization.ID)
 
@@ -424,7 +430,6 @@ func FindAuthorizationByID(
 					UserID:      MustIDBase16(userTwoID),
 					OrgID:       MustIDBase16(orgOneID),
 					Status:      platform.Active,
-					Token:       "rand2",
 					Permissions: createUsersPermission(),
 				},
 			},
@@ -530,7 +535,6 @@ func UpdateAuthorizationStatus(
 					ID:          MustIDBase16(authTwoID),
 					UserID:      MustIDBase16(userTwoID),
 					OrgID:       MustIDBase16(orgOneID),
-					Token:       "rand2",
 					Permissions: createUsersPermission(),
 					Status:      platform.Inactive,
 				},
@@ -856,7 +860,6 @@ func FindAuthorizations(
 						ID:          MustIDBase16(authOneID),
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
-						Token:       "rand1",
 						Status:      platform.Active,
 						Permissions: allUsersPermission(),
 					},
@@ -864,7 +867,6 @@ func FindAuthorizations(
 						ID:          MustIDBase16(authTwoID),
 						UserID:      MustIDBase16(userTwoID),
 						OrgID:       MustIDBase16(orgOneID),
-						Token:       "rand2",
 						Status:      platform.Active,
 						Permissions: createUsersPermission(),
 					},
@@ -925,7 +927,6 @@ func FindAuthorizations(
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "rand1",
 						Permissions: allUsersPermission(),
 					},
 					{
@@ -933,7 +934,6 @@ func FindAuthorizations(
 						UserID:      MustIDBase16(userOneID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "rand3",
 						Permissions: deleteUsersPermission(),
 					},
 				},
@@ -1098,7 +1098,6 @@ func DeleteAuthorization(
 						UserID:      MustIDBase16(userTwoID),
 						OrgID:       MustIDBase16(orgOneID),
 						Status:      platform.Active,
-						Token:       "rand2",
 						Permissions: createUsersPermission(),
 					},
 				},
@@ -1153,7 +1152,6 @@ func DeleteAuthorization(
 					{
 						ID:          MustIDBase16(authOneID),
 						UserID:      MustIDBase16(userOneID),
-						Token:       "rand1",
 						Status:      platform.Active,
 						OrgID:       MustIDBase16(orgOneID),
 						Permissions: allUsersPermission(),
@@ -1162,7 +1160,6 @@ func DeleteAuthorization(
 						ID:          MustIDBase16(authTwoID),
 						UserID:      MustIDBase16(userTwoID),
 						OrgID:       MustIDBase16(orgOneID),
-						Token:       "rand2",
 						Status:      platform.Active,
 						Permissions: createUsersPermission(),
 					},
@@ -1191,6 +1188,277 @@ func DeleteAuthorization(
 	}
 }
 
+// RotateAuthorization testing
+func RotateAuthorization(
+	init func(AuthorizationFields, *testing.T) (platform.AuthorizationService, string, func()),
+	t *testing.T,
+) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastUsedAt := time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		id        platform.ID
+		expiresAt *time.Time
+	}
+	type wants struct {
+		err           error
+		authorization *platform.Authorization
+	}
+	tests := []struct {
+		name   string
+		fields AuthorizationFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "rotate token with expiration",
+			fields: AuthorizationFields{
+				TokenGenerator: mock.NewTokenGenerator("rotated", nil),
+				Users: []*platform.User{
+					{
+						Name: "cooluser",
+						ID:   MustIDBase16(userOneID),
+					},
+				},
+				Orgs: []*platform.Organization{
+					{
+						Name: "o1",
+						ID:   MustIDBase16(orgOneID),
+					},
+				},
+				Authorizations: []*platform.Authorization{
+					{
+						ID:          MustIDBase16(authOneID),
+						UserID:      MustIDBase16(userOneID),
+						OrgID:       MustIDBase16(orgOneID),
+						Token:       "rand1",
+						Status:      platform.Active,
+						Permissions: allUsersPermission(),
+						LastUsedAt:  &lastUsedAt,
+					},
+					{
+						ID:          MustIDBase16(authTwoID),
+						UserID:      MustIDBase16(userOneID),
+						OrgID:       MustIDBase16(orgOneID),
+						Token:       "rand2",
+						Status:      platform.Active,
+						Permissions: createUsersPermission(),
+					},
+				},
+			},
+			args: args{
+				id:        MustIDBase16(authOneID),
+				expiresAt: &expiresAt,
+			},
+			wants: wants{
+				authorization: &platform.Authorization{
+					ID:          MustIDBase16(authOneID),
+					UserID:      MustIDBase16(userOneID),
+					OrgID:       MustIDBase16(orgOneID),
+					Token:       "rotated",
+					Status:      platform.Active,
+					Permissions: allUsersPermission(),
+					ExpiresAt:   &expiresAt,
+				},
+			},
+		},
+		{
+			name: "rotate token without expiration",
+			fields: AuthorizationFields{
+				TokenGenerator: mock.NewTokenGenerator("rotated", nil),
+				Users: []*platform.User{
+					{
+						Name: "cooluser",
+						ID:   MustIDBase16(userOneID),
+					},
+				},
+				Orgs: []*platform.Organization{
+					{
+						Name: "o1",
+						ID:   MustIDBase16(orgOneID),
+					},
+				},
+				Authorizations: []*platform.Authorization{
+					{
+						ID:          MustIDBase16(authOneID),
+						UserID:      MustIDBase16(userOneID),
+						OrgID:       MustIDBase16(orgOneID),
+						Token:       "rand1",
+						Status:      platform.Inactive,
+						Permissions: allUsersPermission(),
+						ExpiresAt:   &lastUsedAt,
+					},
+				},
+			},
+			args: args{
+				id: MustIDBase16(authOneID),
+			},
+			wants: wants{
+				authorization: &platform.Authorization{
+					ID:          MustIDBase16(authOneID),
+					UserID:      MustIDBase16(userOneID),
+					OrgID:       MustIDBase16(orgOneID),
+					Token:       "rotated",
+					Status:      platform.Inactive,
+					Permissions: allUsersPermission(),
+				},
+			},
+		},
+		{
+			name: "rotate with id not found",
+			fields: AuthorizationFields{
+				TokenGenerator: mock.NewTokenGenerator("rotated", nil),
+				Authorizations: []*platform.Authorization{
+					{
+						ID:          MustIDBase16(authOneID),
+						UserID:      MustIDBase16(userOneID),
+						OrgID:       MustIDBase16(orgOneID),
+						Token:       "rand1",
+						Permissions: allUsersPermission(),
+					},
+				},
+			},
+			args: args{
+				id: MustIDBase16(authThreeID),
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Op:   platform.OpRotateAuthorization,
+					Msg:  "authorization not found",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			authorization, err := s.RotateAuthorization(ctx, tt.args.id, tt.args.expiresAt)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+			if tt.wants.err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(authorization, tt.wants.authorization, authorizationCmpOptions...); diff != "" {
+				t.Errorf("authorization is different -got/+want\ndiff %s", diff)
+			}
+
+			found, err := s.FindAuthorizationByToken(ctx, tt.wants.authorization.Token)
+			if err != nil {
+				t.Fatalf("failed to find authorization by rotated token: %v", err)
+			}
+			if diff := cmp.Diff(found, tt.wants.authorization, authorizationCmpOptions...); diff != "" {
+				t.Errorf("authorization found by rotated token is different -got/+want\ndiff %s", diff)
+			}
+
+			if _, err := s.FindAuthorizationByToken(ctx, "rand1"); platform.ErrorCode(err) != platform.ENotFound {
+				t.Errorf("expected previous token to be not found, got %v", err)
+			}
+		})
+	}
+}
+
+// SetAuthorizationLastUsed testing
+func SetAuthorizationLastUsed(
+	init func(AuthorizationFields, *testing.T) (platform.AuthorizationService, string, func()),
+	t *testing.T,
+) {
+	lastUsedAt := time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		id platform.ID
+		at time.Time
+	}
+	type wants struct {
+		err           error
+		authorization *platform.Authorization
+	}
+	tests := []struct {
+		name   string
+		fields AuthorizationFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "set last used",
+			fields: AuthorizationFields{
+				Authorizations: []*platform.Authorization{
+					{
+						ID:          MustIDBase16(authOneID),
+						UserID:      MustIDBase16(userOneID),
+						OrgID:       MustIDBase16(orgOneID),
+						Token:       "rand1",
+						Status:      platform.Active,
+						Permissions: allUsersPermission(),
+					},
+				},
+			},
+			args: args{
+				id: MustIDBase16(authOneID),
+				at: lastUsedAt,
+			},
+			wants: wants{
+				authorization: &platform.Authorization{
+					ID:          MustIDBase16(authOneID),
+					UserID:      MustIDBase16(userOneID),
+					OrgID:       MustIDBase16(orgOneID),
+					Status:      platform.Active,
+					Permissions: allUsersPermission(),
+					LastUsedAt:  &lastUsedAt,
+				},
+			},
+		},
+		{
+			name: "set last used with id not found",
+			fields: AuthorizationFields{
+				Authorizations: []*platform.Authorization{
+					{
+						ID:          MustIDBase16(authOneID),
+						UserID:      MustIDBase16(userOneID),
+						OrgID:       MustIDBase16(orgOneID),
+						Token:       "rand1",
+						Permissions: allUsersPermission(),
+					},
+				},
+			},
+			args: args{
+				id: MustIDBase16(authThreeID),
+				at: lastUsedAt,
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Op:   platform.OpSetAuthorizationLastUsed,
+					Msg:  "authorization not found",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			err := s.SetAuthorizationLastUsed(ctx, tt.args.id, tt.args.at)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			if tt.wants.err == nil {
+				authorization, err := s.FindAuthorizationByID(ctx, tt.args.id)
+				if err != nil {
+					t.Errorf("%s failed, got error %s", tt.name, err.Error())
+				}
+				if diff := cmp.Diff(authorization, tt.wants.authorization, authorizationCmpOptions...); diff != "" {
+					t.Errorf("authorization is different -got/+want\ndiff %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func allUsersPermission() []platform.Permission {
 	return []platform.Permission{
 		{Action: platform.WriteAction, Resource: platform.UsersResource},
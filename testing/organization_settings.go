@@ -0,0 +1,243 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+)
+
+// OrganizationSettingsFields will include the organizations and their settings.
+type OrganizationSettingsFields struct {
+	Organizations []*platform.Organization
+	Settings      []*platform.OrganizationSettings
+}
+
+// OrganizationSettingsService tests all the service functions.
+func OrganizationSettingsService(
+	init func(OrganizationSettingsFields, *testing.T) (platform.OrganizationSettingsService, string, func()), t *testing.T,
+) {
+	tests := []struct {
+		name string
+		fn   func(init func(OrganizationSettingsFields, *testing.T) (platform.OrganizationSettingsService, string, func()),
+			t *testing.T)
+	}{
+		{
+			name: "FindOrganizationSettings",
+			fn:   FindOrganizationSettings,
+		},
+		{
+			name: "PutOrganizationSettings",
+			fn:   PutOrganizationSettings,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(init, t)
+		})
+	}
+}
+
+// FindOrganizationSettings testing
+func FindOrganizationSettings(
+	init func(OrganizationSettingsFields, *testing.T) (platform.OrganizationSettingsService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		orgID platform.ID
+	}
+	type wants struct {
+		settings *platform.OrganizationSettings
+		err      error
+	}
+
+	tests := []struct {
+		name   string
+		fields OrganizationSettingsFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "find stored settings",
+			fields: OrganizationSettingsFields{
+				Organizations: []*platform.Organization{
+					{ID: MustIDBase16(orgOneID), Name: "org1"},
+				},
+				Settings: []*platform.OrganizationSettings{
+					{
+						OrganizationID: MustIDBase16(orgOneID),
+						QueryQuota: platform.QueryQuota{
+							MaxConcurrentQueries: 2,
+							MaxQueuedQueries:     4,
+							MaxMemoryBytes:       1 << 20,
+							MaxQueryDuration:     time.Minute,
+						},
+					},
+				},
+			},
+			args: args{
+				orgID: MustIDBase16(orgOneID),
+			},
+			wants: wants{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+					QueryQuota: platform.QueryQuota{
+						MaxConcurrentQueries: 2,
+						MaxQueuedQueries:     4,
+						MaxMemoryBytes:       1 << 20,
+						MaxQueryDuration:     time.Minute,
+					},
+				},
+			},
+		},
+		{
+			name: "organization without settings has no quota",
+			fields: OrganizationSettingsFields{
+				Organizations: []*platform.Organization{
+					{ID: MustIDBase16(orgOneID), Name: "org1"},
+				},
+			},
+			args: args{
+				orgID: MustIDBase16(orgOneID),
+			},
+			wants: wants{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			settings, err := s.FindOrganizationSettings(ctx, tt.args.orgID)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			if diff := cmp.Diff(settings, tt.wants.settings); diff != "" {
+				t.Errorf("organization settings are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
+
+// PutOrganizationSettings testing
+func PutOrganizationSettings(
+	init func(OrganizationSettingsFields, *testing.T) (platform.OrganizationSettingsService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		settings *platform.OrganizationSettings
+	}
+	type wants struct {
+		settings *platform.OrganizationSettings
+		err      error
+	}
+
+	tests := []struct {
+		name   string
+		fields OrganizationSettingsFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "replace settings",
+			fields: OrganizationSettingsFields{
+				Organizations: []*platform.Organization{
+					{ID: MustIDBase16(orgOneID), Name: "org1"},
+				},
+				Settings: []*platform.OrganizationSettings{
+					{
+						OrganizationID: MustIDBase16(orgOneID),
+						QueryQuota: platform.QueryQuota{
+							MaxConcurrentQueries: 2,
+							MaxQueuedQueries:     4,
+						},
+					},
+				},
+			},
+			args: args{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+					QueryQuota: platform.QueryQuota{
+						MaxMemoryBytes: 1 << 20,
+					},
+				},
+			},
+			wants: wants{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+					QueryQuota: platform.QueryQuota{
+						MaxMemoryBytes: 1 << 20,
+					},
+				},
+			},
+		},
+		{
+			name: "negative limit",
+			fields: OrganizationSettingsFields{
+				Organizations: []*platform.Organization{
+					{ID: MustIDBase16(orgOneID), Name: "org1"},
+				},
+			},
+			args: args{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+					QueryQuota: platform.QueryQuota{
+						MaxConcurrentQueries: -1,
+					},
+				},
+			},
+			wants: wants{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+				},
+				err: &platform.Error{
+					Code: platform.EInvalid,
+					Msg:  "query quota limits must not be negative",
+				},
+			},
+		},
+		{
+			name: "missing organization",
+			args: args{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+				},
+			},
+			wants: wants{
+				settings: &platform.OrganizationSettings{
+					OrganizationID: MustIDBase16(orgOneID),
+				},
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Msg:  "organization not found",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			err := s.PutOrganizationSettings(ctx, tt.args.settings)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			settings, err := s.FindOrganizationSettings(ctx, tt.args.settings.OrganizationID)
+			if err != nil {
+				t.Fatalf("failed to retrieve organization settings: %v", err)
+			}
+			if diff := cmp.Diff(settings, tt.wants.settings); diff != "" {
+				t.Errorf("organization settings are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+)
+
+// TargetStatusFields will include the targets and their statuses.
+type TargetStatusFields struct {
+	Targets  []*platform.ScraperTarget
+	Statuses []*platform.ScraperTargetStatus
+}
+
+// ScraperTargetStatusService tests all the service functions.
+func ScraperTargetStatusService(
+	init func(TargetStatusFields, *testing.T) (platform.ScraperTargetStatusService, string, func()), t *testing.T,
+) {
+	tests := []struct {
+		name string
+		fn   func(init func(TargetStatusFields, *testing.T) (platform.ScraperTargetStatusService, string, func()),
+			t *testing.T)
+	}{
+		{
+			name: "FindTargetStatus",
+			fn:   FindTargetStatus,
+		},
+		{
+			name: "PutTargetStatus",
+			fn:   PutTargetStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(init, t)
+		})
+	}
+}
+
+// FindTargetStatus testing
+func FindTargetStatus(
+	init func(TargetStatusFields, *testing.T) (platform.ScraperTargetStatusService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		id platform.ID
+	}
+	type wants struct {
+		status *platform.ScraperTargetStatus
+		err    error
+	}
+
+	tests := []struct {
+		name   string
+		fields TargetStatusFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "find stored status",
+			fields: TargetStatusFields{
+				Targets: []*platform.ScraperTarget{
+					{ID: MustIDBase16(targetOneID), Name: "target1"},
+				},
+				Statuses: []*platform.ScraperTargetStatus{
+					{
+						TargetID:   MustIDBase16(targetOneID),
+						LastScrape: time.Date(2018, 12, 1, 10, 0, 0, 0, time.UTC),
+						Duration:   20 * time.Millisecond,
+						Samples:    42,
+					},
+				},
+			},
+			args: args{
+				id: MustIDBase16(targetOneID),
+			},
+			wants: wants{
+				status: &platform.ScraperTargetStatus{
+					TargetID:   MustIDBase16(targetOneID),
+					LastScrape: time.Date(2018, 12, 1, 10, 0, 0, 0, time.UTC),
+					Duration:   20 * time.Millisecond,
+					Samples:    42,
+				},
+			},
+		},
+		{
+			name: "target not scraped yet",
+			fields: TargetStatusFields{
+				Targets: []*platform.ScraperTarget{
+					{ID: MustIDBase16(targetOneID), Name: "target1"},
+				},
+			},
+			args: args{
+				id: MustIDBase16(targetOneID),
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Msg:  "scraper target status is not found",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			status, err := s.FindTargetStatus(ctx, tt.args.id)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			if diff := cmp.Diff(status, tt.wants.status); diff != "" {
+				t.Errorf("scraper target statuses are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
+
+// PutTargetStatus testing
+func PutTargetStatus(
+	init func(TargetStatusFields, *testing.T) (platform.ScraperTargetStatusService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		status *platform.ScraperTargetStatus
+	}
+	type wants struct {
+		status *platform.ScraperTargetStatus
+		err    error
+	}
+
+	tests := []struct {
+		name   string
+		fields TargetStatusFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "replace status",
+			fields: TargetStatusFields{
+				Targets: []*platform.ScraperTarget{
+					{ID: MustIDBase16(targetOneID), Name: "target1"},
+				},
+				Statuses: []*platform.ScraperTargetStatus{
+					{
+						TargetID:   MustIDBase16(targetOneID),
+						LastScrape: time.Date(2018, 12, 1, 10, 0, 0, 0, time.UTC),
+						Duration:   20 * time.Millisecond,
+						Samples:    42,
+					},
+				},
+			},
+			args: args{
+				status: &platform.ScraperTargetStatus{
+					TargetID:   MustIDBase16(targetOneID),
+					LastScrape: time.Date(2018, 12, 1, 10, 1, 0, 0, time.UTC),
+					Duration:   time.Second,
+					LastError:  "connection refused",
+				},
+			},
+			wants: wants{
+				status: &platform.ScraperTargetStatus{
+					TargetID:   MustIDBase16(targetOneID),
+					LastScrape: time.Date(2018, 12, 1, 10, 1, 0, 0, time.UTC),
+					Duration:   time.Second,
+					LastError:  "connection refused",
+				},
+			},
+		},
+		{
+			name: "missing target",
+			args: args{
+				status: &platform.ScraperTargetStatus{
+					TargetID:   MustIDBase16(targetOneID),
+					LastScrape: time.Date(2018, 12, 1, 10, 1, 0, 0, time.UTC),
+				},
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Msg:  "scraper target is not found",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			err := s.PutTargetStatus(ctx, tt.args.status)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			status, _ := s.FindTargetStatus(ctx, tt.args.status.TargetID)
+			if diff := cmp.Diff(status, tt.wants.status); diff != "" {
+				t.Errorf("scraper target statuses are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
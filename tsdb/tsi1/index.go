@@ -228,6 +228,9 @@ func (i *Index) Open() error {
 	}
 	mmu.Unlock()
 
+	// Reset the cache, which may hold series id sets from a previous open.
+	i.tagValueCache = NewTagValueSeriesIDCache(i.config.SeriesIDSetCacheSize)
+
 	// Set the correct shared metrics on the cache
 	i.tagValueCache.tracker = newCacheTracker(cms, i.defaultLabels)
 	i.tagValueCache.tracker.enabled = i.metricsEnabled
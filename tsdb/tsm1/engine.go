@@ -2,6 +2,7 @@
 package tsm1 // import "github.com/influxdata/platform/tsdb/tsm1"
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/influxdata/platform/pkg/bytesutil"
 	"github.com/influxdata/platform/pkg/limiter"
 	"github.com/influxdata/platform/pkg/metrics"
+	ptar "github.com/influxdata/platform/pkg/tar"
 	"github.com/influxdata/platform/query"
 	"github.com/influxdata/platform/tsdb"
 	"github.com/influxdata/platform/tsdb/tsi1"
@@ -240,6 +242,11 @@ func (e *Engine) WithParseFileNameFunc(parseFileNameFunc ParseFileNameFunc) {
 	e.Compactor.WithParseFileNameFunc(parseFileNameFunc)
 }
 
+// WithNow sets the function returning the time new TSM files are written at.
+func (e *Engine) WithNow(now func() time.Time) {
+	e.FileStore.WithNow(now)
+}
+
 func (e *Engine) WithFileStoreObserver(obs FileStoreObserver) {
 	e.FileStore.WithObserver(obs)
 }
@@ -1021,8 +1028,48 @@ func (e *Engine) CreateSeriesListIfNotExists(collection *tsdb.SeriesCollection)
 	return e.index.CreateSeriesListIfNotExists(collection)
 }
 
-// WriteTo is not implemented.
-func (e *Engine) WriteTo(w io.Writer) (n int64, err error) { panic("not implemented") }
+// CreateSnapshot flushes the cache to disk and creates hardlinks to all TSM and
+// tombstone files in a new temporary directory. The path of the directory is
+// returned and it is the caller's responsibility to remove it.
+func (e *Engine) CreateSnapshot() (string, error) {
+	if err := e.WriteSnapshot(); err != nil {
+		return "", err
+	}
+	return e.FileStore.CreateSnapshot()
+}
+
+// WriteTo writes a tar archive of a point-in-time snapshot of the engine's TSM
+// and tombstone files to w.
+func (e *Engine) WriteTo(w io.Writer) (n int64, err error) {
+	path, err := e.CreateSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(path)
+
+	cw := &countingWriter{w: w}
+	tw := tar.NewWriter(cw)
+	if err := ptar.Stream(tw, path, "", nil); err != nil {
+		return cw.n, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return cw.n, err
+	}
+	return cw.n, nil
+}
+
+// countingWriter counts the number of bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
 
 // compactionLevel describes a snapshot or levelled compaction.
 type compactionLevel int
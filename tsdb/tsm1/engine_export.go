@@ -0,0 +1,303 @@
+package tsm1
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/influxdata/platform/models"
+	ptar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/tsdb"
+)
+
+// ExportPrefix writes the values of every key beginning with prefix held by
+// the TSM files holding values written at or after since, a unix nanosecond
+// time, to tw as TSM files beneath relativePath. A since of math.MinInt64
+// exports every file.
+//
+// The cache is snapshotted first so that every value, including those in the
+// WAL, is held in a TSM file. Files are selected by when their values were
+// written, not by the timestamps of the values, so that backfilled writes are
+// exported, while files compacted only from older files are not. One file is
+// exported for each selected file holding values of the prefix, with deleted
+// values removed, and named after it so that the relative order of the files
+// is preserved by ImportPrefix. Deletes are not exported, as the files a
+// delete was applied to may since have been compacted; callers must record
+// them separately.
+func (e *Engine) ExportPrefix(tw *tar.Writer, relativePath string, prefix []byte, since int64) error {
+	if err := e.WriteSnapshot(); err != nil {
+		return err
+	}
+
+	files, err := e.FileStore.modifiedFiles(since)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, f := range files {
+			f.Unref()
+		}
+	}()
+
+	dir, err := ioutil.TempDir("", "influxd-export")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	for _, f := range files {
+		path := filepath.Join(dir, filepath.Base(f.Path()))
+		if err := exportFile(f, path, prefix); err != nil {
+			return err
+		}
+	}
+	return ptar.Stream(tw, dir, relativePath, nil)
+}
+
+// exportFile writes the values of the keys in r beginning with prefix to a new
+// TSM file at path. No file is written if r holds no such values.
+func exportFile(r TSMFile, path string, prefix []byte) error {
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+
+	w, err := NewTSMWriter(fd)
+	if err != nil {
+		fd.Close()
+		return err
+	}
+
+	var (
+		n          int
+		values     Values
+		tombstones []TimeRange
+	)
+
+	iter := r.Iterator(prefix)
+	for iter.Next() {
+		key := iter.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+
+		tombstones = r.TombstoneRange(key, tombstones[:0])
+		for _, entry := range iter.Entries() {
+			if values, err = r.ReadAt(&entry, values[:0]); err != nil {
+				w.Remove()
+				return err
+			}
+
+			for _, ts := range tombstones {
+				values = values.Exclude(ts.Min, ts.Max)
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			if err := w.Write(key, values); err != nil {
+				w.Remove()
+				return err
+			}
+			n++
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		w.Remove()
+		return err
+	} else if n == 0 {
+		return w.Remove()
+	}
+
+	if err := w.WriteIndex(); err != nil {
+		w.Remove()
+		return err
+	}
+	return w.Close()
+}
+
+// ImportPrefix imports the TSM files in dir, as written by ExportPrefix, for
+// the keys beginning with prefix. The imported values are added to the
+// existing ones, taking precedence over existing values with the same
+// timestamps.
+//
+// The files are validated and staged in the engine's directory before
+// deletes is called, so that a failed import leaves the existing data in
+// place. deletes, if not nil, deletes the existing values the import
+// replaces, such as every value of the prefix or the values deleted since
+// the export the files were taken after. The series of every imported key
+// are then added to the index, and the staged files are added to the engine
+// in the order of their names.
+func (e *Engine) ImportPrefix(dir string, prefix []byte, deletes func() error) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*."+TSMFileExtension))
+	if err != nil {
+		return err
+	}
+
+	collection := &tsdb.SeriesCollection{}
+	seen := make(map[string]struct{})
+	for _, path := range paths {
+		if err := collectFileSeries(path, prefix, collection, seen); err != nil {
+			return err
+		}
+	}
+
+	staged, err := e.stageFiles(paths)
+	if err != nil {
+		return err
+	}
+
+	if deletes != nil {
+		if err := deletes(); err != nil {
+			removeFiles(staged)
+			return err
+		}
+	}
+
+	if len(staged) == 0 {
+		return nil
+	}
+
+	if err := e.index.CreateSeriesListIfNotExists(collection); err != nil {
+		removeFiles(staged)
+		return err
+	} else if err := collection.PartialWriteError(); err != nil {
+		removeFiles(staged)
+		return err
+	}
+
+	if err := e.FileStore.Replace(nil, staged); err != nil {
+		removeFiles(staged)
+		return err
+	}
+	return nil
+}
+
+// stageFiles moves the TSM files at paths into the engine's directory as
+// temporary files of new generations, and returns their new paths. Any file
+// already moved is removed if a later file fails to move.
+func (e *Engine) stageFiles(paths []string) ([]string, error) {
+	staged := make([]string, 0, len(paths))
+	for _, path := range paths {
+		newPath := filepath.Join(e.path, e.formatFileName(e.FileStore.NextGeneration(), 1)+"."+TSMFileExtension+"."+TmpTSMFileExtension)
+		if err := moveFile(path, newPath); err != nil {
+			removeFiles(staged)
+			return nil, err
+		}
+		staged = append(staged, newPath)
+
+		// Stats files are optional, so only move them if they exist.
+		if err := moveFile(StatsFilename(path), StatsFilename(newPath)); err != nil && !os.IsNotExist(err) {
+			removeFiles(staged)
+			return nil, err
+		}
+	}
+	return staged, nil
+}
+
+// removeFiles removes the staged TSM files at paths and their stats files.
+func removeFiles(paths []string) {
+	for _, path := range paths {
+		os.Remove(path)
+		os.Remove(StatsFilename(path))
+	}
+}
+
+// collectFileSeries adds the series of every key in the TSM file at path to
+// collection, skipping those in seen. An error is returned if the file holds
+// a key not beginning with prefix.
+func collectFileSeries(path string, prefix []byte, collection *tsdb.SeriesCollection, seen map[string]struct{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	r, err := NewTSMReader(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	defer r.Close()
+
+	iter := r.Iterator(nil)
+	for iter.Next() {
+		if !bytes.HasPrefix(iter.Key(), prefix) {
+			return fmt.Errorf("tsm file %s holds keys outside of the imported prefix", filepath.Base(path))
+		}
+
+		typ, err := blockTypeFieldType(iter.Type())
+		if err != nil {
+			return err
+		}
+
+		// The key must be copied as the file is unmapped once it is closed.
+		seriesKey, _ := SeriesAndFieldFromCompositeKey(iter.Key())
+		if _, ok := seen[string(seriesKey)]; ok {
+			continue
+		}
+		seriesKey = append([]byte(nil), seriesKey...)
+		seen[string(seriesKey)] = struct{}{}
+
+		name, tags := models.ParseKeyBytes(seriesKey)
+		collection.Keys = append(collection.Keys, seriesKey)
+		collection.Names = append(collection.Names, name)
+		collection.Tags = append(collection.Tags, tags)
+		collection.Types = append(collection.Types, typ)
+	}
+	return iter.Err()
+}
+
+// blockTypeFieldType returns the field type of the values held by blocks of typ.
+func blockTypeFieldType(typ byte) (models.FieldType, error) {
+	switch typ {
+	case BlockFloat64:
+		return models.Float, nil
+	case BlockInteger:
+		return models.Integer, nil
+	case BlockUnsigned:
+		return models.Unsigned, nil
+	case BlockBoolean:
+		return models.Boolean, nil
+	case BlockString:
+		return models.String, nil
+	default:
+		return models.Empty, fmt.Errorf("unknown block type: %d", typ)
+	}
+}
+
+// moveFile renames src to dst, falling back to a copy when they are on
+// different devices.
+func moveFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	} else if err := out.Sync(); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	} else if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
@@ -0,0 +1,298 @@
+package tsm1_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform/models"
+	ptar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/cursors"
+	"github.com/influxdata/platform/tsdb/tsm1"
+)
+
+func TestEngine_ExportImportPrefix(t *testing.T) {
+	e := MustOpenEngine()
+	defer e.Close()
+
+	if err := e.WritePointsString(
+		"cpu,host=A value=1 1",
+		"cpu,host=A value=2 2",
+		"cpu,host=A value=3 3",
+		"cpu,host=A value=4 4",
+		"mem,host=A value=1 3",
+	); err != nil {
+		t.Fatal(err)
+	}
+	e.MustWriteSnapshot()
+
+	// Values held by the cache must be exported, deleted values must not.
+	if err := e.WritePointsString("cpu,host=A value=5 5"); err != nil {
+		t.Fatal(err)
+	} else if err := e.DeletePrefix([]byte("cpu"), 4, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := e.MustExportPrefix("cpu", math.MinInt64)
+
+	// A full import replaces every value of the prefix and keeps the others.
+	if err := e.WritePointsString(
+		"cpu,host=A value=0 1",
+		"cpu,host=A value=6 6",
+		"cpu,host=B value=1 7",
+		"mem,host=A value=2 4",
+	); err != nil {
+		t.Fatal(err)
+	}
+	e.MustWriteSnapshot()
+
+	e.MustImportPrefix(buf, "cpu", true)
+
+	if got, exp := e.MustReadFloats("cpu", map[string]string{"host": "A"}), map[int64]float64{1: 1, 2: 2, 3: 3, 5: 5}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected cpu,host=A values: got %v, exp %v", got, exp)
+	}
+	if got := e.MustReadFloats("cpu", map[string]string{"host": "B"}); len(got) != 0 {
+		t.Fatalf("unexpected cpu,host=B values: %v", got)
+	}
+	if got, exp := e.MustReadFloats("mem", map[string]string{"host": "A"}), map[int64]float64{3: 1, 4: 2}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected mem,host=A values: got %v, exp %v", got, exp)
+	}
+
+	// The imported data must survive a reopen.
+	if err := e.Reopen(); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := e.MustReadFloats("cpu", map[string]string{"host": "A"}), map[int64]float64{1: 1, 2: 2, 3: 3, 5: 5}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected cpu,host=A values after reopen: got %v, exp %v", got, exp)
+	}
+}
+
+func TestEngine_ExportImportPrefix_Since(t *testing.T) {
+	src := MustOpenEngine()
+	defer src.Close()
+
+	now := time.Unix(0, 0)
+	src.WithNow(func() time.Time { return now })
+
+	if err := src.WritePointsString(
+		"cpu,host=A value=1 10",
+		"cpu,host=A value=2 20",
+		"cpu,host=B value=1 10",
+	); err != nil {
+		t.Fatal(err)
+	}
+	full := src.MustExportPrefix("cpu", math.MinInt64)
+
+	now = now.Add(time.Minute)
+	since := now.UnixNano()
+
+	// Backfilled values written after since must be held by the incremental
+	// export, values written before it must not.
+	if err := src.WritePointsString(
+		"cpu,host=A value=0 5",
+		"cpu,host=A value=3 30",
+	); err != nil {
+		t.Fatal(err)
+	}
+	incr := src.MustExportPrefix("cpu", since)
+
+	dst := MustOpenEngine()
+	defer dst.Close()
+
+	dst.MustImportPrefix(incr, "cpu", false)
+	if got, exp := dst.MustReadFloats("cpu", map[string]string{"host": "A"}), map[int64]float64{5: 0, 30: 3}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected incremental cpu,host=A values: got %v, exp %v", got, exp)
+	}
+
+	dst.MustImportPrefix(full, "cpu", true)
+	dst.MustImportPrefix(incr, "cpu", false)
+	for _, host := range []string{"A", "B"} {
+		tags := map[string]string{"host": host}
+		if got, exp := dst.MustReadFloats("cpu", tags), src.MustReadFloats("cpu", tags); !reflect.DeepEqual(got, exp) {
+			t.Errorf("unexpected cpu,host=%s values: got %v, exp %v", host, got, exp)
+		}
+	}
+}
+
+func TestEngine_ExportPrefix_Compacted(t *testing.T) {
+	e := MustOpenEngine()
+	defer e.Close()
+
+	now := time.Unix(0, 0)
+	e.WithNow(func() time.Time { return now })
+
+	for _, p := range []string{"cpu,host=A value=1 10", "cpu,host=A value=2 20"} {
+		if err := e.WritePointsString(p); err != nil {
+			t.Fatal(err)
+		}
+		e.MustWriteSnapshot()
+	}
+
+	now = now.Add(time.Minute)
+	since := now.UnixNano()
+
+	// Files compacted only from files written before since hold no values
+	// written after it, so they must not be exported again.
+	var paths []string
+	for _, f := range e.FileStore.Files() {
+		paths = append(paths, f.Path())
+	}
+
+	c := tsm1.NewCompactor()
+	c.Dir = e.Path()
+	c.FileStore = e.FileStore
+	c.Open()
+	defer c.Close()
+
+	files, err := c.CompactFull(paths)
+	if err != nil {
+		t.Fatal(err)
+	} else if err := e.FileStore.Replace(paths, files); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := countTSMFiles(e.MustExportPrefix("cpu", since)); n != 0 {
+		t.Fatalf("unexpected number of exported files: got %d, exp 0", n)
+	}
+
+	// Files holding values written after since must be exported.
+	if err := e.WritePointsString("cpu,host=A value=3 5"); err != nil {
+		t.Fatal(err)
+	}
+	if n := countTSMFiles(e.MustExportPrefix("cpu", since)); n != 1 {
+		t.Fatalf("unexpected number of exported files: got %d, exp 1", n)
+	}
+}
+
+func TestEngine_ImportPrefix_InvalidKey(t *testing.T) {
+	e := MustOpenEngine()
+	defer e.Close()
+
+	if err := e.WritePointsString("mem,host=A value=1 3"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := e.ExportPrefix(tw, "data", []byte("mem"), math.MinInt64); err != nil {
+		t.Fatal(err)
+	} else if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "tsm1-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ptar.Restore(&buf, dir); err != nil {
+		t.Fatal(err)
+	} else if err := e.ImportPrefix(filepath.Join(dir, "data"), []byte("cpu"), func() error {
+		return e.DeletePrefix([]byte("mem"), math.MinInt64, math.MaxInt64)
+	}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	// Nothing must have been deleted.
+	if got, exp := e.MustReadFloats("mem", map[string]string{"host": "A"}), map[int64]float64{3: 1}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected mem,host=A values: got %v, exp %v", got, exp)
+	}
+}
+
+// MustExportPrefix returns an archive of the values of prefix written at or after since.
+func (e *Engine) MustExportPrefix(prefix string, since int64) *bytes.Buffer {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := e.ExportPrefix(tw, "data", []byte(prefix), since); err != nil {
+		panic(err)
+	} else if err := tw.Close(); err != nil {
+		panic(err)
+	}
+	return &buf
+}
+
+// MustImportPrefix imports the values of prefix from an archive written by
+// MustExportPrefix, replacing every existing value of prefix if replace is true.
+func (e *Engine) MustImportPrefix(archive *bytes.Buffer, prefix string, replace bool) {
+	dir, err := ioutil.TempDir("", "tsm1-import")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ptar.Restore(bytes.NewReader(archive.Bytes()), dir); err != nil {
+		panic(err)
+	}
+
+	var deletes func() error
+	if replace {
+		deletes = func() error {
+			return e.DeletePrefix([]byte(prefix), math.MinInt64, math.MaxInt64)
+		}
+	}
+	if err := e.ImportPrefix(filepath.Join(dir, "data"), []byte(prefix), deletes); err != nil {
+		panic(err)
+	}
+}
+
+// countTSMFiles returns the number of TSM files in an archive written by MustExportPrefix.
+func countTSMFiles(archive *bytes.Buffer) int {
+	var n int
+	tr := tar.NewReader(bytes.NewReader(archive.Bytes()))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return n
+		} else if err != nil {
+			panic(err)
+		}
+		if strings.HasSuffix(hdr.Name, "."+tsm1.TSMFileExtension) {
+			n++
+		}
+	}
+}
+
+// MustReadFloats returns every value of the float field "value" of a series.
+func (e *Engine) MustReadFloats(name string, tags map[string]string) map[int64]float64 {
+	itr, err := e.CreateCursorIterator(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	cur, err := itr.Next(context.Background(), &tsdb.CursorRequest{
+		Name:      []byte(name),
+		Tags:      models.NewTags(tags),
+		Field:     "value",
+		Ascending: true,
+		StartTime: 0,
+		EndTime:   math.MaxInt64,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	values := make(map[int64]float64)
+	if cur == nil {
+		return values
+	}
+	defer cur.Close()
+
+	fcur := cur.(cursors.FloatArrayCursor)
+	for a := fcur.Next(); a.Len() > 0; a = fcur.Next() {
+		for i, ts := range a.Timestamps {
+			values[ts] = a.Values[i]
+		}
+	}
+	return values
+}
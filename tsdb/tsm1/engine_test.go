@@ -1,8 +1,10 @@
 package tsm1_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"os"
@@ -721,6 +723,52 @@ func TestEngine_SnapshotsDisabled(t *testing.T) {
 	}
 }
 
+func TestEngine_WriteTo(t *testing.T) {
+	e := MustOpenEngine()
+	defer e.Close()
+
+	if err := e.WritePointsString("cpu,host=A value=1.1 1000000000", "cpu,host=B value=1.2 2000000000"); err != nil {
+		t.Fatalf("failed to write points: %s", err.Error())
+	}
+
+	var buf bytes.Buffer
+	n, err := e.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("failed to write archive: %s", err.Error())
+	} else if got, exp := n, int64(buf.Len()); got != exp {
+		t.Fatalf("got %d bytes written, exp %d", got, exp)
+	}
+
+	// The cache must have been flushed into a TSM file and its stats file
+	// within the archive.
+	var names []string
+	tr := tar.NewReader(&buf)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, h.Name)
+	}
+
+	if got, exp := len(names), 2; got != exp {
+		t.Fatalf("got %d archive entries (%v), exp %d", got, names, exp)
+	} else if !strings.HasSuffix(names[0], "."+tsm1.TSMFileExtension) {
+		t.Fatalf("unexpected archive entry %q", names[0])
+	} else if got, exp := names[1], filepath.Base(tsm1.StatsFilename(names[0])); got != exp {
+		t.Fatalf("got archive entry %q, exp %q", got, exp)
+	}
+
+	// The temporary snapshot directory must have been removed.
+	if matches, err := filepath.Glob(filepath.Join(e.Path(), "*."+tsm1.TmpTSMFileExtension)); err != nil {
+		t.Fatal(err)
+	} else if len(matches) != 0 {
+		t.Fatalf("unexpected temporary files: %v", matches)
+	}
+}
+
 func TestEngine_ShouldCompactCache(t *testing.T) {
 	nowTime := time.Now()
 
@@ -206,6 +206,10 @@ type FileStore struct {
 	parseFileName ParseFileNameFunc
 
 	obs FileStoreObserver
+
+	// now returns the time new TSM files are written at. The modification time
+	// of each file is set to when the newest of its values was written.
+	now func() time.Time
 }
 
 // FileStat holds information about a TSM file on disk.
@@ -249,11 +253,17 @@ func NewFileStore(dir string) *FileStore {
 		obs:           noFileStoreObserver{},
 		parseFileName: DefaultParseFileName,
 		tracker:       newFileTracker(newFileMetrics(nil), nil),
+		now:           time.Now,
 	}
 	fs.purger.fileStore = fs
 	return fs
 }
 
+// WithNow sets the function returning the time new TSM files are written at.
+func (f *FileStore) WithNow(now func() time.Time) {
+	f.now = now
+}
+
 // WithObserver sets the observer for the file store.
 func (f *FileStore) WithObserver(obs FileStoreObserver) {
 	if obs == nil {
@@ -359,6 +369,41 @@ func (f *FileStore) Files() []TSMFile {
 	return f.files
 }
 
+// modifiedFiles returns the TSM files holding values written at or after
+// since, a unix nanosecond time. Each file is referenced so that it is not
+// closed out from under the caller, who must release it with Unref.
+func (f *FileStore) modifiedFiles(since int64) ([]TSMFile, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var files []TSMFile
+	for _, tsmf := range f.files {
+		written, err := writtenTime(tsmf.Path())
+		if err != nil {
+			for _, f := range files {
+				f.Unref()
+			}
+			return nil, err
+		}
+
+		if written.UnixNano() >= since {
+			tsmf.Ref()
+			files = append(files, tsmf)
+		}
+	}
+	return files, nil
+}
+
+// writtenTime returns the time the newest of the values of the TSM file at
+// path was written, which is held as its modification time.
+func writtenTime(path string) (time.Time, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
 // Free releases any resources held by the FileStore.  The resources will be re-acquired
 // if necessary if they are needed after freeing them.
 func (f *FileStore) Free() error {
@@ -770,6 +815,25 @@ func (f *FileStore) replace(oldFiles, newFiles []string, updatedFn func(r []TSMF
 	maxTime := f.lastModified
 	f.mu.RUnlock()
 
+	// New files are written now, unless they replace older files, such as
+	// when compacting. Compacted files keep the time of the newest values
+	// they hold so that incremental backups do not export them again.
+	written := f.now()
+	if len(oldFiles) > 0 {
+		written = time.Time{}
+		for _, file := range oldFiles {
+			t, err := writtenTime(file)
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			if t.After(written) {
+				written = t
+			}
+		}
+	}
+
 	updated := make([]TSMFile, 0, len(newFiles))
 	tsmTmpExt := fmt.Sprintf("%s.%s", TSMFileExtension, TmpTSMFileExtension)
 
@@ -802,6 +866,12 @@ func (f *FileStore) replace(oldFiles, newFiles []string, updatedFn func(r []TSMF
 			}
 		}
 
+		if !written.IsZero() {
+			if err := os.Chtimes(newName, written, written); err != nil {
+				return err
+			}
+		}
+
 		fd, err := os.Open(newName)
 		if err != nil {
 			return err
@@ -1112,7 +1182,7 @@ func (f *FileStore) locations(key []byte, t int64, ascending bool) []*location {
 	return locations
 }
 
-// CreateSnapshot creates hardlinks for all tsm and tombstone files
+// CreateSnapshot creates hardlinks for all tsm, tombstone and stats files
 // in the path provided.
 func (f *FileStore) CreateSnapshot() (string, error) {
 	f.traceLogger.Info("Creating snapshot", zap.String("dir", f.dir))
@@ -1152,6 +1222,13 @@ func (f *FileStore) CreateSnapshot() (string, error) {
 				return "", fmt.Errorf("error creating tombstone hard link: %q", err)
 			}
 		}
+
+		// Stats files are optional, so only link them if they exist.
+		statsPath := StatsFilename(tsmf.Path())
+		newpath = filepath.Join(tmpPath, filepath.Base(statsPath))
+		if err := os.Link(statsPath, newpath); err != nil && !os.IsNotExist(err) {
+			return "", fmt.Errorf("error creating stats hard link: %q", err)
+		}
 	}
 
 	return tmpPath, nil
@@ -85,10 +85,10 @@ type UserResourceMappingFilter struct {
 	UserType   UserType
 }
 
-var ownerActions = []Action{WriteAction, ReadAction}
 var memberActions = []Action{ReadAction}
 
 func (m *UserResourceMapping) ownerPerms() ([]Permission, error) {
+	ownerActions := resourceActions(m.Resource)
 	ps := make([]Permission, 0, len(ownerActions))
 	for _, a := range ownerActions {
 		p, err := NewPermissionAtID(m.ResourceID, a, m.Resource)
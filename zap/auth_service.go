@@ -2,6 +2,7 @@ package zap
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -81,3 +82,25 @@ func (s *AuthorizationService) SetAuthorizationStatus(ctx context.Context, id pl
 
 	return s.AuthorizationService.SetAuthorizationStatus(ctx, id, status)
 }
+
+// RotateAuthorization replaces an authorization's token and logs any errors.
+func (s *AuthorizationService) RotateAuthorization(ctx context.Context, id platform.ID, expiresAt *time.Time) (a *platform.Authorization, err error) {
+	defer func() {
+		if err != nil {
+			s.Logger.Info("error rotating authorization", zap.Error(err))
+		}
+	}()
+
+	return s.AuthorizationService.RotateAuthorization(ctx, id, expiresAt)
+}
+
+// SetAuthorizationLastUsed records the last time an authorization's token was used and logs any errors.
+func (s *AuthorizationService) SetAuthorizationLastUsed(ctx context.Context, id platform.ID, at time.Time) (err error) {
+	defer func() {
+		if err != nil {
+			s.Logger.Info("error updating authorization last used time", zap.Error(err))
+		}
+	}()
+
+	return s.AuthorizationService.SetAuthorizationLastUsed(ctx, id, at)
+}